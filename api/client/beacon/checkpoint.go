@@ -19,7 +19,7 @@ import (
 )
 
 // OriginData represents the BeaconState and SignedBeaconBlock necessary to start an empty Beacon Node
-// using Checkpoint Sync.
+// using Checkpoint Sync, along with the CoordinationData used to coordinate gwat from the checkpoint.
 type OriginData struct {
 	wsd *WeakSubjectivityData
 	sb  []byte
@@ -27,6 +27,7 @@ type OriginData struct {
 	st  state.BeaconState
 	b   block.SignedBeaconBlock
 	cf  *detect.VersionedUnmarshaler
+	cd  *CoordinationData
 }
 
 // CheckpointString returns the standard string representation of a Checkpoint for the block root and epoch for the
@@ -51,6 +52,21 @@ func (od *OriginData) SaveState(dir string) (string, error) {
 	return statePath, file.WriteFile(statePath, od.sb)
 }
 
+// SaveCoordinationData saves the downloaded gwat coordination data to a unique json file in the given path.
+// Returns an empty path if the remote node provided no coordination data.
+func (od *OriginData) SaveCoordinationData(dir string) (string, error) {
+	if od.cd == nil {
+		return "", nil
+	}
+	enc, err := od.cd.MarshalJSON()
+	if err != nil {
+		return "", errors.Wrap(err, "error encoding coordination data")
+	}
+	name := fmt.Sprintf("coordination_%s_%s_%d-%#x.json", od.cf.Config.ConfigName, version.String(od.cf.Fork), od.st.Slot(), od.wsd.BlockRoot)
+	cdPath := path.Join(dir, name)
+	return cdPath, file.WriteFile(cdPath, enc)
+}
+
 // StateBytes returns the ssz-encoded bytes of the downloaded BeaconState value.
 func (od *OriginData) StateBytes() []byte {
 	return od.sb
@@ -61,6 +77,12 @@ func (od *OriginData) BlockBytes() []byte {
 	return od.bb
 }
 
+// CoordinationData returns the gwat coordination data of the downloaded BeaconState,
+// or nil if the remote node does not serve it.
+func (od *OriginData) CoordinationData() *CoordinationData {
+	return od.cd
+}
+
 func fname(prefix string, cf *detect.VersionedUnmarshaler, slot types.Slot, root [32]byte) string {
 	return fmt.Sprintf("%s_%s_%s_%d-%#x.ssz", prefix, cf.Config.ConfigName, version.String(cf.Fork), slot, root)
 }
@@ -174,6 +196,11 @@ func downloadBackwardsCompatible(ctx context.Context, client *Client) (*OriginDa
 	log.Printf("BeaconState htr=%#xd, Block state_root=%#x", stateRoot, block.Block().StateRoot())
 	log.Printf("BeaconBlock root computed from state=%#x, Block htr=%#x", computedBlockRoot, blockRoot)
 
+	cd, err := downloadCoordinationData(ctx, client, IdFromSlot(slot), st, blockRoot)
+	if err != nil {
+		return nil, err
+	}
+
 	return &OriginData{
 		wsd: &WeakSubjectivityData{
 			BlockRoot: blockRoot,
@@ -185,6 +212,7 @@ func downloadBackwardsCompatible(ctx context.Context, client *Client) (*OriginDa
 		b:  block,
 		bb: blockBytes,
 		cf: cf,
+		cd: cd,
 	}, nil
 }
 
@@ -251,6 +279,11 @@ func DownloadOriginData(ctx context.Context, client *Client) (*OriginData, error
 	log.Printf("BeaconState slot=%d, Block slot=%d", state.Slot(), block.Block().Slot())
 	log.Printf("BeaconState htr=%#xd, Block state_root=%#x", stateRoot, block.Block().StateRoot())
 	log.Printf("BeaconState latest_block_header htr=%#xd, block htr=%#x", blockRoot, realBlockRoot)
+
+	cd, err := downloadCoordinationData(ctx, client, IdFromSlot(slot), state, realBlockRoot)
+	if err != nil {
+		return nil, err
+	}
 	return &OriginData{
 		wsd: ws,
 		st:  state,
@@ -258,5 +291,27 @@ func DownloadOriginData(ctx context.Context, client *Client) (*OriginData, error
 		sb:  stateBytes,
 		bb:  blockBytes,
 		cf:  cf,
+		cd:  cd,
 	}, nil
 }
+
+// downloadCoordinationData requests the gwat coordination data of the checkpoint state
+// and verifies it against the state and the checkpoint block root.
+// A remote node that doesn't serve the state coordination api is not an error: nil is returned,
+// and the node started from the checkpoint rebuilds the gwat coordinated state from the chain history.
+func downloadCoordinationData(ctx context.Context, client *Client, stateId StateOrBlockId, st state.BeaconState, blockRoot [32]byte) (*CoordinationData, error) {
+	log.Printf("requesting checkpoint state coordination data, state id=%s", stateId)
+	cd, err := client.GetStateCoordination(ctx, stateId)
+	if err != nil {
+		if errors.Is(err, ErrNotOK) {
+			log.WithError(err).Warn("state coordination api not supported by server, checkpoint sync without gwat coordination data")
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "error requesting state coordination data")
+	}
+	if err := cd.Verify(st, blockRoot); err != nil {
+		return nil, errors.Wrap(err, "invalid state coordination data")
+	}
+	log.Printf("gwat checkpoint epoch=%d, root=%#x, spine=%#x", cd.GwatCheckpoint.Epoch, cd.GwatCheckpoint.Root, cd.GwatCheckpoint.Spine)
+	return cd, nil
+}
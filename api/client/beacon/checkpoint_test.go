@@ -158,6 +158,9 @@ func TestDownloadOriginData(t *testing.T) {
 
 	wsSerialized, err := wst.MarshalSSZ()
 	require.NoError(t, err)
+	expectedCD := testCoordinationData(t, wst, bRoot)
+	cdSerialized, err := expectedCD.MarshalJSON()
+	require.NoError(t, err)
 	expectedWSD := WeakSubjectivityData{
 		BlockRoot: bRoot,
 		StateRoot: wRoot,
@@ -193,6 +196,9 @@ func TestDownloadOriginData(t *testing.T) {
 			case renderGetBlockPath(IdFromRoot(bRoot)):
 				res.StatusCode = http.StatusOK
 				res.Body = io.NopCloser(bytes.NewBuffer(serBlock))
+			case getStateCoordinationTpl(IdFromSlot(wSlot)):
+				res.StatusCode = http.StatusOK
+				res.Body = io.NopCloser(bytes.NewBuffer(cdSerialized))
 			}
 
 			return res, nil
@@ -213,6 +219,7 @@ func TestDownloadOriginData(t *testing.T) {
 	require.DeepEqual(t, serBlock, od.bb)
 	require.DeepEqual(t, wst.Fork().CurrentVersion, od.cf.Version[:])
 	require.DeepEqual(t, version.Phase0, od.cf.Fork)
+	require.DeepEqual(t, expectedCD, od.CoordinationData())
 }
 
 // runs downloadBackwardsCompatible directly
@@ -0,0 +1,281 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/helpers"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/state"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1/wrapper"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/time/slots"
+	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
+	"gitlab.waterfall.network/waterfall/protocol/gwat/common/hexutil"
+	gwatTypes "gitlab.waterfall.network/waterfall/protocol/gwat/core/types"
+)
+
+const getStateCoordinationPath = "/eth/v1/waterfall/states/{{.Id}}/coordination"
+
+var getStateCoordinationTpl = idTemplate(getStateCoordinationPath)
+
+// CoordinationData represents the data coordinating a BeaconState with gwat:
+// the SpineData of the state, resolved from the spines lists the state refers to,
+// and the gwat coordinated checkpoint of the node serving the state.
+// It allows a node started by checkpoint sync to coordinate gwat from the checkpoint
+// instead of rebuilding the gwat coordinated state from the chain history.
+type CoordinationData struct {
+	BlockRoot      [32]byte
+	SpineData      *ethpb.SpineData
+	GwatCheckpoint *gwatTypes.Checkpoint
+	// Spines are the unique spines lists the state refers to, in order of their appearance in SpineData.
+	Spines []wrapper.Spines
+}
+
+// Verify checks that the coordination data corresponds to the given state and block root:
+// the SpineData must match the state and the gwat checkpoint must be the block itself
+// or one of its ancestors, so that the node does not coordinate gwat ahead of the state it starts from.
+func (cd *CoordinationData) Verify(st state.BeaconState, blockRoot [32]byte) error {
+	if cd.BlockRoot != blockRoot {
+		return fmt.Errorf("block root mismatch: coordination data %#x, checkpoint %#x", cd.BlockRoot, blockRoot)
+	}
+	spineData := st.SpineData()
+	fields := []struct {
+		name      string
+		got, want []byte
+	}{
+		{"spines", cd.SpineData.Spines, spineData.Spines},
+		{"prefix", cd.SpineData.Prefix, spineData.Prefix},
+		{"finalization", cd.SpineData.Finalization, spineData.Finalization},
+		{"cp_finalized", cd.SpineData.CpFinalized, spineData.CpFinalized},
+	}
+	for _, f := range fields {
+		if !bytes.Equal(f.got, f.want) {
+			return fmt.Errorf("spine data mismatch: %s of coordination data does not match the state", f.name)
+		}
+	}
+	return verifyGwatCheckpoint(st, blockRoot, cd.GwatCheckpoint)
+}
+
+// verifyGwatCheckpoint checks that the gwat checkpoint refers to the block of the state
+// or to the block at the start slot of the checkpoint epoch in the block roots of the state.
+func verifyGwatCheckpoint(st state.BeaconState, blockRoot [32]byte, cp *gwatTypes.Checkpoint) error {
+	if cp.Root == (gwatCommon.Hash{}) || cp.Spine == (gwatCommon.Hash{}) {
+		return errors.New("gwat checkpoint has empty root or spine")
+	}
+	if cp.Epoch > uint64(slots.ToEpoch(st.Slot())) {
+		return fmt.Errorf("gwat checkpoint epoch %d is ahead of the state slot %d", cp.Epoch, st.Slot())
+	}
+	if cp.Root == gwatCommon.BytesToHash(blockRoot[:]) {
+		return nil
+	}
+	startSlot, err := slots.EpochStart(types.Epoch(cp.Epoch))
+	if err != nil {
+		return err
+	}
+	root, err := helpers.BlockRootAtSlot(st, startSlot)
+	if err != nil {
+		return errors.Wrapf(err, "could not get block root of gwat checkpoint epoch %d", cp.Epoch)
+	}
+	if cp.Root != gwatCommon.BytesToHash(root) {
+		return fmt.Errorf("gwat checkpoint mismatch: checkpoint root %#x, state block root %#x at epoch %d", cp.Root, root, cp.Epoch)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the coordination data in the format of the state coordination api response.
+func (cd *CoordinationData) MarshalJSON() ([]byte, error) {
+	keys := make([]string, 0, 4)
+	for _, list := range []wrapper.Spines{cd.SpineData.Spines, cd.SpineData.Prefix, cd.SpineData.Finalization, cd.SpineData.CpFinalized} {
+		key := list.Key()
+		keys = append(keys, hexutil.Encode(key[:]))
+	}
+	spines := make([]*spinesJson, len(cd.Spines))
+	for i, list := range cd.Spines {
+		key := list.Key()
+		spines[i] = &spinesJson{Key: hexutil.Encode(key[:]), Data: hexutil.Encode(list)}
+	}
+	return json.Marshal(&stateCoordinationResponse{Data: &stateCoordinationJson{
+		BlockRoot: hexutil.Encode(cd.BlockRoot[:]),
+		SpineData: &spineDataKeysJson{
+			Spines:       keys[0],
+			Prefix:       keys[1],
+			Finalization: keys[2],
+			CpFinalized:  keys[3],
+		},
+		GwatCheckpoint: &gwatCheckpointJson{
+			Epoch:    strconv.FormatUint(cd.GwatCheckpoint.Epoch, 10),
+			FinEpoch: strconv.FormatUint(cd.GwatCheckpoint.FinEpoch, 10),
+			Root:     hexutil.Encode(cd.GwatCheckpoint.Root.Bytes()),
+			Spine:    hexutil.Encode(cd.GwatCheckpoint.Spine.Bytes()),
+		},
+		Spines: spines,
+	}})
+}
+
+// UnmarshalCoordinationData decodes the coordination data from the format of the state coordination api response.
+// The spines lists are checked against their keys and used to resolve the SpineData.
+func UnmarshalCoordinationData(enc []byte) (*CoordinationData, error) {
+	resp := &stateCoordinationResponse{}
+	if err := json.Unmarshal(enc, resp); err != nil {
+		return nil, errors.Wrap(err, "error decoding json coordination data")
+	}
+	if resp.Data == nil || resp.Data.SpineData == nil || resp.Data.GwatCheckpoint == nil {
+		return nil, errors.New("incomplete coordination data")
+	}
+	data := resp.Data
+
+	cd := &CoordinationData{Spines: make([]wrapper.Spines, 0, len(data.Spines))}
+	lists := make(map[[32]byte]wrapper.Spines, len(data.Spines))
+	for _, s := range data.Spines {
+		key, err := decodeRoot(s.Key)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid spines key")
+		}
+		list, err := hexutil.Decode(s.Data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid spines data of key %#x", key)
+		}
+		if len(list)%gwatCommon.HashLength != 0 {
+			return nil, fmt.Errorf("invalid spines data length %d of key %#x", len(list), key)
+		}
+		if wrapper.Spines(list).Key() != key {
+			return nil, fmt.Errorf("spines data does not match key %#x", key)
+		}
+		lists[key] = list
+		cd.Spines = append(cd.Spines, list)
+	}
+	resolve := func(name, hexKey string) ([]byte, error) {
+		key, err := decodeRoot(hexKey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s key", name)
+		}
+		if key == (wrapper.Spines{}).Key() {
+			return []byte{}, nil
+		}
+		list, ok := lists[key]
+		if !ok {
+			return nil, fmt.Errorf("spines of %s key %#x not found", name, key)
+		}
+		return bytesutil.SafeCopyBytes(list), nil
+	}
+	var err error
+	cd.SpineData = &ethpb.SpineData{}
+	if cd.SpineData.Spines, err = resolve("spines", data.SpineData.Spines); err != nil {
+		return nil, err
+	}
+	if cd.SpineData.Prefix, err = resolve("prefix", data.SpineData.Prefix); err != nil {
+		return nil, err
+	}
+	if cd.SpineData.Finalization, err = resolve("finalization", data.SpineData.Finalization); err != nil {
+		return nil, err
+	}
+	if cd.SpineData.CpFinalized, err = resolve("cp_finalized", data.SpineData.CpFinalized); err != nil {
+		return nil, err
+	}
+
+	if cd.BlockRoot, err = decodeRoot(data.BlockRoot); err != nil {
+		return nil, errors.Wrap(err, "invalid block root")
+	}
+	if cd.GwatCheckpoint, err = data.GwatCheckpoint.checkpoint(); err != nil {
+		return nil, errors.Wrap(err, "invalid gwat checkpoint")
+	}
+	return cd, nil
+}
+
+// GetStateCoordination retrieves the CoordinationData of the BeaconState for the given state id.
+// State identifier can be one of: "head" (canonical head in node's view), "genesis", "finalized",
+// <slot>, <hex encoded stateRoot with 0x prefix>. Variables of type StateOrBlockId are exported by this package
+// for the named identifiers.
+func (c *Client) GetStateCoordination(ctx context.Context, stateId StateOrBlockId) (*CoordinationData, error) {
+	b, err := c.get(ctx, getStateCoordinationTpl(stateId))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error requesting state coordination data by id = %s", stateId)
+	}
+	return UnmarshalCoordinationData(b)
+}
+
+type stateCoordinationResponse struct {
+	Data *stateCoordinationJson `json:"data"`
+}
+
+type stateCoordinationJson struct {
+	BlockRoot      string              `json:"block_root"`
+	SpineData      *spineDataKeysJson  `json:"spine_data"`
+	GwatCheckpoint *gwatCheckpointJson `json:"gwat_checkpoint"`
+	Spines         []*spinesJson       `json:"spines"`
+}
+
+type spineDataKeysJson struct {
+	Spines       string `json:"spines"`
+	Prefix       string `json:"prefix"`
+	Finalization string `json:"finalization"`
+	CpFinalized  string `json:"cp_finalized"`
+}
+
+type spinesJson struct {
+	Key  string `json:"key"`
+	Data string `json:"data"`
+}
+
+type gwatCheckpointJson struct {
+	Epoch    string `json:"epoch"`
+	FinEpoch string `json:"fin_epoch"`
+	Root     string `json:"root"`
+	Spine    string `json:"spine"`
+}
+
+func (j *gwatCheckpointJson) checkpoint() (*gwatTypes.Checkpoint, error) {
+	epoch, err := strconv.ParseUint(j.Epoch, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	finEpoch, err := strconv.ParseUint(j.FinEpoch, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	root, err := decodeRoot(j.Root)
+	if err != nil {
+		return nil, err
+	}
+	spine, err := decodeRoot(j.Spine)
+	if err != nil {
+		return nil, err
+	}
+	return &gwatTypes.Checkpoint{
+		Epoch:    epoch,
+		FinEpoch: finEpoch,
+		Root:     gwatCommon.BytesToHash(root[:]),
+		Spine:    gwatCommon.BytesToHash(spine[:]),
+	}, nil
+}
+
+func decodeRoot(h string) ([32]byte, error) {
+	b, err := hexutil.Decode(h)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	if len(b) != 32 {
+		return [32]byte{}, fmt.Errorf("got %d byte root, expected 32 bytes. hex=%s", len(b), h)
+	}
+	return bytesutil.ToBytes32(b), nil
+}
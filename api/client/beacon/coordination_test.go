@@ -0,0 +1,154 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/state"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1/wrapper"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/util"
+	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
+	"gitlab.waterfall.network/waterfall/protocol/gwat/common/hexutil"
+	gwatTypes "gitlab.waterfall.network/waterfall/protocol/gwat/core/types"
+)
+
+func testCoordinationData(t *testing.T, st state.BeaconState, blockRoot [32]byte) *CoordinationData {
+	spineData := st.SpineData()
+	cd := &CoordinationData{
+		BlockRoot: blockRoot,
+		SpineData: &ethpb.SpineData{
+			Spines:       spineData.Spines,
+			Prefix:       spineData.Prefix,
+			Finalization: spineData.Finalization,
+			CpFinalized:  spineData.CpFinalized,
+		},
+		GwatCheckpoint: &gwatTypes.Checkpoint{
+			Epoch:    2,
+			FinEpoch: 2,
+			Root:     gwatCommon.BytesToHash(blockRoot[:]),
+			Spine:    gwatCommon.Hash{0x03},
+		},
+	}
+	known := make(map[[32]byte]bool)
+	for _, list := range []wrapper.Spines{spineData.Spines, spineData.Prefix, spineData.Finalization, spineData.CpFinalized} {
+		if len(list) == 0 || known[list.Key()] {
+			continue
+		}
+		known[list.Key()] = true
+		cd.Spines = append(cd.Spines, list)
+	}
+	return cd
+}
+
+func testCoordinationState(t *testing.T) state.BeaconState {
+	st, err := util.NewBeaconState(util.WithStateSlot(64))
+	require.NoError(t, err)
+	require.NoError(t, st.SetSpineData(&ethpb.SpineData{
+		Spines:       gwatCommon.HashArray{{0x04}}.ToBytes(),
+		Prefix:       []byte{},
+		Finalization: gwatCommon.HashArray{{0x02}, {0x03}}.ToBytes(),
+		CpFinalized:  gwatCommon.HashArray{{0x01}}.ToBytes(),
+	}))
+	return st
+}
+
+func TestCoordinationData_MarshalUnmarshal(t *testing.T) {
+	st := testCoordinationState(t)
+	blockRoot := [32]byte{'r', 'o', 'o', 't'}
+	cd := testCoordinationData(t, st, blockRoot)
+	require.Equal(t, 3, len(cd.Spines))
+
+	enc, err := cd.MarshalJSON()
+	require.NoError(t, err)
+	decoded, err := UnmarshalCoordinationData(enc)
+	require.NoError(t, err)
+	require.DeepEqual(t, cd, decoded)
+	require.NoError(t, decoded.Verify(st, blockRoot))
+}
+
+func TestUnmarshalCoordinationData_Invalid(t *testing.T) {
+	st := testCoordinationState(t)
+	cd := testCoordinationData(t, st, [32]byte{'r', 'o', 'o', 't'})
+
+	// spines list does not match its key
+	tampered := *cd
+	tampered.Spines = []wrapper.Spines{cd.Spines[0], cd.Spines[1], gwatCommon.HashArray{{0x05}}.ToBytes()}
+	enc, err := tampered.MarshalJSON()
+	require.NoError(t, err)
+	enc = bytes.Replace(enc, []byte(hexKey(gwatCommon.HashArray{{0x05}}.ToBytes())), []byte(hexKey(cd.Spines[2])), 1)
+	_, err = UnmarshalCoordinationData(enc)
+	require.ErrorContains(t, "spines data does not match key", err)
+
+	// spines list referred by the spine data is missing
+	tampered = *cd
+	tampered.Spines = cd.Spines[:2]
+	enc, err = tampered.MarshalJSON()
+	require.NoError(t, err)
+	_, err = UnmarshalCoordinationData(enc)
+	require.ErrorContains(t, "spines of cp_finalized key", err)
+
+	_, err = UnmarshalCoordinationData([]byte(`{"data":{}}`))
+	require.ErrorContains(t, "incomplete coordination data", err)
+}
+
+func TestCoordinationData_Verify(t *testing.T) {
+	st := testCoordinationState(t)
+	blockRoot := [32]byte{'r', 'o', 'o', 't'}
+
+	cd := testCoordinationData(t, st, blockRoot)
+	require.ErrorContains(t, "block root mismatch", cd.Verify(st, [32]byte{'o', 't', 'h', 'e', 'r'}))
+
+	cd = testCoordinationData(t, st, blockRoot)
+	cd.SpineData.Finalization = gwatCommon.HashArray{{0x02}}.ToBytes()
+	require.ErrorContains(t, "finalization of coordination data does not match the state", cd.Verify(st, blockRoot))
+
+	cd = testCoordinationData(t, st, blockRoot)
+	cd.GwatCheckpoint.Spine = gwatCommon.Hash{}
+	require.ErrorContains(t, "gwat checkpoint has empty root or spine", cd.Verify(st, blockRoot))
+
+	cd = testCoordinationData(t, st, blockRoot)
+	cd.GwatCheckpoint.Epoch = 3
+	require.ErrorContains(t, "gwat checkpoint epoch 3 is ahead of the state slot 64", cd.Verify(st, blockRoot))
+
+	// the gwat checkpoint of an ancestor is checked against the block roots of the state.
+	ancestorRoot := [32]byte{'a', 'n', 'c'}
+	require.NoError(t, st.UpdateBlockRootAtIndex(uint64(params.BeaconConfig().SlotsPerEpoch), ancestorRoot))
+	cd = testCoordinationData(t, st, blockRoot)
+	cd.GwatCheckpoint.Epoch = 1
+	cd.GwatCheckpoint.Root = gwatCommon.BytesToHash(ancestorRoot[:])
+	require.NoError(t, cd.Verify(st, blockRoot))
+
+	cd.GwatCheckpoint.Root = gwatCommon.Hash{'o', 't', 'h', 'e', 'r'}
+	require.ErrorContains(t, "gwat checkpoint mismatch", cd.Verify(st, blockRoot))
+}
+
+func TestDownloadCoordinationData_NotSupported(t *testing.T) {
+	hc := &http.Client{
+		Transport: &testRT{rt: func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				Request:    req,
+				StatusCode: http.StatusNotFound,
+				Body:       io.NopCloser(bytes.NewBuffer(nil)),
+			}, nil
+		}},
+	}
+	c := &Client{
+		hc:     hc,
+		host:   "localhost:3500",
+		scheme: "http",
+	}
+	cd, err := downloadCoordinationData(context.Background(), c, IdFromSlot(64), testCoordinationState(t), [32]byte{})
+	require.NoError(t, err)
+	require.IsNil(t, cd)
+}
+
+func hexKey(list wrapper.Spines) string {
+	key := list.Key()
+	return hexutil.Encode(key[:])
+}
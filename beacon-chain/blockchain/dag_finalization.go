@@ -18,13 +18,14 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/sirupsen/logrus"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/helpers"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/db"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/powchain"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/state"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/features"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
@@ -37,10 +38,6 @@ import (
 	"go.opencensus.io/trace"
 )
 
-const (
-	srtErrInvalidBaseSpine = "invalid base spine"
-)
-
 var (
 	errGwatSyncInProgress = errors.New("Gwat sync is in progress")
 )
@@ -92,7 +89,7 @@ func (s *Service) initGwatSync() {
 				if !errors.Is(err, errGwatSyncInProgress) {
 					s.ResetCachedGwatCoordinatedState()
 				}
-				if strings.Contains(err.Error(), srtErrInvalidBaseSpine) {
+				if powchain.DagErrorKindOf(err) == powchain.DagErrKindInvalidBaseSpine {
 					log.WithError(err).Warning("Gwat sync: reset sync state cache")
 					s.cfg.StateGen.PurgeSyncStateCache()
 				}
@@ -141,7 +138,7 @@ func (s *Service) initParallelGwatSync() {
 		if !errors.Is(err, errGwatSyncInProgress) {
 			s.ResetCachedGwatCoordinatedState()
 		}
-		if strings.Contains(err.Error(), srtErrInvalidBaseSpine) {
+		if powchain.DagErrorKindOf(err) == powchain.DagErrKindInvalidBaseSpine {
 			log.WithError(err).Warning("Gwat sync: reset sync state cache")
 			s.cfg.StateGen.PurgeSyncStateCache()
 		}
@@ -290,16 +287,11 @@ func (s *Service) runGwatSynchronization(ctx context.Context) error {
 				"syncRoot": fmt.Sprintf("%#x", syncRoot),
 				"headRoot": fmt.Sprintf("%#x", s.headRoot()),
 			}).Error("Gwat sync: failed 4")
-			// try to fix "invalid base spine"
-			if strings.Contains(err.Error(), srtErrInvalidBaseSpine) {
-				errRepair := s.repairGwatFinalization(ctx, syncState, gwatTypes.MainSync)
-				if errRepair != nil {
-					log.WithError(errRepair).Error("Gwat sync: repair finalization failed 5")
-					return errRepair
-				}
-				err = errGwatSyncInProgress
+			// try to recover and continue sync
+			if errRecover := s.recoverGwatFinalization(ctx, syncState, gwatTypes.MainSync, err); errRecover != nil {
+				log.WithError(errRecover).Error("Gwat sync: recover finalization failed 5")
+				return errRecover
 			}
-			return err
 		}
 
 		// sync next epoch
@@ -351,11 +343,10 @@ func (s *Service) runGwatSynchronization(ctx context.Context) error {
 			"headSlot": s.headSlot(),
 			"headRoot": fmt.Sprintf("%#x", s.headRoot()),
 		}).Error("Gwat sync: head failed")
-		// try to fix "invalid base spine"
-		if strings.Contains(err.Error(), srtErrInvalidBaseSpine) {
-			err = s.repairGwatFinalization(ctx, headState, gwatTypes.HeadSync)
+		// try to recover
+		if err = s.recoverGwatFinalization(ctx, headState, gwatTypes.HeadSync, err); err != nil {
+			return err
 		}
-		return err
 	}
 
 	log.WithFields(logrus.Fields{
@@ -368,25 +359,41 @@ func (s *Service) runGwatSynchronization(ctx context.Context) error {
 }
 
 // runProcessDagFinalize This routine processes gwat finalization process.
+// A failed finalization is recovered in background, meanwhile the new heads are coalesced
+// in the head channel and the latest one is finalized after the recovery.
 func (s *Service) runProcessDagFinalize() {
 	go func() {
+		var recoveryDone chan error
+		headCh := s.newHeadCh
 		for {
 			select {
 			case <-s.ctx.Done():
 				log.Info("Dag finalization: context done")
 				return
-			case newHead := <-s.newHeadCh:
-				err := s.processDagFinalization(newHead.state, gwatTypes.NoSync)
+			case err := <-recoveryDone:
+				recoveryDone = nil
+				headCh = s.newHeadCh
 				if err != nil {
 					// reset if failed
-					log.WithError(err).WithFields(logrus.Fields{
-						"newHead.root": fmt.Sprintf("%#x", newHead.root),
-						"newHead.slot": newHead.slot,
-					}).Error("Dag finalization: failed start sync sync procedure")
+					log.WithError(err).Error("Dag finalization: failed start sync sync procedure")
 					s.ResetCachedGwatCoordinatedState()
 					go s.initGwatSync()
 					return
 				}
+			case newHead := <-headCh:
+				if err := s.processDagFinalization(newHead.state, gwatTypes.NoSync); err != nil {
+					log.WithError(err).WithFields(logrus.Fields{
+						"newHead.root": fmt.Sprintf("%#x", newHead.root),
+						"newHead.slot": newHead.slot,
+					}).Error("Dag finalization: failed")
+					// try to recover, the heads are not consumed until the recovery is done
+					recoveryDone = make(chan error, 1)
+					headCh = nil
+					go func(done chan<- error, st state.BeaconState, finErr error) {
+						done <- s.recoverGwatFinalization(s.ctx, st, gwatTypes.NoSync, finErr)
+					}(recoveryDone, newHead.state, err)
+					continue
+				}
 
 				log.WithFields(logrus.Fields{
 					"StateRoot": fmt.Sprintf("%#x", newHead.block.Block().StateRoot()),
@@ -398,6 +405,29 @@ func (s *Service) runProcessDagFinalize() {
 	}()
 }
 
+// notifyNewHead passes the current head to the dag finalization.
+// The head channel keeps the latest head only: a head not consumed yet is replaced by the newer one.
+func (s *Service) notifyNewHead() {
+	s.headLock.RLock()
+	h := s.head
+	s.headLock.RUnlock()
+	if h == nil {
+		return
+	}
+	for {
+		select {
+		case s.newHeadCh <- h:
+			return
+		default:
+		}
+		// drop the older head
+		select {
+		case <-s.newHeadCh:
+		default:
+		}
+	}
+}
+
 // processDagFinalization implements dag finalization procedure.
 func (s *Service) processDagFinalization(headState state.BeaconState, syncMode gwatTypes.SyncMode) error {
 	ctx, span := trace.StartSpan(s.ctx, "blockChain.processDagFinalization")
@@ -423,6 +453,7 @@ func (s *Service) processDagFinalization(headState state.BeaconState, syncMode g
 		}).Info("Dag finalization: finalization params")
 
 		finRes, err := s.cfg.ExecutionEngineCaller.ExecutionDagFinalize(ctx, finParams)
+		s.notifyGwatFinalization(headState, finParams, finRes, err)
 		lfSpine := finRes.LFSpine
 		if err != nil || lfSpine == nil {
 			log.WithError(err).WithFields(logrus.Fields{
@@ -435,6 +466,7 @@ func (s *Service) processDagFinalization(headState state.BeaconState, syncMode g
 			}).Error("Dag finalization: execution failed")
 			return errors.Wrap(err, "Dag finalization: execution failed")
 		}
+		s.saveSpinesSent(ctx, finParams.Spines, headState.Slot())
 		// cache coordinated checkpoint
 		if finRes.CpEpoch != nil && finRes.CpRoot != nil {
 			if paramCp.Root == *finRes.CpRoot && paramCp.Epoch == *finRes.CpEpoch {
@@ -675,6 +707,44 @@ func (s *Service) collectValidatorSyncData(ctx context.Context, headState state.
 	return validatorSyncData, nil
 }
 
+// originGwatCheckpoint returns the gwat checkpoint saved by checkpoint sync,
+// or nil if the node was not started by checkpoint sync with gwat coordination data.
+func (s *Service) originGwatCheckpoint(ctx context.Context) (*gwatTypes.Checkpoint, error) {
+	cp, err := s.cfg.BeaconDB.OriginGwatCheckpoint(ctx)
+	if errors.Is(err, db.ErrNotFoundOriginGwatCheckpoint) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "Init coordinated state: could not get origin gwat checkpoint")
+	}
+	return cp, nil
+}
+
+// initByOriginGwatCheckpoint caches the origin gwat checkpoint as the coordinated checkpoint
+// once gwat confirms the checkpoint spine. Until then an error is returned to retry later,
+// so that the node does not coordinate gwat by the checkpoint unknown to gwat.
+func (s *Service) initByOriginGwatCheckpoint(ctx context.Context, cp *gwatTypes.Checkpoint) error {
+	valid, err := s.cfg.ExecutionEngineCaller.ExecutionDagValidateSpines(ctx, gwatCommon.HashArray{cp.Spine})
+	if err != nil {
+		return errors.Wrap(err, "Init coordinated state: validate origin gwat checkpoint spine failed")
+	}
+	if !valid {
+		log.WithFields(logrus.Fields{
+			"CpEpoch": cp.Epoch,
+			"CpRoot":  fmt.Sprintf("%#x", cp.Root),
+			"LFSpine": fmt.Sprintf("%#x", cp.Spine),
+		}).Warn("Init coordinated state: origin gwat checkpoint is not confirmed by gwat yet")
+		return errors.New("Init coordinated state: origin gwat checkpoint is not confirmed by gwat")
+	}
+	log.WithFields(logrus.Fields{
+		"CpEpoch": cp.Epoch,
+		"CpRoot":  fmt.Sprintf("%#x", cp.Root),
+		"LFSpine": fmt.Sprintf("%#x", cp.Spine),
+	}).Info("Init coordinated state: init by origin gwat checkpoint")
+	s.CacheGwatCoordinatedState(cp)
+	return nil
+}
+
 // initCoordinatedState initialize coordinated state on start up sync and finalization processing
 func (s *Service) initCoordinatedState(ctx context.Context) error {
 	if features.Get().EnablePassSlotInfoToGwat {
@@ -705,8 +775,17 @@ func (s *Service) initCoordinatedState(ctx context.Context) error {
 		"CpRoot":  fmt.Sprintf("%#x", coordState.CpRoot),
 	}).Debug("Gwat sync: coordinated state retrieved")
 
+	originCp, err := s.originGwatCheckpoint(ctx)
+	if err != nil {
+		return err
+	}
+
 	// if gwat at genesis state
 	if coordState.CpRoot == nil || coordState.CpEpoch == nil || *coordState.CpRoot == (gwatCommon.Hash{}) {
+		// node started by checkpoint sync coordinates gwat from the checkpoint
+		if originCp != nil {
+			return s.initByOriginGwatCheckpoint(ctx, originCp)
+		}
 		coordCp, err = s.createGenesisCoordinatedCheckpoint(ctx, 1)
 		if err != nil {
 			log.WithError(err).Error("Init coordinated state: create genesis state failed")
@@ -737,6 +816,13 @@ func (s *Service) initCoordinatedState(ctx context.Context) error {
 	cpRoot := bytesutil.ToBytes32(coordState.CpRoot.Bytes())
 
 	cpState, err := s.cfg.StateGen.SyncStateByRoot(ctx, cpRoot)
+	if (err != nil || cpState == nil) && originCp != nil {
+		// the coordinated state is before the checkpoint the node started from
+		log.WithError(err).WithFields(logrus.Fields{
+			"cpRoot": fmt.Sprintf("%#x", cpRoot),
+		}).Warn("Init coordinated state: the coordinated state not found, fallback to origin gwat checkpoint")
+		return s.initByOriginGwatCheckpoint(ctx, originCp)
+	}
 	if err != nil || cpState == nil {
 		log.WithError(err).WithFields(logrus.Fields{
 			"cpRoot": fmt.Sprintf("%#x", cpRoot),
@@ -792,6 +878,9 @@ func (s *Service) repairGwatFinalization(
 	if bState == nil || bState.IsNil() {
 		return errors.New("repair gwat finalization: nil state received")
 	}
+	s.isGwatRepairing.Set()
+	defer s.isGwatRepairing.UnSet()
+
 	repairStates := make([]state.BeaconState, 0, params.BeaconConfig().SlotsPerEpoch*4)
 	// get gwat coordinated data
 	gwatCoordData := s.GetCachedGwatCoordinatedState()
@@ -0,0 +1,126 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package blockchain
+
+import (
+	"fmt"
+	"time"
+
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/feed"
+	statefeed "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/feed/state"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/state"
+	gwatTypes "gitlab.waterfall.network/waterfall/protocol/gwat/core/types"
+)
+
+// GwatFinalizationFetcher retrieves the status of the gwat finalization procedure.
+type GwatFinalizationFetcher interface {
+	GwatFinalizationStatus() *GwatFinalizationStatus
+}
+
+// GwatFinalizationStatus represents the state of the coordinator to gwat finalization link.
+type GwatFinalizationStatus struct {
+	// LastFinalization holds the params and the result of the last gwat finalization call.
+	LastFinalization *statefeed.GwatFinalizationData
+	// CoordinatedState is the cached gwat coordinated checkpoint.
+	CoordinatedState *gwatTypes.Checkpoint
+	// IsSyncing is true while gwat synchronization is running.
+	IsSyncing bool
+	// IsRepairing is true while gwat finalization repairing is running.
+	IsRepairing bool
+	// RecoveryStage is the current stage of gwat finalization recovery.
+	RecoveryStage string
+	// RecoveryAttempts is the number of consecutive recovery attempts.
+	RecoveryAttempts uint64
+}
+
+// GwatFinalizationStatus returns the current status of the gwat finalization procedure.
+func (s *Service) GwatFinalizationStatus() *GwatFinalizationStatus {
+	s.gwatFinalizationLock.RLock()
+	lastFinalization := s.lastGwatFinalization
+	recoveryStage := s.gwatRecoveryStage
+	recoveryAttempts := s.gwatRecoveryAttempts
+	s.gwatFinalizationLock.RUnlock()
+
+	var coordState *gwatTypes.Checkpoint
+	if cp := s.GetCachedGwatCoordinatedState(); cp != nil {
+		coordState = cp.Copy()
+	}
+	return &GwatFinalizationStatus{
+		LastFinalization: lastFinalization,
+		CoordinatedState: coordState,
+		IsSyncing:        s.isGwatSyncing.IsSet(),
+		IsRepairing:      s.isGwatRepairing.IsSet(),
+		RecoveryStage:    recoveryStage.String(),
+		RecoveryAttempts: recoveryAttempts,
+	}
+}
+
+// IsGwatRepairing returns true while gwat finalization repairing is running.
+func (s *Service) IsGwatRepairing() bool {
+	return s.isGwatRepairing.IsSet()
+}
+
+// notifyGwatFinalization stores the params and the result of the gwat finalization call
+// and sends the corresponding event to the state feed.
+func (s *Service) notifyGwatFinalization(
+	headState state.BeaconState,
+	finParams *gwatTypes.FinalizationParams,
+	finRes *gwatTypes.FinalizationResult,
+	finErr error,
+) {
+	data := &statefeed.GwatFinalizationData{
+		Slot:     headState.Slot(),
+		SyncMode: fmt.Sprintf("%v", finParams.SyncMode),
+		Spines:   make([][32]byte, len(finParams.Spines)),
+		Time:     time.Now(),
+	}
+	for i, spine := range finParams.Spines {
+		data.Spines[i] = spine
+	}
+	if finParams.BaseSpine != nil {
+		data.BaseSpine = *finParams.BaseSpine
+	}
+	if finParams.Checkpoint != nil {
+		data.CpEpoch = finParams.Checkpoint.Epoch
+		data.CpFinEpoch = finParams.Checkpoint.FinEpoch
+		data.CpRoot = finParams.Checkpoint.Root
+		data.CpSpine = finParams.Checkpoint.Spine
+	}
+	if finRes != nil && finRes.LFSpine != nil {
+		data.LFSpine = *finRes.LFSpine
+	}
+	if finErr != nil {
+		data.Error = finErr.Error()
+	} else if finRes == nil || finRes.LFSpine == nil {
+		data.Error = "no last finalized spine received"
+	}
+
+	s.gwatFinalizationLock.Lock()
+	s.lastGwatFinalization = data
+	// reset consecutive recovery attempts on success out of recovery
+	if data.Error == "" && s.gwatRecoveryStage == gwatRecoveryIdle && s.gwatRecoveryAttempts > 0 {
+		s.gwatRecoveryAttempts = 0
+		gwatRecoveryAttemptsGauge.Set(0)
+	}
+	s.gwatFinalizationLock.Unlock()
+
+	if s.cfg.StateNotifier == nil {
+		return
+	}
+	s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.GwatFinalization,
+		Data: data,
+	})
+}
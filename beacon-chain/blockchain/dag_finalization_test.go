@@ -0,0 +1,123 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	testDB "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/db/testing"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/powchain"
+	mockPOW "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/powchain/testing"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1/wrapper"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/util"
+	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
+	gwatTypes "gitlab.waterfall.network/waterfall/protocol/gwat/core/types"
+)
+
+func TestService_originGwatCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	dag := mockPOW.NewDagSimulator(gwatCommon.Hash{'g', 'e', 'n'})
+	s := &Service{cfg: &config{BeaconDB: beaconDB, ExecutionEngineCaller: &mockPOW.EngineClient{Dag: dag}}}
+
+	cp, err := s.originGwatCheckpoint(ctx)
+	require.NoError(t, err)
+	require.IsNil(t, cp)
+
+	originCp := &gwatTypes.Checkpoint{
+		Epoch:    5,
+		FinEpoch: 5,
+		Root:     gwatCommon.Hash{'r', 'o', 'o', 't'},
+		Spine:    mockPOW.SpineForSlot(160),
+	}
+	require.NoError(t, beaconDB.SaveOriginGwatCheckpoint(ctx, originCp))
+	cp, err = s.originGwatCheckpoint(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, originCp, cp)
+
+	// the checkpoint spine is unknown to gwat yet.
+	require.ErrorContains(t, "origin gwat checkpoint is not confirmed by gwat", s.initByOriginGwatCheckpoint(ctx, cp))
+	require.IsNil(t, s.GetCachedGwatCoordinatedState())
+
+	dag.ProduceSlots(160)
+	require.NoError(t, s.initByOriginGwatCheckpoint(ctx, cp))
+	require.DeepEqual(t, originCp, s.GetCachedGwatCoordinatedState())
+}
+
+func TestService_runGwatSynchronization_DagSimulator(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	ctx := context.Background()
+
+	genSpine := mockPOW.SpineForSlot(0)
+	dag := mockPOW.NewDagSimulator(genSpine)
+	spines := gwatCommon.HashArray(dag.ProduceSlots(1, 2, 3, 4, 5, 6, 7, 8))
+
+	opts := testServiceOptsWithDB(t)
+	opts = append(opts, WithExecutionEngineCaller(&mockPOW.EngineClient{Dag: dag}))
+	service, err := NewService(ctx, opts...)
+	require.NoError(t, err)
+	beaconDB := service.cfg.BeaconDB
+
+	// genesis
+	genBlk := util.NewBeaconBlock()
+	wsb, err := wrapper.WrappedSignedBeaconBlock(genBlk)
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, wsb))
+	genRoot, err := genBlk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genRoot))
+	genState, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, genState.SetEth1Data(&ethpb.Eth1Data{BlockHash: genSpine.Bytes()}))
+	require.NoError(t, genState.SetSpineData(&ethpb.SpineData{CpFinalized: gwatCommon.HashArray{genSpine}.ToBytes()}))
+	require.NoError(t, beaconDB.SaveState(ctx, genState, genRoot))
+
+	// the first block of epoch 1 finalizes the produced spines
+	slot := params.BeaconConfig().SlotsPerEpoch
+	blk := util.NewBeaconBlock()
+	blk.Block.Slot = slot
+	blk.Block.ParentRoot = genRoot[:]
+	wsb, err = wrapper.WrappedSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, wsb))
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	st := genState.Copy()
+	require.NoError(t, st.SetSlot(slot))
+	require.NoError(t, st.SetFinalizedCheckpoint(&ethpb.Checkpoint{Epoch: 0, Root: genRoot[:]}))
+	require.NoError(t, st.SetSpineData(&ethpb.SpineData{
+		CpFinalized:  gwatCommon.HashArray{genSpine}.ToBytes(),
+		Finalization: spines.ToBytes(),
+	}))
+	require.NoError(t, beaconDB.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: slot, Root: root[:]}))
+	require.NoError(t, beaconDB.SaveState(ctx, st, root))
+
+	service.head = &head{slot: slot, root: root, block: wsb, state: st}
+	service.genesisTime = time.Now().Add(-time.Duration(uint64(slot+1)*params.BeaconConfig().SecondsPerSlot) * time.Second)
+
+	// sync is not started without the gwat coordinated state.
+	require.ErrorIs(t, service.runGwatSynchronization(ctx), errNoCoordState)
+
+	service.CacheGwatCoordinatedState(&gwatTypes.Checkpoint{
+		Root:  gwatCommon.BytesToHash(genRoot[:]),
+		Spine: genSpine,
+	})
+	require.NoError(t, service.runGwatSynchronization(ctx))
+	require.Equal(t, false, service.IsGwatSynchronizing())
+	require.DeepEqual(t, append(gwatCommon.HashArray{genSpine}, spines...), dag.FinalizedSpines())
+
+	coordState := service.GetCachedGwatCoordinatedState()
+	require.NotNil(t, coordState)
+	require.Equal(t, uint64(0), coordState.Epoch)
+	require.Equal(t, gwatCommon.BytesToHash(genRoot[:]), coordState.Root)
+	res, err := dag.CoordinatedState(ctx)
+	require.NoError(t, err)
+	require.Equal(t, gwatCommon.BytesToHash(genRoot[:]), *res.CpRoot)
+
+	// the finalization fails while the dag api is unavailable.
+	service.cfg.ExecutionEngineCaller = &mockPOW.EngineClient{}
+	require.ErrorIs(t, service.processDagFinalization(st, gwatTypes.HeadSync), powchain.ErrDagUnavailable)
+}
@@ -0,0 +1,237 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/powchain"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/state"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	gwatTypes "gitlab.waterfall.network/waterfall/protocol/gwat/core/types"
+)
+
+// maxGwatRecoveryBackoffShift limits the exponential growth of the recovery back off.
+const maxGwatRecoveryBackoffShift = 6
+
+// gwatRecoveryStage is a stage of the gwat finalization recovery.
+type gwatRecoveryStage int
+
+const (
+	// gwatRecoveryIdle - recovery is not running.
+	gwatRecoveryIdle gwatRecoveryStage = iota
+	// gwatRecoveryBackoff - waiting before the next attempt.
+	gwatRecoveryBackoff
+	// gwatRecoveryRefetch - re-fetching of the gwat coordinated state.
+	gwatRecoveryRefetch
+	// gwatRecoveryWalkBack - walking back to the last common checkpoint and finalizing forward.
+	gwatRecoveryWalkBack
+	// gwatRecoveryResume - finalization of the state which failed.
+	gwatRecoveryResume
+)
+
+// String returns the name of the recovery stage.
+func (st gwatRecoveryStage) String() string {
+	switch st {
+	case gwatRecoveryIdle:
+		return "idle"
+	case gwatRecoveryBackoff:
+		return "backoff"
+	case gwatRecoveryRefetch:
+		return "refetch"
+	case gwatRecoveryWalkBack:
+		return "walk_back"
+	case gwatRecoveryResume:
+		return "resume"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(st))
+	}
+}
+
+// gwatRecoveryBackoff returns the delay before the recovery attempt,
+// which grows exponentially with the number of consecutive failed attempts up to an epoch duration.
+func gwatRecoveryBackoff(attempt uint64) time.Duration {
+	cfg := params.BeaconConfig()
+	base := time.Duration(cfg.GwatSyncIntervalMs) * time.Millisecond
+	maxDelay := time.Duration(cfg.SecondsPerSlot*uint64(cfg.SlotsPerEpoch)) * time.Second
+	shift := attempt
+	if shift > maxGwatRecoveryBackoffShift {
+		shift = maxGwatRecoveryBackoffShift
+	}
+	delay := base << shift
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+	return delay
+}
+
+// isGwatRecoverableError returns true if the recovery should be run for the gwat finalization error:
+// only the errors of the gwat side are recovered, the local errors (DagErrKindUnknown) are not.
+func isGwatRecoverableError(err error) bool {
+	switch powchain.DagErrorKindOf(err) {
+	case powchain.DagErrKindTimeout,
+		powchain.DagErrKindUnavailable,
+		powchain.DagErrKindInvalidBaseSpine,
+		powchain.DagErrKindRPC:
+		return true
+	default:
+		return false
+	}
+}
+
+// gwatRecoveryProcs are the procedures run by the stages of the gwat finalization recovery.
+type gwatRecoveryProcs struct {
+	refetch  func(ctx context.Context) error
+	walkBack func(ctx context.Context, bState state.BeaconState, syncMode gwatTypes.SyncMode) error
+	resume   func(bState state.BeaconState, syncMode gwatTypes.SyncMode) error
+}
+
+// recoveryProcs returns the procedures of the recovery stages,
+// which are the gwat synchronization procedures of the service unless set.
+func (s *Service) recoveryProcs() *gwatRecoveryProcs {
+	if s.gwatRecoveryProcs != nil {
+		return s.gwatRecoveryProcs
+	}
+	return &gwatRecoveryProcs{
+		refetch: func(ctx context.Context) error {
+			s.ResetCachedGwatCoordinatedState()
+			return s.initCoordinatedState(ctx)
+		},
+		walkBack: s.repairGwatFinalization,
+		resume:   s.processDagFinalization,
+	}
+}
+
+// recoverGwatFinalization runs the recovery of the gwat finalization failed for the state:
+// 1. backs off,
+// 2. re-fetches the gwat coordinated state,
+// 3. walks back to the last common checkpoint and finalizes forward,
+// 4. resumes by finalization of the state.
+// If recovery failed the finalization error wrapped by the recovery error is returned.
+func (s *Service) recoverGwatFinalization(
+	ctx context.Context,
+	bState state.BeaconState,
+	syncMode gwatTypes.SyncMode,
+	finErr error,
+) error {
+	kind := powchain.DagErrorKindOf(finErr)
+	if kind == powchain.DagErrKindNone {
+		return nil
+	}
+	gwatFinalizationFailuresCount.WithLabelValues(string(kind)).Inc()
+	if !isGwatRecoverableError(finErr) {
+		return finErr
+	}
+	if bState == nil || bState.IsNil() {
+		return errors.Wrap(finErr, "recover gwat finalization: nil state received")
+	}
+
+	attempt := s.startGwatRecovery()
+	delay := gwatRecoveryBackoff(attempt)
+	gwatRecoveryBackoffSeconds.Set(delay.Seconds())
+
+	log.WithError(finErr).WithFields(logrus.Fields{
+		"kind":     kind,
+		"attempt":  attempt + 1,
+		"backoff":  delay,
+		"stSlot":   bState.Slot(),
+		"syncMode": syncMode,
+	}).Warn("Recover gwat finalization: start")
+
+	// 1. back off
+	select {
+	case <-ctx.Done():
+		return s.failGwatRecovery(finErr, ctx.Err())
+	case <-time.After(delay):
+	}
+
+	procs := s.recoveryProcs()
+
+	// 2. re-fetch coordinated state
+	s.setGwatRecoveryStage(gwatRecoveryRefetch)
+	if err := procs.refetch(ctx); err != nil {
+		return s.failGwatRecovery(finErr, err)
+	}
+
+	// 3. walk back to the last common checkpoint
+	s.setGwatRecoveryStage(gwatRecoveryWalkBack)
+	if err := procs.walkBack(ctx, bState, syncMode); err != nil {
+		return s.failGwatRecovery(finErr, err)
+	}
+
+	// 4. resume
+	s.setGwatRecoveryStage(gwatRecoveryResume)
+	if err := procs.resume(bState, syncMode); err != nil {
+		return s.failGwatRecovery(finErr, err)
+	}
+	s.completeGwatRecovery()
+
+	log.WithFields(logrus.Fields{
+		"kind":     kind,
+		"attempt":  attempt + 1,
+		"stSlot":   bState.Slot(),
+		"syncMode": syncMode,
+	}).Info("Recover gwat finalization: success")
+	return nil
+}
+
+// startGwatRecovery sets the back off stage and returns the number of previous consecutive failed attempts.
+func (s *Service) startGwatRecovery() uint64 {
+	s.gwatFinalizationLock.Lock()
+	defer s.gwatFinalizationLock.Unlock()
+	attempt := s.gwatRecoveryAttempts
+	s.gwatRecoveryAttempts++
+	s.gwatRecoveryStage = gwatRecoveryBackoff
+	gwatRecoveryStageGauge.Set(float64(gwatRecoveryBackoff))
+	gwatRecoveryAttemptsGauge.Set(float64(s.gwatRecoveryAttempts))
+	return attempt
+}
+
+// setGwatRecoveryStage sets the current stage of the recovery.
+func (s *Service) setGwatRecoveryStage(stage gwatRecoveryStage) {
+	s.gwatFinalizationLock.Lock()
+	defer s.gwatFinalizationLock.Unlock()
+	s.gwatRecoveryStage = stage
+	gwatRecoveryStageGauge.Set(float64(stage))
+}
+
+// failGwatRecovery completes the failed recovery, the number of attempts is kept to increase the next back off.
+func (s *Service) failGwatRecovery(finErr, err error) error {
+	s.gwatFinalizationLock.Lock()
+	stage := s.gwatRecoveryStage
+	s.gwatRecoveryStage = gwatRecoveryIdle
+	s.gwatFinalizationLock.Unlock()
+
+	gwatRecoveryStageGauge.Set(float64(gwatRecoveryIdle))
+	gwatRecoveryFailedCount.WithLabelValues(stage.String()).Inc()
+
+	log.WithError(err).WithField("stage", stage).Error("Recover gwat finalization: failed")
+	return errors.Wrapf(finErr, "recover gwat finalization failed at %s stage: %v", stage, err)
+}
+
+// completeGwatRecovery completes the successful recovery.
+func (s *Service) completeGwatRecovery() {
+	s.gwatFinalizationLock.Lock()
+	defer s.gwatFinalizationLock.Unlock()
+	s.gwatRecoveryStage = gwatRecoveryIdle
+	s.gwatRecoveryAttempts = 0
+	gwatRecoveryStageGauge.Set(float64(gwatRecoveryIdle))
+	gwatRecoveryAttemptsGauge.Set(0)
+	gwatRecoverySuccessCount.Inc()
+}
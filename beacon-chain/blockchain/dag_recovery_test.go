@@ -0,0 +1,169 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package blockchain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/powchain"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/state"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/util"
+	gwatTypes "gitlab.waterfall.network/waterfall/protocol/gwat/core/types"
+)
+
+func TestGwatRecoveryBackoff(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.GwatSyncIntervalMs = 1000
+	cfg.SecondsPerSlot = 4
+	cfg.SlotsPerEpoch = 8
+	params.OverrideBeaconConfig(cfg)
+
+	require.Equal(t, time.Second, gwatRecoveryBackoff(0))
+	require.Equal(t, 2*time.Second, gwatRecoveryBackoff(1))
+	require.Equal(t, 16*time.Second, gwatRecoveryBackoff(4))
+	// limited by epoch duration
+	require.Equal(t, 32*time.Second, gwatRecoveryBackoff(5))
+	require.Equal(t, 32*time.Second, gwatRecoveryBackoff(100))
+}
+
+func TestGwatRecoveryStage_String(t *testing.T) {
+	require.Equal(t, "idle", gwatRecoveryIdle.String())
+	require.Equal(t, "backoff", gwatRecoveryBackoff.String())
+	require.Equal(t, "refetch", gwatRecoveryRefetch.String())
+	require.Equal(t, "walk_back", gwatRecoveryWalkBack.String())
+	require.Equal(t, "resume", gwatRecoveryResume.String())
+	require.Equal(t, "unknown(10)", gwatRecoveryStage(10).String())
+}
+
+func TestService_recoverGwatFinalization_NotRecoverable(t *testing.T) {
+	s := &Service{}
+	ctx := context.Background()
+
+	require.NoError(t, s.recoverGwatFinalization(ctx, nil, gwatTypes.NoSync, nil))
+
+	err := s.recoverGwatFinalization(ctx, nil, gwatTypes.MainSync, errGwatSyncInProgress)
+	require.Equal(t, true, errors.Is(err, errGwatSyncInProgress))
+
+	err = s.recoverGwatFinalization(ctx, nil, gwatTypes.MainSync, context.Canceled)
+	require.Equal(t, true, errors.Is(err, context.Canceled))
+
+	// local errors are not recovered.
+	localErr := errors.New("could not get finalization params")
+	err = s.recoverGwatFinalization(ctx, nil, gwatTypes.NoSync, localErr)
+	require.Equal(t, true, errors.Is(err, localErr))
+
+	require.Equal(t, gwatRecoveryIdle, s.gwatRecoveryStage)
+	require.Equal(t, uint64(0), s.gwatRecoveryAttempts)
+}
+
+func TestIsGwatRecoverableError(t *testing.T) {
+	require.Equal(t, false, isGwatRecoverableError(nil))
+	require.Equal(t, false, isGwatRecoverableError(errors.New("local error")))
+	require.Equal(t, false, isGwatRecoverableError(errGwatSyncInProgress))
+	require.Equal(t, true, isGwatRecoverableError(powchain.ErrDagHTTPTimeout))
+	require.Equal(t, true, isGwatRecoverableError(powchain.ErrDagUnavailable))
+	require.Equal(t, true, isGwatRecoverableError(errors.Wrap(powchain.ErrDagInvalidBaseSpine, "finalize")))
+	require.Equal(t, true, isGwatRecoverableError(powchain.ErrServer))
+}
+
+func TestService_recoverGwatFinalization_StateMachine(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.GwatSyncIntervalMs = 1
+	params.OverrideBeaconConfig(cfg)
+
+	ctx := context.Background()
+	bState, err := util.NewBeaconState()
+	require.NoError(t, err)
+	finErr := errors.Wrap(powchain.ErrDagInvalidBaseSpine, "finalize")
+	walkBackErr := errors.New("base spine not found")
+
+	s := &Service{}
+	var stages []gwatRecoveryStage
+	var resumed state.BeaconState
+	failWalkBack := true
+	s.gwatRecoveryProcs = &gwatRecoveryProcs{
+		refetch: func(context.Context) error {
+			stages = append(stages, s.gwatRecoveryStage)
+			return nil
+		},
+		walkBack: func(_ context.Context, st state.BeaconState, syncMode gwatTypes.SyncMode) error {
+			stages = append(stages, s.gwatRecoveryStage)
+			require.Equal(t, bState, st)
+			require.Equal(t, gwatTypes.NoSync, syncMode)
+			if failWalkBack {
+				return walkBackErr
+			}
+			return nil
+		},
+		resume: func(st state.BeaconState, _ gwatTypes.SyncMode) error {
+			stages = append(stages, s.gwatRecoveryStage)
+			resumed = st
+			return nil
+		},
+	}
+
+	// the first attempt fails at the walk back stage and keeps the attempts number.
+	err = s.recoverGwatFinalization(ctx, bState, gwatTypes.NoSync, finErr)
+	require.ErrorContains(t, "recover gwat finalization failed at walk_back stage: base spine not found", err)
+	require.Equal(t, true, errors.Is(err, powchain.ErrDagInvalidBaseSpine))
+	require.DeepEqual(t, []gwatRecoveryStage{gwatRecoveryRefetch, gwatRecoveryWalkBack}, stages)
+	require.Equal(t, gwatRecoveryIdle, s.gwatRecoveryStage)
+	require.Equal(t, uint64(1), s.gwatRecoveryAttempts)
+	require.IsNil(t, resumed)
+
+	// the next attempt repairs and resumes the finalization of the state.
+	stages = nil
+	failWalkBack = false
+	require.NoError(t, s.recoverGwatFinalization(ctx, bState, gwatTypes.NoSync, finErr))
+	require.DeepEqual(t, []gwatRecoveryStage{gwatRecoveryRefetch, gwatRecoveryWalkBack, gwatRecoveryResume}, stages)
+	require.Equal(t, bState, resumed)
+	require.Equal(t, gwatRecoveryIdle, s.gwatRecoveryStage)
+	require.Equal(t, uint64(0), s.gwatRecoveryAttempts)
+}
+
+func TestService_notifyNewHead_KeepsLatest(t *testing.T) {
+	s := &Service{newHeadCh: make(chan *head, 1)}
+	first := &head{slot: 1}
+	second := &head{slot: 2}
+
+	s.head = first
+	s.notifyNewHead()
+	s.head = second
+	s.notifyNewHead()
+
+	require.Equal(t, 1, len(s.newHeadCh))
+	require.Equal(t, second, <-s.newHeadCh)
+}
+
+func TestService_failGwatRecovery(t *testing.T) {
+	s := &Service{gwatRecoveryStage: gwatRecoveryRefetch, gwatRecoveryAttempts: 2}
+	finErr := errors.New("invalid base spine")
+
+	err := s.failGwatRecovery(finErr, errors.New("gwat unavailable"))
+	require.Equal(t, true, errors.Is(err, finErr))
+	require.ErrorContains(t, "recover gwat finalization failed at refetch stage: gwat unavailable", err)
+	require.Equal(t, gwatRecoveryIdle, s.gwatRecoveryStage)
+	require.Equal(t, uint64(2), s.gwatRecoveryAttempts)
+
+	s.completeGwatRecovery()
+	require.Equal(t, uint64(0), s.gwatRecoveryAttempts)
+}
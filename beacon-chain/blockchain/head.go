@@ -124,11 +124,9 @@ func (s *Service) saveHead(ctx context.Context, headRoot [32]byte, headBlock blo
 		return err
 	}
 	if headRoot == bytesutil.ToBytes32(r) {
-		go func() {
-			if !s.IsGwatSynchronizing() && headRoot != params.BeaconConfig().ZeroHash {
-				s.newHeadCh <- s.head
-			}
-		}()
+		if !s.IsGwatSynchronizing() && headRoot != params.BeaconConfig().ZeroHash {
+			s.notifyNewHead()
+		}
 		return nil
 	}
 	if err := helpers.BeaconBlockIsNil(headBlock); err != nil {
@@ -189,11 +187,9 @@ func (s *Service) saveHead(ctx context.Context, headRoot [32]byte, headBlock blo
 		return errors.Wrap(err, "could not save head root in DB")
 	}
 
-	go func() {
-		if !s.IsGwatSynchronizing() {
-			s.newHeadCh <- s.head
-		}
-	}()
+	if !s.IsGwatSynchronizing() {
+		s.notifyNewHead()
+	}
 
 	// Forward an event capturing a new chain head over a common event feed
 	// done in a goroutine to avoid blocking the critical runtime main routine.
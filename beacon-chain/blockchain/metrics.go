@@ -8,6 +8,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	types "github.com/prysmaticlabs/eth2-types"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/altair"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/blocks"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/epoch/precompute"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/state"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
@@ -15,6 +16,7 @@ import (
 	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1/block"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/runtime/version"
+	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
 )
 
 var (
@@ -158,6 +160,69 @@ var (
 		Name: "forkchoice_updated_optimistic_node_count",
 		Help: "Count the number of optimistic nodes after forkchoiceUpdated EE call",
 	})
+	gwatFinalizationFailuresCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gwat_finalization_failures_total",
+		Help: "Count the number of failed gwat finalizations by the kind of error",
+	}, []string{"kind"})
+	gwatRecoverySuccessCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "gwat_recovery_success_total",
+		Help: "Count the number of successful gwat finalization recoveries",
+	})
+	gwatRecoveryFailedCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gwat_recovery_failed_total",
+		Help: "Count the number of failed gwat finalization recoveries by the stage of failure",
+	}, []string{"stage"})
+	gwatRecoveryStageGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "gwat_recovery_stage",
+		Help: "Current stage of gwat finalization recovery: 0 - idle, 1 - backoff, 2 - refetch, 3 - walk back, 4 - resume",
+	})
+	gwatRecoveryAttemptsGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "gwat_recovery_consecutive_attempts",
+		Help: "Number of consecutive gwat finalization recovery attempts",
+	})
+	gwatRecoveryBackoffSeconds = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "gwat_recovery_backoff_seconds",
+		Help: "Back off of the last gwat finalization recovery attempt",
+	})
+	dagPrefixLength = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "dag_prefix_length",
+		Help: "The number of spines in the prefix of the processed state",
+	})
+	dagFinalizationLength = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "dag_finalization_length",
+		Help: "The number of spines in the finalization sequence of the processed state",
+	})
+	dagParentSpinesCount = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "dag_parent_spines_count",
+		Help: "The number of the parent spines chains of the processed state",
+	})
+	dagUniqSpinesCount = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "dag_uniq_spines_count",
+		Help: "The number of unique spines of the processed state, limited by AllSpinesLimit",
+	})
+	dagAllSpinesLimit = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "dag_all_spines_limit",
+		Help: "The AllSpinesLimit of the number of unique spines of a state",
+	})
+	dagBlockVotingCount = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "dag_block_voting_count",
+		Help: "The number of BlockVoting items of the processed state",
+	})
+	dagBlockVotingSupportedCount = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "dag_block_voting_supported_count",
+		Help: "The number of BlockVoting items of the processed state which reached BlockVotingMinSupport",
+	})
+	dagStaleVotesRemoved = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "dag_block_voting_stale_votes_removed",
+		Help: "The number of BlockVoting votes removed as stale at the start of the last imported epoch",
+	})
+	dagCandidatesVotes = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "dag_candidates_votes",
+			Help:    "The number of votes of a candidates sequence in the finalization calculation of an imported block",
+			Buckets: []float64{1, 2, 3, 4, 6, 8, 12, 16, 24, 32},
+		},
+	)
 )
 
 // reportSlotMetrics reports slot related metrics.
@@ -171,6 +236,26 @@ func reportSlotMetrics(stateSlot, headSlot, clockSlot types.Slot, finalizedCheck
 	}
 }
 
+// reportDagConsensusMetrics reports dag consensus related metrics of the post state of an imported block
+// by the stats collected by the state transition.
+func reportDagConsensusMetrics(postState state.BeaconState, uniqSpinesCount int, stats *blocks.DagConsensusStats) {
+	spineData := postState.SpineData()
+	dagPrefixLength.Set(float64(len(spineData.GetPrefix()) / gwatCommon.HashLength))
+	dagFinalizationLength.Set(float64(len(spineData.GetFinalization()) / gwatCommon.HashLength))
+	dagParentSpinesCount.Set(float64(len(spineData.GetParentSpines())))
+	dagUniqSpinesCount.Set(float64(uniqSpinesCount))
+	dagAllSpinesLimit.Set(float64(params.BeaconConfig().AllSpinesLimit))
+	dagBlockVotingCount.Set(float64(len(postState.BlockVoting())))
+
+	dagBlockVotingSupportedCount.Set(float64(stats.SupportedBlockVotings))
+	for _, votes := range stats.CandidatesVotes {
+		dagCandidatesVotes.Observe(float64(votes))
+	}
+	if stats.EpochStart {
+		dagStaleVotesRemoved.Set(float64(stats.StaleVotesRemoved))
+	}
+}
+
 // reportEpochMetrics reports epoch related metrics.
 func reportEpochMetrics(ctx context.Context, postState, headState state.BeaconState) error {
 	var err error
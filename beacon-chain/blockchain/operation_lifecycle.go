@@ -0,0 +1,124 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package blockchain
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/sirupsen/logrus"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/operations/lifecycle"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/state"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1/block"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/time/slots"
+	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
+)
+
+// operationsProcessed returns the lifecycle records of the gwat initiated withdrawals and exits of the block:
+// the inclusion in the block and the application to the block post state.
+func operationsProcessed(b block.BeaconBlock, blockRoot [32]byte, postState state.ReadOnlyBeaconState) []*lifecycle.Operation {
+	included := &lifecycle.Record{Stage: lifecycle.StageIncluded, Slot: b.Slot(), BlockRoot: blockRoot}
+	applied := &lifecycle.Record{Stage: lifecycle.StageApplied, Slot: b.Slot(), BlockRoot: blockRoot}
+	ops := make([]*lifecycle.Operation, 0, len(b.Body().Withdrawals())+len(b.Body().VoluntaryExits()))
+	for _, w := range b.Body().Withdrawals() {
+		if len(w.InitTxHash) != gwatCommon.HashLength {
+			continue
+		}
+		op := &lifecycle.Operation{
+			InitTxHash:     bytesutil.ToBytes32(w.InitTxHash),
+			Type:           lifecycle.OpWithdrawal,
+			ValidatorIndex: w.ValidatorIndex,
+			Amount:         w.Amount,
+			Records:        []*lifecycle.Record{included},
+		}
+		val, err := postState.ValidatorAtIndexReadOnly(w.ValidatorIndex)
+		if err == nil {
+			for _, wop := range val.WithdrawalOps() {
+				if wop != nil && wop.Slot == b.Slot() && bytes.Equal(wop.Hash, w.InitTxHash) {
+					op.Records = append(op.Records, applied)
+					break
+				}
+			}
+		}
+		ops = append(ops, op)
+	}
+	for _, e := range b.Body().VoluntaryExits() {
+		if len(e.InitTxHash) != gwatCommon.HashLength {
+			continue
+		}
+		op := &lifecycle.Operation{
+			InitTxHash:     bytesutil.ToBytes32(e.InitTxHash),
+			Type:           lifecycle.OpExit,
+			ValidatorIndex: e.ValidatorIndex,
+			Records:        []*lifecycle.Record{included},
+		}
+		val, err := postState.ValidatorAtIndexReadOnly(e.ValidatorIndex)
+		if err == nil && bytes.Equal(val.ExitHash(), e.InitTxHash) {
+			op.Records = append(op.Records, applied)
+		}
+		ops = append(ops, op)
+	}
+	return ops
+}
+
+// saveOperationsProcessed records the inclusion and the application of the gwat initiated operations by the block.
+func (s *Service) saveOperationsProcessed(ctx context.Context, b block.BeaconBlock, blockRoot [32]byte, ops []*lifecycle.Operation) {
+	if len(ops) == 0 {
+		return
+	}
+	if err := s.cfg.BeaconDB.SaveOperationLifecycles(ctx, ops); err != nil {
+		log.WithError(err).WithFields(logrus.Fields{
+			"slot":      b.Slot(),
+			"blockRoot": fmt.Sprintf("%#x", blockRoot),
+		}).Error("Could not save operations lifecycle: processed")
+	}
+}
+
+// pruneOperationsLifecycle removes the block records of the orphaned forks up to the finalized epoch
+// and records as pruned the withdrawals whose WithdrawalOps are cleaned from the state
+// after CleanWithdrawalsAftEpochs.
+func (s *Service) pruneOperationsLifecycle(ctx context.Context, finalizedEpoch types.Epoch) {
+	slot, err := slots.EpochStart(finalizedEpoch)
+	if err != nil {
+		log.WithError(err).WithField("finalizedEpoch", finalizedEpoch).Error("Could not prune operations lifecycle")
+		return
+	}
+	orphaned, err := s.cfg.BeaconDB.PruneOrphanedOperationRecords(ctx, slot)
+	if err != nil {
+		log.WithError(err).WithField("finalizedEpoch", finalizedEpoch).Error("Could not prune operations lifecycle: orphaned")
+		return
+	}
+
+	pruned := 0
+	staleAfterSlots := types.Slot(params.BeaconConfig().CleanWithdrawalsAftEpochs) * params.BeaconConfig().SlotsPerEpoch
+	if slot > staleAfterSlots {
+		pruned, err = s.cfg.BeaconDB.PruneOperationLifecycles(ctx, slot-staleAfterSlots, slot)
+		if err != nil {
+			log.WithError(err).WithField("finalizedEpoch", finalizedEpoch).Error("Could not prune operations lifecycle: pruned")
+			return
+		}
+	}
+	if orphaned > 0 || pruned > 0 {
+		log.WithFields(logrus.Fields{
+			"finalizedEpoch": finalizedEpoch,
+			"orphaned":       orphaned,
+			"pruned":         pruned,
+		}).Debug("Operations lifecycle pruned")
+	}
+}
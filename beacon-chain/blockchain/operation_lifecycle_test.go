@@ -0,0 +1,88 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	testDB "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/db/testing"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/operations/lifecycle"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1/wrapper"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/util"
+)
+
+func TestService_saveOperationsLifecycle(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.CleanWithdrawalsAftEpochs = 1
+	cfg.SlotsPerEpoch = 8
+	params.OverrideBeaconConfig(cfg)
+
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	s := &Service{cfg: &config{BeaconDB: beaconDB}}
+
+	withdrawalHash, skippedHash, exitHash := [32]byte{'w'}, [32]byte{'s'}, [32]byte{'e'}
+	b := util.NewBeaconBlock()
+	b.Block.Slot = 4
+	b.Block.Body.Withdrawals = []*ethpb.Withdrawal{
+		{ValidatorIndex: 3, Amount: 100, InitTxHash: withdrawalHash[:]},
+		{ValidatorIndex: 4, Amount: 100, InitTxHash: skippedHash[:]},
+	}
+	b.Block.Body.VoluntaryExits = []*ethpb.VoluntaryExit{{
+		ValidatorIndex: 5,
+		InitTxHash:     exitHash[:],
+	}}
+	wb, err := wrapper.WrappedSignedBeaconBlock(b)
+	require.NoError(t, err)
+
+	postState, _ := util.DeterministicGenesisState(t, 8)
+	val, err := postState.ValidatorAtIndex(3)
+	require.NoError(t, err)
+	val.WithdrawalOps = []*ethpb.WithdrawalOp{{Amount: 100, Hash: withdrawalHash[:], Slot: 4}}
+	require.NoError(t, postState.UpdateValidatorAtIndex(3, val))
+	val, err = postState.ValidatorAtIndex(5)
+	require.NoError(t, err)
+	val.ExitHash = exitHash[:]
+	require.NoError(t, postState.UpdateValidatorAtIndex(5, val))
+
+	// the genesis block root is considered as finalized
+	blockRoot, forkRoot := [32]byte{'r', 'o', 'o', 't'}, [32]byte{'f', 'o', 'r', 'k'}
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, blockRoot))
+	s.saveOperationsProcessed(ctx, wb.Block(), blockRoot, operationsProcessed(wb.Block(), blockRoot, postState))
+	s.saveOperationsProcessed(ctx, wb.Block(), forkRoot, operationsProcessed(wb.Block(), forkRoot, postState))
+
+	op, err := beaconDB.OperationLifecycle(ctx, withdrawalHash)
+	require.NoError(t, err)
+	require.Equal(t, lifecycle.OpWithdrawal, op.Type)
+	require.Equal(t, uint64(100), op.Amount)
+	require.Equal(t, lifecycle.StageApplied, op.Status())
+	require.Equal(t, blockRoot, op.Records[1].BlockRoot)
+
+	// the withdrawal is not applied to the post state of the block
+	op, err = beaconDB.OperationLifecycle(ctx, skippedHash)
+	require.NoError(t, err)
+	require.Equal(t, lifecycle.StageIncluded, op.Status())
+
+	op, err = beaconDB.OperationLifecycle(ctx, exitHash)
+	require.NoError(t, err)
+	require.Equal(t, lifecycle.StageApplied, op.Status())
+	require.Equal(t, 4, len(op.Records))
+
+	// the records of the orphaned fork are removed and
+	// the withdrawal applied at slot 4 is pruned after 1 epoch
+	s.pruneOperationsLifecycle(ctx, 2)
+	op, err = beaconDB.OperationLifecycle(ctx, exitHash)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(op.Records))
+	require.Equal(t, blockRoot, op.Records[1].BlockRoot)
+
+	op, err = beaconDB.OperationLifecycle(ctx, withdrawalHash)
+	require.NoError(t, err)
+	require.Equal(t, lifecycle.StagePruned, op.Status())
+	require.Equal(t, 3, len(op.Records))
+	require.Equal(t, types.Slot(16), op.Records[2].Slot)
+}
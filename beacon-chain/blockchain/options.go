@@ -77,6 +77,14 @@ func WithProposerIdsCache(c *cache.ProposerPayloadIDsCache) Option {
 	}
 }
 
+// WithPrevoteInclusionCache for the inclusion of prevotes into the processed blocks.
+func WithPrevoteInclusionCache(c *cache.PrevoteInclusionCache) Option {
+	return func(s *Service) error {
+		s.cfg.PrevoteInclusionCache = c
+		return nil
+	}
+}
+
 // WithAttestationPool for attestation lifecycle after chain inclusion.
 func WithAttestationPool(p attestations.Pool) Option {
 	return func(s *Service) error {
@@ -0,0 +1,70 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package blockchain
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/sirupsen/logrus"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/helpers"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1/attestation"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1/block"
+)
+
+// savePrevotesInclusion records for the prevotes of the block slot whether the candidates
+// of the block are a part of the candidates of the validators' prevotes.
+func (s *Service) savePrevotesInclusion(ctx context.Context, b block.BeaconBlock, blockRoot [32]byte) {
+	if s.cfg.PrevoteInclusionCache == nil || s.cfg.PrevotePool == nil {
+		return
+	}
+	prevotes := s.cfg.PrevotePool.GetPrevoteBySlot(ctx, b.Slot())
+	if len(prevotes) == 0 {
+		return
+	}
+	st, err := s.cfg.StateGen.StateByRoot(ctx, blockRoot)
+	if err != nil {
+		log.WithError(err).WithFields(logrus.Fields{
+			"slot":      b.Slot(),
+			"blockRoot": fmt.Sprintf("%#x", blockRoot),
+		}).Error("Could not get state to record prevotes inclusion")
+		return
+	}
+	candidates := b.Body().Eth1Data().GetCandidates()
+	included := make(map[types.ValidatorIndex]bool)
+	for _, pv := range prevotes {
+		if pv == nil || pv.Data == nil {
+			continue
+		}
+		committee, err := helpers.BeaconCommitteeFromState(ctx, st, pv.Data.Slot, pv.Data.Index)
+		if err != nil {
+			log.WithError(err).WithField("slot", pv.Data.Slot).Error("Could not get committee of prevote")
+			continue
+		}
+		indices, err := attestation.AttestingIndices(pv.AggregationBits, committee)
+		if err != nil {
+			log.WithError(err).WithField("slot", pv.Data.Slot).Error("Could not get prevoting indices")
+			continue
+		}
+		ok := len(candidates) > 0 && bytes.HasPrefix(pv.Data.Candidates, candidates)
+		for _, i := range indices {
+			idx := types.ValidatorIndex(i)
+			included[idx] = included[idx] || ok
+		}
+	}
+	s.cfg.PrevoteInclusionCache.Put(b.Slot(), included)
+}
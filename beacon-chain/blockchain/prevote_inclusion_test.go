@@ -0,0 +1,72 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/cache"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/helpers"
+	testDB "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/db/testing"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/operations/prevote"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/state/stategen"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1/wrapper"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/assert"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/util"
+)
+
+func TestService_savePrevotesInclusion(t *testing.T) {
+	helpers.ClearCache()
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	st, _ := util.DeterministicGenesisState(t, 64)
+	blockRoot := [32]byte{'r', 'o', 'o', 't'}
+	stateGen := stategen.New(beaconDB)
+	require.NoError(t, stateGen.SaveState(ctx, blockRoot, st))
+
+	pool := prevote.NewPool()
+	inclusionCache := cache.NewPrevoteInclusionCache()
+	s := &Service{cfg: &config{
+		BeaconDB:              beaconDB,
+		StateGen:              stateGen,
+		PrevotePool:           pool,
+		PrevoteInclusionCache: inclusionCache,
+	}}
+
+	slot := types.Slot(1)
+	committee, err := helpers.BeaconCommitteeFromState(ctx, st, slot, 0)
+	require.NoError(t, err)
+	require.Equal(t, true, len(committee) > 1)
+
+	blockCandidates := []byte{0x01, 0x02}
+	bits := bitfield.NewBitlist(uint64(len(committee)))
+	bits.SetBitAt(0, true)
+	require.NoError(t, pool.SavePrevote(&ethpb.PreVote{
+		AggregationBits: bits,
+		Data:            &ethpb.PreVoteData{Slot: slot, Index: 0, Candidates: []byte{0x01, 0x02, 0x03}},
+		Signature:       make([]byte, 96),
+	}))
+	bits = bitfield.NewBitlist(uint64(len(committee)))
+	bits.SetBitAt(1, true)
+	require.NoError(t, pool.SavePrevote(&ethpb.PreVote{
+		AggregationBits: bits,
+		Data:            &ethpb.PreVoteData{Slot: slot, Index: 0, Candidates: []byte{0x02}},
+		Signature:       make([]byte, 96),
+	}))
+
+	b := util.NewBeaconBlock()
+	b.Block.Slot = slot
+	b.Block.Body.Eth1Data.Candidates = blockCandidates
+	wb, err := wrapper.WrappedSignedBeaconBlock(b)
+	require.NoError(t, err)
+	s.savePrevotesInclusion(ctx, wb.Block(), blockRoot)
+
+	seen, included := inclusionCache.EpochSummary(0)
+	assert.Equal(t, uint64(1), seen[committee[0]])
+	assert.Equal(t, uint64(1), included[committee[0]])
+	assert.Equal(t, uint64(1), seen[committee[1]])
+	assert.Equal(t, uint64(0), included[committee[1]])
+}
@@ -8,13 +8,16 @@ import (
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/sirupsen/logrus"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/blocks"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/feed"
 	statefeed "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/feed/state"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/helpers"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/rewards"
 	coreTime "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/time"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/transition"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/db"
 	forkchoicetypes "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/forkchoice/types"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/operations/lifecycle"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/state"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/features"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
@@ -179,7 +182,7 @@ func (s *Service) onBlock(ctx context.Context, signed block.SignedBeaconBlock, b
 			}).Info("onBlock: exit")
 
 			if !s.IsGwatSynchronizing() && !s.isSynchronizing() && params.BeaconConfig().IsDelegatingStakeSlot(signed.Block().Slot()) {
-				if err := s.cfg.ExitPool.Verify(itm); err != nil {
+				if err := s.cfg.ExitPool.Verify(ctx, itm); err != nil {
 					log.WithError(err).WithFields(logrus.Fields{
 						"i":              i,
 						"slot":           signed.Block().Slot(),
@@ -200,8 +203,13 @@ func (s *Service) onBlock(ctx context.Context, signed block.SignedBeaconBlock, b
 		}).Error("onBlock error")
 		return err
 	}
+	// the pre state is modified by the state transition.
+	preSpineData := preState.SpineData()
 
-	postState, err := transition.ExecuteStateTransition(ctx, preState, signed)
+	transitionCtx, blockRewards := rewardsContext(ctx)
+	dagStats := &blocks.DagConsensusStats{}
+	transitionCtx = blocks.DagConsensusStatsContext(transitionCtx, dagStats)
+	postState, err := transition.ExecuteStateTransition(transitionCtx, preState, signed)
 	if err != nil {
 		log.WithError(err).WithFields(logrus.Fields{
 			"block.slot": signed.Block().Slot(),
@@ -209,8 +217,11 @@ func (s *Service) onBlock(ctx context.Context, signed block.SignedBeaconBlock, b
 		return err
 	}
 
+	spinesCount := helpers.CountUniqSpines(postState)
+	reportDagConsensusMetrics(postState, spinesCount, dagStats)
+
 	//validate limitation of all spines count
-	if spinesCount := helpers.CountUniqSpines(postState); spinesCount > params.BeaconConfig().AllSpinesLimit {
+	if spinesCount > params.BeaconConfig().AllSpinesLimit {
 		err = errAllSpinesLimitExceeded
 		log.WithError(err).WithFields(logrus.Fields{
 			"block.slot":     signed.Block().Slot(),
@@ -258,6 +269,9 @@ func (s *Service) onBlock(ctx context.Context, signed block.SignedBeaconBlock, b
 		}).Error("onBlock error")
 		return err
 	}
+	s.saveSpinesProcessed(ctx, signed.Block(), blockRoot, preSpineData, postState.SpineData())
+	s.saveOperationsProcessed(ctx, signed.Block(), blockRoot, operationsProcessed(signed.Block(), blockRoot, postState))
+	s.saveRewards(ctx, blockRoot, blockRewards)
 	s.rmBlRootProcessing(blockRoot)
 	rmBlRootProc = false
 
@@ -550,7 +564,10 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []block.SignedBeaconBlo
 		return nil, nil, fmt.Errorf("nil pre state for slot %d", b.Slot())
 	}
 
+	parentSpineData := preState.SpineData()
 	stSpineData := make([]*ethpb.SpineData, len(blks))
+	stOperations := make([][]*lifecycle.Operation, len(blks))
+	stRewards := make([]*rewards.Buffer, len(blks))
 	jCheckpoints := make([]*ethpb.Checkpoint, len(blks))
 	fCheckpoints := make([]*ethpb.Checkpoint, len(blks))
 	sigSet := &bls.SignatureBatch{
@@ -563,7 +580,8 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []block.SignedBeaconBlo
 	boundaries := make(map[[32]byte]state.BeaconState)
 	for i, b := range blks {
 
-		set, preState, err = transition.ExecuteStateTransitionNoVerifyAnySig(ctx, preState, b)
+		transitionCtx, blockRewards := rewardsContext(ctx)
+		set, preState, err = transition.ExecuteStateTransitionNoVerifyAnySig(transitionCtx, preState, b)
 		if err != nil {
 			log.WithError(err).WithFields(logrus.Fields{
 				"ExecuteStateTransitionNoVerifyAnySig": "fail",
@@ -571,6 +589,8 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []block.SignedBeaconBlo
 			return nil, nil, err
 		}
 		stSpineData[i] = preState.SpineData()
+		stOperations[i] = operationsProcessed(b.Block(), blockRoots[i], preState)
+		stRewards[i] = blockRewards
 		// Save potential boundary states.
 		if slots.IsEpochStart(preState.Slot()) {
 			boundaries[blockRoots[i]] = preState.Copy()
@@ -603,6 +623,10 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []block.SignedBeaconBlo
 		if err = s.insertBlockToForkChoiceStore(ctx, b.Block(), blockRoots[i], fCheckpoints[i], jCheckpoints[i], stSpineData[i]); err != nil {
 			return nil, nil, err
 		}
+		s.saveSpinesProcessed(ctx, b.Block(), blockRoots[i], parentSpineData, stSpineData[i])
+		parentSpineData = stSpineData[i]
+		s.saveOperationsProcessed(ctx, b.Block(), blockRoots[i], stOperations[i])
+		s.saveRewards(ctx, blockRoots[i], stRewards[i])
 	}
 
 	for r, st := range boundaries {
@@ -263,6 +263,9 @@ func (s *Service) updateFinalized(ctx context.Context, cp *ethpb.Checkpoint) err
 		if err := s.cfg.StateGen.MigrateToCold(s.ctx, fRoot); err != nil {
 			log.WithError(err).Error("could not migrate to cold")
 		}
+		s.pruneOperationsLifecycle(s.ctx, cp.Epoch)
+		s.pruneSpinesLifecycle(s.ctx, cp.Epoch)
+		s.pruneRewards(s.ctx, cp.Epoch)
 	}()
 	return nil
 }
@@ -155,8 +155,8 @@ func (s *Service) spawnProcessAttestationsRoutine(stateFeed *event.Feed) {
 				}
 
 				// update pools
-				s.cfg.WithdrawalPool.OnSlot(s.headState(s.ctx))
-				s.cfg.ExitPool.OnSlot(s.headState(s.ctx))
+				s.cfg.WithdrawalPool.OnSlot(s.ctx, s.headState(s.ctx))
+				s.cfg.ExitPool.OnSlot(s.ctx, s.headState(s.ctx))
 
 				// TODO consider moving of prevote cleanup to other place
 				err := s.cfg.PrevotePool.PurgeOutdatedPrevote(s.CurrentSlot())
@@ -71,9 +71,10 @@ func (s *Service) ReceiveBlock(ctx context.Context, block block.SignedBeaconBloc
 	}
 
 	// Handle post block operations such as attestations and exits.
-	if err := s.handlePostBlockOperations(blockCopy.Block()); err != nil {
+	if err := s.handlePostBlockOperations(ctx, blockCopy.Block()); err != nil {
 		return err
 	}
+	s.savePrevotesInclusion(ctx, blockCopy.Block(), blockRoot)
 
 	// Have we been finalizing? Should we start saving hot states to db?
 	if err := s.checkSaveHotStateDB(ctx); err != nil {
@@ -164,7 +165,7 @@ func (s *Service) HasInitSyncBlock(root [32]byte) bool {
 	return s.hasInitSyncBlock(root)
 }
 
-func (s *Service) handlePostBlockOperations(b block.BeaconBlock) error {
+func (s *Service) handlePostBlockOperations(ctx context.Context, b block.BeaconBlock) error {
 	// Delete the processed block attestations from attestation pool.
 	if err := s.deletePoolAtts(b.Body().Attestations()); err != nil {
 		return err
@@ -182,7 +183,7 @@ func (s *Service) handlePostBlockOperations(b block.BeaconBlock) error {
 
 	// Mark block withdrawals as seen so we don't include same ones in future blocks.
 	for _, w := range b.Body().Withdrawals() {
-		s.cfg.WithdrawalPool.MarkIncluded(w)
+		s.cfg.WithdrawalPool.MarkIncluded(ctx, w)
 	}
 
 	//  Mark attester slashings as seen so we don't include same ones in future blocks.
@@ -0,0 +1,77 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package blockchain
+
+import (
+	"context"
+	"fmt"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/sirupsen/logrus"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/helpers"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/rewards"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+)
+
+// rewardsContext returns the context of the block state transition, which records the applied
+// rewards and penalties to the returned buffer, if saving of the rewards is enabled.
+func rewardsContext(ctx context.Context) (context.Context, *rewards.Buffer) {
+	if !params.BeaconConfig().SaveRewards {
+		return ctx, nil
+	}
+	buf := rewards.NewBuffer()
+	return helpers.RewardsRecorderContext(ctx, buf), buf
+}
+
+// saveRewards saves the rewards and penalties applied by the state transition of the imported block.
+func (s *Service) saveRewards(ctx context.Context, blockRoot [32]byte, buf *rewards.Buffer) {
+	if buf == nil {
+		return
+	}
+	changes := buf.Flush()
+	if len(changes) == 0 {
+		return
+	}
+	for _, ch := range changes {
+		ch.BlockRoot = blockRoot
+	}
+	if err := s.cfg.BeaconDB.SaveRewards(ctx, changes); err != nil {
+		log.WithError(err).WithFields(logrus.Fields{
+			"blockRoot": fmt.Sprintf("%#x", blockRoot),
+			"count":     len(changes),
+		}).Error("Could not save rewards and penalties")
+	}
+}
+
+// pruneRewards deletes the rewards and penalties of the epochs
+// out of the RewardsRetentionEpochs before the finalized epoch.
+func (s *Service) pruneRewards(ctx context.Context, finalizedEpoch types.Epoch) {
+	retention := params.BeaconConfig().RewardsRetentionEpochs
+	if !params.BeaconConfig().SaveRewards || retention == 0 || finalizedEpoch <= retention {
+		return
+	}
+	beforeEpoch := finalizedEpoch - retention
+	deleted, err := s.cfg.BeaconDB.DeleteRewardsBefore(ctx, beforeEpoch)
+	if err != nil {
+		log.WithError(err).WithField("beforeEpoch", beforeEpoch).Error("Could not delete stale rewards and penalties")
+		return
+	}
+	if deleted > 0 {
+		log.WithFields(logrus.Fields{
+			"beforeEpoch": beforeEpoch,
+			"deleted":     deleted,
+		}).Debug("Rewards and penalties pruned")
+	}
+}
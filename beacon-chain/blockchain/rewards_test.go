@@ -0,0 +1,106 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/helpers"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/rewards"
+	testDB "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/db/testing"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+)
+
+func TestRewardsContext(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	ctx := context.Background()
+
+	cfg := params.BeaconConfig().Copy()
+	cfg.SaveRewards = false
+	params.OverrideBeaconConfig(cfg)
+	transitionCtx, buf := rewardsContext(ctx)
+	require.Equal(t, true, buf == nil)
+	require.Equal(t, false, helpers.IsRewardsRecording(transitionCtx))
+
+	cfg.SaveRewards = true
+	params.OverrideBeaconConfig(cfg)
+	transitionCtx, buf = rewardsContext(ctx)
+	require.NotNil(t, buf)
+	require.Equal(t, true, helpers.IsRewardsRecording(transitionCtx))
+	// The parent context does not record.
+	require.Equal(t, false, helpers.IsRewardsRecording(ctx))
+}
+
+func TestService_saveRewards(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.SlotsPerEpoch = 8
+	params.OverrideBeaconConfig(cfg)
+
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	s := &Service{cfg: &config{BeaconDB: beaconDB}}
+
+	// The same change applied by the blocks of two forks is saved per block.
+	roots := [][32]byte{{'a'}, {'b'}}
+	for _, root := range roots {
+		buf := rewards.NewBuffer()
+		buf.RecordBalanceChange(&rewards.BalanceChange{
+			Slot:      9,
+			Validator: 1,
+			Operation: rewards.Increase,
+			Role:      rewards.RoleProposing,
+			Amount:    10,
+		})
+		s.saveRewards(ctx, root, buf)
+		require.Equal(t, 0, len(buf.Flush()))
+	}
+	s.saveRewards(ctx, [32]byte{'c'}, nil)
+
+	changes, err := beaconDB.EpochRewards(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, len(roots), len(changes))
+	for i, ch := range changes {
+		require.Equal(t, roots[i], ch.BlockRoot)
+	}
+}
+
+func TestService_pruneRewards(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.SaveRewards = true
+	cfg.RewardsRetentionEpochs = 2
+	cfg.SlotsPerEpoch = 8
+	params.OverrideBeaconConfig(cfg)
+
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	s := &Service{cfg: &config{BeaconDB: beaconDB}}
+
+	for epoch := uint64(1); epoch <= 4; epoch++ {
+		buf := rewards.NewBuffer()
+		buf.RecordBalanceChange(&rewards.BalanceChange{
+			Slot:      params.BeaconConfig().SlotsPerEpoch.Mul(epoch),
+			Validator: 1,
+			Operation: rewards.Increase,
+			Role:      rewards.RoleProposing,
+			Amount:    epoch,
+		})
+		s.saveRewards(ctx, [32]byte{byte(epoch)}, buf)
+	}
+
+	// Nothing is out of the retention yet.
+	s.pruneRewards(ctx, 2)
+	changes, err := beaconDB.EpochRewards(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(changes))
+
+	// The rewards of the epochs out of the retention before the finalized epoch are deleted.
+	s.pruneRewards(ctx, 4)
+	for epoch, count := range map[types.Epoch]int{1: 0, 2: 1, 3: 1, 4: 1} {
+		changes, err := beaconDB.EpochRewards(ctx, epoch)
+		require.NoError(t, err)
+		require.Equal(t, count, len(changes))
+	}
+}
@@ -87,6 +87,12 @@ type Service struct {
 	fnIsSync              func() bool
 	newHeadCh             chan *head
 	isGwatSyncing         *abool.AtomicBool
+	isGwatRepairing       *abool.AtomicBool
+	lastGwatFinalization  *statefeed.GwatFinalizationData
+	gwatFinalizationLock  sync.RWMutex
+	gwatRecoveryStage     gwatRecoveryStage
+	gwatRecoveryAttempts  uint64
+	gwatRecoveryProcs     *gwatRecoveryProcs
 	onBlockMu             sync.RWMutex
 }
 
@@ -97,6 +103,7 @@ type config struct {
 	BeaconDB                db.HeadAccessDatabase
 	DepositCache            *depositcache.DepositCache
 	ProposerSlotIndexCache  *cache.ProposerPayloadIDsCache
+	PrevoteInclusionCache   *cache.PrevoteInclusionCache
 	AttPool                 attestations.Pool
 	PrevotePool             prevote.Pool
 	ExitPool                voluntaryexits.PoolManager
@@ -137,8 +144,9 @@ func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 		cfg:                  &config{},
 		store:                &store.Store{},
 		spineData:            spineData{},
-		newHeadCh:            make(chan *head),
+		newHeadCh:            make(chan *head, 1),
 		isGwatSyncing:        abool.New(),
+		isGwatRepairing:      abool.New(),
 		procBlockCache:       procBlockCache,
 		procBlRootCache:      procBlRootCache,
 	}
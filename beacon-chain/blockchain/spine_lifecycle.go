@@ -0,0 +1,110 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package blockchain
+
+import (
+	"context"
+	"fmt"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/sirupsen/logrus"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/operations/lifecycle"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1/block"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/time/slots"
+	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
+)
+
+// spineLifecycleRetentionEpochs is the number of epochs the spines lifecycle is kept after the finalization.
+const spineLifecycleRetentionEpochs = types.Epoch(256)
+
+// saveSpinesProcessed records the stages reached by the spines in the block:
+// the spines carried by the candidates of the block and the spines entered the prefix
+// and the finalization of the block post state, which are not in the parent post state.
+func (s *Service) saveSpinesProcessed(
+	ctx context.Context,
+	b block.BeaconBlock,
+	blockRoot [32]byte,
+	parentSpineData, spineData *ethpb.SpineData,
+) {
+	spines := make([]*lifecycle.Spine, 0)
+	index := make(map[gwatCommon.Hash]*lifecycle.Spine)
+	addStage := func(hashes gwatCommon.HashArray, stage lifecycle.SpineStage) {
+		for _, h := range hashes {
+			sp, ok := index[h]
+			if !ok {
+				sp = &lifecycle.Spine{Hash: [32]byte(h)}
+				index[h] = sp
+				spines = append(spines, sp)
+			}
+			sp.Merge(&lifecycle.Spine{Records: []*lifecycle.SpineRecord{{Stage: stage, Slot: b.Slot(), BlockRoot: blockRoot}}})
+		}
+	}
+	prefix := gwatCommon.HashArrayFromBytes(spineData.GetPrefix()).
+		Difference(gwatCommon.HashArrayFromBytes(parentSpineData.GetPrefix()))
+	finalization := gwatCommon.HashArrayFromBytes(spineData.GetFinalization()).
+		Difference(gwatCommon.HashArrayFromBytes(parentSpineData.GetFinalization()))
+	addStage(gwatCommon.HashArrayFromBytes(b.Body().Eth1Data().GetCandidates()), lifecycle.SpineCandidate)
+	addStage(prefix, lifecycle.SpinePrefix)
+	addStage(finalization, lifecycle.SpineFinalization)
+	if len(spines) == 0 {
+		return
+	}
+	if err := s.cfg.BeaconDB.SaveSpineLifecycles(ctx, spines); err != nil {
+		log.WithError(err).WithFields(logrus.Fields{
+			"slot":      b.Slot(),
+			"blockRoot": fmt.Sprintf("%#x", blockRoot),
+		}).Error("Could not save spines lifecycle: processed")
+	}
+}
+
+// saveSpinesSent records the spines sent to gwat by the dag_finalize call at the slot.
+func (s *Service) saveSpinesSent(ctx context.Context, finSpines gwatCommon.HashArray, slot types.Slot) {
+	if len(finSpines) == 0 {
+		return
+	}
+	sent := &lifecycle.SpineRecord{Stage: lifecycle.SpineSent, Slot: slot}
+	spines := make([]*lifecycle.Spine, len(finSpines))
+	for i, h := range finSpines {
+		spines[i] = &lifecycle.Spine{Hash: [32]byte(h), Records: []*lifecycle.SpineRecord{sent}}
+	}
+	if err := s.cfg.BeaconDB.SaveSpineLifecycles(ctx, spines); err != nil {
+		log.WithError(err).WithField("slot", slot).Error("Could not save spines lifecycle: sent")
+	}
+}
+
+// pruneSpinesLifecycle deletes the spines lifecycle
+// reached spineLifecycleRetentionEpochs before the finalized epoch.
+func (s *Service) pruneSpinesLifecycle(ctx context.Context, finalizedEpoch types.Epoch) {
+	if finalizedEpoch <= spineLifecycleRetentionEpochs {
+		return
+	}
+	maxSlot, err := slots.EpochStart(finalizedEpoch - spineLifecycleRetentionEpochs)
+	if err != nil {
+		log.WithError(err).WithField("finalizedEpoch", finalizedEpoch).Error("Could not prune spines lifecycle")
+		return
+	}
+	pruned, err := s.cfg.BeaconDB.PruneSpineLifecycles(ctx, maxSlot)
+	if err != nil {
+		log.WithError(err).WithField("finalizedEpoch", finalizedEpoch).Error("Could not prune spines lifecycle")
+		return
+	}
+	if pruned > 0 {
+		log.WithFields(logrus.Fields{
+			"finalizedEpoch": finalizedEpoch,
+			"pruned":         pruned,
+		}).Debug("Spines lifecycle pruned")
+	}
+}
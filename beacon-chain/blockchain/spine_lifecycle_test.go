@@ -0,0 +1,80 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	testDB "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/db/testing"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/operations/lifecycle"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1/wrapper"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/util"
+	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
+)
+
+func TestService_saveSpinesLifecycle(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	s := &Service{cfg: &config{BeaconDB: beaconDB}}
+
+	spineA, spineB, spineC, spineD := gwatCommon.Hash{'a'}, gwatCommon.Hash{'b'}, gwatCommon.Hash{'c'}, gwatCommon.Hash{'d'}
+	b := util.NewBeaconBlock()
+	b.Block.Slot = 4
+	b.Block.Body.Eth1Data.Candidates = gwatCommon.HashArray{spineB, spineC}.ToBytes()
+	wb, err := wrapper.WrappedSignedBeaconBlock(b)
+	require.NoError(t, err)
+	blockRoot := [32]byte{'r', 'o', 'o', 't'}
+	parentSpineData := &ethpb.SpineData{
+		Prefix:       gwatCommon.HashArray{spineA, spineD}.ToBytes(),
+		Finalization: gwatCommon.HashArray{spineD}.ToBytes(),
+	}
+	s.saveSpinesProcessed(ctx, wb.Block(), blockRoot, parentSpineData, &ethpb.SpineData{
+		Prefix:       gwatCommon.HashArray{spineC}.ToBytes(),
+		Finalization: gwatCommon.HashArray{spineD, spineA, spineB}.ToBytes(),
+	})
+
+	sp, err := beaconDB.SpineLifecycle(ctx, spineB)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(sp.Records))
+	require.Equal(t, lifecycle.SpineCandidate, sp.Records[0].Stage)
+	require.Equal(t, lifecycle.SpineFinalization, sp.Status())
+	require.Equal(t, blockRoot, sp.Records[1].BlockRoot)
+
+	sp, err = beaconDB.SpineLifecycle(ctx, spineC)
+	require.NoError(t, err)
+	require.Equal(t, lifecycle.SpinePrefix, sp.Status())
+
+	s.saveSpinesSent(ctx, gwatCommon.HashArray{spineA, spineB}, 6)
+	sp, err = beaconDB.SpineLifecycle(ctx, spineA)
+	require.NoError(t, err)
+	require.Equal(t, lifecycle.SpineSent, sp.Status())
+	require.Equal(t, 2, len(sp.Records))
+	// the finalization of the parent post state is not recorded by the block
+	_, err = beaconDB.SpineLifecycle(ctx, spineD)
+	require.ErrorContains(t, "not found", err)
+}
+
+func TestService_pruneSpinesLifecycle(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	s := &Service{cfg: &config{BeaconDB: beaconDB}}
+
+	spineA, spineB := [32]byte{'a'}, [32]byte{'b'}
+	require.NoError(t, beaconDB.SaveSpineLifecycles(ctx, []*lifecycle.Spine{
+		{Hash: spineA, Records: []*lifecycle.SpineRecord{{Stage: lifecycle.SpineSent, Slot: 10}}},
+		{Hash: spineB, Records: []*lifecycle.SpineRecord{{Stage: lifecycle.SpineSent, Slot: 10 + types.Slot(spineLifecycleRetentionEpochs)*params.BeaconConfig().SlotsPerEpoch}}},
+	}))
+
+	s.pruneSpinesLifecycle(ctx, spineLifecycleRetentionEpochs)
+	_, err := beaconDB.SpineLifecycle(ctx, spineA)
+	require.NoError(t, err)
+
+	s.pruneSpinesLifecycle(ctx, spineLifecycleRetentionEpochs+1)
+	_, err = beaconDB.SpineLifecycle(ctx, spineA)
+	require.ErrorContains(t, "not found", err)
+	_, err = beaconDB.SpineLifecycle(ctx, spineB)
+	require.NoError(t, err)
+}
@@ -0,0 +1,158 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package cache
+
+import (
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+	types "github.com/prysmaticlabs/eth2-types"
+	lruwrpr "gitlab.waterfall.network/waterfall/protocol/coordinator/cache/lru"
+	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
+)
+
+// maxPrevoteDecisionsSize defines the max number of the produced blocks
+// whose prevote decisions are kept by the proposer.
+const maxPrevoteDecisionsSize = 256
+
+// PrevoteDecisionReason describes why the proposer took the candidates of a block.
+type PrevoteDecisionReason string
+
+const (
+	// ReasonNoPrevotes - no prevotes for the slot, the candidates are calculated by the optimistic spines.
+	ReasonNoPrevotes PrevoteDecisionReason = "no_prevotes"
+	// ReasonNoVotedChain - no prevote candidates are contained in the optimistic candidates,
+	// the candidates are calculated by the optimistic spines.
+	ReasonNoVotedChain PrevoteDecisionReason = "no_voted_chain"
+	// ReasonMostVotedChain - the candidates are the longest chain with the most of votes.
+	ReasonMostVotedChain PrevoteDecisionReason = "most_voted_chain"
+)
+
+// PrevoteDecision is the trace of the decision of the proposer
+// on the gwat spines candidates of the produced block.
+type PrevoteDecision struct {
+	Slot       types.Slot
+	BlockRoot  [32]byte
+	ParentRoot [32]byte
+	// OptCandidates are the candidates calculated by the gwat optimistic spines.
+	OptCandidates gwatCommon.HashArray
+	Prevotes      []*ConsideredPrevote
+	Chains        []*VotedChain
+	Chosen        gwatCommon.HashArray
+	Reason        PrevoteDecisionReason
+	// SpinesLimitCut is the number of the candidates cut to fit the AllSpinesLimit.
+	SpinesLimitCut int
+	// Candidates are the candidates put into the block.
+	Candidates gwatCommon.HashArray
+}
+
+// ConsideredPrevote is the prevote considered by the proposer.
+type ConsideredPrevote struct {
+	// Candidates are the prevote candidates trimmed by the parent state.
+	Candidates gwatCommon.HashArray
+	Votes      uint64
+	Aggregated bool
+	// Accepted is the length of the prefix of the candidates contained in the optimistic candidates.
+	Accepted int
+}
+
+// VotedChain is the subchain of the prevote candidates with its votes weight.
+type VotedChain struct {
+	Chain gwatCommon.HashArray
+	Votes uint64
+}
+
+// PrevoteDecisionCache keeps the prevote decisions of the recently produced blocks by slot and block root.
+type PrevoteDecisionCache struct {
+	cache      *lru.Cache
+	rootToSlot map[[32]byte]types.Slot
+	lock       sync.RWMutex
+}
+
+// NewPrevoteDecisionCache creates a new prevote decisions cache.
+func NewPrevoteDecisionCache() *PrevoteDecisionCache {
+	c := &PrevoteDecisionCache{
+		rootToSlot: make(map[[32]byte]types.Slot),
+	}
+	c.cache = lruwrpr.NewWithEvict(maxPrevoteDecisionsSize, func(_ interface{}, value interface{}) {
+		if d, ok := value.(*PrevoteDecision); ok && d.BlockRoot != ([32]byte{}) {
+			delete(c.rootToSlot, d.BlockRoot)
+		}
+	})
+	return c
+}
+
+// Put saves the prevote decision of the block produced at the slot of the decision,
+// the previous decision of the slot is replaced.
+func (c *PrevoteDecisionCache) Put(d *PrevoteDecision) {
+	if d == nil {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if prev, ok := c.cache.Peek(d.Slot); ok && prev.(*PrevoteDecision).BlockRoot != ([32]byte{}) {
+		delete(c.rootToSlot, prev.(*PrevoteDecision).BlockRoot)
+	}
+	c.cache.Add(d.Slot, d)
+}
+
+// SetBlockRoot links the decision of the slot to the root of the proposed block
+// if the block carries the candidates of the decision.
+func (c *PrevoteDecisionCache) SetBlockRoot(slot types.Slot, candidates gwatCommon.HashArray, root [32]byte) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	item, ok := c.cache.Peek(slot)
+	if !ok {
+		return false
+	}
+	d := item.(*PrevoteDecision)
+	if !d.Candidates.IsEqualTo(candidates) {
+		return false
+	}
+	if d.BlockRoot != ([32]byte{}) {
+		delete(c.rootToSlot, d.BlockRoot)
+	}
+	d.BlockRoot = root
+	c.rootToSlot[root] = slot
+	return true
+}
+
+// BySlot returns the prevote decision of the block produced at the slot.
+func (c *PrevoteDecisionCache) BySlot(slot types.Slot) (*PrevoteDecision, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	item, ok := c.cache.Peek(slot)
+	if !ok {
+		return nil, false
+	}
+	d := *item.(*PrevoteDecision)
+	return &d, true
+}
+
+// ByRoot returns the prevote decision of the proposed block with the root.
+func (c *PrevoteDecisionCache) ByRoot(root [32]byte) (*PrevoteDecision, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	slot, ok := c.rootToSlot[root]
+	if !ok {
+		return nil, false
+	}
+	item, ok := c.cache.Peek(slot)
+	if !ok {
+		return nil, false
+	}
+	d := *item.(*PrevoteDecision)
+	return &d, true
+}
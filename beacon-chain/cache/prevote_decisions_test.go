@@ -0,0 +1,56 @@
+package cache
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/assert"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
+)
+
+func TestPrevoteDecisionCache_PutAndGet(t *testing.T) {
+	c := NewPrevoteDecisionCache()
+	candidates := gwatCommon.HashArray{{0x01}, {0x02}}
+	c.Put(&PrevoteDecision{Slot: 10, Reason: ReasonMostVotedChain, Candidates: candidates})
+
+	d, ok := c.BySlot(10)
+	require.Equal(t, true, ok)
+	assert.Equal(t, ReasonMostVotedChain, d.Reason)
+	_, ok = c.BySlot(11)
+	assert.Equal(t, false, ok)
+
+	root := [32]byte{'r'}
+	_, ok = c.ByRoot(root)
+	assert.Equal(t, false, ok)
+	assert.Equal(t, false, c.SetBlockRoot(10, gwatCommon.HashArray{{0x01}}, root), "candidates mismatch")
+	assert.Equal(t, false, c.SetBlockRoot(11, candidates, root), "unknown slot")
+	require.Equal(t, true, c.SetBlockRoot(10, candidates, root))
+
+	d, ok = c.ByRoot(root)
+	require.Equal(t, true, ok)
+	assert.Equal(t, types.Slot(10), d.Slot)
+	assert.Equal(t, root, d.BlockRoot)
+
+	// Replacing the decision of the slot unlinks the root.
+	c.Put(&PrevoteDecision{Slot: 10, Reason: ReasonNoPrevotes})
+	_, ok = c.ByRoot(root)
+	assert.Equal(t, false, ok)
+}
+
+func TestPrevoteDecisionCache_Eviction(t *testing.T) {
+	c := NewPrevoteDecisionCache()
+	root := [32]byte{'r'}
+	candidates := gwatCommon.HashArray{{0x01}}
+	c.Put(&PrevoteDecision{Slot: 0, Candidates: candidates})
+	require.Equal(t, true, c.SetBlockRoot(0, candidates, root))
+	for i := 1; i <= maxPrevoteDecisionsSize; i++ {
+		c.Put(&PrevoteDecision{Slot: types.Slot(i)})
+	}
+	_, ok := c.BySlot(0)
+	assert.Equal(t, false, ok)
+	_, ok = c.ByRoot(root)
+	assert.Equal(t, false, ok)
+	_, ok = c.BySlot(maxPrevoteDecisionsSize)
+	assert.Equal(t, true, ok)
+}
@@ -0,0 +1,80 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package cache
+
+import (
+	"sync"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/time/slots"
+)
+
+// maxPrevoteInclusionEpochs defines the number of the recent epochs
+// whose prevotes inclusion is kept.
+const maxPrevoteInclusionEpochs = 4
+
+// PrevoteInclusionCache keeps by slot whether the candidates of the validators' prevotes
+// were included into the processed block of the prevote slot.
+type PrevoteInclusionCache struct {
+	slots map[types.Slot]map[types.ValidatorIndex]bool
+	lock  sync.RWMutex
+}
+
+// NewPrevoteInclusionCache creates a new prevotes inclusion cache.
+func NewPrevoteInclusionCache() *PrevoteInclusionCache {
+	return &PrevoteInclusionCache{
+		slots: make(map[types.Slot]map[types.ValidatorIndex]bool),
+	}
+}
+
+// Put saves the prevotes inclusion of the block of the slot, the previous inclusion
+// of the slot (by a block of another fork) is replaced.
+// The slots older than maxPrevoteInclusionEpochs are deleted.
+func (c *PrevoteInclusionCache) Put(slot types.Slot, included map[types.ValidatorIndex]bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.slots[slot] = included
+
+	keepSlots := params.BeaconConfig().SlotsPerEpoch.Mul(maxPrevoteInclusionEpochs)
+	if slot < keepSlots {
+		return
+	}
+	for s := range c.slots {
+		if s < slot-keepSlots {
+			delete(c.slots, s)
+		}
+	}
+}
+
+// EpochSummary returns the numbers of the seen and included prevotes of the validators at the epoch.
+func (c *PrevoteInclusionCache) EpochSummary(epoch types.Epoch) (seen, included map[types.ValidatorIndex]uint64) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	seen = make(map[types.ValidatorIndex]uint64)
+	included = make(map[types.ValidatorIndex]uint64)
+	for slot, inclusion := range c.slots {
+		if slots.ToEpoch(slot) != epoch {
+			continue
+		}
+		for idx, ok := range inclusion {
+			seen[idx]++
+			if ok {
+				included[idx]++
+			}
+		}
+	}
+	return seen, included
+}
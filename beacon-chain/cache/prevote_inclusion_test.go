@@ -0,0 +1,45 @@
+package cache
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/assert"
+)
+
+func TestPrevoteInclusionCache_EpochSummary(t *testing.T) {
+	c := NewPrevoteInclusionCache()
+	spe := params.BeaconConfig().SlotsPerEpoch
+	c.Put(spe, map[types.ValidatorIndex]bool{1: true, 2: false})
+	c.Put(spe+1, map[types.ValidatorIndex]bool{1: false})
+	c.Put(2*spe, map[types.ValidatorIndex]bool{1: true})
+
+	seen, included := c.EpochSummary(1)
+	assert.Equal(t, uint64(2), seen[1])
+	assert.Equal(t, uint64(1), included[1])
+	assert.Equal(t, uint64(1), seen[2])
+	assert.Equal(t, uint64(0), included[2])
+
+	// The block of another fork replaces the inclusion of the slot.
+	c.Put(spe+1, map[types.ValidatorIndex]bool{1: true})
+	seen, included = c.EpochSummary(1)
+	assert.Equal(t, uint64(2), seen[1])
+	assert.Equal(t, uint64(2), included[1])
+
+	seen, _ = c.EpochSummary(3)
+	assert.Equal(t, 0, len(seen))
+}
+
+func TestPrevoteInclusionCache_Prune(t *testing.T) {
+	c := NewPrevoteInclusionCache()
+	spe := params.BeaconConfig().SlotsPerEpoch
+	c.Put(0, map[types.ValidatorIndex]bool{1: true})
+	c.Put(spe.Mul(maxPrevoteInclusionEpochs), map[types.ValidatorIndex]bool{1: true})
+	seen, _ := c.EpochSummary(0)
+	assert.Equal(t, uint64(1), seen[1])
+
+	c.Put(spe.Mul(maxPrevoteInclusionEpochs)+1, map[types.ValidatorIndex]bool{1: true})
+	seen, _ = c.EpochSummary(0)
+	assert.Equal(t, 0, len(seen))
+}
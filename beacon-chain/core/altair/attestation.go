@@ -176,7 +176,7 @@ func SetParticipationAndRewardProposer(
 	}
 
 	// write Rewards And Penalties log
-	if err = helpers.LogBeforeRewardsAndPenalties(beaconState, proposerIndex, proposerReward, indices, helpers.BalanceIncrease, helpers.OpProposing); err != nil {
+	if err = helpers.LogBeforeRewardsAndPenalties(ctx, beaconState, proposerIndex, proposerReward, indices, helpers.BalanceIncrease, helpers.OpProposing); err != nil {
 		log.WithError(err).WithFields(log.Fields{
 			"Slot":           beaconState.Slot(),
 			"Proposer":       proposerIndex,
@@ -382,7 +382,7 @@ func RewardBeaconBlockRootProposer(
 	}).Debug("Reward proposer: voting for root incr")
 
 	// write Rewards And Penalties log
-	if err = helpers.LogBeforeRewardsAndPenalties(beaconState, proposerIndex, reward, indices, helpers.BalanceIncrease, helpers.OpBlockAttested); err != nil {
+	if err = helpers.LogBeforeRewardsAndPenalties(ctx, beaconState, proposerIndex, reward, indices, helpers.BalanceIncrease, helpers.OpBlockAttested); err != nil {
 		log.WithError(err).WithFields(log.Fields{
 			"Slot":     beaconState.Slot(),
 			"Proposer": proposerIndex,
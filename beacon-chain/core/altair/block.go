@@ -148,7 +148,7 @@ func ApplySyncRewardsPenalties(ctx context.Context, s state.BeaconStateAltair, v
 		//}).Debug("Reward sync committee: participant incr")
 
 		// write Rewards And Penalties log
-		if err = helpers.LogBeforeRewardsAndPenalties(s, index, participantReward, nil, helpers.BalanceIncrease, helpers.OpSyncCommittee); err != nil {
+		if err = helpers.LogBeforeRewardsAndPenalties(ctx, s, index, participantReward, nil, helpers.BalanceIncrease, helpers.OpSyncCommittee); err != nil {
 			log.WithError(err).WithFields(log.Fields{
 				"Slot":              s.Slot(),
 				"Validator":         index,
@@ -173,7 +173,7 @@ func ApplySyncRewardsPenalties(ctx context.Context, s state.BeaconStateAltair, v
 	//}).Debug("Reward sync committee: proposer incr")
 
 	// write Rewards And Penalties log
-	if err = helpers.LogBeforeRewardsAndPenalties(s, proposerIndex, earnedProposerReward, nil, helpers.BalanceIncrease, helpers.OpSyncAggregation); err != nil {
+	if err = helpers.LogBeforeRewardsAndPenalties(ctx, s, proposerIndex, earnedProposerReward, nil, helpers.BalanceIncrease, helpers.OpSyncAggregation); err != nil {
 		log.WithError(err).WithFields(log.Fields{
 			"Slot":              s.Slot(),
 			"Validator":         proposerIndex,
@@ -195,7 +195,7 @@ func ApplySyncRewardsPenalties(ctx context.Context, s state.BeaconStateAltair, v
 		//}).Debug("Reward sync committee: proposer decr")
 
 		// write Rewards And Penalties log
-		if err = helpers.LogBeforeRewardsAndPenalties(s, index, participantReward, nil, helpers.BalanceDecrease, helpers.OpSyncCommittee); err != nil {
+		if err = helpers.LogBeforeRewardsAndPenalties(ctx, s, index, participantReward, nil, helpers.BalanceDecrease, helpers.OpSyncCommittee); err != nil {
 			log.WithError(err).WithFields(log.Fields{
 				"Slot":           s.Slot(),
 				"Validator":      index,
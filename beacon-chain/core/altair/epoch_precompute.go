@@ -219,6 +219,7 @@ func ProcessEpochParticipation(
 // ProcessRewardsAndPenaltiesPrecompute processes the rewards and penalties of individual validator.
 // This is an optimized version by passing in precomputed validator attesting records and and total epoch balances.
 func ProcessRewardsAndPenaltiesPrecompute(
+	ctx context.Context,
 	beaconState state.BeaconStateAltair,
 	bal *precompute.Balance,
 	vals []*precompute.Validator,
@@ -267,7 +268,7 @@ func ProcessRewardsAndPenaltiesPrecompute(
 			if err != nil {
 				return nil, err
 			}
-			if err = helpers.LogBalanceChanges(types.ValidatorIndex(valIndex), balances[valIndex], attsRewards[valIndex], aftBal, beaconState.Slot(), nil, helpers.BalanceIncrease, helpers.OpAttestation); err != nil {
+			if err = helpers.LogBalanceChanges(ctx, types.ValidatorIndex(valIndex), balances[valIndex], attsRewards[valIndex], aftBal, beaconState.Slot(), nil, helpers.BalanceIncrease, helpers.OpAttestation); err != nil {
 				return nil, err
 			}
 		}
@@ -285,7 +286,7 @@ func ProcessRewardsAndPenaltiesPrecompute(
 
 		// write Rewards And Penalties log
 		if attsPenalties[valIndex] != 0 {
-			if err = helpers.LogBalanceChanges(types.ValidatorIndex(valIndex), balances[valIndex], attsPenalties[valIndex], helpers.DecreaseBalanceWithVal(balances[valIndex], attsPenalties[valIndex]), beaconState.Slot(), nil, helpers.BalanceDecrease, helpers.OpAttestation); err != nil {
+			if err = helpers.LogBalanceChanges(ctx, types.ValidatorIndex(valIndex), balances[valIndex], attsPenalties[valIndex], helpers.DecreaseBalanceWithVal(balances[valIndex], attsPenalties[valIndex]), beaconState.Slot(), nil, helpers.BalanceDecrease, helpers.OpAttestation); err != nil {
 				return nil, err
 			}
 		}
@@ -278,7 +278,7 @@ func TestProcessRewardsAndPenaltiesPrecompute_Ok(t *testing.T) {
 	require.NoError(t, err)
 	validators, balance, err = ProcessEpochParticipation(context.Background(), s, balance, validators)
 	require.NoError(t, err)
-	s, err = ProcessRewardsAndPenaltiesPrecompute(s, balance, validators)
+	s, err = ProcessRewardsAndPenaltiesPrecompute(context.Background(), s, balance, validators)
 	require.NoError(t, err)
 
 	balances := s.Balances()
@@ -312,12 +312,12 @@ func TestProcessRewardsAndPenaltiesPrecompute_InactivityLeak(t *testing.T) {
 	validators, balance, err = ProcessEpochParticipation(context.Background(), s, balance, validators)
 	require.NoError(t, err)
 	sCopy := s.Copy()
-	s, err = ProcessRewardsAndPenaltiesPrecompute(s, balance, validators)
+	s, err = ProcessRewardsAndPenaltiesPrecompute(context.Background(), s, balance, validators)
 	require.NoError(t, err)
 
 	// Copied state where finality happened long ago
 	require.NoError(t, sCopy.SetSlot(params.BeaconConfig().SlotsPerEpoch*1000))
-	sCopy, err = ProcessRewardsAndPenaltiesPrecompute(sCopy, balance, validators)
+	sCopy, err = ProcessRewardsAndPenaltiesPrecompute(context.Background(), sCopy, balance, validators)
 	require.NoError(t, err)
 
 	balances := s.Balances()
@@ -398,7 +398,7 @@ func TestProcessRewardsAndPenaltiesPrecompute_GenesisEpoch(t *testing.T) {
 	validators, balance, err = ProcessEpochParticipation(context.Background(), s, balance, validators)
 	require.NoError(t, err)
 	require.NoError(t, s.SetSlot(0))
-	s, err = ProcessRewardsAndPenaltiesPrecompute(s, balance, validators)
+	s, err = ProcessRewardsAndPenaltiesPrecompute(context.Background(), s, balance, validators)
 	require.NoError(t, err)
 
 	balances := s.Balances()
@@ -416,7 +416,7 @@ func TestProcessRewardsAndPenaltiesPrecompute_BadState(t *testing.T) {
 	require.NoError(t, err)
 	_, balance, err = ProcessEpochParticipation(context.Background(), s, balance, validators)
 	require.NoError(t, err)
-	_, err = ProcessRewardsAndPenaltiesPrecompute(s, balance, []*precompute.Validator{})
+	_, err = ProcessRewardsAndPenaltiesPrecompute(context.Background(), s, balance, []*precompute.Validator{})
 	require.ErrorContains(t, "validator registries not the same length as state's validator registries", err)
 }
 
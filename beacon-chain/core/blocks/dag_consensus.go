@@ -44,6 +44,17 @@ type mapCandidates map[gwatCommon.Hash]gwatCommon.HashArray
 // 1. calculate new prefix of spines
 // 2. collect attestations and calculate consensus of finalization.
 func ProcessDagConsensus(ctx context.Context, beaconState state.BeaconState, signed block.SignedBeaconBlock) (state.BeaconState, error) {
+	return processDagConsensus(ctx, beaconState, signed, nil)
+}
+
+// processDagConsensus implements ProcessDagConsensus,
+// the intermediate data of the calculation is collected to the trace if provided.
+func processDagConsensus(
+	ctx context.Context,
+	beaconState state.BeaconState,
+	signed block.SignedBeaconBlock,
+	tr *DagConsensusTrace,
+) (state.BeaconState, error) {
 	if beaconState == nil || beaconState.IsNil() {
 		return nil, errors.New("nil state")
 	}
@@ -60,6 +71,10 @@ func ProcessDagConsensus(ctx context.Context, beaconState state.BeaconState, sig
 	if err != nil {
 		return nil, err
 	}
+	if tr != nil {
+		tr.Prefix = prefix.Copy()
+		tr.ParentSpines = unpubChains
+	}
 
 	//add item of block voting for the current block
 	if params.BeaconConfig().IsPrefixFinForkSlot(beaconBlock.Slot()) {
@@ -78,7 +93,7 @@ func ProcessDagConsensus(ctx context.Context, beaconState state.BeaconState, sig
 	}
 
 	//calculation of finalization sequence
-	finalization, err := calcFinalization(ctx, beaconState, blockVoting)
+	finalization, err := calcFinalization(ctx, beaconState, blockVoting, tr)
 	if err != nil {
 		return nil, err
 	}
@@ -115,16 +130,22 @@ func ProcessDagConsensus(ctx context.Context, beaconState state.BeaconState, sig
 
 	// removes BlockVoting with completely finalized candidates
 	deprecatedRoots := getBlockVotingsDeprecatedRoots(blockVoting, finalization)
+	traceRemovedBlockVoting(tr, blockVoting, deprecatedRoots, DropReasonFinalized)
 	blockVoting = removeBlockVoting(blockVoting, deprecatedRoots)
 
-	if blockVoting, err = handleBlockVotingVotesLimit(ctx, blockVoting, beaconState); err != nil {
+	if blockVoting, err = handleBlockVotingVotesLimit(ctx, blockVoting, beaconState, tr); err != nil {
 		return nil, err
 	}
 
 	// if it's a new epoch - removes stale BlockVoting.
 	if slots.IsEpochStart(beaconBlock.Slot()) {
+		stats := dagConsensusStats(ctx)
+		votesBefore := uint64(0)
+		if stats != nil {
+			votesBefore = countBlockVotingVotes(blockVoting)
+		}
 
-		if blockVoting, err = cleanBlockVotingStaleVotes(ctx, blockVoting, beaconState); err != nil {
+		if blockVoting, err = cleanBlockVotingStaleVotes(ctx, blockVoting, beaconState, tr); err != nil {
 			return nil, err
 		}
 
@@ -133,9 +154,15 @@ func ProcessDagConsensus(ctx context.Context, beaconState state.BeaconState, sig
 			return nil, err
 		}
 		staleRoots := getBlockVotingRootsLtSlot(blockVoting, cpSlot)
+		traceRemovedBlockVoting(tr, blockVoting, staleRoots, DropReasonBeforeCheckpoint)
 
 		blockVoting = removeBlockVoting(blockVoting, staleRoots)
 
+		if stats != nil {
+			stats.EpochStart = true
+			stats.StaleVotesRemoved = votesBefore - countBlockVotingVotes(blockVoting)
+		}
+
 		log.WithFields(logrus.Fields{
 			"slot":             beaconState.Slot(),
 			"BlockVoting":      len(blockVoting),
@@ -157,7 +184,12 @@ func ProcessDagConsensus(ctx context.Context, beaconState state.BeaconState, sig
 }
 
 // calcFinalization calculates finalization sequence by BlockVotings.
-func calcFinalization(ctx context.Context, beaconState state.BeaconState, blockVotingArr []*ethpb.BlockVoting) (gwatCommon.HashArray, error) {
+func calcFinalization(
+	ctx context.Context,
+	beaconState state.BeaconState,
+	blockVotingArr []*ethpb.BlockVoting,
+	tr *DagConsensusTrace,
+) (gwatCommon.HashArray, error) {
 	var (
 		blockVotings    = helpers.BlockVotingArrCopy(blockVotingArr)
 		supportedVotes  = make([]*ethpb.BlockVoting, 0)
@@ -183,6 +215,7 @@ func calcFinalization(ctx context.Context, beaconState state.BeaconState, blockV
 				"lastFinSpine": lastFinSpine.Hex(),
 			}).Warn("skip: bad candidates (not uniq)")
 			badVotes = append(badVotes, bv)
+			tr.addDropped(bv.GetRoot(), bv.GetCandidates(), nil, DropReasonNotUniqCandidates)
 			continue
 		}
 
@@ -191,17 +224,47 @@ func calcFinalization(ctx context.Context, beaconState state.BeaconState, blockV
 		for _, att := range bv.GetVotes() {
 			mapSlotAtt[att.GetSlot()] = append(mapSlotAtt[att.GetSlot()], att)
 		}
+		var bvTrace *BlockVotingTrace
+		if tr != nil {
+			bvTrace = &BlockVotingTrace{
+				Root:       bytesutil.ToBytes32(bv.GetRoot()),
+				Slot:       bv.GetSlot(),
+				Candidates: candidates,
+			}
+		}
+		isSupported := false
 		for slot, atts := range mapSlotAtt {
 			minSupport, err := BlockVotingMinSupport(ctx, beaconState, slot)
 			if err != nil {
 				return nil, err
 			}
+			votes := helpers.CountCommitteeVotes(atts)
+			if bvTrace != nil {
+				bvTrace.SlotsSupport = append(bvTrace.SlotsSupport, &SlotSupportTrace{
+					Slot:       slot,
+					Votes:      votes,
+					MinSupport: minSupport,
+				})
+			}
 			// if provided enough support for slot adds data as separated item
-			if helpers.CountCommitteeVotes(atts) >= uint64(minSupport) {
-				supportedVotes = append(supportedVotes, helpers.BlockVotingCopy(bv))
-				break
+			if votes >= uint64(minSupport) {
+				isSupported = true
+				// check all the slots while tracing
+				if bvTrace == nil {
+					break
+				}
 			}
 		}
+		if isSupported {
+			supportedVotes = append(supportedVotes, helpers.BlockVotingCopy(bv))
+		}
+		if bvTrace != nil {
+			sort.Slice(bvTrace.SlotsSupport, func(i, j int) bool {
+				return bvTrace.SlotsSupport[i].Slot < bvTrace.SlotsSupport[j].Slot
+			})
+			bvTrace.Supported = isSupported
+			tr.addVoting(bvTrace)
+		}
 	}
 
 	log.WithFields(logrus.Fields{
@@ -229,6 +292,7 @@ func calcFinalization(ctx context.Context, beaconState state.BeaconState, blockV
 				"lastFinSpine": lastFinSpine.Hex(),
 			}).Warn("skip: no candidates")
 			badVotes = append(badVotes, bv)
+			tr.addDropped(bv.GetRoot(), bv.GetCandidates(), nil, DropReasonNoCandidates)
 			continue
 		}
 
@@ -261,6 +325,31 @@ func calcFinalization(ctx context.Context, beaconState state.BeaconState, blockV
 		}
 	}
 
+	if stats := dagConsensusStats(ctx); stats != nil {
+		stats.SupportedBlockVotings = len(supportedVotes)
+		stats.CandidatesVotes = make([]int, 0, len(tabVoting))
+		for _, votes := range tabVoting {
+			stats.CandidatesVotes = append(stats.CandidatesVotes, votes)
+		}
+	}
+
+	if tr != nil {
+		tr.RequiredVotes = slotsToConfirm
+		tr.CandidatesVotes = make([]*CandidatesVotesTrace, 0, len(tabVoting))
+		for key, votes := range tabVoting {
+			tr.CandidatesVotes = append(tr.CandidatesVotes, &CandidatesVotesTrace{
+				Candidates: tabCandidates[key],
+				Votes:      votes,
+			})
+		}
+		sort.Slice(tr.CandidatesVotes, func(i, j int) bool {
+			if len(tr.CandidatesVotes[i].Candidates) != len(tr.CandidatesVotes[j].Candidates) {
+				return len(tr.CandidatesVotes[i].Candidates) > len(tr.CandidatesVotes[j].Candidates)
+			}
+			return tr.CandidatesVotes[i].Votes > tr.CandidatesVotes[j].Votes
+		})
+	}
+
 	//sort by priority
 	priorities := make([]int, 0)
 	for p := range tabPriority {
@@ -293,6 +382,9 @@ func calcFinalization(ctx context.Context, beaconState state.BeaconState, blockV
 
 	if resKey != (gwatCommon.Hash{}) {
 		resFinalization = append(resFinalization, tabCandidates[resKey]...)
+		if tr != nil {
+			tr.Finalized = tabCandidates[resKey].Copy()
+		}
 	}
 
 	return resFinalization, nil
@@ -431,7 +523,12 @@ func getBlockVotingRootsLtSlot(blockVoting []*ethpb.BlockVoting, slot types.Slot
 }
 
 // cleanBlockVotingStaleVotes removes unsupported BlockVoting.Votes and empty BlockVoting older than 2 epochs.
-func cleanBlockVotingStaleVotes(ctx context.Context, blockVoting []*ethpb.BlockVoting, bState state.BeaconState) ([]*ethpb.BlockVoting, error) {
+func cleanBlockVotingStaleVotes(
+	ctx context.Context,
+	blockVoting []*ethpb.BlockVoting,
+	bState state.BeaconState,
+	tr *DagConsensusTrace,
+) ([]*ethpb.BlockVoting, error) {
 	// define slot of deprecation
 	stSlot := bState.Slot()
 	targetEpoch := slots.ToEpoch(bState.Slot())
@@ -488,6 +585,8 @@ func cleanBlockVotingStaleVotes(ctx context.Context, blockVoting []*ethpb.BlockV
 						"blVoting":       helpers.PrintBlockVoting(bv),
 					}).Info("BlockVoting votes clean stale votes")
 
+					rmSlot := slot
+					tr.addDropped(bv.GetRoot(), bv.GetCandidates(), &rmSlot, DropReasonStaleVotes)
 					resLen -= len(slotMap[slot])
 					delete(slotMap, slot)
 				}
@@ -515,13 +614,19 @@ func cleanBlockVotingStaleVotes(ctx context.Context, blockVoting []*ethpb.BlockV
 				"staleVotesSlot": staleVotesSlot,
 				"blVoting":       helpers.PrintBlockVoting(bv),
 			}).Info("BlockVoting votes clean empty item")
+			tr.addDropped(bv.GetRoot(), bv.GetCandidates(), nil, DropReasonStaleEmpty)
 		}
 	}
 	return res, nil
 }
 
 // handleBlockVotingVotesLimit checks BlockVoting.Votes len limitation and reduces len in needed.
-func handleBlockVotingVotesLimit(ctx context.Context, blockVoting []*ethpb.BlockVoting, bState state.BeaconState) ([]*ethpb.BlockVoting, error) {
+func handleBlockVotingVotesLimit(
+	ctx context.Context,
+	blockVoting []*ethpb.BlockVoting,
+	bState state.BeaconState,
+	tr *DagConsensusTrace,
+) ([]*ethpb.BlockVoting, error) {
 	var (
 		minSupport    int
 		err           error
@@ -570,6 +675,8 @@ func handleBlockVotingVotesLimit(ctx context.Context, blockVoting []*ethpb.Block
 				}
 				// if no enough support rm slot votes
 				if helpers.CountCommitteeVotes(votes) < uint64(minSupport) {
+					rmSlot := slot
+					tr.addDropped(bv.GetRoot(), bv.GetCandidates(), &rmSlot, DropReasonVotesLimit)
 					resLen -= len(slotMap[slot])
 					delete(slotMap, slot)
 					break
@@ -649,6 +756,18 @@ func appendBlockVotingAtt(votes []*ethpb.BlockVoting, val *ethpb.Attestation, is
 	return cpy
 }
 
+// traceRemovedBlockVoting records the BlockVoting items of the roots to the trace as dropped.
+func traceRemovedBlockVoting(tr *DagConsensusTrace, votes []*ethpb.BlockVoting, roots [][]byte, reason string) {
+	if tr == nil {
+		return
+	}
+	for _, itm := range votes {
+		if helpers.IndexOfRoot(roots, itm.Root) > -1 {
+			tr.addDropped(itm.GetRoot(), itm.GetCandidates(), nil, reason)
+		}
+	}
+}
+
 func removeBlockVoting(votes []*ethpb.BlockVoting, roots [][]byte) []*ethpb.BlockVoting {
 	if len(roots) == 0 {
 		return votes
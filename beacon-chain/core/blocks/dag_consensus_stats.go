@@ -0,0 +1,65 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package blocks
+
+import (
+	"context"
+
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/helpers"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+)
+
+// DagConsensusStats are the statistics of the dag consensus processing of a block.
+// They are collected only if requested by the context of the processing,
+// so that the replay of blocks by stategen and the state root computation of proposals do not affect them.
+type DagConsensusStats struct {
+	// SupportedBlockVotings is the number of the BlockVoting items which reached BlockVotingMinSupport.
+	SupportedBlockVotings int
+	// CandidatesVotes are the numbers of votes of the candidates sequences of the finalization calculation.
+	CandidatesVotes []int
+	// EpochStart is true if the block removed the stale BlockVoting votes at the start of an epoch.
+	EpochStart bool
+	// StaleVotesRemoved is the number of the BlockVoting votes removed as stale.
+	StaleVotesRemoved uint64
+}
+
+type dagConsensusStatsKey struct{}
+
+// DagConsensusStatsContext returns the context of the state transition,
+// which collects the statistics of the dag consensus processing to the given stats.
+func DagConsensusStatsContext(ctx context.Context, stats *DagConsensusStats) context.Context {
+	return context.WithValue(ctx, dagConsensusStatsKey{}, stats)
+}
+
+// dagConsensusStats returns the stats collected by the context of the processing if any.
+func dagConsensusStats(ctx context.Context) *DagConsensusStats {
+	if ctx == nil {
+		return nil
+	}
+	stats, ok := ctx.Value(dagConsensusStatsKey{}).(*DagConsensusStats)
+	if !ok {
+		return nil
+	}
+	return stats
+}
+
+// countBlockVotingVotes returns the total number of the committee votes of the BlockVoting items.
+func countBlockVotingVotes(blockVoting []*ethpb.BlockVoting) uint64 {
+	count := uint64(0)
+	for _, bv := range blockVoting {
+		count += helpers.CountCommitteeVotes(bv.GetVotes())
+	}
+	return count
+}
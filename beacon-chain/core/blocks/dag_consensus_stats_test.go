@@ -0,0 +1,45 @@
+package blocks
+
+import (
+	"context"
+	"testing"
+
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/util"
+	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
+)
+
+func TestDagConsensusStatsContext(t *testing.T) {
+	require.Equal(t, true, dagConsensusStats(context.Background()) == nil)
+
+	stats := &DagConsensusStats{}
+	ctx := DagConsensusStatsContext(context.Background(), stats)
+	require.Equal(t, stats, dagConsensusStats(ctx))
+}
+
+func Test_calcFinalization_Stats(t *testing.T) {
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	finalization := gwatCommon.HashArray{{0x01}}
+	require.NoError(t, st.SetSpineData(&ethpb.SpineData{
+		Finalization: finalization.ToBytes(),
+		CpFinalized:  finalization.ToBytes(),
+	}))
+	blockVoting := []*ethpb.BlockVoting{
+		{
+			Root:       bytesutil.PadTo([]byte{1}, 32),
+			Slot:       1,
+			Candidates: gwatCommon.HashArray{{0x02}}.ToBytes(),
+		},
+	}
+
+	stats := &DagConsensusStats{}
+	ctx := DagConsensusStatsContext(context.Background(), stats)
+	_, err = calcFinalization(ctx, st, blockVoting, nil)
+	require.NoError(t, err)
+	require.Equal(t, 0, stats.SupportedBlockVotings)
+	require.Equal(t, 0, len(stats.CandidatesVotes))
+	require.Equal(t, false, stats.EpochStart)
+}
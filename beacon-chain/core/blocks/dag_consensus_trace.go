@@ -0,0 +1,123 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package blocks
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/state"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1/block"
+	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
+)
+
+// Reasons of the BlockVoting items and votes removal recorded by DagConsensusTrace.
+const (
+	DropReasonNotUniqCandidates = "candidates are not unique"
+	DropReasonNoCandidates      = "all candidates are finalized"
+	DropReasonFinalized         = "last candidate is finalized"
+	DropReasonVotesLimit        = "votes limit exceeded, unsupported slot votes removed"
+	DropReasonStaleVotes        = "unsupported slot votes older than 2 epochs"
+	DropReasonStaleEmpty        = "no votes left and older than 2 epochs"
+	DropReasonBeforeCheckpoint  = "older than finalized checkpoint"
+)
+
+// DagConsensusTrace collects the intermediate data of the dag consensus calculation
+// of a block, which is used to investigate how SpineData and BlockVoting were obtained.
+type DagConsensusTrace struct {
+	// Slot of the processed block.
+	Slot types.Slot
+	// Prefix calculated by CalcPrefixAndParentSpines before the finalization cutoff.
+	Prefix gwatCommon.HashArray
+	// ParentSpines calculated by CalcPrefixAndParentSpines.
+	ParentSpines []gwatCommon.HashArray
+	// Votings describes the support of each BlockVoting item taken into account.
+	Votings []*BlockVotingTrace
+	// CandidatesVotes contains the number of votes of each candidates sequence.
+	CandidatesVotes []*CandidatesVotesTrace
+	// RequiredVotes is the min number of votes for a candidates sequence to be finalized.
+	RequiredVotes int
+	// Finalized are the spines finalized by the block.
+	Finalized gwatCommon.HashArray
+	// Dropped describes the BlockVoting items and votes removed while processing.
+	Dropped []*DroppedVotingTrace
+}
+
+// BlockVotingTrace describes the support of a BlockVoting item.
+type BlockVotingTrace struct {
+	Root       [32]byte
+	Slot       types.Slot
+	Candidates gwatCommon.HashArray
+	// SlotsSupport contains the votes of each attestations' slot.
+	SlotsSupport []*SlotSupportTrace
+	// Supported is true if the BlockVotingMinSupport threshold was reached for any slot.
+	Supported bool
+}
+
+// SlotSupportTrace describes the votes of an attestations' slot of a BlockVoting item.
+type SlotSupportTrace struct {
+	Slot       types.Slot
+	Votes      uint64
+	MinSupport int
+}
+
+// CandidatesVotesTrace contains the number of votes of a candidates sequence.
+type CandidatesVotesTrace struct {
+	Candidates gwatCommon.HashArray
+	Votes      int
+}
+
+// DroppedVotingTrace describes the removal of a BlockVoting item or a part of its votes.
+type DroppedVotingTrace struct {
+	Root       [32]byte
+	Candidates gwatCommon.HashArray
+	// VotesSlot is the slot of the removed votes, if only a part of votes is removed.
+	VotesSlot *types.Slot
+	Reason    string
+}
+
+// TraceDagConsensus performs ProcessDagConsensus and collects the intermediate data of the calculation.
+func TraceDagConsensus(
+	ctx context.Context,
+	beaconState state.BeaconState,
+	signed block.SignedBeaconBlock,
+) (state.BeaconState, *DagConsensusTrace, error) {
+	tr := &DagConsensusTrace{Slot: signed.Block().Slot()}
+	st, err := processDagConsensus(ctx, beaconState, signed, tr)
+	if err != nil {
+		return nil, nil, err
+	}
+	return st, tr, nil
+}
+
+func (tr *DagConsensusTrace) addDropped(root, candidates []byte, votesSlot *types.Slot, reason string) {
+	if tr == nil {
+		return
+	}
+	tr.Dropped = append(tr.Dropped, &DroppedVotingTrace{
+		Root:       bytesutil.ToBytes32(root),
+		Candidates: gwatCommon.HashArrayFromBytes(candidates),
+		VotesSlot:  votesSlot,
+		Reason:     reason,
+	})
+}
+
+func (tr *DagConsensusTrace) addVoting(bvTrace *BlockVotingTrace) {
+	if tr == nil {
+		return
+	}
+	tr.Votings = append(tr.Votings, bvTrace)
+}
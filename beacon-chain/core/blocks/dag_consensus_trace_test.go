@@ -0,0 +1,74 @@
+package blocks
+
+import (
+	"context"
+	"testing"
+
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/util"
+	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
+)
+
+func Test_calcFinalization_Trace(t *testing.T) {
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	finalization := gwatCommon.HashArray{{0x01}}
+	require.NoError(t, st.SetSpineData(&ethpb.SpineData{
+		Finalization: finalization.ToBytes(),
+		CpFinalized:  finalization.ToBytes(),
+	}))
+
+	notUniqRoot := bytesutil.PadTo([]byte{1}, 32)
+	noVotesRoot := bytesutil.PadTo([]byte{2}, 32)
+	blockVoting := []*ethpb.BlockVoting{
+		{
+			Root:       notUniqRoot,
+			Slot:       1,
+			Candidates: gwatCommon.HashArray{{0x02}, {0x02}}.ToBytes(),
+		},
+		{
+			Root:       noVotesRoot,
+			Slot:       1,
+			Candidates: gwatCommon.HashArray{{0x03}}.ToBytes(),
+		},
+	}
+
+	tr := &DagConsensusTrace{}
+	res, err := calcFinalization(context.Background(), st, blockVoting, tr)
+	require.NoError(t, err)
+	require.DeepEqual(t, finalization, res)
+
+	require.Equal(t, 1, len(tr.Dropped))
+	require.Equal(t, bytesutil.ToBytes32(notUniqRoot), tr.Dropped[0].Root)
+	require.Equal(t, DropReasonNotUniqCandidates, tr.Dropped[0].Reason)
+
+	require.Equal(t, 1, len(tr.Votings))
+	require.Equal(t, bytesutil.ToBytes32(noVotesRoot), tr.Votings[0].Root)
+	require.Equal(t, false, tr.Votings[0].Supported)
+	require.Equal(t, 0, len(tr.Votings[0].SlotsSupport))
+
+	require.Equal(t, 0, len(tr.CandidatesVotes))
+	require.Equal(t, params.BeaconConfig().VotingRequiredSlots, tr.RequiredVotes)
+	require.Equal(t, 0, len(tr.Finalized))
+}
+
+func Test_traceRemovedBlockVoting(t *testing.T) {
+	blockVoting := []*ethpb.BlockVoting{
+		{Root: bytesutil.PadTo([]byte{1}, 32), Candidates: gwatCommon.HashArray{{0x01}}.ToBytes()},
+		{Root: bytesutil.PadTo([]byte{2}, 32), Candidates: gwatCommon.HashArray{{0x02}}.ToBytes()},
+	}
+	roots := [][]byte{bytesutil.PadTo([]byte{2}, 32)}
+
+	// Nil trace is ignored.
+	traceRemovedBlockVoting(nil, blockVoting, roots, DropReasonFinalized)
+
+	tr := &DagConsensusTrace{}
+	traceRemovedBlockVoting(tr, blockVoting, roots, DropReasonFinalized)
+	require.Equal(t, 1, len(tr.Dropped))
+	require.Equal(t, bytesutil.ToBytes32(roots[0]), tr.Dropped[0].Root)
+	require.DeepEqual(t, gwatCommon.HashArray{{0x02}}, tr.Dropped[0].Candidates)
+	require.Equal(t, DropReasonFinalized, tr.Dropped[0].Reason)
+}
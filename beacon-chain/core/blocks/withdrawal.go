@@ -88,6 +88,7 @@ func ProcessWithdrawal(
 
 		// write Rewards And Penalties log
 		if err = helpers.LogBeforeRewardsAndPenalties(
+			ctx,
 			beaconState,
 			withdrawal.ValidatorIndex,
 			amount,
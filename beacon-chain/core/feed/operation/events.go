@@ -19,6 +19,9 @@ const (
 
 	// SyncCommitteeContributionReceived is sent after a sync committee contribution object has been received.
 	SyncCommitteeContributionReceived
+
+	// PrevoteReceived is sent after a prevote object has been received from the outside world (eg in RPC or sync).
+	PrevoteReceived
 )
 
 // UnAggregatedAttReceivedData is the data sent with UnaggregatedAttReceived events.
@@ -44,3 +47,9 @@ type SyncCommitteeContributionReceivedData struct {
 	// Contribution is the sync committee contribution object.
 	Contribution *ethpb.SignedContributionAndProof
 }
+
+// PrevoteReceivedData is the data sent with PrevoteReceived events.
+type PrevoteReceivedData struct {
+	// Prevote is the prevote object.
+	Prevote *ethpb.PreVote
+}
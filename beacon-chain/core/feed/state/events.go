@@ -26,6 +26,8 @@ const (
 	FinalizedCheckpoint
 	// NewHead of the chain event.
 	NewHead
+	// GwatFinalization is sent after each call of the gwat finalization api, successful or not.
+	GwatFinalization
 )
 
 // BlockProcessedData is the data sent with BlockProcessed events.
@@ -61,3 +63,29 @@ type InitializedData struct {
 	// GenesisValidatorsRoot represents state.validators.HashTreeRoot().
 	GenesisValidatorsRoot []byte
 }
+
+// GwatFinalizationData is the data sent with GwatFinalization events.
+type GwatFinalizationData struct {
+	// Slot of the state the finalization params were collected from.
+	Slot types.Slot
+	// SyncMode of the finalization call.
+	SyncMode string
+	// Spines sent to gwat for finalization.
+	Spines [][32]byte
+	// BaseSpine sent to gwat for finalization.
+	BaseSpine [32]byte
+	// CpEpoch is the epoch of the checkpoint sent to gwat.
+	CpEpoch uint64
+	// CpFinEpoch is the finalization epoch of the checkpoint sent to gwat.
+	CpFinEpoch uint64
+	// CpRoot is the root of the checkpoint sent to gwat.
+	CpRoot [32]byte
+	// CpSpine is the spine of the checkpoint sent to gwat.
+	CpSpine [32]byte
+	// LFSpine is the last finalized spine returned by gwat.
+	LFSpine [32]byte
+	// Error of the finalization call, empty on success.
+	Error string
+	// Time of the finalization call completion.
+	Time time.Time
+}
@@ -1,23 +1,16 @@
 package helpers
 
 import (
-	"bufio"
+	"context"
 	"errors"
 	"fmt"
-	"math"
-	"os"
-	"path"
-	"strconv"
-	"strings"
-	"time"
 
-	lru "github.com/hashicorp/golang-lru"
 	types "github.com/prysmaticlabs/eth2-types"
 	log "github.com/sirupsen/logrus"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/cache"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/rewards"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/state"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
-	"gitlab.waterfall.network/waterfall/protocol/coordinator/crypto/hash"
 	mathutil "gitlab.waterfall.network/waterfall/protocol/coordinator/math"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/time/slots"
 )
@@ -218,101 +211,55 @@ func FinalityDelay(prevEpoch, finalizedEpoch types.Epoch) types.Epoch {
 	return prevEpoch - finalizedEpoch
 }
 
-/* functionality of Reward and Penalty logging */
+/* functionality of Reward and Penalty recording */
 const (
-	BalanceIncrease   = "+"
-	BalanceDecrease   = "-"
-	OpAttestation     = "attesting"
-	OpProposing       = "blk-props"
-	OpBlockAttested   = "blk-attsd"
-	OpSyncCommittee   = "sync-comm"
-	OpSyncAggregation = "sync-aggr"
-	OpWithdrawal      = "withdrawal"
-	MetaGlobalParams  = "META"
+	BalanceIncrease   = rewards.Increase
+	BalanceDecrease   = rewards.Decrease
+	OpAttestation     = rewards.RoleAttestation
+	OpProposing       = rewards.RoleProposing
+	OpBlockAttested   = rewards.RoleBlockAttested
+	OpSyncCommittee   = rewards.RoleSyncCommittee
+	OpSyncAggregation = rewards.RoleSyncAggregation
+	OpWithdrawal      = rewards.RoleWithdrawal
 )
 
-var (
-	rpLogCache *lru.Cache
-	rpLogFile  string
-)
+// RewardsRecorderContext returns the context of the state transition, which records
+// the rewards and penalties applied by the transition to the recorder.
+func RewardsRecorderContext(ctx context.Context, r rewards.Recorder) context.Context {
+	return context.WithValue(ctx, params.BeaconConfig().CtxRewardsRecorderKey, r)
+}
+
+// IsRewardsRecording returns true if the rewards and penalties applied by the state transition
+// of the context are recorded.
+func IsRewardsRecording(ctx context.Context) bool {
+	return rewardsRecorder(ctx) != nil
+}
 
-func initLogRewardsAndPenalties(st state.BeaconState) error {
-	if !params.BeaconConfig().WriteRewardLogFlag {
+func rewardsRecorder(ctx context.Context) rewards.Recorder {
+	if !params.BeaconConfig().SaveRewards || ctx == nil {
 		return nil
 	}
-	if rpLogCache != nil {
+	r, ok := ctx.Value(params.BeaconConfig().CtxRewardsRecorderKey).(rewards.Recorder)
+	if !ok {
 		return nil
 	}
-	vCount := st.NumValidators()
-	cacheLen := int(params.BeaconConfig().SlotsPerEpoch) * vCount
-	var err error
-	rpLogCache, err = lru.New(cacheLen)
-	if err != nil {
-		panic(fmt.Errorf("lru new rpLogCache failed: %w", err))
-	}
-
-	now := time.Now()
-	rpLogFileName := fmt.Sprintf("rewards-%s.log", now.Format("060102t150405"))
-	rpLogFile = path.Join(params.BeaconConfig().DataDir, rpLogFileName)
-	file, err := os.OpenFile(rpLogFile, os.O_CREATE|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions) // #nosec G304
-	if err != nil {
-		return err
-	}
-	defer func() {
-		if err := file.Close(); err != nil {
-			panic(err)
-		}
-	}()
-
-	// collect metadata params
-	numberOfCoordinators := vCount
-	optimalNumberOfCoordinators := params.BeaconConfig().OptValidatorsNum
-	stakeAmount := params.BeaconConfig().MaxEffectiveBalance / uint64(math.Pow(10, 9))
-	annualizedReturnRate := params.BeaconConfig().MaxAnnualizedReturnRate
-	slotDuration := params.BeaconConfig().SecondsPerSlot
-
-	// Write the metadata params
-	writer := bufio.NewWriter(file)
-	_, err = fmt.Fprintf(writer, fmt.Sprintf(
-		"%d %s %d %d %f %d %d %d %s %s\n",
-		numberOfCoordinators,
-		MetaGlobalParams,
-		optimalNumberOfCoordinators,
-		stakeAmount,
-		annualizedReturnRate,
-		slotDuration,
-		-1,
-		-1,
-		"NOOP",
-		"-",
-	))
-	if err != nil {
-		return err
-	}
-
-	// Flush the writer to ensure the line is written to the file
-	err = writer.Flush()
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return r
 }
 
+// LogBeforeRewardsAndPenalties records the balance change of the validator
+// before it is applied to the state.
 func LogBeforeRewardsAndPenalties(
+	ctx context.Context,
 	st state.BeaconState,
 	validator types.ValidatorIndex,
 	amount uint64,
 	votesIncluded []uint64,
-	operation,
-	role string,
+	operation rewards.Operation,
+	role rewards.Role,
 ) error {
-	if !params.BeaconConfig().WriteRewardLogFlag {
+	if !IsRewardsRecording(ctx) {
 		return nil
 	}
-	if err := initLogRewardsAndPenalties(st); err != nil {
-		return err
-	}
 	// skip zero values
 	if amount == 0 {
 		return nil
@@ -326,90 +273,41 @@ func LogBeforeRewardsAndPenalties(
 	case BalanceIncrease:
 		after = before + amount
 	case BalanceDecrease:
-		after = before - amount
+		after = DecreaseBalanceWithVal(before, amount)
 	default:
 		return fmt.Errorf("bad operation %s", operation)
 	}
-	return LogBalanceChanges(validator, before, amount, after, st.Slot(), votesIncluded, operation, role)
+	return LogBalanceChanges(ctx, validator, before, amount, after, st.Slot(), votesIncluded, operation, role)
 }
 
+// LogBalanceChanges records the balance change of the validator at the slot.
 func LogBalanceChanges(
+	ctx context.Context,
 	index types.ValidatorIndex,
 	before, delta, after uint64,
 	slot types.Slot,
 	votesIncluded []uint64,
-	operation, role string,
+	operation rewards.Operation,
+	role rewards.Role,
 ) error {
-	if !params.BeaconConfig().WriteRewardLogFlag {
+	recorder := rewardsRecorder(ctx)
+	if recorder == nil {
 		return nil
 	}
-	//start write after 3-d epoch
-	if slots.ToEpoch(slot) < 3 {
-		return nil
-	}
-	if rpLogCache == nil {
-		return fmt.Errorf("Reward and penalty logging is not initialized")
-	}
-
-	votesIncludedNum := uint64(0)
-	votesList := ""
-	if votesIncluded != nil {
-		votesIncludedNum = uint64(len(votesIncluded))
-
-		strNumbers := make([]string, len(votesIncluded))
-		for i, num := range votesIncluded {
-			strNumbers[i] = strconv.FormatUint(num, 10)
-		}
-		votesList = strings.Join(strNumbers, ",")
-		if votesIncludedNum == 0 {
-			votesList = "-"
-		}
-	} else {
-		votesList = "-"
-	}
-
-	line := fmt.Sprintf(
-		"%d %s %d %d %d %d %d %d %s %s",
-		index,
-		role,
-		votesIncludedNum,
-		before,
-		delta,
-		after,
-		slot,
-		slots.ToEpoch(slot),
-		operation,
-		votesList,
-	)
-	key := hash.FastSum64([]byte(line))
-	if _, ok := rpLogCache.Get(key); ok {
-		return nil
+	var votes []uint64
+	if len(votesIncluded) > 0 {
+		votes = make([]uint64, len(votesIncluded))
+		copy(votes, votesIncluded)
 	}
-	rpLogCache.Add(key, true)
-
-	// Open the file in append mode
-	file, err := os.OpenFile(rpLogFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, params.BeaconIoConfig().ReadWritePermissions) // #nosec G304
-	if err != nil {
-		return err
-	}
-	defer func() {
-		if err := file.Close(); err != nil {
-			panic(err)
-		}
-	}()
-	// Create a new writer for the file
-	writer := bufio.NewWriter(file)
-	// Write the new line to the file
-	_, err = fmt.Fprintln(writer, line)
-	if err != nil {
-		return err
-	}
-
-	// Flush the writer to ensure the line is written to the file
-	err = writer.Flush()
-	if err != nil {
-		return err
-	}
-
+	recorder.RecordBalanceChange(&rewards.BalanceChange{
+		Slot:          slot,
+		Validator:     index,
+		Operation:     operation,
+		Role:          role,
+		Amount:        delta,
+		VotesIncluded: votes,
+		Before:        before,
+		After:         after,
+	})
 	return nil
 }
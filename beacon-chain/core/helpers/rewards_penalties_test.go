@@ -1,10 +1,12 @@
 package helpers
 
 import (
+	"context"
 	"math"
 	"testing"
 
 	types "github.com/prysmaticlabs/eth2-types"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/rewards"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/time"
 	v1 "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/state/v1"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/features"
@@ -260,3 +262,49 @@ func TestIncreaseBadBalance_NotOK(t *testing.T) {
 		require.ErrorContains(t, "addition overflows", IncreaseBalance(state, test.i, test.nb))
 	}
 }
+
+func TestLogBeforeRewardsAndPenalties_Recorder(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.SaveRewards = true
+	params.OverrideBeaconConfig(cfg)
+
+	buf := rewards.NewBuffer()
+	ctx := RewardsRecorderContext(context.Background(), buf)
+
+	state, err := v1.InitializeFromProto(&ethpb.BeaconState{
+		Slot:     5,
+		Balances: []uint64{100, 50},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, LogBeforeRewardsAndPenalties(ctx, state, 0, 10, []uint64{1}, BalanceIncrease, OpProposing))
+	require.NoError(t, LogBeforeRewardsAndPenalties(ctx, state, 1, 70, nil, BalanceDecrease, OpSyncCommittee))
+	// zero amounts are skipped
+	require.NoError(t, LogBeforeRewardsAndPenalties(ctx, state, 1, 0, nil, BalanceDecrease, OpSyncCommittee))
+
+	changes := buf.Flush()
+	require.Equal(t, 2, len(changes))
+	require.DeepEqual(t, &rewards.BalanceChange{
+		Slot:          5,
+		Validator:     0,
+		Operation:     rewards.Increase,
+		Role:          rewards.RoleProposing,
+		Amount:        10,
+		VotesIncluded: []uint64{1},
+		Before:        100,
+		After:         110,
+	}, changes[0])
+	assert.Equal(t, uint64(0), changes[1].After)
+
+	// nothing is recorded by the state transition without the recorder
+	require.NoError(t, LogBeforeRewardsAndPenalties(context.Background(), state, 0, 10, nil, BalanceIncrease, OpProposing))
+	require.Equal(t, 0, len(buf.Flush()))
+
+	// nothing is recorded if saving is disabled
+	cfg.SaveRewards = false
+	params.OverrideBeaconConfig(cfg)
+	require.Equal(t, false, IsRewardsRecording(ctx))
+	require.NoError(t, LogBeforeRewardsAndPenalties(ctx, state, 0, 10, nil, BalanceIncrease, OpProposing))
+	require.Equal(t, 0, len(buf.Flush()))
+}
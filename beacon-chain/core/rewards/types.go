@@ -0,0 +1,142 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+// Package rewards defines the records of the rewards and penalties applied
+// to the validators balances by the state transition.
+package rewards
+
+import (
+	"fmt"
+	"sync"
+
+	types "github.com/prysmaticlabs/eth2-types"
+)
+
+// Operation is the direction of the balance change.
+type Operation uint8
+
+const (
+	// Increase is a reward added to the validator balance.
+	Increase Operation = iota + 1
+	// Decrease is a penalty or a withdrawal subtracted from the validator balance.
+	Decrease
+)
+
+// String returns the name of the operation.
+func (o Operation) String() string {
+	switch o {
+	case Increase:
+		return "+"
+	case Decrease:
+		return "-"
+	default:
+		return fmt.Sprintf("unknown(%d)", o)
+	}
+}
+
+// Role is the duty of the validator which the balance change is applied for.
+type Role uint8
+
+const (
+	// RoleAttestation is the attesting reward or penalty of the epoch processing.
+	RoleAttestation Role = iota + 1
+	// RoleProposing is the reward of the proposer for the attestations included in the block.
+	RoleProposing
+	// RoleBlockAttested is the reward of the proposer of the block voted by the included attestations.
+	RoleBlockAttested
+	// RoleSyncCommittee is the reward or penalty of the sync committee participant.
+	RoleSyncCommittee
+	// RoleSyncAggregation is the reward of the proposer for the included sync aggregate.
+	RoleSyncAggregation
+	// RoleWithdrawal is the withdrawal included in the block.
+	RoleWithdrawal
+)
+
+// String returns the name of the role.
+func (r Role) String() string {
+	switch r {
+	case RoleAttestation:
+		return "attesting"
+	case RoleProposing:
+		return "blk-props"
+	case RoleBlockAttested:
+		return "blk-attsd"
+	case RoleSyncCommittee:
+		return "sync-comm"
+	case RoleSyncAggregation:
+		return "sync-aggr"
+	case RoleWithdrawal:
+		return "withdrawal"
+	default:
+		return fmt.Sprintf("unknown(%d)", r)
+	}
+}
+
+// IsBlockRole returns true if the balance change of the role is applied by the block processing,
+// otherwise it is applied by the epoch processing.
+func (r Role) IsBlockRole() bool {
+	return r != RoleAttestation
+}
+
+// BalanceChange is a reward or a penalty applied to the validator balance at the slot.
+// BlockRoot is the root of the imported block, which state transition applied the change.
+// VotesIncluded contains the indices of the attesters, which votes are rewarded to the proposer.
+type BalanceChange struct {
+	Slot          types.Slot
+	BlockRoot     [32]byte
+	Validator     types.ValidatorIndex
+	Operation     Operation
+	Role          Role
+	Amount        uint64
+	VotesIncluded []uint64
+	Before        uint64
+	After         uint64
+}
+
+// Recorder receives the balance changes applied by the state transition.
+type Recorder interface {
+	RecordBalanceChange(change *BalanceChange)
+}
+
+// Buffer is a Recorder which accumulates the balance changes until they are flushed.
+type Buffer struct {
+	lock    sync.Mutex
+	changes []*BalanceChange
+}
+
+// NewBuffer returns an empty balance changes buffer.
+func NewBuffer() *Buffer {
+	return &Buffer{
+		changes: make([]*BalanceChange, 0),
+	}
+}
+
+// RecordBalanceChange adds the balance change to the buffer.
+func (b *Buffer) RecordBalanceChange(change *BalanceChange) {
+	if change == nil {
+		return
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.changes = append(b.changes, change)
+}
+
+// Flush returns the accumulated balance changes and empties the buffer.
+func (b *Buffer) Flush() []*BalanceChange {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	changes := b.changes
+	b.changes = make([]*BalanceChange, 0)
+	return changes
+}
@@ -0,0 +1,27 @@
+package rewards
+
+import (
+	"testing"
+
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+)
+
+func TestRole_IsBlockRole(t *testing.T) {
+	require.Equal(t, false, RoleAttestation.IsBlockRole())
+	require.Equal(t, true, RoleProposing.IsBlockRole())
+	require.Equal(t, true, RoleWithdrawal.IsBlockRole())
+	require.Equal(t, "blk-attsd", RoleBlockAttested.String())
+	require.Equal(t, "-", Decrease.String())
+}
+
+func TestBuffer_Flush(t *testing.T) {
+	b := NewBuffer()
+	b.RecordBalanceChange(&BalanceChange{Slot: 1, Validator: 2, Operation: Increase, Role: RoleProposing, Amount: 3})
+	b.RecordBalanceChange(nil)
+	b.RecordBalanceChange(&BalanceChange{Slot: 1, Validator: 3, Operation: Decrease, Role: RoleSyncCommittee, Amount: 4})
+
+	changes := b.Flush()
+	require.Equal(t, 2, len(changes))
+	require.Equal(t, uint64(3), changes[0].Amount)
+	require.Equal(t, 0, len(b.Flush()))
+}
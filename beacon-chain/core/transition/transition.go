@@ -177,7 +177,8 @@ func ProcessSlotsUsingNextSlotCache(
 	if err != nil {
 		return nil, err
 	}
-	cachedStateExists := nextSlotState != nil && !nextSlotState.IsNil()
+	// The epoch processing is not skipped by the cache, while the rewards and penalties are recorded.
+	cachedStateExists := nextSlotState != nil && !nextSlotState.IsNil() && !helpers.IsRewardsRecording(ctx)
 	// If the next slot state is not nil (i.e. cache hit).
 	// We replace next slot state with parent state.
 	if cachedStateExists {
@@ -240,13 +241,16 @@ func ProcessSlots(ctx context.Context, st state.BeaconState, slot types.Slot) (s
 		return nil, err
 	}
 
+	// The epoch processing is not skipped by the caches, while the rewards and penalties are recorded.
+	recording := helpers.IsRewardsRecording(ctx)
+
 	// Restart from cached value, if one exists.
 	cachedState, err := SkipSlotCache.Get(ctx, key)
 	if err != nil {
 		return nil, err
 	}
 
-	if cachedState != nil && !cachedState.IsNil() && cachedState.Slot() < slot {
+	if !recording && cachedState != nil && !cachedState.IsNil() && cachedState.Slot() < slot {
 		highestSlot = cachedState.Slot()
 		st = cachedState
 	}
@@ -255,7 +259,7 @@ func ProcessSlots(ctx context.Context, st state.BeaconState, slot types.Slot) (s
 		if err != nil {
 			return nil, err
 		}
-		if cachedState != nil && !cachedState.IsNil() && cachedState.Slot() < slot {
+		if !recording && cachedState != nil && !cachedState.IsNil() && cachedState.Slot() < slot {
 			highestSlot = cachedState.Slot()
 			st = cachedState
 		}
@@ -287,11 +291,14 @@ func ProcessSlots(ctx context.Context, st state.BeaconState, slot types.Slot) (s
 		if time.CanProcessEpoch(st) {
 			// new epoch
 			//check nextSlotCache
-			nscState, err := GetNextEpochStateByState(ctx, st)
-			if err != nil {
-				log.WithError(err).WithFields(logrus.Fields{
-					"slot": st.Slot(),
-				}).Warn("Transition: process epoch: next slot cache not found")
+			var nscState state.BeaconState
+			if !recording {
+				nscState, err = GetNextEpochStateByState(ctx, st)
+				if err != nil {
+					log.WithError(err).WithFields(logrus.Fields{
+						"slot": st.Slot(),
+					}).Warn("Transition: process epoch: next slot cache not found")
+				}
 			}
 
 			if nscState == nil {
@@ -14,6 +14,15 @@ var ErrNotFoundState = kv.ErrNotFoundState
 // ErrNotFoundOriginBlockRoot wraps ErrNotFound for an error specific to the origin block root.
 var ErrNotFoundOriginBlockRoot = kv.ErrNotFoundOriginBlockRoot
 
+// ErrNotFoundOriginGwatCheckpoint wraps ErrNotFound for an error specific to the origin gwat checkpoint.
+var ErrNotFoundOriginGwatCheckpoint = kv.ErrNotFoundOriginGwatCheckpoint
+
+// ErrNotFoundOperationLifecycle wraps ErrNotFound for an error specific to the operation lifecycle.
+var ErrNotFoundOperationLifecycle = kv.ErrNotFoundOperationLifecycle
+
+// ErrNotFoundSpineLifecycle wraps ErrNotFound for an error specific to the spine lifecycle.
+var ErrNotFoundSpineLifecycle = kv.ErrNotFoundSpineLifecycle
+
 // ErrNotFoundOriginBlockRoot wraps ErrNotFound for an error specific to the origin block root.
 var ErrNotFoundBackfillBlockRoot = kv.ErrNotFoundBackfillBlockRoot
 
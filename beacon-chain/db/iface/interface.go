@@ -8,7 +8,9 @@ import (
 	"io"
 
 	types "github.com/prysmaticlabs/eth2-types"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/rewards"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/db/filters"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/operations/lifecycle"
 	slashertypes "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/slasher/types"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/state"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/monitoring/backup"
@@ -16,6 +18,7 @@ import (
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1/block"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1/wrapper"
 	"gitlab.waterfall.network/waterfall/protocol/gwat/common"
+	gwatTypes "gitlab.waterfall.network/waterfall/protocol/gwat/core/types"
 )
 
 // ReadOnlyDatabase defines a struct which only has read access to database methods.
@@ -56,7 +59,21 @@ type ReadOnlyDatabase interface {
 	FeeRecipientByValidatorID(ctx context.Context, id types.ValidatorIndex) (common.Address, error)
 	// origin checkpoint sync support
 	OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error)
+	OriginGwatCheckpoint(ctx context.Context) (*gwatTypes.Checkpoint, error)
 	BackfillBlockRoot(ctx context.Context) ([32]byte, error)
+	// Withdrawal pool operations.
+	WithdrawalPool(ctx context.Context) ([]*ethpb.Withdrawal, error)
+	// Exit pool operations.
+	ExitPool(ctx context.Context) ([]*ethpb.VoluntaryExit, error)
+	ExitPoolItem(ctx context.Context, initTxHash []byte) (*ethpb.VoluntaryExit, error)
+	ExitLogsLastHandledBlock(ctx context.Context) (uint64, error)
+	// Gwat initiated operations lifecycle.
+	OperationLifecycle(ctx context.Context, initTxHash [32]byte) (*lifecycle.Operation, error)
+	// Gwat spines lifecycle.
+	SpineLifecycle(ctx context.Context, hash [32]byte) (*lifecycle.Spine, error)
+	// Rewards and penalties.
+	EpochRewards(ctx context.Context, epoch types.Epoch) ([]*rewards.BalanceChange, error)
+	SlotRewards(ctx context.Context, slot types.Slot) ([]*rewards.BalanceChange, error)
 }
 
 // NoHeadAccessDatabase defines a struct without access to chain head data.
@@ -93,6 +110,28 @@ type NoHeadAccessDatabase interface {
 	ReadSpines(ctx context.Context, key [32]byte) (wrapper.Spines, error)
 	WriteSpines(ctx context.Context, spines wrapper.Spines) ([32]byte, error)
 	DeleteSpines(ctx context.Context, key [32]byte) error
+
+	// Withdrawal pool operations.
+	SaveWithdrawalPoolItems(ctx context.Context, withdrawals []*ethpb.Withdrawal) error
+	DeleteWithdrawalPoolItems(ctx context.Context, initTxHashes [][]byte) error
+
+	// Exit pool operations.
+	SaveExitPoolItems(ctx context.Context, exits []*ethpb.VoluntaryExit) error
+	DeleteExitPoolItems(ctx context.Context, initTxHashes [][]byte) error
+	SaveExitLogsLastHandledBlock(ctx context.Context, blockNum uint64) error
+
+	// Gwat initiated operations lifecycle.
+	SaveOperationLifecycles(ctx context.Context, ops []*lifecycle.Operation) error
+	PruneOperationLifecycles(ctx context.Context, maxSlot, slot types.Slot) (int, error)
+	PruneOrphanedOperationRecords(ctx context.Context, finalizedSlot types.Slot) (int, error)
+
+	// Gwat spines lifecycle.
+	SaveSpineLifecycles(ctx context.Context, spines []*lifecycle.Spine) error
+	PruneSpineLifecycles(ctx context.Context, maxSlot types.Slot) (int, error)
+
+	// Rewards and penalties.
+	SaveRewards(ctx context.Context, changes []*rewards.BalanceChange) error
+	DeleteRewardsBefore(ctx context.Context, epoch types.Epoch) (int, error)
 }
 
 // HeadAccessDatabase defines a struct with access to reading chain head data.
@@ -110,6 +149,7 @@ type HeadAccessDatabase interface {
 
 	// initialization method needed for origin checkpoint sync
 	SaveOrigin(ctx context.Context, serState, serBlock []byte) error
+	SaveOriginGwatCheckpoint(ctx context.Context, cp *gwatTypes.Checkpoint) error
 	SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error
 }
 
@@ -129,6 +169,12 @@ type SlasherDatabase interface {
 	SaveBlockProposals(
 		ctx context.Context, proposal []*slashertypes.SignedBlockHeaderWrapper,
 	) error
+	SavePrevoteRecords(
+		ctx context.Context, prevotes []*slashertypes.IndexedPrevoteWrapper,
+	) error
+	SavePrevoteDoubleVotes(
+		ctx context.Context, doubleVotes []*slashertypes.PrevoteDoubleVote,
+	) error
 	LastEpochWrittenForValidators(
 		ctx context.Context, validatorIndices []types.ValidatorIndex,
 	) ([]*slashertypes.AttestedEpochForValidator, error)
@@ -138,6 +184,9 @@ type SlasherDatabase interface {
 	BlockProposalForValidator(
 		ctx context.Context, validatorIdx types.ValidatorIndex, slot types.Slot,
 	) (*slashertypes.SignedBlockHeaderWrapper, error)
+	PrevoteRecordForValidator(
+		ctx context.Context, validatorIdx types.ValidatorIndex, slot types.Slot,
+	) (*slashertypes.IndexedPrevoteWrapper, error)
 	CheckAttesterDoubleVotes(
 		ctx context.Context, attestations []*slashertypes.IndexedAttestationWrapper,
 	) ([]*slashertypes.AttesterDoubleVote, error)
@@ -147,12 +196,21 @@ type SlasherDatabase interface {
 	CheckDoubleBlockProposals(
 		ctx context.Context, proposals []*slashertypes.SignedBlockHeaderWrapper,
 	) ([]*ethpb.ProposerSlashing, error)
+	CheckDoublePrevotes(
+		ctx context.Context, prevotes []*slashertypes.IndexedPrevoteWrapper,
+	) ([]*slashertypes.PrevoteDoubleVote, error)
+	PrevoteDoubleVotes(
+		ctx context.Context, indices []types.ValidatorIndex,
+	) ([]*slashertypes.PrevoteDoubleVote, error)
 	PruneAttestationsAtEpoch(
 		ctx context.Context, maxEpoch types.Epoch,
 	) (numPruned uint, err error)
 	PruneProposalsAtEpoch(
 		ctx context.Context, maxEpoch types.Epoch,
 	) (numPruned uint, err error)
+	PrunePrevotesAtEpoch(
+		ctx context.Context, maxEpoch types.Epoch,
+	) (numPruned uint, err error)
 	HighestAttestations(
 		ctx context.Context,
 		indices []types.ValidatorIndex,
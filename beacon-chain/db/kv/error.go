@@ -13,6 +13,15 @@ var ErrNotFoundState = errors.Wrap(ErrNotFound, "state not found")
 // ErrNotFoundOriginBlockRoot is an error specifically for the origin block root getter
 var ErrNotFoundOriginBlockRoot = errors.Wrap(ErrNotFound, "OriginBlockRoot")
 
+// ErrNotFoundOriginGwatCheckpoint is an error specifically for the origin gwat checkpoint getter
+var ErrNotFoundOriginGwatCheckpoint = errors.Wrap(ErrNotFound, "OriginGwatCheckpoint")
+
+// ErrNotFoundOperationLifecycle is a not found error specifically for the operation lifecycle getter
+var ErrNotFoundOperationLifecycle = errors.Wrap(ErrNotFound, "operation lifecycle")
+
+// ErrNotFoundSpineLifecycle is a not found error specifically for the spine lifecycle getter
+var ErrNotFoundSpineLifecycle = errors.Wrap(ErrNotFound, "spine lifecycle")
+
 // ErrNotFoundGenesisBlockRoot means no genesis block root was found, indicating the db was not initialized with genesis
 var ErrNotFoundGenesisBlockRoot = errors.Wrap(ErrNotFound, "OriginGenesisRoot")
 
@@ -0,0 +1,140 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package kv
+
+import (
+	"context"
+	"errors"
+
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/monitoring/tracing"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// ExitPool retrieves the gwat initiated voluntary exits of the exit pool.
+func (s *Store) ExitPool(ctx context.Context) ([]*ethpb.VoluntaryExit, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ExitPool")
+	defer span.End()
+
+	exits := make([]*ethpb.VoluntaryExit, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(exitPoolBucket)
+		return bkt.ForEach(func(_, enc []byte) error {
+			e := &ethpb.VoluntaryExit{}
+			if err := decode(ctx, enc, e); err != nil {
+				return err
+			}
+			exits = append(exits, e)
+			return nil
+		})
+	})
+	tracing.AnnotateError(span, err)
+	return exits, err
+}
+
+// ExitPoolItem retrieves the voluntary exit of the exit pool by its init tx hash.
+// Returns nil if the exit is not found.
+func (s *Store) ExitPoolItem(ctx context.Context, initTxHash []byte) (*ethpb.VoluntaryExit, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ExitPoolItem")
+	defer span.End()
+
+	var exit *ethpb.VoluntaryExit
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(exitPoolBucket).Get(initTxHash)
+		if enc == nil {
+			return nil
+		}
+		exit = &ethpb.VoluntaryExit{}
+		return decode(ctx, enc, exit)
+	})
+	tracing.AnnotateError(span, err)
+	return exit, err
+}
+
+// SaveExitPoolItems saves the voluntary exits of the exit pool
+// keyed by their init tx hash, overwriting the existing items with the same key.
+func (s *Store) SaveExitPoolItems(ctx context.Context, exits []*ethpb.VoluntaryExit) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveExitPoolItems")
+	defer span.End()
+
+	err := s.db.Batch(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(exitPoolBucket)
+		for _, e := range exits {
+			if e == nil || len(e.InitTxHash) == 0 {
+				return errors.New("cannot save exit without init tx hash")
+			}
+			enc, err := encode(ctx, e)
+			if err != nil {
+				return err
+			}
+			if err := bkt.Put(e.InitTxHash, enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	tracing.AnnotateError(span, err)
+	return err
+}
+
+// DeleteExitPoolItems deletes the voluntary exits of the exit pool by init tx hashes.
+func (s *Store) DeleteExitPoolItems(ctx context.Context, initTxHashes [][]byte) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.DeleteExitPoolItems")
+	defer span.End()
+
+	err := s.db.Batch(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(exitPoolBucket)
+		for _, h := range initTxHashes {
+			if err := bkt.Delete(h); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	tracing.AnnotateError(span, err)
+	return err
+}
+
+// ExitLogsLastHandledBlock retrieves the number of the gwat block of the last handled exit log.
+// Returns 0 if no exit log was handled.
+func (s *Store) ExitLogsLastHandledBlock(ctx context.Context) (uint64, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.ExitLogsLastHandledBlock")
+	defer span.End()
+
+	var blockNum uint64
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(powchainBucket).Get(exitLogsLastHandledBlockKey)
+		if enc != nil {
+			blockNum = bytesutil.BytesToUint64BigEndian(enc)
+		}
+		return nil
+	})
+	tracing.AnnotateError(span, err)
+	return blockNum, err
+}
+
+// SaveExitLogsLastHandledBlock saves the number of the gwat block of the last handled exit log.
+func (s *Store) SaveExitLogsLastHandledBlock(ctx context.Context, blockNum uint64) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveExitLogsLastHandledBlock")
+	defer span.End()
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(powchainBucket).Put(exitLogsLastHandledBlockKey, bytesutil.Uint64ToBytesBigEndian(blockNum))
+	})
+	tracing.AnnotateError(span, err)
+	return err
+}
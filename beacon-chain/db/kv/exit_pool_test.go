@@ -0,0 +1,71 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+)
+
+func TestStore_ExitPool_SaveRetrieveDelete(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	items, err := db.ExitPool(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(items))
+
+	e1 := &ethpb.VoluntaryExit{
+		Epoch:          2,
+		ValidatorIndex: 1,
+		InitTxHash:     bytesutil.PadTo([]byte{1}, 32),
+	}
+	e2 := &ethpb.VoluntaryExit{
+		Epoch:          3,
+		ValidatorIndex: 2,
+		InitTxHash:     bytesutil.PadTo([]byte{2}, 32),
+	}
+	require.NoError(t, db.SaveExitPoolItems(ctx, []*ethpb.VoluntaryExit{e1, e2}))
+
+	items, err = db.ExitPool(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(items))
+	require.DeepEqual(t, e1, items[0])
+	require.DeepEqual(t, e2, items[1])
+
+	item, err := db.ExitPoolItem(ctx, e2.InitTxHash)
+	require.NoError(t, err)
+	require.DeepEqual(t, e2, item)
+
+	require.NoError(t, db.DeleteExitPoolItems(ctx, [][]byte{e1.InitTxHash}))
+	item, err = db.ExitPoolItem(ctx, e1.InitTxHash)
+	require.NoError(t, err)
+	require.Equal(t, (*ethpb.VoluntaryExit)(nil), item)
+
+	items, err = db.ExitPool(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(items))
+	require.DeepEqual(t, e2, items[0])
+}
+
+func TestStore_SaveExitPoolItems_NoInitTxHash(t *testing.T) {
+	db := setupDB(t)
+	err := db.SaveExitPoolItems(context.Background(), []*ethpb.VoluntaryExit{{ValidatorIndex: 1}})
+	require.ErrorContains(t, "cannot save exit without init tx hash", err)
+}
+
+func TestStore_ExitLogsLastHandledBlock(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	blockNum, err := db.ExitLogsLastHandledBlock(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), blockNum)
+
+	require.NoError(t, db.SaveExitLogsLastHandledBlock(ctx, 1234))
+	blockNum, err = db.ExitLogsLastHandledBlock(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1234), blockNum)
+}
@@ -73,6 +73,9 @@ var blockedBuckets = [][]byte{
 type Config struct {
 	InitialMMapSize int
 	GenesisSszPath  string
+	// ReadOnly opens the existing database without the creation of buckets,
+	// intended for the offline inspection tools.
+	ReadOnly bool
 }
 
 // Store defines an implementation of the Prysm Database interface
@@ -86,6 +89,7 @@ type Store struct {
 	stateSummaryCache   *stateSummaryCache
 	ctx                 context.Context
 	genesisSszPath      string
+	readOnly            bool
 }
 
 // KVStoreDatafilePath is the canonical construction of a full
@@ -104,6 +108,9 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 		return nil, err
 	}
 	if !hasDir {
+		if config.ReadOnly {
+			return nil, errors.Errorf("database directory %s not found", dirPath)
+		}
 		if err := file.MkdirAll(dirPath); err != nil {
 			return nil, err
 		}
@@ -117,6 +124,7 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 		&bolt.Options{
 			Timeout:         1 * time.Second,
 			InitialMmapSize: config.InitialMMapSize,
+			ReadOnly:        config.ReadOnly,
 		},
 	)
 	if err != nil {
@@ -171,11 +179,15 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 		stateSummaryCache:   newStateSummaryCache(),
 		ctx:                 ctx,
 		genesisSszPath:      config.GenesisSszPath,
+		readOnly:            config.ReadOnly,
+	}
+	if kv.readOnly {
+		return kv, nil
 	}
 	start = time.Now()
 	log.Infof("Updating DB and creating buckets...")
 	if err := kv.db.Update(func(tx *bolt.Tx) error {
-		return createBuckets(
+		if err := createBuckets(
 			tx,
 			attestationsBucket,
 			blocksBucket,
@@ -207,7 +219,25 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			feeRecipientBucket,
 			// spines lists bucket
 			spinesBucket,
-		)
+			stateSpinesIndicesBucket,
+			spinesRefsBucket,
+			// pending withdrawals bucket
+			withdrawalPoolBucket,
+			// gwat initiated operations lifecycle buckets
+			operationLifecycleBucket,
+			operationLifecycleSlotIndicesBucket,
+			operationLifecycleBlockIndicesBucket,
+			// gwat initiated exits bucket
+			exitPoolBucket,
+			// rewards and penalties bucket
+			rewardsBucket,
+			// gwat spines lifecycle bucket
+			spineLifecycleBucket,
+			spineLifecycleSlotIndicesBucket,
+		); err != nil {
+			return err
+		}
+		return markSpinesRefsOfNewDB(tx)
 	}); err != nil {
 		log.WithField("elapsed", time.Since(start)).Error("Failed to update db and create buckets")
 		return nil, err
@@ -235,6 +265,9 @@ func (s *Store) ClearDB() error {
 func (s *Store) Close() error {
 	prometheus.Unregister(createBoltCollector(s.db))
 
+	if s.readOnly {
+		return s.db.Close()
+	}
 	// Before DB closes, we should dump the cached state summary objects to DB.
 	if err := s.saveCachedStateSummariesDB(s.ctx); err != nil {
 		return err
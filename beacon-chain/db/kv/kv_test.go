@@ -709,3 +709,28 @@ func HydrateBeaconHeader(h *ethpb.BeaconBlockHeader) *ethpb.BeaconBlockHeader {
 	}
 	return h
 }
+
+func TestNewKVStore_ReadOnly(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	_, err := NewKVStore(ctx, dir+"/missing", &Config{ReadOnly: true})
+	require.ErrorContains(t, "not found", err)
+
+	db, err := NewKVStore(ctx, dir, &Config{})
+	require.NoError(t, err)
+	w := &ethpb.Withdrawal{
+		PublicKey:  bytesutil.PadTo([]byte{1}, 48),
+		InitTxHash: bytesutil.PadTo([]byte{1}, 32),
+	}
+	require.NoError(t, db.SaveWithdrawalPoolItems(ctx, []*ethpb.Withdrawal{w}))
+	require.NoError(t, db.Close())
+
+	roDB, err := NewKVStore(ctx, dir, &Config{ReadOnly: true})
+	require.NoError(t, err)
+	items, err := roDB.WithdrawalPool(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(items))
+	require.ErrorContains(t, "read-only", roDB.DeleteWithdrawalPoolItems(ctx, [][]byte{w.InitTxHash}))
+	require.NoError(t, roDB.Close())
+}
@@ -0,0 +1,162 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	bolt "go.etcd.io/bbolt"
+)
+
+var migrationStateSpinesRefs0Key = []byte("state_spines_refs_0")
+
+// spinesRefsBatchSize is the number of states decoded or records written per db transaction
+// while rebuilding the spines references.
+const spinesRefsBatchSize = 64
+
+// migrateStateSpinesRefs rebuilds the spines references of the states
+// saved before the spines references counting was introduced.
+func migrateStateSpinesRefs(ctx context.Context, db *bolt.DB) error {
+	completed := false
+	if err := db.View(func(tx *bolt.Tx) error {
+		completed = spinesRefsRebuilt(tx)
+		return nil
+	}); err != nil {
+		return err
+	}
+	if completed {
+		return nil // Migration already completed.
+	}
+	if err := rebuildSpinesRefs(ctx, db); err != nil {
+		log.WithError(err).Errorf("could not migrate bucket: %s", spinesRefsBucket)
+		return err
+	}
+	return nil
+}
+
+// spinesRefsRebuilt checks the spines references are counted for all the stored states,
+// so the spines which are not referred anymore can be deleted.
+func spinesRefsRebuilt(tx *bolt.Tx) bool {
+	return bytes.Equal(tx.Bucket(migrationsBucket).Get(migrationStateSpinesRefs0Key), migrationCompleted)
+}
+
+// markSpinesRefsOfNewDB marks the spines references of the new db as rebuilt,
+// as there are no states saved before the references counting.
+func markSpinesRefsOfNewDB(tx *bolt.Tx) error {
+	if k, _ := tx.Bucket(stateBucket).Cursor().First(); k != nil {
+		return nil
+	}
+	return tx.Bucket(migrationsBucket).Put(migrationStateSpinesRefs0Key, migrationCompleted)
+}
+
+// rebuildSpinesRefs recounts the spines references of all the stored states in batches.
+// The deletion of the spines is suspended until the references are rebuilt,
+// so an interrupted rebuilding is restarted by the migration and loses no spines.
+func rebuildSpinesRefs(ctx context.Context, db *bolt.DB) error {
+	if err := db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(migrationsBucket).Delete(migrationStateSpinesRefs0Key); err != nil {
+			return err
+		}
+		for _, bucket := range [][]byte{stateSpinesIndicesBucket, spinesRefsBucket} {
+			if err := tx.DeleteBucket(bucket); err != nil && !errors.Is(err, bolt.ErrBucketNotFound) {
+				return err
+			}
+			if _, err := tx.CreateBucket(bucket); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	// collect the spines keys referred by the states.
+	indices := make(map[[32]byte][]byte)
+	refs := make(map[[32]byte]uint64)
+	var lastKey []byte
+	for done := false; !done; {
+		if err := db.View(func(tx *bolt.Tx) error {
+			c := tx.Bucket(stateBucket).Cursor()
+			k, v := seekAfter(c, lastKey)
+			for n := 0; k != nil && n < spinesRefsBatchSize; k, v = c.Next() {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				keys, err := stateSpinesKeys(v)
+				if err != nil {
+					return errors.Wrapf(err, "could not get spines of state %#x", k)
+				}
+				indices[bytesutil.ToBytes32(k)] = keys
+				for i := 0; i < len(keys); i += hashLength {
+					refs[bytesutil.ToBytes32(keys[i:i+hashLength])]++
+				}
+				lastKey = bytesutil.SafeCopyBytes(k)
+				n++
+			}
+			done = k == nil
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	// store the references.
+	if err := putInBatches(ctx, db, stateSpinesIndicesBucket, indices); err != nil {
+		return err
+	}
+	counts := make(map[[32]byte][]byte, len(refs))
+	for k, count := range refs {
+		counts[k] = bytesutil.Uint64ToBytesBigEndian(count)
+	}
+	if err := putInBatches(ctx, db, spinesRefsBucket, counts); err != nil {
+		return err
+	}
+	return db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(migrationsBucket).Put(migrationStateSpinesRefs0Key, migrationCompleted)
+	})
+}
+
+// putInBatches puts the records to the bucket in batches of spinesRefsBatchSize per transaction.
+func putInBatches(ctx context.Context, db *bolt.DB, bucket []byte, records map[[32]byte][]byte) error {
+	keys := make([][32]byte, 0, len(records))
+	for k := range records {
+		keys = append(keys, k)
+	}
+	for start := 0; start < len(keys); start += spinesRefsBatchSize {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		end := start + spinesRefsBatchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+		if err := db.Update(func(tx *bolt.Tx) error {
+			bkt := tx.Bucket(bucket)
+			for _, k := range keys[start:end] {
+				key := k
+				if err := bkt.Put(key[:], records[key]); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/assert"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func Test_migrateStateSpinesRefs(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	spinesA := NewSpinesParam()
+	r1 := [32]byte{'A'}
+	r2 := [32]byte{'B'}
+	require.NoError(t, db.SaveState(ctx, newSpinesState(t, 100, spinesA, nil), r1))
+
+	// simulate the state saved before the references counting.
+	require.NoError(t, db.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(migrationsBucket).Delete(migrationStateSpinesRefs0Key); err != nil {
+			return err
+		}
+		if err := tx.Bucket(stateSpinesIndicesBucket).Delete(r1[:]); err != nil {
+			return err
+		}
+		key := spinesA.Key()
+		return tx.Bucket(spinesRefsBucket).Delete(key[:])
+	}))
+
+	// the spines shared with the legacy state are kept until the references are rebuilt.
+	require.NoError(t, db.SaveState(ctx, newSpinesState(t, 101, spinesA, nil), r2))
+	assert.Equal(t, uint64(1), spinesRefs(t, db, spinesA.Key()))
+	require.NoError(t, db.DeleteState(ctx, r2))
+	assert.Equal(t, true, hasSpines(t, db, spinesA.Key()))
+
+	require.NoError(t, migrateStateSpinesRefs(ctx, db.db))
+	assert.Equal(t, uint64(1), spinesRefs(t, db, spinesA.Key()))
+
+	// only runs once.
+	require.NoError(t, migrateStateSpinesRefs(ctx, db.db))
+	assert.Equal(t, uint64(1), spinesRefs(t, db, spinesA.Key()))
+
+	require.NoError(t, db.DeleteState(ctx, r1))
+	assert.Equal(t, false, hasSpines(t, db, spinesA.Key()))
+}
@@ -0,0 +1,254 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/operations/lifecycle"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/monitoring/tracing"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+const (
+	// encoded operation: type, validator index, amount, records.
+	operationHeaderLength = 1 + 8 + 8
+	// encoded record: stage, slot, block root.
+	operationRecordLength = 1 + 8 + hashLength
+)
+
+// OperationLifecycle retrieves the lifecycle of the gwat initiated operation by its init tx hash.
+func (s *Store) OperationLifecycle(ctx context.Context, initTxHash [32]byte) (*lifecycle.Operation, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.OperationLifecycle")
+	defer span.End()
+
+	var op *lifecycle.Operation
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(operationLifecycleBucket).Get(initTxHash[:])
+		if enc == nil {
+			return ErrNotFoundOperationLifecycle
+		}
+		var err error
+		op, err = decodeOperationLifecycle(initTxHash, enc)
+		return err
+	})
+	tracing.AnnotateError(span, err)
+	return op, err
+}
+
+// SaveOperationLifecycles merges the records of the given operations into the stored lifecycles.
+// The type, validator index and amount of a stored operation are kept.
+// The block records are indexed by slot to be checked by PruneOrphanedOperationRecords
+// and the applied withdrawals are indexed by slot to be pruned by PruneOperationLifecycles.
+func (s *Store) SaveOperationLifecycles(ctx context.Context, ops []*lifecycle.Operation) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveOperationLifecycles")
+	defer span.End()
+
+	err := s.db.Batch(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(operationLifecycleBucket)
+		idxBkt := tx.Bucket(operationLifecycleSlotIndicesBucket)
+		blockIdxBkt := tx.Bucket(operationLifecycleBlockIndicesBucket)
+		for _, op := range ops {
+			if op == nil {
+				return errors.New("cannot save nil operation lifecycle")
+			}
+			stored := &lifecycle.Operation{
+				InitTxHash:     op.InitTxHash,
+				Type:           op.Type,
+				ValidatorIndex: op.ValidatorIndex,
+				Amount:         op.Amount,
+			}
+			if enc := bkt.Get(op.InitTxHash[:]); enc != nil {
+				var err error
+				if stored, err = decodeOperationLifecycle(op.InitTxHash, enc); err != nil {
+					return err
+				}
+			}
+			if !stored.Merge(op) && len(stored.Records) > 0 {
+				continue
+			}
+			if err := bkt.Put(op.InitTxHash[:], encodeOperationLifecycle(stored)); err != nil {
+				return err
+			}
+			for _, r := range op.Records {
+				if !r.Stage.IsBlockStage() {
+					continue
+				}
+				if err := blockIdxBkt.Put(operationSlotIndexKey(r.Slot, op.InitTxHash), []byte{}); err != nil {
+					return err
+				}
+				if stored.Type != lifecycle.OpWithdrawal || r.Stage != lifecycle.StageApplied {
+					continue
+				}
+				if err := idxBkt.Put(operationSlotIndexKey(r.Slot, op.InitTxHash), []byte{}); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	tracing.AnnotateError(span, err)
+	return err
+}
+
+// PruneOperationLifecycles records the StagePruned at the given slot for the withdrawals
+// applied up to the max slot, whose WithdrawalOps are cleaned from the state.
+// Returns the number of the pruned withdrawals.
+func (s *Store) PruneOperationLifecycles(ctx context.Context, maxSlot, slot types.Slot) (int, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.PruneOperationLifecycles")
+	defer span.End()
+
+	count := 0
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(operationLifecycleBucket)
+		idxBkt := tx.Bucket(operationLifecycleSlotIndicesBucket)
+		maxKey := bytesutil.Uint64ToBytesBigEndian(uint64(maxSlot))
+		keys := make([][]byte, 0)
+		c := idxBkt.Cursor()
+		for k, _ := c.First(); k != nil && bytes.Compare(k[:8], maxKey) <= 0; k, _ = c.Next() {
+			keys = append(keys, bytesutil.SafeCopyBytes(k))
+		}
+		for _, k := range keys {
+			if err := idxBkt.Delete(k); err != nil {
+				return err
+			}
+			initTxHash := bytesutil.ToBytes32(k[8:])
+			enc := bkt.Get(initTxHash[:])
+			if enc == nil {
+				continue
+			}
+			op, err := decodeOperationLifecycle(initTxHash, enc)
+			if err != nil {
+				return err
+			}
+			// the withdrawal is applied by the orphaned blocks only.
+			if !op.HasStage(lifecycle.StageApplied) {
+				continue
+			}
+			if !op.Merge(&lifecycle.Operation{Records: []*lifecycle.Record{{Stage: lifecycle.StagePruned, Slot: slot}}}) {
+				continue
+			}
+			if err := bkt.Put(initTxHash[:], encodeOperationLifecycle(op)); err != nil {
+				return err
+			}
+			count++
+		}
+		return nil
+	})
+	tracing.AnnotateError(span, err)
+	return count, err
+}
+
+// PruneOrphanedOperationRecords removes the block records reached up to the finalized slot
+// by the blocks, which are not finalized, so the orphaned forks are removed from the lifecycles.
+// Returns the number of the removed records.
+func (s *Store) PruneOrphanedOperationRecords(ctx context.Context, finalizedSlot types.Slot) (int, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.PruneOrphanedOperationRecords")
+	defer span.End()
+
+	count := 0
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(operationLifecycleBucket)
+		idxBkt := tx.Bucket(operationLifecycleBlockIndicesBucket)
+		finBkt := tx.Bucket(finalizedBlockRootsIndexBucket)
+		genRoot := tx.Bucket(blocksBucket).Get(genesisBlockRootKey)
+		maxKey := bytesutil.Uint64ToBytesBigEndian(uint64(finalizedSlot))
+		keys := make([][]byte, 0)
+		c := idxBkt.Cursor()
+		for k, _ := c.First(); k != nil && bytes.Compare(k[:8], maxKey) <= 0; k, _ = c.Next() {
+			keys = append(keys, bytesutil.SafeCopyBytes(k))
+		}
+		for _, k := range keys {
+			if err := idxBkt.Delete(k); err != nil {
+				return err
+			}
+			initTxHash := bytesutil.ToBytes32(k[8:])
+			enc := bkt.Get(initTxHash[:])
+			if enc == nil {
+				continue
+			}
+			op, err := decodeOperationLifecycle(initTxHash, enc)
+			if err != nil {
+				return err
+			}
+			records := make([]*lifecycle.Record, 0, len(op.Records))
+			for _, r := range op.Records {
+				orphaned := r.Stage.IsBlockStage() &&
+					r.Slot <= finalizedSlot &&
+					finBkt.Get(r.BlockRoot[:]) == nil &&
+					!bytes.Equal(genRoot, r.BlockRoot[:])
+				if orphaned {
+					continue
+				}
+				records = append(records, r)
+			}
+			if len(records) == len(op.Records) {
+				continue
+			}
+			count += len(op.Records) - len(records)
+			op.Records = records
+			if err := bkt.Put(initTxHash[:], encodeOperationLifecycle(op)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	tracing.AnnotateError(span, err)
+	return count, err
+}
+
+func operationSlotIndexKey(slot types.Slot, initTxHash [32]byte) []byte {
+	return append(bytesutil.Uint64ToBytesBigEndian(uint64(slot)), initTxHash[:]...)
+}
+
+func encodeOperationLifecycle(op *lifecycle.Operation) []byte {
+	enc := make([]byte, 0, operationHeaderLength+len(op.Records)*operationRecordLength)
+	enc = append(enc, byte(op.Type))
+	enc = append(enc, bytesutil.Uint64ToBytesBigEndian(uint64(op.ValidatorIndex))...)
+	enc = append(enc, bytesutil.Uint64ToBytesBigEndian(op.Amount)...)
+	for _, r := range op.Records {
+		enc = append(enc, byte(r.Stage))
+		enc = append(enc, bytesutil.Uint64ToBytesBigEndian(uint64(r.Slot))...)
+		enc = append(enc, r.BlockRoot[:]...)
+	}
+	return enc
+}
+
+func decodeOperationLifecycle(initTxHash [32]byte, enc []byte) (*lifecycle.Operation, error) {
+	if len(enc) < operationHeaderLength || (len(enc)-operationHeaderLength)%operationRecordLength != 0 {
+		return nil, fmt.Errorf("invalid operation lifecycle length %d of init tx hash %#x", len(enc), initTxHash)
+	}
+	op := &lifecycle.Operation{
+		InitTxHash:     initTxHash,
+		Type:           lifecycle.OpType(enc[0]),
+		ValidatorIndex: types.ValidatorIndex(bytesutil.BytesToUint64BigEndian(enc[1:9])),
+		Amount:         bytesutil.BytesToUint64BigEndian(enc[9:17]),
+		Records:        make([]*lifecycle.Record, 0, (len(enc)-operationHeaderLength)/operationRecordLength),
+	}
+	for i := operationHeaderLength; i < len(enc); i += operationRecordLength {
+		op.Records = append(op.Records, &lifecycle.Record{
+			Stage:     lifecycle.Stage(enc[i]),
+			Slot:      types.Slot(bytesutil.BytesToUint64BigEndian(enc[i+1 : i+9])),
+			BlockRoot: bytesutil.ToBytes32(enc[i+9 : i+operationRecordLength]),
+		})
+	}
+	return op, nil
+}
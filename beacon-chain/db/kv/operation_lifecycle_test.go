@@ -0,0 +1,135 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/operations/lifecycle"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/assert"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+)
+
+func TestStore_OperationLifecycle_SaveMerge(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	initTxHash := [32]byte{'t', 'x'}
+
+	_, err := db.OperationLifecycle(ctx, initTxHash)
+	require.ErrorIs(t, err, ErrNotFoundOperationLifecycle)
+
+	require.NoError(t, db.SaveOperationLifecycles(ctx, []*lifecycle.Operation{{
+		InitTxHash:     initTxHash,
+		Type:           lifecycle.OpWithdrawal,
+		ValidatorIndex: 7,
+		Amount:         1000,
+		Records: []*lifecycle.Record{
+			{Stage: lifecycle.StageSeen, Slot: 10},
+			{Stage: lifecycle.StagePooled, Slot: 10},
+		},
+	}}))
+	require.NoError(t, db.SaveOperationLifecycles(ctx, []*lifecycle.Operation{{
+		InitTxHash: initTxHash,
+		Type:       lifecycle.OpWithdrawal,
+		Records: []*lifecycle.Record{
+			{Stage: lifecycle.StageSeen, Slot: 11},
+			{Stage: lifecycle.StageIncluded, Slot: 12, BlockRoot: [32]byte{'b'}},
+		},
+	}}))
+
+	op, err := db.OperationLifecycle(ctx, initTxHash)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &lifecycle.Operation{
+		InitTxHash:     initTxHash,
+		Type:           lifecycle.OpWithdrawal,
+		ValidatorIndex: 7,
+		Amount:         1000,
+		Records: []*lifecycle.Record{
+			{Stage: lifecycle.StageSeen, Slot: 10},
+			{Stage: lifecycle.StagePooled, Slot: 10},
+			{Stage: lifecycle.StageIncluded, Slot: 12, BlockRoot: [32]byte{'b'}},
+		},
+	}, op)
+}
+
+func TestStore_PruneOperationLifecycles(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	processed := func(hash [32]byte, opType lifecycle.OpType, slot uint64, stage lifecycle.Stage) *lifecycle.Operation {
+		return &lifecycle.Operation{
+			InitTxHash: hash,
+			Type:       opType,
+			Records:    []*lifecycle.Record{{Stage: stage, Slot: types.Slot(slot), BlockRoot: hash}},
+		}
+	}
+	early, late, exit, skipped := [32]byte{'a'}, [32]byte{'b'}, [32]byte{'c'}, [32]byte{'d'}
+	require.NoError(t, db.SaveOperationLifecycles(ctx, []*lifecycle.Operation{
+		processed(early, lifecycle.OpWithdrawal, 5, lifecycle.StageApplied),
+		processed(late, lifecycle.OpWithdrawal, 50, lifecycle.StageApplied),
+		processed(exit, lifecycle.OpExit, 5, lifecycle.StageApplied),
+		processed(skipped, lifecycle.OpWithdrawal, 5, lifecycle.StageIncluded),
+	}))
+
+	count, err := db.PruneOperationLifecycles(ctx, 10, 100)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	// pruned withdrawals are removed from the index
+	count, err = db.PruneOperationLifecycles(ctx, 10, 110)
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+
+	op, err := db.OperationLifecycle(ctx, early)
+	require.NoError(t, err)
+	require.Equal(t, lifecycle.StagePruned, op.Status())
+	require.Equal(t, types.Slot(100), op.Records[len(op.Records)-1].Slot)
+
+	op, err = db.OperationLifecycle(ctx, late)
+	require.NoError(t, err)
+	require.Equal(t, lifecycle.StageApplied, op.Status())
+
+	op, err = db.OperationLifecycle(ctx, exit)
+	require.NoError(t, err)
+	require.Equal(t, lifecycle.StageApplied, op.Status())
+
+	op, err = db.OperationLifecycle(ctx, skipped)
+	require.NoError(t, err)
+	require.Equal(t, lifecycle.StageIncluded, op.Status())
+}
+
+func TestStore_PruneOrphanedOperationRecords(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	initTxHash := [32]byte{'t', 'x'}
+	canonical, orphaned, orphanedLate := [32]byte{'c'}, [32]byte{'o'}, [32]byte{'l'}
+	// the genesis block root is considered as finalized
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, canonical))
+
+	require.NoError(t, db.SaveOperationLifecycles(ctx, []*lifecycle.Operation{{
+		InitTxHash: initTxHash,
+		Type:       lifecycle.OpWithdrawal,
+		Records: []*lifecycle.Record{
+			{Stage: lifecycle.StagePooled, Slot: 2},
+			{Stage: lifecycle.StageIncluded, Slot: 4, BlockRoot: orphaned},
+			{Stage: lifecycle.StageApplied, Slot: 4, BlockRoot: orphaned},
+			{Stage: lifecycle.StageIncluded, Slot: 5, BlockRoot: canonical},
+			{Stage: lifecycle.StageIncluded, Slot: 40, BlockRoot: orphanedLate},
+		},
+	}}))
+
+	count, err := db.PruneOrphanedOperationRecords(ctx, 32)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	op, err := db.OperationLifecycle(ctx, initTxHash)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*lifecycle.Record{
+		{Stage: lifecycle.StagePooled, Slot: 2},
+		{Stage: lifecycle.StageIncluded, Slot: 5, BlockRoot: canonical},
+		{Stage: lifecycle.StageIncluded, Slot: 40, BlockRoot: orphanedLate},
+	}, op.Records)
+
+	// the records after the finalized slot are checked later
+	count, err = db.PruneOrphanedOperationRecords(ctx, 64)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+}
@@ -0,0 +1,86 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package kv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
+	gwatTypes "gitlab.waterfall.network/waterfall/protocol/gwat/core/types"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// encoded gwat checkpoint: epoch, fin epoch, root, spine.
+const gwatCheckpointLength = 8 + 8 + hashLength + hashLength
+
+// OriginGwatCheckpoint returns the value written to the db in SaveOriginGwatCheckpoint.
+// This is the gwat coordinated checkpoint of the origin block used to initialize
+// the database by checkpoint sync.
+func (s *Store) OriginGwatCheckpoint(ctx context.Context) (*gwatTypes.Checkpoint, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.OriginGwatCheckpoint")
+	defer span.End()
+
+	var cp *gwatTypes.Checkpoint
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(checkpointBucket).Get(originGwatCheckpointKey)
+		if enc == nil {
+			return ErrNotFoundOriginGwatCheckpoint
+		}
+		var err error
+		cp, err = decodeGwatCheckpoint(enc)
+		return err
+	})
+	return cp, err
+}
+
+// SaveOriginGwatCheckpoint saves the gwat coordinated checkpoint of the origin block
+// used for syncing from a checkpoint origin.
+func (s *Store) SaveOriginGwatCheckpoint(ctx context.Context, cp *gwatTypes.Checkpoint) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveOriginGwatCheckpoint")
+	defer span.End()
+
+	if cp == nil {
+		return errors.New("nil gwat checkpoint")
+	}
+	enc := encodeGwatCheckpoint(cp)
+	return s.db.Batch(func(tx *bolt.Tx) error {
+		return tx.Bucket(checkpointBucket).Put(originGwatCheckpointKey, enc)
+	})
+}
+
+func encodeGwatCheckpoint(cp *gwatTypes.Checkpoint) []byte {
+	enc := make([]byte, 0, gwatCheckpointLength)
+	enc = append(enc, bytesutil.Uint64ToBytesBigEndian(cp.Epoch)...)
+	enc = append(enc, bytesutil.Uint64ToBytesBigEndian(cp.FinEpoch)...)
+	enc = append(enc, cp.Root.Bytes()...)
+	enc = append(enc, cp.Spine.Bytes()...)
+	return enc
+}
+
+func decodeGwatCheckpoint(enc []byte) (*gwatTypes.Checkpoint, error) {
+	if len(enc) != gwatCheckpointLength {
+		return nil, fmt.Errorf("invalid gwat checkpoint length: got %d, want %d", len(enc), gwatCheckpointLength)
+	}
+	return &gwatTypes.Checkpoint{
+		Epoch:    bytesutil.BytesToUint64BigEndian(enc[:8]),
+		FinEpoch: bytesutil.BytesToUint64BigEndian(enc[8:16]),
+		Root:     gwatCommon.BytesToHash(enc[16 : 16+hashLength]),
+		Spine:    gwatCommon.BytesToHash(enc[16+hashLength:]),
+	}, nil
+}
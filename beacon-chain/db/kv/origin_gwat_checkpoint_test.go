@@ -0,0 +1,36 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/assert"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
+	gwatTypes "gitlab.waterfall.network/waterfall/protocol/gwat/core/types"
+)
+
+func TestStore_OriginGwatCheckpoint_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	_, err := db.OriginGwatCheckpoint(ctx)
+	require.ErrorIs(t, err, ErrNotFoundOriginGwatCheckpoint)
+
+	cp := &gwatTypes.Checkpoint{
+		Epoch:    12,
+		FinEpoch: 14,
+		Root:     gwatCommon.BytesToHash([]byte{'r', 'o', 'o', 't'}),
+		Spine:    gwatCommon.BytesToHash([]byte{'s', 'p', 'i', 'n', 'e'}),
+	}
+	require.NoError(t, db.SaveOriginGwatCheckpoint(ctx, cp))
+
+	retrieved, err := db.OriginGwatCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, cp, retrieved)
+}
+
+func TestStore_SaveOriginGwatCheckpoint_Nil(t *testing.T) {
+	db := setupDB(t)
+	require.ErrorContains(t, "nil gwat checkpoint", db.SaveOriginGwatCheckpoint(context.Background(), nil))
+}
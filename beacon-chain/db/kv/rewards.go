@@ -0,0 +1,171 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/rewards"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/monitoring/tracing"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/time/slots"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+const (
+	// rewards key: epoch, slot, block root, validator index, role, operation.
+	rewardsKeyLength = 8 + 8 + hashLength + 8 + 1 + 1
+	// encoded balance change: amount, balance before, balance after, included votes.
+	rewardsValueHeaderLength = 8 + 8 + 8
+)
+
+// EpochRewards retrieves the rewards and penalties applied to the validators balances in the epoch.
+func (s *Store) EpochRewards(ctx context.Context, epoch types.Epoch) ([]*rewards.BalanceChange, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.EpochRewards")
+	defer span.End()
+
+	changes, err := s.rewardsByPrefix(bytesutil.Uint64ToBytesBigEndian(uint64(epoch)))
+	tracing.AnnotateError(span, err)
+	return changes, err
+}
+
+// SlotRewards retrieves the rewards and penalties applied to the validators balances at the slot.
+func (s *Store) SlotRewards(ctx context.Context, slot types.Slot) ([]*rewards.BalanceChange, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SlotRewards")
+	defer span.End()
+
+	prefix := append(bytesutil.Uint64ToBytesBigEndian(uint64(slots.ToEpoch(slot))), bytesutil.Uint64ToBytesBigEndian(uint64(slot))...)
+	changes, err := s.rewardsByPrefix(prefix)
+	tracing.AnnotateError(span, err)
+	return changes, err
+}
+
+// SaveRewards saves the rewards and penalties applied to the validators balances.
+// A balance change is identified by the slot, the block root, the validator, the role and the operation,
+// so the changes applied by the blocks of different forks are kept separately
+// and the change recorded again by the same block replaces the stored one.
+func (s *Store) SaveRewards(ctx context.Context, changes []*rewards.BalanceChange) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveRewards")
+	defer span.End()
+
+	err := s.db.Batch(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(rewardsBucket)
+		for _, ch := range changes {
+			if ch == nil {
+				continue
+			}
+			if err := bkt.Put(rewardsKey(ch), encodeBalanceChange(ch)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	tracing.AnnotateError(span, err)
+	return err
+}
+
+// DeleteRewardsBefore deletes the rewards and penalties of the epochs before the given one.
+// Returns the number of the deleted balance changes.
+func (s *Store) DeleteRewardsBefore(ctx context.Context, epoch types.Epoch) (int, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.DeleteRewardsBefore")
+	defer span.End()
+
+	count := 0
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(rewardsBucket)
+		maxKey := bytesutil.Uint64ToBytesBigEndian(uint64(epoch))
+		keys := make([][]byte, 0)
+		c := bkt.Cursor()
+		for k, _ := c.First(); k != nil && bytes.Compare(k[:8], maxKey) < 0; k, _ = c.Next() {
+			keys = append(keys, bytesutil.SafeCopyBytes(k))
+		}
+		for _, k := range keys {
+			if err := bkt.Delete(k); err != nil {
+				return err
+			}
+		}
+		count = len(keys)
+		return nil
+	})
+	tracing.AnnotateError(span, err)
+	return count, err
+}
+
+func (s *Store) rewardsByPrefix(prefix []byte) ([]*rewards.BalanceChange, error) {
+	changes := make([]*rewards.BalanceChange, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(rewardsBucket).Cursor()
+		for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+			ch, err := decodeBalanceChange(k, v)
+			if err != nil {
+				return err
+			}
+			changes = append(changes, ch)
+		}
+		return nil
+	})
+	return changes, err
+}
+
+func rewardsKey(ch *rewards.BalanceChange) []byte {
+	key := make([]byte, 0, rewardsKeyLength)
+	key = append(key, bytesutil.Uint64ToBytesBigEndian(uint64(slots.ToEpoch(ch.Slot)))...)
+	key = append(key, bytesutil.Uint64ToBytesBigEndian(uint64(ch.Slot))...)
+	key = append(key, ch.BlockRoot[:]...)
+	key = append(key, bytesutil.Uint64ToBytesBigEndian(uint64(ch.Validator))...)
+	return append(key, byte(ch.Role), byte(ch.Operation))
+}
+
+func encodeBalanceChange(ch *rewards.BalanceChange) []byte {
+	enc := make([]byte, 0, rewardsValueHeaderLength+len(ch.VotesIncluded)*8)
+	enc = append(enc, bytesutil.Uint64ToBytesBigEndian(ch.Amount)...)
+	enc = append(enc, bytesutil.Uint64ToBytesBigEndian(ch.Before)...)
+	enc = append(enc, bytesutil.Uint64ToBytesBigEndian(ch.After)...)
+	for _, v := range ch.VotesIncluded {
+		enc = append(enc, bytesutil.Uint64ToBytesBigEndian(v)...)
+	}
+	return enc
+}
+
+func decodeBalanceChange(key, enc []byte) (*rewards.BalanceChange, error) {
+	if len(key) != rewardsKeyLength {
+		return nil, fmt.Errorf("invalid rewards key length %d", len(key))
+	}
+	if len(enc) < rewardsValueHeaderLength || (len(enc)-rewardsValueHeaderLength)%8 != 0 {
+		return nil, fmt.Errorf("invalid balance change length %d of key %#x", len(enc), key)
+	}
+	ch := &rewards.BalanceChange{
+		Slot:      types.Slot(bytesutil.BytesToUint64BigEndian(key[8:16])),
+		BlockRoot: bytesutil.ToBytes32(key[16:48]),
+		Validator: types.ValidatorIndex(bytesutil.BytesToUint64BigEndian(key[48:56])),
+		Role:      rewards.Role(key[56]),
+		Operation: rewards.Operation(key[57]),
+		Amount:    bytesutil.BytesToUint64BigEndian(enc[0:8]),
+		Before:    bytesutil.BytesToUint64BigEndian(enc[8:16]),
+		After:     bytesutil.BytesToUint64BigEndian(enc[16:24]),
+	}
+	if votesNum := (len(enc) - rewardsValueHeaderLength) / 8; votesNum > 0 {
+		ch.VotesIncluded = make([]uint64, votesNum)
+		for i := range ch.VotesIncluded {
+			offset := rewardsValueHeaderLength + i*8
+			ch.VotesIncluded[i] = bytesutil.BytesToUint64BigEndian(enc[offset : offset+8])
+		}
+	}
+	return ch, nil
+}
@@ -0,0 +1,74 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/rewards"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+)
+
+func TestStore_Rewards_SaveRetrieve(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	changes := []*rewards.BalanceChange{
+		{Slot: slotsPerEpoch + 1, Validator: 1, Operation: rewards.Increase, Role: rewards.RoleProposing, Amount: 10, VotesIncluded: []uint64{2, 3}, Before: 100, After: 110},
+		{Slot: slotsPerEpoch + 1, Validator: 4, Operation: rewards.Decrease, Role: rewards.RoleSyncCommittee, Amount: 5, Before: 100, After: 95},
+		{Slot: 2*slotsPerEpoch - 1, Validator: 4, Operation: rewards.Increase, Role: rewards.RoleAttestation, Amount: 7, Before: 95, After: 102},
+		{Slot: 2 * slotsPerEpoch, Validator: 1, Operation: rewards.Decrease, Role: rewards.RoleWithdrawal, Amount: 20, Before: 110, After: 90},
+	}
+	require.NoError(t, db.SaveRewards(ctx, changes))
+
+	epochChanges, err := db.EpochRewards(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(epochChanges))
+	require.DeepEqual(t, changes[0], epochChanges[0])
+
+	slotChanges, err := db.SlotRewards(ctx, slotsPerEpoch+1)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(slotChanges))
+	require.DeepEqual(t, changes[1], slotChanges[1])
+
+	// The change recorded again replaces the stored one.
+	replaced := &rewards.BalanceChange{Slot: slotsPerEpoch + 1, Validator: 1, Operation: rewards.Increase, Role: rewards.RoleProposing, Amount: 12, Before: 100, After: 112}
+	require.NoError(t, db.SaveRewards(ctx, []*rewards.BalanceChange{replaced}))
+	slotChanges, err = db.SlotRewards(ctx, slotsPerEpoch+1)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(slotChanges))
+	require.DeepEqual(t, replaced, slotChanges[0])
+
+	// The change applied by the block of other fork is kept separately.
+	forked := &rewards.BalanceChange{Slot: slotsPerEpoch + 1, BlockRoot: [32]byte{'f'}, Validator: 1, Operation: rewards.Increase, Role: rewards.RoleProposing, Amount: 11, Before: 100, After: 111}
+	require.NoError(t, db.SaveRewards(ctx, []*rewards.BalanceChange{forked}))
+	slotChanges, err = db.SlotRewards(ctx, slotsPerEpoch+1)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(slotChanges))
+	require.DeepEqual(t, forked, slotChanges[2])
+}
+
+func TestStore_DeleteRewardsBefore(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	changes := []*rewards.BalanceChange{
+		{Slot: 1, Validator: 1, Operation: rewards.Increase, Role: rewards.RoleProposing, Amount: 1},
+		{Slot: slotsPerEpoch, Validator: 1, Operation: rewards.Increase, Role: rewards.RoleProposing, Amount: 1},
+		{Slot: 2 * slotsPerEpoch, Validator: 1, Operation: rewards.Increase, Role: rewards.RoleProposing, Amount: 1},
+	}
+	require.NoError(t, db.SaveRewards(ctx, changes))
+
+	deleted, err := db.DeleteRewardsBefore(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, deleted)
+
+	epochChanges, err := db.EpochRewards(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(epochChanges))
+	epochChanges, err = db.EpochRewards(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(epochChanges))
+}
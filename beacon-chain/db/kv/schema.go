@@ -7,19 +7,24 @@ package kv
 // it easy to scan for keys that have a certain shard number as a prefix and return those
 // corresponding attestations.
 var (
-	attestationsBucket      = []byte("attestations")
-	blocksBucket            = []byte("blocks")
-	stateBucket             = []byte("state")
-	stateSummaryBucket      = []byte("state-summary")
-	proposerSlashingsBucket = []byte("proposer-slashings")
-	attesterSlashingsBucket = []byte("attester-slashings")
-	voluntaryExitsBucket    = []byte("voluntary-exits")
-	chainMetadataBucket     = []byte("chain-metadata")
-	checkpointBucket        = []byte("check-point")
-	powchainBucket          = []byte("powchain")
-	stateValidatorsBucket   = []byte("state-validators")
-	feeRecipientBucket      = []byte("fee-recipient")
-	spinesBucket            = []byte("spines")
+	attestationsBucket       = []byte("attestations")
+	blocksBucket             = []byte("blocks")
+	stateBucket              = []byte("state")
+	stateSummaryBucket       = []byte("state-summary")
+	proposerSlashingsBucket  = []byte("proposer-slashings")
+	attesterSlashingsBucket  = []byte("attester-slashings")
+	voluntaryExitsBucket     = []byte("voluntary-exits")
+	chainMetadataBucket      = []byte("chain-metadata")
+	checkpointBucket         = []byte("check-point")
+	powchainBucket           = []byte("powchain")
+	stateValidatorsBucket    = []byte("state-validators")
+	feeRecipientBucket       = []byte("fee-recipient")
+	spinesBucket             = []byte("spines")
+	withdrawalPoolBucket     = []byte("withdrawal-pool")
+	operationLifecycleBucket = []byte("operation-lifecycle")
+	exitPoolBucket           = []byte("exit-pool")
+	rewardsBucket            = []byte("rewards")
+	spineLifecycleBucket     = []byte("spine-lifecycle")
 
 	// Deprecated: This bucket was migrated in PR 6461. Do not use, except for migrations.
 	slotsHasObjectBucket = []byte("slots-has-objects")
@@ -27,16 +32,21 @@ var (
 	archivedRootBucket = []byte("archived-index-root")
 
 	// Key indices buckets.
-	blockParentRootIndicesBucket        = []byte("block-parent-root-indices")
-	blockSlotIndicesBucket              = []byte("block-slot-indices")
-	stateSlotIndicesBucket              = []byte("state-slot-indices")
-	attestationHeadBlockRootBucket      = []byte("attestation-head-block-root-indices")
-	attestationSourceRootIndicesBucket  = []byte("attestation-source-root-indices")
-	attestationSourceEpochIndicesBucket = []byte("attestation-source-epoch-indices")
-	attestationTargetRootIndicesBucket  = []byte("attestation-target-root-indices")
-	attestationTargetEpochIndicesBucket = []byte("attestation-target-epoch-indices")
-	finalizedBlockRootsIndexBucket      = []byte("finalized-block-roots-index")
-	blockRootValidatorHashesBucket      = []byte("block-root-validator-hashes")
+	blockParentRootIndicesBucket         = []byte("block-parent-root-indices")
+	blockSlotIndicesBucket               = []byte("block-slot-indices")
+	stateSlotIndicesBucket               = []byte("state-slot-indices")
+	attestationHeadBlockRootBucket       = []byte("attestation-head-block-root-indices")
+	attestationSourceRootIndicesBucket   = []byte("attestation-source-root-indices")
+	attestationSourceEpochIndicesBucket  = []byte("attestation-source-epoch-indices")
+	attestationTargetRootIndicesBucket   = []byte("attestation-target-root-indices")
+	attestationTargetEpochIndicesBucket  = []byte("attestation-target-epoch-indices")
+	finalizedBlockRootsIndexBucket       = []byte("finalized-block-roots-index")
+	blockRootValidatorHashesBucket       = []byte("block-root-validator-hashes")
+	stateSpinesIndicesBucket             = []byte("state-spines-indices")
+	spinesRefsBucket                     = []byte("spines-refs")
+	operationLifecycleSlotIndicesBucket  = []byte("operation-lifecycle-slot-indices")
+	operationLifecycleBlockIndicesBucket = []byte("operation-lifecycle-block-indices")
+	spineLifecycleSlotIndicesBucket      = []byte("spine-lifecycle-slot-indices")
 
 	// Specific item keys.
 	headBlockRootKey           = []byte("head-root")
@@ -46,6 +56,8 @@ var (
 	finalizedCheckpointKey     = []byte("finalized-checkpoint")
 	powchainDataKey            = []byte("powchain-data")
 	lastValidatedCheckpointKey = []byte("last-validated-checkpoint")
+	// gwat block number of the last handled exit log, the exit logs catch-up starts from it
+	exitLogsLastHandledBlockKey = []byte("exit-logs-last-handled-block")
 
 	// Below keys are used to identify objects are to be fork compatible.
 	// Objects that are only compatible with specific forks should be prefixed with such keys.
@@ -53,6 +65,8 @@ var (
 	bellatrixKey = []byte("merge")
 	// block root included in the beacon state used by weak subjectivity initial sync
 	originCheckpointBlockRootKey = []byte("origin-checkpoint-block-root")
+	// gwat coordinated checkpoint of the origin block used by weak subjectivity initial sync
+	originGwatCheckpointKey = []byte("origin-gwat-checkpoint")
 	// block root tracking the progress of backfill, or pointing at genesis if backfill has not been initiated
 	backfillBlockRootKey = []byte("backfill-block-root")
 
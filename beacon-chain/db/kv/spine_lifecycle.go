@@ -0,0 +1,165 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/operations/lifecycle"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/monitoring/tracing"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// encoded spine record: stage, slot, block root.
+const spineRecordLength = 1 + 8 + hashLength
+
+// SpineLifecycle retrieves the lifecycle of the gwat spine by its hash.
+func (s *Store) SpineLifecycle(ctx context.Context, hash [32]byte) (*lifecycle.Spine, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SpineLifecycle")
+	defer span.End()
+
+	var sp *lifecycle.Spine
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(spineLifecycleBucket).Get(hash[:])
+		if enc == nil {
+			return ErrNotFoundSpineLifecycle
+		}
+		var err error
+		sp, err = decodeSpineLifecycle(hash, enc)
+		return err
+	})
+	tracing.AnnotateError(span, err)
+	return sp, err
+}
+
+// SaveSpineLifecycles merges the records of the given spines into the stored lifecycles.
+// The spines are indexed by the slots of the added records to be pruned by PruneSpineLifecycles.
+func (s *Store) SaveSpineLifecycles(ctx context.Context, spines []*lifecycle.Spine) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveSpineLifecycles")
+	defer span.End()
+
+	err := s.db.Batch(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(spineLifecycleBucket)
+		idxBkt := tx.Bucket(spineLifecycleSlotIndicesBucket)
+		for _, sp := range spines {
+			if sp == nil {
+				return errors.New("cannot save nil spine lifecycle")
+			}
+			stored := &lifecycle.Spine{Hash: sp.Hash}
+			if enc := bkt.Get(sp.Hash[:]); enc != nil {
+				var err error
+				if stored, err = decodeSpineLifecycle(sp.Hash, enc); err != nil {
+					return err
+				}
+			}
+			if !stored.Merge(sp) {
+				continue
+			}
+			if err := bkt.Put(sp.Hash[:], encodeSpineLifecycle(stored)); err != nil {
+				return err
+			}
+			for _, r := range sp.Records {
+				if err := idxBkt.Put(spineSlotIndexKey(r.Slot, sp.Hash), []byte{}); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	tracing.AnnotateError(span, err)
+	return err
+}
+
+// PruneSpineLifecycles deletes the lifecycles of the spines, whose records are reached up to the max slot.
+// Returns the number of the deleted lifecycles.
+func (s *Store) PruneSpineLifecycles(ctx context.Context, maxSlot types.Slot) (int, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.PruneSpineLifecycles")
+	defer span.End()
+
+	count := 0
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(spineLifecycleBucket)
+		idxBkt := tx.Bucket(spineLifecycleSlotIndicesBucket)
+		maxKey := bytesutil.Uint64ToBytesBigEndian(uint64(maxSlot))
+		keys := make([][]byte, 0)
+		c := idxBkt.Cursor()
+		for k, _ := c.First(); k != nil && bytes.Compare(k[:8], maxKey) <= 0; k, _ = c.Next() {
+			keys = append(keys, bytesutil.SafeCopyBytes(k))
+		}
+		for _, k := range keys {
+			if err := idxBkt.Delete(k); err != nil {
+				return err
+			}
+			hash := bytesutil.ToBytes32(k[8:])
+			enc := bkt.Get(hash[:])
+			if enc == nil {
+				continue
+			}
+			sp, err := decodeSpineLifecycle(hash, enc)
+			if err != nil {
+				return err
+			}
+			// the spine is still indexed by the later records.
+			if sp.LatestSlot() > maxSlot {
+				continue
+			}
+			if err := bkt.Delete(hash[:]); err != nil {
+				return err
+			}
+			count++
+		}
+		return nil
+	})
+	tracing.AnnotateError(span, err)
+	return count, err
+}
+
+func spineSlotIndexKey(slot types.Slot, hash [32]byte) []byte {
+	return append(bytesutil.Uint64ToBytesBigEndian(uint64(slot)), hash[:]...)
+}
+
+func encodeSpineLifecycle(sp *lifecycle.Spine) []byte {
+	enc := make([]byte, 0, len(sp.Records)*spineRecordLength)
+	for _, r := range sp.Records {
+		enc = append(enc, byte(r.Stage))
+		enc = append(enc, bytesutil.Uint64ToBytesBigEndian(uint64(r.Slot))...)
+		enc = append(enc, r.BlockRoot[:]...)
+	}
+	return enc
+}
+
+func decodeSpineLifecycle(hash [32]byte, enc []byte) (*lifecycle.Spine, error) {
+	if len(enc)%spineRecordLength != 0 {
+		return nil, fmt.Errorf("invalid spine lifecycle length %d of spine %#x", len(enc), hash)
+	}
+	sp := &lifecycle.Spine{
+		Hash:    hash,
+		Records: make([]*lifecycle.SpineRecord, 0, len(enc)/spineRecordLength),
+	}
+	for i := 0; i < len(enc); i += spineRecordLength {
+		sp.Records = append(sp.Records, &lifecycle.SpineRecord{
+			Stage:     lifecycle.SpineStage(enc[i]),
+			Slot:      types.Slot(bytesutil.BytesToUint64BigEndian(enc[i+1 : i+9])),
+			BlockRoot: bytesutil.ToBytes32(enc[i+9 : i+spineRecordLength]),
+		})
+	}
+	return sp, nil
+}
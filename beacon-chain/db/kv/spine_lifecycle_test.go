@@ -0,0 +1,78 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/operations/lifecycle"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/assert"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+)
+
+func TestStore_SpineLifecycle_SaveMerge(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	hash := [32]byte{'s', 'p', 'i', 'n', 'e'}
+
+	_, err := db.SpineLifecycle(ctx, hash)
+	require.ErrorIs(t, err, ErrNotFoundSpineLifecycle)
+
+	require.NoError(t, db.SaveSpineLifecycles(ctx, []*lifecycle.Spine{{
+		Hash: hash,
+		Records: []*lifecycle.SpineRecord{
+			{Stage: lifecycle.SpineCandidate, Slot: 10, BlockRoot: [32]byte{'a'}},
+			{Stage: lifecycle.SpinePrefix, Slot: 10, BlockRoot: [32]byte{'a'}},
+		},
+	}}))
+	require.NoError(t, db.SaveSpineLifecycles(ctx, []*lifecycle.Spine{{
+		Hash: hash,
+		Records: []*lifecycle.SpineRecord{
+			{Stage: lifecycle.SpinePrefix, Slot: 11, BlockRoot: [32]byte{'b'}},
+			{Stage: lifecycle.SpineFinalization, Slot: 12, BlockRoot: [32]byte{'c'}},
+		},
+	}}))
+
+	sp, err := db.SpineLifecycle(ctx, hash)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &lifecycle.Spine{
+		Hash: hash,
+		Records: []*lifecycle.SpineRecord{
+			{Stage: lifecycle.SpineCandidate, Slot: 10, BlockRoot: [32]byte{'a'}},
+			{Stage: lifecycle.SpinePrefix, Slot: 10, BlockRoot: [32]byte{'a'}},
+			{Stage: lifecycle.SpinePrefix, Slot: 11, BlockRoot: [32]byte{'b'}},
+			{Stage: lifecycle.SpineFinalization, Slot: 12, BlockRoot: [32]byte{'c'}},
+		},
+	}, sp)
+}
+
+func TestStore_PruneSpineLifecycles(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	spineA, spineB, spineC := [32]byte{'a'}, [32]byte{'b'}, [32]byte{'c'}
+
+	require.NoError(t, db.SaveSpineLifecycles(ctx, []*lifecycle.Spine{
+		{Hash: spineA, Records: []*lifecycle.SpineRecord{
+			{Stage: lifecycle.SpineCandidate, Slot: 10, BlockRoot: [32]byte{'r'}},
+			{Stage: lifecycle.SpineSent, Slot: 14},
+		}},
+		{Hash: spineB, Records: []*lifecycle.SpineRecord{{Stage: lifecycle.SpineCandidate, Slot: 12, BlockRoot: [32]byte{'r'}}}},
+		{Hash: spineC, Records: []*lifecycle.SpineRecord{{Stage: lifecycle.SpineCandidate, Slot: 20, BlockRoot: [32]byte{'r'}}}},
+	}))
+
+	// spine A is kept by the record reached after the max slot
+	pruned, err := db.PruneSpineLifecycles(ctx, 12)
+	require.NoError(t, err)
+	assert.Equal(t, 1, pruned)
+	_, err = db.SpineLifecycle(ctx, spineB)
+	require.ErrorIs(t, err, ErrNotFoundSpineLifecycle)
+	_, err = db.SpineLifecycle(ctx, spineA)
+	require.NoError(t, err)
+
+	pruned, err = db.PruneSpineLifecycles(ctx, 14)
+	require.NoError(t, err)
+	assert.Equal(t, 1, pruned)
+	_, err = db.SpineLifecycle(ctx, spineA)
+	require.ErrorIs(t, err, ErrNotFoundSpineLifecycle)
+	_, err = db.SpineLifecycle(ctx, spineC)
+	require.NoError(t, err)
+}
@@ -15,9 +15,14 @@
 package kv
 
 import (
+	"bytes"
 	"context"
 
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/state"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1/wrapper"
 	bolt "go.etcd.io/bbolt"
 	"go.opencensus.io/trace"
@@ -82,3 +87,185 @@ func (s *Store) DeleteSpines(ctx context.Context, key [32]byte) error {
 		return nil
 	})
 }
+
+// PruneSpines rebuilds the references of the spines from the stored states
+// and removes the spines which are not referred by any state.
+// Returns the number of removed spines.
+func (s *Store) PruneSpines(ctx context.Context) (int, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PruneSpines")
+	defer span.End()
+
+	if err := rebuildSpinesRefs(ctx, s.db); err != nil {
+		return 0, err
+	}
+
+	// sweep: remove the spines without references.
+	pruned := make([][32]byte, 0)
+	var lastKey []byte
+	for done := false; !done; {
+		if ctx.Err() != nil {
+			return len(pruned), ctx.Err()
+		}
+		err := s.db.Update(func(tx *bolt.Tx) error {
+			spinesBkt := tx.Bucket(spinesBucket)
+			refsBkt := tx.Bucket(spinesRefsBucket)
+			batch := make([][]byte, 0)
+			c := spinesBkt.Cursor()
+			k, _ := seekAfter(c, lastKey)
+			for n := 0; k != nil && n < spinesRefsBatchSize; k, _ = c.Next() {
+				if refsBkt.Get(k) == nil {
+					batch = append(batch, bytesutil.SafeCopyBytes(k))
+				}
+				lastKey = bytesutil.SafeCopyBytes(k)
+				n++
+			}
+			done = k == nil
+			for _, key := range batch {
+				if err := spinesBkt.Delete(key); err != nil {
+					return err
+				}
+				s.spinesCache.Remove(bytesutil.ToBytes32(key))
+				pruned = append(pruned, bytesutil.ToBytes32(key))
+			}
+			return nil
+		})
+		if err != nil {
+			return len(pruned), err
+		}
+	}
+	return len(pruned), nil
+}
+
+// seekAfter moves the cursor to the first key after the last one,
+// or to the first key of the bucket if the last key is nil.
+func seekAfter(c *bolt.Cursor, lastKey []byte) ([]byte, []byte) {
+	if lastKey == nil {
+		return c.First()
+	}
+	k, v := c.Seek(lastKey)
+	if bytes.Equal(k, lastKey) {
+		return c.Next()
+	}
+	return k, v
+}
+
+// stateSpines returns the spines lists of the state in order of their references storing.
+func stateSpines(st state.ReadOnlyBeaconState) []wrapper.Spines {
+	spineData := st.SpineData()
+	return []wrapper.Spines{
+		spineData.Spines,
+		spineData.Prefix,
+		spineData.Finalization,
+		spineData.CpFinalized,
+	}
+}
+
+// stateSpinesKeys returns the keys of the spines referred by the encoded state.
+func stateSpinesKeys(enc []byte) ([]byte, error) {
+	enc, err := snappy.Decode(nil, enc)
+	if err != nil {
+		return nil, err
+	}
+	var spineData *ethpb.SpineData
+	if hasAltairKey(enc) {
+		protoState := &ethpb.BeaconStateAltair{}
+		if err := protoState.UnmarshalSSZ(enc[len(altairKey):]); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal encoding for altair")
+		}
+		spineData = protoState.SpineData
+	} else {
+		protoState := &ethpb.BeaconState{}
+		if err := protoState.UnmarshalSSZ(enc); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal encoding")
+		}
+		spineData = protoState.SpineData
+	}
+	if spineData == nil {
+		return nil, errors.New("nil spine data")
+	}
+	keys := make([]byte, 0, 4*hashLength)
+	for _, key := range [][]byte{spineData.Spines, spineData.Prefix, spineData.Finalization, spineData.CpFinalized} {
+		k := bytesutil.ToBytes32(key)
+		keys = append(keys, k[:]...)
+	}
+	return keys, nil
+}
+
+// addStateSpinesRefs stores the spines of the state and increments their references.
+// The previous references of the state are released, if the state is overwritten.
+// Returns the keys of the spines removed from the db.
+func addStateSpinesRefs(tx *bolt.Tx, blockRoot []byte, spines []wrapper.Spines) ([][32]byte, error) {
+	spinesBkt := tx.Bucket(spinesBucket)
+	refsBkt := tx.Bucket(spinesRefsBucket)
+	keys := make([]byte, 0, len(spines)*hashLength)
+	for _, sp := range spines {
+		key := sp.Key()
+		// the spines could be removed by the concurrent release of another state.
+		if spinesBkt.Get(key[:]) == nil {
+			if err := spinesBkt.Put(key[:], sp); err != nil {
+				return nil, err
+			}
+		}
+		refs := bytesutil.BytesToUint64BigEndian(refsBkt.Get(key[:]))
+		if err := refsBkt.Put(key[:], bytesutil.Uint64ToBytesBigEndian(refs+1)); err != nil {
+			return nil, err
+		}
+		keys = append(keys, key[:]...)
+	}
+	pruned, err := releaseStateSpinesRefs(tx, blockRoot)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Bucket(stateSpinesIndicesBucket).Put(blockRoot, keys); err != nil {
+		return nil, err
+	}
+	return pruned, nil
+}
+
+// releaseStateSpinesRefs decrements the references of the spines of the state
+// and removes the spines which are not referred anymore,
+// once the references of the states saved before the references counting are rebuilt.
+// Returns the keys of the spines removed from the db.
+func releaseStateSpinesRefs(tx *bolt.Tx, blockRoot []byte) ([][32]byte, error) {
+	idxBkt := tx.Bucket(stateSpinesIndicesBucket)
+	keys := idxBkt.Get(blockRoot)
+	// the state saved before the references counting was introduced.
+	if keys == nil {
+		return nil, nil
+	}
+	if len(keys)%hashLength != 0 {
+		return nil, errors.Errorf("invalid state spines keys length: %d", len(keys))
+	}
+	keys = bytesutil.SafeCopyBytes(keys)
+	if err := idxBkt.Delete(blockRoot); err != nil {
+		return nil, err
+	}
+
+	spinesBkt := tx.Bucket(spinesBucket)
+	refsBkt := tx.Bucket(spinesRefsBucket)
+	rebuilt := spinesRefsRebuilt(tx)
+	pruned := make([][32]byte, 0)
+	for i := 0; i < len(keys); i += hashLength {
+		key := keys[i : i+hashLength]
+		refs := bytesutil.BytesToUint64BigEndian(refsBkt.Get(key))
+		switch {
+		case refs > 1:
+			if err := refsBkt.Put(key, bytesutil.Uint64ToBytesBigEndian(refs-1)); err != nil {
+				return nil, err
+			}
+		case refs == 1:
+			if err := refsBkt.Delete(key); err != nil {
+				return nil, err
+			}
+			// the spines could be still referred by the states not counted yet.
+			if !rebuilt {
+				continue
+			}
+			if err := spinesBkt.Delete(key); err != nil {
+				return nil, err
+			}
+			pruned = append(pruned, bytesutil.ToBytes32(key))
+		}
+	}
+	return pruned, nil
+}
@@ -19,10 +19,15 @@ import (
 	"fmt"
 	"testing"
 
+	types "github.com/prysmaticlabs/eth2-types"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/state"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1/wrapper"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/assert"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
 	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
+	bolt "go.etcd.io/bbolt"
 )
 
 func TestStore_GwatSyncParamCRUD(t *testing.T) {
@@ -73,6 +78,111 @@ func TestStore_GwatSyncParamCRUD(t *testing.T) {
 	}
 }
 
+func TestStore_SpinesRefs(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	spinesA := NewSpinesParam()
+	spinesB := wrapper.Spines(gwatCommon.HashArray{
+		gwatCommon.HexToHash("0x0a"),
+		gwatCommon.HexToHash("0x0b"),
+	}.ToBytes())
+	r1 := [32]byte{'A'}
+	r2 := [32]byte{'B'}
+
+	st1 := newSpinesState(t, 100, spinesA, spinesB)
+	st2 := newSpinesState(t, 101, spinesA, nil)
+	require.NoError(t, db.SaveState(ctx, st1, r1))
+	require.NoError(t, db.SaveState(ctx, st2, r2))
+	assert.Equal(t, uint64(2), spinesRefs(t, db, spinesA.Key()))
+	assert.Equal(t, uint64(1), spinesRefs(t, db, spinesB.Key()))
+
+	// overwriting of the state does not change the references.
+	require.NoError(t, db.SaveState(ctx, st1, r1))
+	assert.Equal(t, uint64(2), spinesRefs(t, db, spinesA.Key()))
+	assert.Equal(t, uint64(1), spinesRefs(t, db, spinesB.Key()))
+
+	// the spines referred only by the deleted state are removed.
+	require.NoError(t, db.DeleteState(ctx, r1))
+	assert.Equal(t, uint64(1), spinesRefs(t, db, spinesA.Key()))
+	assert.Equal(t, true, hasSpines(t, db, spinesA.Key()))
+	assert.Equal(t, false, hasSpines(t, db, spinesB.Key()))
+	_, ok := db.spinesCache.Get(spinesB.Key())
+	assert.Equal(t, false, ok)
+
+	require.NoError(t, db.DeleteState(ctx, r2))
+	assert.Equal(t, uint64(0), spinesRefs(t, db, spinesA.Key()))
+	assert.Equal(t, false, hasSpines(t, db, spinesA.Key()))
+}
+
+func TestStore_PruneSpines(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	spinesA := NewSpinesParam()
+	orphan := wrapper.Spines(gwatCommon.HashArray{
+		gwatCommon.HexToHash("0x0c"),
+	}.ToBytes())
+	r1 := [32]byte{'A'}
+
+	st := newSpinesState(t, 100, spinesA, nil)
+	require.NoError(t, db.SaveState(ctx, st, r1))
+	_, err := db.WriteSpines(ctx, orphan)
+	require.NoError(t, err)
+
+	// simulate the db created before the references counting.
+	require.NoError(t, db.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(stateSpinesIndicesBucket).Delete(r1[:]); err != nil {
+			return err
+		}
+		key := spinesA.Key()
+		return tx.Bucket(spinesRefsBucket).Delete(key[:])
+	}))
+
+	pruned, err := db.PruneSpines(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, pruned)
+	assert.Equal(t, false, hasSpines(t, db, orphan.Key()))
+	assert.Equal(t, true, hasSpines(t, db, spinesA.Key()))
+	assert.Equal(t, uint64(1), spinesRefs(t, db, spinesA.Key()))
+
+	// the references are rebuilt.
+	require.NoError(t, db.DeleteState(ctx, r1))
+	assert.Equal(t, false, hasSpines(t, db, spinesA.Key()))
+}
+
+func newSpinesState(t *testing.T, slot types.Slot, spines, prefix wrapper.Spines) state.BeaconState {
+	st, err := NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(slot))
+	require.NoError(t, st.SetSpineData(&ethpb.SpineData{
+		Spines:       spines,
+		Prefix:       prefix,
+		Finalization: []byte{},
+		CpFinalized:  []byte{},
+		ParentSpines: []*ethpb.SpinesSeq{},
+	}))
+	return st
+}
+
+func spinesRefs(t *testing.T, db *Store, key [32]byte) uint64 {
+	var refs uint64
+	require.NoError(t, db.db.View(func(tx *bolt.Tx) error {
+		refs = bytesutil.BytesToUint64BigEndian(tx.Bucket(spinesRefsBucket).Get(key[:]))
+		return nil
+	}))
+	return refs
+}
+
+func hasSpines(t *testing.T, db *Store, key [32]byte) bool {
+	var has bool
+	require.NoError(t, db.db.View(func(tx *bolt.Tx) error {
+		has = tx.Bucket(spinesBucket).Get(key[:]) != nil
+		return nil
+	}))
+	return has
+}
+
 func NewSpinesParam() wrapper.Spines {
 	return gwatCommon.HashArray{
 		gwatCommon.HexToHash("0x12380221e25ac8aedaa824fa4a456072dbe48f3421794edafcaed1f57f9aab59"),
@@ -205,7 +205,9 @@ func (s *Store) SaveStates(ctx context.Context, states []state.ReadOnlyBeaconSta
 		return errors.New("nil state")
 	}
 	multipleEncs := make([][]byte, len(states))
+	statesSpines := make([][]wrapper.Spines, len(states))
 	for i, st := range states {
+		statesSpines[i] = stateSpines(states[i])
 		//store the spines data and replace it by keys
 		keySpines, err := s.WriteSpines(ctx, states[i].SpineData().Spines)
 		if err != nil {
@@ -268,19 +270,32 @@ func (s *Store) SaveStates(ctx context.Context, states []state.ReadOnlyBeaconSta
 		multipleEncs[i] = stateBytes
 	}
 
-	return s.db.Batch(func(tx *bolt.Tx) error {
+	var prunedSpines [][32]byte
+	if err := s.db.Batch(func(tx *bolt.Tx) error {
+		prunedSpines = make([][32]byte, 0)
 		bucket := tx.Bucket(stateBucket)
 		for i, rt := range blockRoots {
 			indicesByBucket := createStateIndicesFromStateSlot(ctx, states[i].Slot())
 			if err := updateValueForIndices(ctx, indicesByBucket, rt[:], tx); err != nil {
 				return errors.Wrap(err, "could not update DB indices")
 			}
+			pruned, err := addStateSpinesRefs(tx, rt[:], statesSpines[i])
+			if err != nil {
+				return errors.Wrap(err, "could not update state spines references")
+			}
+			prunedSpines = append(prunedSpines, pruned...)
 			if err := bucket.Put(rt[:], multipleEncs[i]); err != nil {
 				return err
 			}
 		}
 		return nil
-	})
+	}); err != nil {
+		return err
+	}
+	for _, key := range prunedSpines {
+		s.spinesCache.Remove(key)
+	}
+	return nil
 }
 
 // SaveStatesEfficient stores multiple states to the db (new schema) using the provided corresponding roots.
@@ -292,7 +307,9 @@ func (s *Store) SaveStatesEfficient(ctx context.Context, states []state.ReadOnly
 	}
 	validatorsEntries := make(map[string]*ethpb.Validator) // It's a map to make sure that you store only new validator entries.
 	validatorKeys := make([][]byte, len(states))           // For every state, this stores a compressed list of validator keys.
+	statesSpines := make([][]wrapper.Spines, len(states))
 	for i, st := range states {
+		statesSpines[i] = stateSpines(states[i])
 		//store the spines data and replace it by keys
 		keySpines, err := s.WriteSpines(ctx, states[i].SpineData().Spines)
 		if err != nil {
@@ -359,7 +376,9 @@ func (s *Store) SaveStatesEfficient(ctx context.Context, states []state.ReadOnly
 		validatorKeys[i] = snappy.Encode(nil, hashes)
 	}
 
+	var prunedSpines [][32]byte
 	if err := s.db.Batch(func(tx *bolt.Tx) error {
+		prunedSpines = make([][32]byte, 0)
 		bucket := tx.Bucket(stateBucket)
 		valIdxBkt := tx.Bucket(blockRootValidatorHashesBucket)
 		var _ = bucket
@@ -370,6 +389,11 @@ func (s *Store) SaveStatesEfficient(ctx context.Context, states []state.ReadOnly
 			if err := updateValueForIndices(ctx, indicesByBucket, rt[:], tx); err != nil {
 				return errors.Wrap(err, "could not update DB indices")
 			}
+			pruned, err := addStateSpinesRefs(tx, rt[:], statesSpines[i])
+			if err != nil {
+				return errors.Wrap(err, "could not update state spines references")
+			}
+			prunedSpines = append(prunedSpines, pruned...)
 
 			// There is a gap when the states that are passed are used outside this
 			// thread. But while storing the state object, we should not store the
@@ -451,6 +475,9 @@ func (s *Store) SaveStatesEfficient(ctx context.Context, states []state.ReadOnly
 	}); err != nil {
 		return err
 	}
+	for _, key := range prunedSpines {
+		s.spinesCache.Remove(key)
+	}
 
 	return nil
 }
@@ -479,7 +506,9 @@ func (s *Store) DeleteState(ctx context.Context, blockRoot [32]byte) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.DeleteState")
 	defer span.End()
 
-	return s.db.Batch(func(tx *bolt.Tx) error {
+	var prunedSpines [][32]byte
+	err := s.db.Batch(func(tx *bolt.Tx) error {
+		prunedSpines = nil
 		bkt := tx.Bucket(blocksBucket)
 		genesisBlockRoot := bkt.Get(genesisBlockRootKey)
 
@@ -552,8 +581,21 @@ func (s *Store) DeleteState(ctx context.Context, blockRoot [32]byte) error {
 			}
 		}
 
+		// release the spines of the state and remove the unreferenced ones.
+		prunedSpines, err = releaseStateSpinesRefs(tx, blockRoot[:])
+		if err != nil {
+			return errors.Wrap(err, "could not release state spines references")
+		}
+
 		return bkt.Delete(blockRoot[:])
 	})
+	if err != nil {
+		return err
+	}
+	for _, key := range prunedSpines {
+		s.spinesCache.Remove(key)
+	}
+	return nil
 }
 
 // DeleteStates by block roots.
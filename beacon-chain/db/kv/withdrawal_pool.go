@@ -0,0 +1,90 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package kv
+
+import (
+	"context"
+	"errors"
+
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/monitoring/tracing"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// WithdrawalPool retrieves the pending withdrawals of the withdrawal pool.
+func (s *Store) WithdrawalPool(ctx context.Context) ([]*ethpb.Withdrawal, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.WithdrawalPool")
+	defer span.End()
+
+	withdrawals := make([]*ethpb.Withdrawal, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(withdrawalPoolBucket)
+		return bkt.ForEach(func(_, enc []byte) error {
+			w := &ethpb.Withdrawal{}
+			if err := decode(ctx, enc, w); err != nil {
+				return err
+			}
+			withdrawals = append(withdrawals, w)
+			return nil
+		})
+	})
+	tracing.AnnotateError(span, err)
+	return withdrawals, err
+}
+
+// SaveWithdrawalPoolItems saves the pending withdrawals of the withdrawal pool
+// keyed by their init tx hash, overwriting the existing items with the same key.
+func (s *Store) SaveWithdrawalPoolItems(ctx context.Context, withdrawals []*ethpb.Withdrawal) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveWithdrawalPoolItems")
+	defer span.End()
+
+	err := s.db.Batch(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(withdrawalPoolBucket)
+		for _, w := range withdrawals {
+			if w == nil || len(w.InitTxHash) == 0 {
+				return errors.New("cannot save withdrawal without init tx hash")
+			}
+			enc, err := encode(ctx, w)
+			if err != nil {
+				return err
+			}
+			if err := bkt.Put(w.InitTxHash, enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	tracing.AnnotateError(span, err)
+	return err
+}
+
+// DeleteWithdrawalPoolItems deletes the pending withdrawals of the withdrawal pool by init tx hashes.
+func (s *Store) DeleteWithdrawalPoolItems(ctx context.Context, initTxHashes [][]byte) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.DeleteWithdrawalPoolItems")
+	defer span.End()
+
+	err := s.db.Batch(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(withdrawalPoolBucket)
+		for _, h := range initTxHashes {
+			if err := bkt.Delete(h); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	tracing.AnnotateError(span, err)
+	return err
+}
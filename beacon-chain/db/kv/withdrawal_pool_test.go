@@ -0,0 +1,59 @@
+package kv
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+)
+
+func TestStore_WithdrawalPool_SaveRetrieveDelete(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	items, err := db.WithdrawalPool(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(items))
+
+	w1 := &ethpb.Withdrawal{
+		PublicKey:      bytesutil.PadTo([]byte{1}, 48),
+		ValidatorIndex: 1,
+		Amount:         100,
+		InitTxHash:     bytesutil.PadTo([]byte{1}, 32),
+		Epoch:          2,
+	}
+	w2 := &ethpb.Withdrawal{
+		PublicKey:      bytesutil.PadTo([]byte{2}, 48),
+		ValidatorIndex: types.ValidatorIndex(math.MaxUint64),
+		Amount:         200,
+		InitTxHash:     bytesutil.PadTo([]byte{2}, 32),
+		Epoch:          3,
+	}
+	require.NoError(t, db.SaveWithdrawalPoolItems(ctx, []*ethpb.Withdrawal{w1, w2}))
+
+	items, err = db.WithdrawalPool(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(items))
+	require.DeepEqual(t, w1, items[0])
+	require.DeepEqual(t, w2, items[1])
+
+	// Saving an item with the same init tx hash overwrites it.
+	w2.ValidatorIndex = 5
+	require.NoError(t, db.SaveWithdrawalPoolItems(ctx, []*ethpb.Withdrawal{w2}))
+	require.NoError(t, db.DeleteWithdrawalPoolItems(ctx, [][]byte{w1.InitTxHash}))
+
+	items, err = db.WithdrawalPool(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(items))
+	require.DeepEqual(t, w2, items[0])
+}
+
+func TestStore_SaveWithdrawalPoolItems_NoInitTxHash(t *testing.T) {
+	db := setupDB(t)
+	err := db.SaveWithdrawalPoolItems(context.Background(), []*ethpb.Withdrawal{{Amount: 1}})
+	require.ErrorContains(t, "cannot save withdrawal without init tx hash", err)
+}
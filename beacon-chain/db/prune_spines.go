@@ -0,0 +1,39 @@
+package db
+
+import (
+	"path"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli/v2"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/db/kv"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/cmd"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/io/file"
+)
+
+// PruneSpines removes the spines lists which are not referred by any state
+// from the beacon chain database.
+func PruneSpines(cliCtx *cli.Context) error {
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+
+	dbDir := path.Join(dataDir, kv.BeaconNodeDbDirName)
+	if !file.FileExists(path.Join(dbDir, kv.DatabaseFileName)) {
+		return errors.Errorf("no database found in %s", dbDir)
+	}
+	d, err := kv.NewKVStore(cliCtx.Context, dbDir, &kv.Config{})
+	if err != nil {
+		return errors.Wrap(err, "could not open database")
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+
+	pruned, err := d.PruneSpines(cliCtx.Context)
+	if err != nil {
+		return errors.Wrap(err, "could not prune spines")
+	}
+
+	log.WithField("pruned", pruned).Info("Spines pruning completed successfully")
+	return nil
+}
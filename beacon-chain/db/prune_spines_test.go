@@ -0,0 +1,59 @@
+package db
+
+import (
+	"context"
+	"flag"
+	"path"
+	"testing"
+
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/urfave/cli/v2"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/db/kv"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/cmd"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1/wrapper"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/assert"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+)
+
+func TestPruneSpines(t *testing.T) {
+	logHook := logTest.NewGlobal()
+	ctx := context.Background()
+
+	dataDir := t.TempDir()
+	dbDir := path.Join(dataDir, kv.BeaconNodeDbDirName)
+	d, err := kv.NewKVStore(ctx, dbDir, &kv.Config{})
+	require.NoError(t, err)
+	orphan := wrapper.Spines(make([]byte, 64))
+	orphan[0] = 1
+	_, err = d.WriteSpines(ctx, orphan)
+	require.NoError(t, err)
+	require.NoError(t, d.Close())
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, "", "")
+	require.NoError(t, set.Set(cmd.DataDirFlag.Name, dataDir))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	assert.NoError(t, PruneSpines(cliCtx))
+	assert.LogsContain(t, logHook, "Spines pruning completed successfully")
+
+	d, err = kv.NewKVStore(ctx, dbDir, &kv.Config{})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, d.Close())
+	}()
+	spines, err := d.ReadSpines(ctx, orphan.Key())
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(spines))
+}
+
+func TestPruneSpines_NoDatabase(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, "", "")
+	require.NoError(t, set.Set(cmd.DataDirFlag.Name, t.TempDir()))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	assert.ErrorContains(t, "no database found", PruneSpines(cliCtx))
+}
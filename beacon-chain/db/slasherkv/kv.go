@@ -80,6 +80,9 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			attestationDataRootsBucket,
 			proposalRecordsBucket,
 			slasherChunksBucket,
+			prevoteRecordsBucket,
+			prevoteDataRootsBucket,
+			prevoteDoubleVotesBucket,
 		)
 	}); err != nil {
 		return nil, err
@@ -14,4 +14,8 @@ var (
 		Name: "slasher_proposals_pruned_total",
 		Help: "Total number of old proposals pruned by slasher",
 	})
+	slasherPrevotesPrunedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_prevotes_pruned_total",
+		Help: "Total number of old prevotes pruned by slasher",
+	})
 )
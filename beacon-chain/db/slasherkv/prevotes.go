@@ -0,0 +1,318 @@
+package slasherkv
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	slashertypes "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/slasher/types"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/crypto/hash"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// A prevote data root value is comprised of a signing root (32 bytes)
+	// and the key of the prevote record (32 bytes).
+	prevoteDataRootValueSize = 64 // Bytes.
+	// Size of the length prefix of the first record in an encoded prevote double vote.
+	prevoteDoubleVoteLenPrefixSize = 4 // Bytes.
+)
+
+// CheckDoublePrevotes retrieves any slashable double prevotes that exist
+// for a series of input prevotes. A validator commits a double prevote
+// if it signs two prevotes with different data for the same slot.
+func (s *Store) CheckDoublePrevotes(
+	ctx context.Context, prevotes []*slashertypes.IndexedPrevoteWrapper,
+) ([]*slashertypes.PrevoteDoubleVote, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.CheckDoublePrevotes")
+	defer span.End()
+	doubleVotes := make([]*slashertypes.PrevoteDoubleVote, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		signingRootsBkt := tx.Bucket(prevoteDataRootsBucket)
+		recordsBkt := tx.Bucket(prevoteRecordsBucket)
+		for _, prevote := range prevotes {
+			slot := prevote.IndexedPreVote.Data.Slot
+			for _, valIdx := range prevote.IndexedPreVote.AttestingIndices {
+				key, err := keyForValidatorProposal(slot, types.ValidatorIndex(valIdx))
+				if err != nil {
+					return err
+				}
+				dataRootValue := signingRootsBkt.Get(key)
+				if len(dataRootValue) < prevoteDataRootValueSize {
+					continue
+				}
+				existingSigningRoot := bytesutil.ToBytes32(dataRootValue[:signingRootSize])
+				if existingSigningRoot == prevote.SigningRoot {
+					continue
+				}
+				encExistingRecord := recordsBkt.Get(dataRootValue[signingRootSize:])
+				if encExistingRecord == nil {
+					continue
+				}
+				existingRecord, err := decodePrevoteRecord(encExistingRecord)
+				if err != nil {
+					return err
+				}
+				doubleVotes = append(doubleVotes, &slashertypes.PrevoteDoubleVote{
+					Slot:               slot,
+					ValidatorIndex:     types.ValidatorIndex(valIdx),
+					PrevPrevoteWrapper: existingRecord,
+					PrevoteWrapper:     prevote,
+				})
+			}
+		}
+		return nil
+	})
+	return doubleVotes, err
+}
+
+// PrevoteRecordForValidator given a validator index and a slot
+// retrieves an existing prevote record we have stored in the database.
+func (s *Store) PrevoteRecordForValidator(
+	ctx context.Context, validatorIdx types.ValidatorIndex, slot types.Slot,
+) (*slashertypes.IndexedPrevoteWrapper, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.PrevoteRecordForValidator")
+	defer span.End()
+	var record *slashertypes.IndexedPrevoteWrapper
+	key, err := keyForValidatorProposal(slot, validatorIdx)
+	if err != nil {
+		return nil, err
+	}
+	err = s.db.View(func(tx *bolt.Tx) error {
+		dataRootValue := tx.Bucket(prevoteDataRootsBucket).Get(key)
+		if len(dataRootValue) < prevoteDataRootValueSize {
+			return nil
+		}
+		encRecord := tx.Bucket(prevoteRecordsBucket).Get(dataRootValue[signingRootSize:])
+		if encRecord == nil {
+			return nil
+		}
+		decoded, err := decodePrevoteRecord(encRecord)
+		if err != nil {
+			return err
+		}
+		record = decoded
+		return nil
+	})
+	return record, err
+}
+
+// SavePrevoteRecords saves prevote records for the attesting indices of each prevote.
+// Records are stored per aggregate, so the evidence for each validator always
+// contains a signature it took part in. The first prevote a validator
+// is seen signing for a slot is kept and never overwritten by a later one.
+func (s *Store) SavePrevoteRecords(
+	ctx context.Context, prevotes []*slashertypes.IndexedPrevoteWrapper,
+) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SavePrevoteRecords")
+	defer span.End()
+	encodedRecords := make([][]byte, len(prevotes))
+	recordKeys := make([][32]byte, len(prevotes))
+	for i, prevote := range prevotes {
+		enc, err := encodePrevoteRecord(prevote)
+		if err != nil {
+			return err
+		}
+		encodedRecords[i] = enc
+		recordKeys[i] = hash.Hash(enc)
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		recordsBkt := tx.Bucket(prevoteRecordsBucket)
+		signingRootsBkt := tx.Bucket(prevoteDataRootsBucket)
+		for i, prevote := range prevotes {
+			dataRootValue := append(prevote.SigningRoot[:], recordKeys[i][:]...)
+			referenced := false
+			for _, valIdx := range prevote.IndexedPreVote.AttestingIndices {
+				key, err := keyForValidatorProposal(prevote.IndexedPreVote.Data.Slot, types.ValidatorIndex(valIdx))
+				if err != nil {
+					return err
+				}
+				if signingRootsBkt.Get(key) != nil {
+					continue
+				}
+				if err := signingRootsBkt.Put(key, dataRootValue); err != nil {
+					return err
+				}
+				referenced = true
+			}
+			if !referenced {
+				continue
+			}
+			if err := recordsBkt.Put(recordKeys[i][:], encodedRecords[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// SavePrevoteDoubleVotes stores the evidence of detected double prevotes
+// keyed by slot and validator index.
+func (s *Store) SavePrevoteDoubleVotes(
+	ctx context.Context, doubleVotes []*slashertypes.PrevoteDoubleVote,
+) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SavePrevoteDoubleVotes")
+	defer span.End()
+	encodedKeys := make([][]byte, len(doubleVotes))
+	encodedDoubleVotes := make([][]byte, len(doubleVotes))
+	for i, doubleVote := range doubleVotes {
+		key, err := keyForValidatorProposal(doubleVote.Slot, doubleVote.ValidatorIndex)
+		if err != nil {
+			return err
+		}
+		enc, err := encodePrevoteDoubleVote(doubleVote)
+		if err != nil {
+			return err
+		}
+		encodedKeys[i] = key
+		encodedDoubleVotes[i] = enc
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(prevoteDoubleVotesBucket)
+		for i := range doubleVotes {
+			if err := bkt.Put(encodedKeys[i], encodedDoubleVotes[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// PrevoteDoubleVotes retrieves the stored double prevote evidence for the
+// specified validator indices. If no indices are specified, all evidence is returned.
+func (s *Store) PrevoteDoubleVotes(
+	ctx context.Context, indices []types.ValidatorIndex,
+) ([]*slashertypes.PrevoteDoubleVote, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.PrevoteDoubleVotes")
+	defer span.End()
+	wanted := make(map[types.ValidatorIndex]bool, len(indices))
+	for _, idx := range indices {
+		wanted[idx] = true
+	}
+	doubleVotes := make([]*slashertypes.PrevoteDoubleVote, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(prevoteDoubleVotesBucket)
+		return bkt.ForEach(func(k, v []byte) error {
+			valIdx := validatorIndexFromSlotKey(k)
+			if len(wanted) > 0 && !wanted[valIdx] {
+				return nil
+			}
+			doubleVote, err := decodePrevoteDoubleVote(v)
+			if err != nil {
+				return err
+			}
+			doubleVote.Slot = slotFromProposalKey(k)
+			doubleVote.ValidatorIndex = valIdx
+			doubleVotes = append(doubleVotes, doubleVote)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(doubleVotes, func(i, j int) bool {
+		if doubleVotes[i].Slot == doubleVotes[j].Slot {
+			return doubleVotes[i].ValidatorIndex < doubleVotes[j].ValidatorIndex
+		}
+		return doubleVotes[i].Slot < doubleVotes[j].Slot
+	})
+	return doubleVotes, nil
+}
+
+// Decodes the validator index from a (slot ++ validatorIndex) disk key.
+func validatorIndexFromSlotKey(key []byte) types.ValidatorIndex {
+	encIdx := key[8:]
+	var v uint64
+	for i := len(encIdx) - 1; i >= 0; i-- {
+		v = v<<8 | uint64(encIdx[i])
+	}
+	return types.ValidatorIndex(v)
+}
+
+func encodePrevoteRecord(prevote *slashertypes.IndexedPrevoteWrapper) ([]byte, error) {
+	if prevote == nil || prevote.IndexedPreVote == nil {
+		return []byte{}, errors.New("nil prevote record")
+	}
+	encodedPrevote, err := proto.Marshal(prevote.IndexedPreVote)
+	if err != nil {
+		return nil, err
+	}
+	compressedPrevote := snappy.Encode(nil, encodedPrevote)
+	return append(prevote.SigningRoot[:], compressedPrevote...), nil
+}
+
+func decodePrevoteRecord(encoded []byte) (*slashertypes.IndexedPrevoteWrapper, error) {
+	if len(encoded) < signingRootSize {
+		return nil, fmt.Errorf(
+			"wrong length for encoded prevote record, want %d, got %d", signingRootSize, len(encoded),
+		)
+	}
+	signingRoot := encoded[:signingRootSize]
+	decodedPrevoteBytes, err := snappy.Decode(nil, encoded[signingRootSize:])
+	if err != nil {
+		return nil, err
+	}
+	decodedPrevote := &ethpb.IndexedPreVote{}
+	if err := proto.Unmarshal(decodedPrevoteBytes, decodedPrevote); err != nil {
+		return nil, err
+	}
+	return &slashertypes.IndexedPrevoteWrapper{
+		IndexedPreVote: decodedPrevote,
+		SigningRoot:    bytesutil.ToBytes32(signingRoot),
+	}, nil
+}
+
+// Double prevotes in the database look like this:
+//
+//	(slot ++ validatorIndex) => len(prevRecord) ++ prevRecord ++ record
+func encodePrevoteDoubleVote(doubleVote *slashertypes.PrevoteDoubleVote) ([]byte, error) {
+	if doubleVote == nil {
+		return []byte{}, errors.New("nil prevote double vote")
+	}
+	prevRecord, err := encodePrevoteRecord(doubleVote.PrevPrevoteWrapper)
+	if err != nil {
+		return nil, err
+	}
+	record, err := encodePrevoteRecord(doubleVote.PrevoteWrapper)
+	if err != nil {
+		return nil, err
+	}
+	enc := make([]byte, prevoteDoubleVoteLenPrefixSize, prevoteDoubleVoteLenPrefixSize+len(prevRecord)+len(record))
+	binary.LittleEndian.PutUint32(enc, uint32(len(prevRecord)))
+	enc = append(enc, prevRecord...)
+	return append(enc, record...), nil
+}
+
+func decodePrevoteDoubleVote(encoded []byte) (*slashertypes.PrevoteDoubleVote, error) {
+	if len(encoded) < prevoteDoubleVoteLenPrefixSize {
+		return nil, fmt.Errorf(
+			"wrong length for encoded prevote double vote, want at least %d, got %d",
+			prevoteDoubleVoteLenPrefixSize, len(encoded),
+		)
+	}
+	prevLen := int(binary.LittleEndian.Uint32(encoded[:prevoteDoubleVoteLenPrefixSize]))
+	encoded = encoded[prevoteDoubleVoteLenPrefixSize:]
+	if prevLen > len(encoded) {
+		return nil, fmt.Errorf("prevote double vote record length %d exceeds data length %d", prevLen, len(encoded))
+	}
+	prevRecord, err := decodePrevoteRecord(encoded[:prevLen])
+	if err != nil {
+		return nil, err
+	}
+	record, err := decodePrevoteRecord(encoded[prevLen:])
+	if err != nil {
+		return nil, err
+	}
+	return &slashertypes.PrevoteDoubleVote{
+		PrevPrevoteWrapper: prevRecord,
+		PrevoteWrapper:     record,
+	}, nil
+}
@@ -0,0 +1,150 @@
+package slasherkv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	slashertypes "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/slasher/types"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+)
+
+func TestStore_PrevoteRecordForValidator_SaveRetrieve(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+	valIdx := types.ValidatorIndex(1)
+	slot := types.Slot(5)
+
+	// Defaults to nil.
+	record, err := beaconDB.PrevoteRecordForValidator(ctx, valIdx, slot)
+	require.NoError(t, err)
+	require.Equal(t, true, record == nil)
+
+	prevote := createPrevoteWrapper(slot, []uint64{uint64(valIdx)}, []byte{1}, []byte{1})
+	require.NoError(t, beaconDB.SavePrevoteRecords(ctx, []*slashertypes.IndexedPrevoteWrapper{prevote}))
+
+	record, err = beaconDB.PrevoteRecordForValidator(ctx, valIdx, slot)
+	require.NoError(t, err)
+	require.DeepEqual(t, prevote.IndexedPreVote, record.IndexedPreVote)
+	require.Equal(t, prevote.SigningRoot, record.SigningRoot)
+}
+
+func TestStore_CheckDoublePrevotes(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+	err := beaconDB.SavePrevoteRecords(ctx, []*slashertypes.IndexedPrevoteWrapper{
+		createPrevoteWrapper(3, []uint64{0, 1}, []byte{1}, []byte{1}),
+		createPrevoteWrapper(4, []uint64{2, 3}, []byte{3}, []byte{3}),
+	})
+	require.NoError(t, err)
+
+	prevotes := []*slashertypes.IndexedPrevoteWrapper{
+		createPrevoteWrapper(3, []uint64{1}, []byte{2}, []byte{2}), // Different candidates.
+		createPrevoteWrapper(4, []uint64{2}, []byte{3}, []byte{3}), // Same candidates.
+		createPrevoteWrapper(5, []uint64{3}, []byte{4}, []byte{4}), // Different slot.
+	}
+	doubleVotes, err := beaconDB.CheckDoublePrevotes(ctx, prevotes)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(doubleVotes))
+	require.Equal(t, types.Slot(3), doubleVotes[0].Slot)
+	require.Equal(t, types.ValidatorIndex(1), doubleVotes[0].ValidatorIndex)
+	require.Equal(t, bytesutil.ToBytes32([]byte{1}), doubleVotes[0].PrevPrevoteWrapper.SigningRoot)
+	require.Equal(t, bytesutil.ToBytes32([]byte{2}), doubleVotes[0].PrevoteWrapper.SigningRoot)
+}
+
+func TestStore_PrevoteDoubleVotes_SaveRetrieve(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+	doubleVotes := []*slashertypes.PrevoteDoubleVote{
+		{
+			Slot:               7,
+			ValidatorIndex:     2,
+			PrevPrevoteWrapper: createPrevoteWrapper(7, []uint64{1, 2}, []byte{1}, []byte{1}),
+			PrevoteWrapper:     createPrevoteWrapper(7, []uint64{2}, []byte{2}, []byte{2}),
+		},
+		{
+			Slot:               3,
+			ValidatorIndex:     5,
+			PrevPrevoteWrapper: createPrevoteWrapper(3, []uint64{5}, []byte{3}, []byte{3}),
+			PrevoteWrapper:     createPrevoteWrapper(3, []uint64{5, 6}, []byte{4}, []byte{4}),
+		},
+	}
+	require.NoError(t, beaconDB.SavePrevoteDoubleVotes(ctx, doubleVotes))
+
+	all, err := beaconDB.PrevoteDoubleVotes(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(all))
+	// Sorted by slot.
+	require.Equal(t, types.Slot(3), all[0].Slot)
+	require.Equal(t, types.ValidatorIndex(5), all[0].ValidatorIndex)
+	require.Equal(t, types.Slot(7), all[1].Slot)
+	require.Equal(t, types.ValidatorIndex(2), all[1].ValidatorIndex)
+	require.DeepEqual(t, doubleVotes[0].PrevPrevoteWrapper.IndexedPreVote, all[1].PrevPrevoteWrapper.IndexedPreVote)
+	require.DeepEqual(t, doubleVotes[0].PrevoteWrapper.IndexedPreVote, all[1].PrevoteWrapper.IndexedPreVote)
+
+	filtered, err := beaconDB.PrevoteDoubleVotes(ctx, []types.ValidatorIndex{2})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(filtered))
+	require.Equal(t, types.ValidatorIndex(2), filtered[0].ValidatorIndex)
+
+	none, err := beaconDB.PrevoteDoubleVotes(ctx, []types.ValidatorIndex{100})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(none))
+}
+
+func TestStore_PrunePrevotesAtEpoch(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	err := beaconDB.SavePrevoteRecords(ctx, []*slashertypes.IndexedPrevoteWrapper{
+		createPrevoteWrapper(1, []uint64{0}, []byte{1}, []byte{1}),
+		createPrevoteWrapper(slotsPerEpoch+1, []uint64{0}, []byte{2}, []byte{2}),
+	})
+	require.NoError(t, err)
+
+	numPruned, err := beaconDB.PrunePrevotesAtEpoch(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint(1), numPruned)
+
+	record, err := beaconDB.PrevoteRecordForValidator(ctx, 0, 1)
+	require.NoError(t, err)
+	require.Equal(t, true, record == nil)
+	record, err = beaconDB.PrevoteRecordForValidator(ctx, 0, slotsPerEpoch+1)
+	require.NoError(t, err)
+	require.NotNil(t, record)
+}
+
+func Test_encodeDecodePrevoteDoubleVote(t *testing.T) {
+	doubleVote := &slashertypes.PrevoteDoubleVote{
+		PrevPrevoteWrapper: createPrevoteWrapper(1, []uint64{1}, []byte{1}, []byte{1}),
+		PrevoteWrapper:     createPrevoteWrapper(1, []uint64{1, 2}, []byte{2}, []byte{2}),
+	}
+	enc, err := encodePrevoteDoubleVote(doubleVote)
+	require.NoError(t, err)
+	decoded, err := decodePrevoteDoubleVote(enc)
+	require.NoError(t, err)
+	require.DeepEqual(t, doubleVote.PrevPrevoteWrapper.IndexedPreVote, decoded.PrevPrevoteWrapper.IndexedPreVote)
+	require.DeepEqual(t, doubleVote.PrevoteWrapper.IndexedPreVote, decoded.PrevoteWrapper.IndexedPreVote)
+	require.Equal(t, doubleVote.PrevPrevoteWrapper.SigningRoot, decoded.PrevPrevoteWrapper.SigningRoot)
+	require.Equal(t, doubleVote.PrevoteWrapper.SigningRoot, decoded.PrevoteWrapper.SigningRoot)
+
+	_, err = decodePrevoteDoubleVote([]byte{1})
+	require.ErrorContains(t, "wrong length", err)
+}
+
+func createPrevoteWrapper(slot types.Slot, indices []uint64, candidates, signingRoot []byte) *slashertypes.IndexedPrevoteWrapper {
+	return &slashertypes.IndexedPrevoteWrapper{
+		IndexedPreVote: &ethpb.IndexedPreVote{
+			AttestingIndices: indices,
+			Data: &ethpb.PreVoteData{
+				Slot:       slot,
+				Candidates: bytesutil.PadTo(candidates, 32),
+			},
+			Signature: params.BeaconConfig().EmptySignature[:],
+		},
+		SigningRoot: bytesutil.ToBytes32(signingRoot),
+	}
+}
@@ -156,6 +156,84 @@ func (s *Store) PruneProposalsAtEpoch(
 	return
 }
 
+// PrunePrevotesAtEpoch deletes all prevote records from the slasher DB with slot
+// less than or equal to the end slot of the specified epoch. Detected double prevotes
+// are kept as slashing evidence.
+func (s *Store) PrunePrevotesAtEpoch(
+	ctx context.Context, maxEpoch types.Epoch,
+) (numPruned uint, err error) {
+	var endPruneSlot types.Slot
+	endPruneSlot, err = slots.EpochEnd(maxEpoch)
+	if err != nil {
+		return
+	}
+	encodedEndPruneSlot := fssz.MarshalUint64([]byte{}, uint64(endPruneSlot))
+
+	// We retrieve the lowest stored slot in the prevote data roots bucket.
+	var lowestSlot types.Slot
+	var hasData bool
+	if err = s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(prevoteDataRootsBucket).Cursor()
+		k, _ := c.First()
+		if k == nil {
+			return nil
+		}
+		hasData = true
+		lowestSlot = slotFromProposalKey(k)
+		return nil
+	}); err != nil {
+		return
+	}
+
+	// If there is no data stored, just exit early.
+	if !hasData {
+		return
+	}
+
+	// If the lowest slot is greater than the end pruning slot,
+	// there is nothing to prune, so we return early.
+	if lowestSlot > endPruneSlot {
+		log.Debugf("Lowest slot %d is > pruning slot %d, nothing to prune", lowestSlot, endPruneSlot)
+		return
+	}
+
+	if err = s.db.Update(func(tx *bolt.Tx) error {
+		signingRootsBkt := tx.Bucket(prevoteDataRootsBucket)
+		recordsBkt := tx.Bucket(prevoteRecordsBucket)
+		c := signingRootsBkt.Cursor()
+		// We begin a pruning iteration starting from the first item in the bucket.
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			// We check the slot from the current key in the database.
+			// If we have hit a slot that is greater than the end slot of the pruning process,
+			// we then completely exit the process as we are done.
+			if uint64PrefixGreaterThan(k, encodedEndPruneSlot) {
+				return nil
+			}
+			// Prevotes in the database look like this:
+			//  (slot ++ validatorIndex) => (signingRoot ++ recordKey)
+			//  recordKey => encode(prevote)
+			// so several validator keys of the same slot may point to one record.
+			if err := signingRootsBkt.Delete(k); err != nil {
+				return err
+			}
+			if len(v) == prevoteDataRootValueSize {
+				if err := recordsBkt.Delete(v[signingRootSize:]); err != nil {
+					return err
+				}
+			}
+			slasherPrevotesPrunedTotal.Inc()
+			numPruned++
+		}
+		return nil
+	}); err != nil {
+		return
+	}
+	return
+}
+
 func slotFromProposalKey(key []byte) types.Slot {
 	return types.Slot(binary.LittleEndian.Uint64(key[:8]))
 }
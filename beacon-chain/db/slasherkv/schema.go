@@ -13,4 +13,7 @@ var (
 	attestationDataRootsBucket = []byte("attestation-data-roots")
 	proposalRecordsBucket      = []byte("proposal-records")
 	slasherChunksBucket        = []byte("slasher-chunks")
+	prevoteRecordsBucket       = []byte("prevote-records")
+	prevoteDataRootsBucket     = []byte("prevote-data-roots")
+	prevoteDoubleVotesBucket   = []byte("prevote-double-votes")
 )
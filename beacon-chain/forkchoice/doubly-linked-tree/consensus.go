@@ -0,0 +1,458 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package doublylinkedtree
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	fieldparams "gitlab.waterfall.network/waterfall/protocol/coordinator/config/fieldparams"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
+	"go.opencensus.io/trace"
+)
+
+func (s *Store) setBalances(root [32]byte, balances []uint64) {
+	s.balancesLock.Lock()
+	defer s.balancesLock.Unlock()
+	if s.balances == nil {
+		s.balances = make(map[[fieldparams.RootLength]byte][]uint64)
+	}
+	s.balances[root] = balances
+}
+
+func (s *Store) getBalances(root [32]byte) []uint64 {
+	s.balancesLock.RLock()
+	defer s.balancesLock.RUnlock()
+	return s.balances[root]
+}
+
+// setNodeVotes keeps the validator's vote in the node's attestations data.
+// The vote is stored in the node with the highest slot if node is not passed.
+// This function assumes a read lock on s.nodesLock.
+func (s *Store) setNodeVotes(validator uint64, vote Vote, node *Node) (lastNode *Node) {
+	if node == nil {
+		for _, n := range s.nodeByRoot {
+			if node == nil || n.slot > node.slot || (n.slot == node.slot && bytes.Compare(n.root[:], node.root[:]) > 0) {
+				node = n
+			}
+		}
+	}
+	if node == nil || node.attsData == nil {
+		return node
+	}
+	node.attsData.setVote(validator, vote)
+	return node
+}
+
+// GetParentByOptimisticSpines retrieves node by root.
+func (f *ForkChoice) GetParentByOptimisticSpines(ctx context.Context, optSpines []gwatCommon.HashArray, jCpRoot [32]byte) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "doublyLinkedForkchoice.GetParentByOptimisticSpines")
+	defer span.End()
+
+	var headRoot [32]byte
+	var err error
+
+	defer func(start time.Time) {
+		log.WithField(
+			"elapsed", time.Since(start),
+		).WithFields(logrus.Fields{
+			"optSpines": len(optSpines),
+			"headRoot":  fmt.Sprintf("%#x", headRoot),
+			"jCpRoot":   fmt.Sprintf("%#x", jCpRoot),
+		}).Info("FC: GetParentByOptimisticSpines end")
+	}(time.Now())
+
+	//removes empty values
+	_optSpines := make([]gwatCommon.HashArray, 0, len(optSpines))
+	for _, ha := range optSpines {
+		if len(ha) > 0 {
+			_optSpines = append(_optSpines, ha)
+		}
+	}
+
+	f.mu.RLock()
+	f.store.nodesLock.RLock()
+	// collect nodes of T(G) tree
+	acceptableNodes, _ := collectTgTreeNodesByOptimisticSpines(f, _optSpines, jCpRoot)
+	tgNodes := make([]*Node, 0, len(acceptableNodes))
+	parentRoots := make(map[[32]byte][32]byte, len(acceptableNodes))
+	for r, n := range acceptableNodes {
+		tgNodes = append(tgNodes, copyNode(n))
+		if n.parent != nil {
+			parentRoots[r] = n.parent.root
+		}
+	}
+	justifiedEpoch, finalizedEpoch := f.store.justifiedEpoch, f.store.finalizedEpoch
+	f.store.nodesLock.RUnlock()
+	f.mu.RUnlock()
+
+	log.WithFields(logrus.Fields{
+		"acceptableNodes": fmt.Sprintf("%d", len(acceptableNodes)),
+	}).Info("FC: TG Tree")
+
+	if len(tgNodes) == 0 {
+		return [32]byte{}, nil
+	}
+
+	fcBase := New(justifiedEpoch, finalizedEpoch)
+	headRoot, err = calculateHeadRootByNodes(ctx, fcBase, tgNodes, parentRoots, jCpRoot, f.store.getBalances(jCpRoot))
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return headRoot, nil
+}
+
+// calculateHeadRootByNodes builds the fork choice tree of passed nodes
+// and retrieves the head root by applying the votes kept in nodes.
+func calculateHeadRootByNodes(
+	ctx context.Context,
+	fcBase *ForkChoice,
+	nodes []*Node,
+	parentRoots map[[32]byte][32]byte,
+	justifiedRoot [32]byte,
+	balances []uint64,
+) ([32]byte, error) {
+	// sort nodes to insert parents before children
+	sortNodesBySlot(nodes, false)
+
+	// fill ForkChoice instance
+	fcBase.store.nodesLock.Lock()
+	for _, n := range nodes {
+		if err := fcBase.store.insertNode(ctx, n, parentRoots[n.root]); err != nil {
+			fcBase.store.nodesLock.Unlock()
+			return [32]byte{}, err
+		}
+		if n.attsData == nil {
+			continue
+		}
+
+		// sort validators' indexes
+		nodeVotes := n.attsData.Votes()
+		validatorIndexes := make(gwatCommon.SorterAscU64, 0, len(nodeVotes))
+		for ix := range nodeVotes {
+			validatorIndexes = append(validatorIndexes, ix)
+		}
+		sort.Sort(validatorIndexes)
+
+		for _, vi := range validatorIndexes {
+			vote := nodeVotes[vi]
+			// Validator indices will grow the vote cache.
+			for vi >= uint64(len(fcBase.votes)) {
+				fcBase.votes = append(fcBase.votes, Vote{currentRoot: params.BeaconConfig().ZeroHash, nextRoot: params.BeaconConfig().ZeroHash})
+			}
+
+			// Newly allocated vote if the root fields are untouched.
+			newVote := fcBase.votes[vi].nextRoot == params.BeaconConfig().ZeroHash &&
+				fcBase.votes[vi].currentRoot == params.BeaconConfig().ZeroHash
+
+			// Vote gets updated if it's newly allocated or high target epoch.
+			if newVote || vote.nextEpoch > fcBase.votes[vi].nextEpoch {
+				fcBase.votes[vi].nextEpoch = vote.nextEpoch
+				fcBase.votes[vi].nextRoot = vote.nextRoot
+			}
+		}
+	}
+	fcBase.store.nodesLock.Unlock()
+	topNode := nodes[len(nodes)-1]
+
+	// apply LMD GHOST
+	headRoot, err := fcBase.Head(ctx, topNode.justifiedEpoch, justifiedRoot, balances, topNode.finalizedEpoch)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	log.WithFields(logrus.Fields{
+		"headRoot": fmt.Sprintf("%#x", headRoot),
+		"balances": len(balances),
+	}).Info("Get parent by optimistic spines res")
+
+	return headRoot, nil
+}
+
+// collectTgTreeNodesByOptimisticSpines collects nodes of forks matching to optimistic spines.
+// This function assumes a read lock on f.store.nodesLock.
+func collectTgTreeNodesByOptimisticSpines(f *ForkChoice, optSpines []gwatCommon.HashArray, jCpRoot [32]byte) (map[[32]byte]*Node, map[[32]byte]int) {
+	forks := f.getForks()
+	rootNodeMap := make(map[[32]byte]*Node)
+	leafs := make(map[[32]byte]int)
+
+	for frkNr, frk := range forks {
+		if frk == nil {
+			continue
+		}
+		//exclude not justified forks
+		if _, ok := frk.nodesMap[jCpRoot]; !ok {
+			log.WithFields(logrus.Fields{
+				"frkNr":    frkNr,
+				"jCpRoot":  fmt.Sprintf("%#x", jCpRoot),
+				"frkRoots": fmt.Sprintf("%#x", frk.roots),
+			}).Warn("collectTgTreeNodesByOptimisticSpines: skip not justified fork")
+			continue
+		}
+		for i, r := range frk.roots {
+			node := frk.nodesMap[r]
+			if node.spinesData == nil || len(node.spinesData.cpFinalized) == 0 {
+				log.WithFields(logrus.Fields{
+					"frkNr":     frkNr,
+					"node.slot": node.slot,
+					"node.root": fmt.Sprintf("%#x", node.root),
+				}).Error("collectTgTreeNodesByOptimisticSpines: checkpoint finalized seq empty")
+				continue
+			}
+
+			// rm finalized spines from optSpines if contains
+			lastFinHash := node.spinesData.cpFinalized[len(node.spinesData.cpFinalized)-1]
+			lastFinIndex := indexOfOptimisticSpines(lastFinHash, optSpines)
+			forkOptSpines := optSpines
+			if lastFinIndex > -1 {
+				forkOptSpines = optSpines[lastFinIndex+1:]
+			}
+
+			// check finalization matches to optSpines
+			finalization := node.spinesData.Finalization()
+			if !isSequenceMatchOptimisticSpines(finalization, forkOptSpines) {
+				continue
+			}
+
+			// check prefix matches to optSpines
+			prefOptSpines := []gwatCommon.HashArray{}
+			if len(forkOptSpines) > len(finalization) {
+				prefOptSpines = forkOptSpines[len(finalization):]
+			}
+			prefix := node.spinesData.Prefix()
+			if !isSequenceMatchOptimisticSpines(prefix, prefOptSpines) {
+				continue
+			}
+
+			//check prefix extension or no published spines
+			published := node.spinesData.Spines()
+			isExtended := len(prefix.Intersection(published)) > 0 || len(finalization.Intersection(published)) > 0
+			if !isExtended && len(published) > 0 {
+				// check the first published spine matches to prefOptSpines
+				pubOptSpines := []gwatCommon.HashArray{}
+				if len(prefOptSpines) > len(prefix) {
+					pubOptSpines = prefOptSpines[len(prefix):]
+				}
+				if len(pubOptSpines) == 0 || !pubOptSpines[0].Has(published[0]) {
+					continue
+				}
+			}
+
+			//collect roots of acceptable forks
+			forkRoots := frk.roots[i:]
+			for _, root := range forkRoots {
+				rootNodeMap[root] = frk.nodesMap[root]
+			}
+
+			log.WithFields(logrus.Fields{
+				"frkNr":      frkNr,
+				"node.index": i,
+				"node.slot":  node.slot,
+				"node.root":  fmt.Sprintf("%#x", node.root),
+			}).Info("collectTgTreeNodesByOptimisticSpines: success")
+
+			leafs[frk.roots[i]] = len(forkRoots)
+			break
+		}
+	}
+	return rootNodeMap, leafs
+}
+
+func isSequenceMatchOptimisticSpines(seq gwatCommon.HashArray, optSpines []gwatCommon.HashArray) bool {
+	if len(seq) > len(optSpines) {
+		return false
+	}
+	for i, h := range seq {
+		if !optSpines[i].Has(h) {
+			return false
+		}
+	}
+	return true
+}
+
+func indexOfOptimisticSpines(hash gwatCommon.Hash, optSpines []gwatCommon.HashArray) int {
+	for i, sines := range optSpines {
+		if sines.Has(hash) {
+			return i
+		}
+	}
+	return -1
+}
+
+// CollectForkExcludedBlkRoots collects roots of nodes which are not included to the fork of leaf.
+func (f *ForkChoice) CollectForkExcludedBlkRoots(leaf gwatCommon.Hash) gwatCommon.HashArray {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+
+	// collect nodes excluded from fork
+	fork := f.getFork(leaf)
+	exRoots := make(gwatCommon.HashArray, 0, len(f.store.nodeByRoot))
+	for r := range f.store.nodeByRoot {
+		if fork != nil {
+			if _, ok := fork.nodesMap[r]; ok {
+				continue
+			}
+		}
+		exRoots = append(exRoots, r)
+	}
+	return exRoots
+}
+
+// GetRoots get roots of nodes of forkchoice sorted by slots.
+func (f *ForkChoice) GetRoots() gwatCommon.HashArray {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+
+	nodes := make([]*Node, 0, len(f.store.nodeByRoot))
+	for _, n := range f.store.nodeByRoot {
+		nodes = append(nodes, n)
+	}
+	sortNodesBySlot(nodes, false)
+	res := make(gwatCommon.HashArray, len(nodes))
+	for i, n := range nodes {
+		res[i] = gwatCommon.BytesToHash(n.root[:])
+	}
+	return res
+}
+
+// GetNode retrieves node by root.
+func (f *ForkChoice) GetNode(root [32]byte) *Node {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+	return f.store.nodeByRoot[root]
+}
+
+// GetForks collects forks.
+func (f *ForkChoice) GetForks() []*Fork {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+	return f.getForks()
+}
+
+// getForks collects forks starting from the leaf with the highest slot.
+// This function assumes a read lock on f.store.nodesLock.
+func (f *ForkChoice) getForks() []*Fork {
+	leafs := make([]*Node, 0)
+	for _, n := range f.store.nodeByRoot {
+		if len(n.children) == 0 {
+			leafs = append(leafs, n)
+		}
+	}
+	sortNodesBySlot(leafs, true)
+
+	res := make([]*Fork, 0, len(leafs))
+	for _, leaf := range leafs {
+		if fork := f.getFork(leaf.root); fork != nil {
+			res = append(res, fork)
+		}
+	}
+	return res
+}
+
+// GetFork collect nodes of tip by recursively iterate by parents.
+func (f *ForkChoice) GetFork(root [32]byte) *Fork {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+	return f.getFork(root)
+}
+
+// getFork collect nodes of tip by recursively iterate by parents.
+// This function assumes a read lock on f.store.nodesLock.
+func (f *ForkChoice) getFork(root [32]byte) *Fork {
+	head, ok := f.store.nodeByRoot[root]
+	if !ok || head == nil {
+		return nil
+	}
+	fork := Fork{
+		roots:    make([][32]byte, 0, head.depth()+1),
+		nodesMap: make(map[[32]byte]*Node),
+	}
+	for n := head; n != nil; n = n.parent {
+		fork.roots = append(fork.roots, n.root)
+		fork.nodesMap[n.root] = n
+	}
+	return &fork
+}
+
+// GetCommonAncestor searches the highest common ancestor.
+func (f *ForkChoice) GetCommonAncestor() (node *Node) {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+
+	forks := f.getForks()
+	if len(forks) == 0 {
+		return nil
+	}
+	if len(forks) == 1 {
+		if len(forks[0].roots) > 0 {
+			root := forks[0].roots[0]
+			node = forks[0].nodesMap[root]
+		}
+		return node
+	}
+
+	var commonChain gwatCommon.HashArray
+	for _, fork := range forks {
+		tipRoots := fork.roots
+		tipChain := make(gwatCommon.HashArray, len(tipRoots))
+		for i, r := range tipRoots {
+			tipChain[i] = gwatCommon.BytesToHash(r[:])
+		}
+		if commonChain == nil {
+			commonChain = tipChain.Reverse()
+		} else {
+			commonChain = commonChain.SequenceIntersection(tipChain.Reverse())
+		}
+	}
+	if len(commonChain) == 0 {
+		return nil
+	}
+	commonRoot := commonChain[len(commonChain)-1]
+	return f.store.nodeByRoot[commonRoot]
+}
+
+// sortNodesBySlot sorts nodes by slot, nodes of the same slot are sorted by root.
+func sortNodesBySlot(nodes []*Node, desc bool) {
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].slot == nodes[j].slot {
+			return (bytes.Compare(nodes[i].root[:], nodes[j].root[:]) < 0) != desc
+		}
+		return (nodes[i].slot < nodes[j].slot) != desc
+	})
+}
+
+// copyNode returns the copy of node detached from the tree.
+func copyNode(node *Node) *Node {
+	if node == nil {
+		return &Node{}
+	}
+	return &Node{
+		slot:           node.slot,
+		root:           node.root,
+		payloadHash:    node.payloadHash,
+		justifiedEpoch: node.justifiedEpoch,
+		finalizedEpoch: node.finalizedEpoch,
+		optimistic:     node.optimistic,
+		spinesData:     node.spinesData.Copy(),
+		attsData:       node.attsData.Copy(),
+	}
+}
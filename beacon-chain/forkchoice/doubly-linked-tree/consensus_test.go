@@ -0,0 +1,162 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package doublylinkedtree
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	testtmpl "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/forkchoice/testing"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/assert"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
+)
+
+func nrToHash(i int) [32]byte {
+	return bytesutil.ToBytes32([]byte(fmt.Sprintf("%d", i)))
+}
+
+func newConsensusStore() *testtmpl.ConsensusStore {
+	f := New(0, 0)
+	return &testtmpl.ConsensusStore{
+		ForkChoicer: f,
+		Fork: func(leaf [32]byte) [][32]byte {
+			frk := f.GetFork(leaf)
+			if frk == nil {
+				return nil
+			}
+			return frk.roots
+		},
+		Forks: func() [][][32]byte {
+			forks := f.GetForks()
+			res := make([][][32]byte, len(forks))
+			for i, frk := range forks {
+				res[i] = frk.roots
+			}
+			return res
+		},
+		CommonAncestor: func() [32]byte {
+			return f.GetCommonAncestor().Root()
+		},
+		TgTree: func(optSpines []gwatCommon.HashArray, jCpRoot [32]byte) (map[[32]byte]bool, map[[32]byte]int) {
+			f.store.nodesLock.RLock()
+			defer f.store.nodesLock.RUnlock()
+			nodes, leafs := collectTgTreeNodesByOptimisticSpines(f, optSpines, jCpRoot)
+			roots := make(map[[32]byte]bool, len(nodes))
+			for r := range nodes {
+				roots[r] = true
+			}
+			return roots, leafs
+		},
+	}
+}
+
+func TestForkChoice_Forks(t *testing.T) {
+	testtmpl.VerifyForkChoiceForks(t, newConsensusStore)
+}
+
+func TestForkChoice_TgTreeByOptimisticSpines(t *testing.T) {
+	testtmpl.VerifyForkChoiceTgTreeByOptimisticSpines(t, newConsensusStore)
+}
+
+func TestGetParentByOptimisticSpines_TwoBranches(t *testing.T) {
+	ctx := context.Background()
+	balances := []uint64{1, 1}
+	justifiedRoot := nrToHash(0)
+	finalizedRoot := nrToHash(0)
+	cpFinalized := gwatCommon.HashArray{{'x', 'x', 'x'}}
+	spineData := func(spines ...gwatCommon.Hash) *ethpb.SpineData {
+		return &ethpb.SpineData{
+			Spines:       gwatCommon.HashArray(spines).ToBytes(),
+			Finalization: gwatCommon.HashArray{{'a', '1'}}.ToBytes(),
+			CpFinalized:  cpFinalized.ToBytes(),
+		}
+	}
+
+	f := New(0, 0)
+	require.NoError(t, f.InsertOptimisticBlock(ctx, 0, nrToHash(0), params.BeaconConfig().ZeroHash, 0, 0, justifiedRoot[:], finalizedRoot[:], spineData()))
+
+	r, err := f.Head(ctx, 0, nrToHash(0), balances, 0)
+	require.NoError(t, err)
+	assert.Equal(t, nrToHash(0), r, "Incorrect head with genesis")
+
+	// Define the following tree:
+	//         0
+	//        / \
+	//       1   2  <- spine b1
+	//       |   |
+	//       3   4
+	//       |   |
+	//       5   6
+	// Left branch.
+	require.NoError(t, f.InsertOptimisticBlock(ctx, 1, nrToHash(1), nrToHash(0), 0, 0, justifiedRoot[:], finalizedRoot[:], spineData(gwatCommon.Hash{'a', '2'})))
+	require.NoError(t, f.InsertOptimisticBlock(ctx, 2, nrToHash(3), nrToHash(1), 0, 0, justifiedRoot[:], finalizedRoot[:], spineData(gwatCommon.Hash{'a', '2'})))
+	require.NoError(t, f.InsertOptimisticBlock(ctx, 3, nrToHash(5), nrToHash(3), 0, 0, justifiedRoot[:], finalizedRoot[:], spineData(gwatCommon.Hash{'a', '2'})))
+	// Right branch.
+	require.NoError(t, f.InsertOptimisticBlock(ctx, 1, nrToHash(2), nrToHash(0), 0, 0, justifiedRoot[:], finalizedRoot[:], spineData(gwatCommon.Hash{'b', '1'})))
+	require.NoError(t, f.InsertOptimisticBlock(ctx, 2, nrToHash(4), nrToHash(2), 0, 0, justifiedRoot[:], finalizedRoot[:], spineData(gwatCommon.Hash{'b', '1'})))
+	require.NoError(t, f.InsertOptimisticBlock(ctx, 3, nrToHash(6), nrToHash(4), 0, 0, justifiedRoot[:], finalizedRoot[:], spineData(gwatCommon.Hash{'b', '1'})))
+
+	// Vote to the right branch.
+	f.ProcessAttestation(ctx, []uint64{0, 1}, nrToHash(6), 0)
+	r, err = f.Head(ctx, 0, justifiedRoot, balances, 0)
+	require.NoError(t, err)
+	assert.Equal(t, nrToHash(6), r, "Incorrect head with justified epoch at 0")
+
+	// Optimistic spines contain the spine of the left branch only.
+	optSpines := []gwatCommon.HashArray{
+		{{'a', '1'}},
+		{{'a', '2'}},
+	}
+	hRoot, err := f.GetParentByOptimisticSpines(ctx, optSpines, justifiedRoot)
+	require.NoError(t, err)
+	assert.Equal(t, nrToHash(5), hRoot, "Incorrect parent by optimistic spines")
+
+	// Optimistic spines contain the spine of the right branch only.
+	optSpines = []gwatCommon.HashArray{
+		{{'a', '1'}},
+		{{'b', '1'}},
+	}
+	hRoot, err = f.GetParentByOptimisticSpines(ctx, optSpines, justifiedRoot)
+	require.NoError(t, err)
+	assert.Equal(t, nrToHash(6), hRoot, "Incorrect parent by optimistic spines")
+
+	// Optimistic spines do not match the finalization.
+	optSpines = []gwatCommon.HashArray{
+		{{'c', '1'}},
+	}
+	hRoot, err = f.GetParentByOptimisticSpines(ctx, optSpines, justifiedRoot)
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{}, hRoot, "Incorrect parent by optimistic spines")
+}
+
+func TestForkChoice_ProcessAttestation_NodeVotes(t *testing.T) {
+	ctx := context.Background()
+	f := New(0, 0)
+	testtmpl.InsertForkNodes(t, f)
+
+	f.ProcessAttestation(ctx, []uint64{0, 2}, [32]byte{'H'}, 1)
+
+	// votes are kept in the node with the highest slot.
+	votes := f.GetNode([32]byte{'K'}).AttestationsData().Votes()
+	require.Equal(t, 2, len(votes))
+	assert.Equal(t, [32]byte{'H'}, votes[0].nextRoot)
+	assert.Equal(t, [32]byte{'H'}, votes[2].nextRoot)
+	assert.Equal(t, 0, len(f.GetNode([32]byte{'H'}).AttestationsData().Votes()))
+}
@@ -23,6 +23,7 @@ func New(justifiedEpoch, finalizedEpoch types.Epoch) *ForkChoice {
 		nodeByRoot:        make(map[[fieldparams.RootLength]byte]*Node),
 		nodeByPayload:     make(map[[fieldparams.RootLength]byte]*Node),
 		pruneThreshold:    defaultPruneThreshold,
+		balances:          make(map[[fieldparams.RootLength]byte][]uint64),
 	}
 
 	b := make([]uint64, 0)
@@ -74,6 +75,7 @@ func (f *ForkChoice) Head(
 	if err := f.store.treeRootNode.updateBestDescendant(ctx, justifiedEpoch, finalizedEpoch); err != nil {
 		return [32]byte{}, errors.Wrap(err, "could not update best descendant")
 	}
+	f.store.setBalances(justifiedRoot, justifiedStateBalances)
 
 	return f.store.head(ctx, justifiedRoot)
 }
@@ -86,6 +88,10 @@ func (f *ForkChoice) ProcessAttestation(ctx context.Context, validatorIndices []
 	f.votesLock.Lock()
 	defer f.votesLock.Unlock()
 
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+
+	var lastNode *Node
 	for _, index := range validatorIndices {
 		// Validator indices will grow the vote cache.
 		for index >= uint64(len(f.votes)) {
@@ -100,6 +106,8 @@ func (f *ForkChoice) ProcessAttestation(ctx context.Context, validatorIndices []
 		if newVote || targetEpoch > f.votes[index].nextEpoch {
 			f.votes[index].nextEpoch = targetEpoch
 			f.votes[index].nextRoot = blockRoot
+
+			lastNode = f.store.setNodeVotes(index, f.votes[index], lastNode)
 		}
 	}
 
@@ -119,14 +127,31 @@ func (f *ForkChoice) InsertOptimisticBlock(
 ) error {
 	ctx, span := trace.StartSpan(ctx, "doublyLinkedForkchoice.InsertOptimisticBlock")
 	defer span.End()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
 	//todo deprecated
 	payloadHash := params.BeaconConfig().ZeroHash
-	return f.store.insert(ctx, slot, blockRoot, parentRoot, payloadHash, justifiedEpoch, finalizedEpoch)
+	return f.store.insert(ctx,
+		slot,
+		blockRoot,
+		parentRoot,
+		payloadHash,
+		justifiedEpoch,
+		finalizedEpoch,
+		//optimistic consensus params
+		bytesutil.ToBytes32(justifiedRoot),
+		bytesutil.ToBytes32(finalizedRoot),
+		spineData,
+	)
 }
 
 // Prune prunes the fork choice store with the new finalized root. The store is only pruned if the input
 // root is different than the current store finalized root, and the number of the store has met prune threshold.
 func (f *ForkChoice) Prune(ctx context.Context, finalizedRoot [32]byte) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
 	return f.store.prune(ctx, finalizedRoot)
 }
 
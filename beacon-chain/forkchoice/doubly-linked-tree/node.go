@@ -9,6 +9,44 @@ import (
 	pbrpc "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
 )
 
+// Slot of the fork choice node.
+func (n *Node) Slot() types.Slot {
+	return n.slot
+}
+
+// Root of the fork choice node.
+func (n *Node) Root() [32]byte {
+	return n.root
+}
+
+// Parent of the fork choice node.
+func (n *Node) Parent() *Node {
+	return n.parent
+}
+
+// JustifiedEpoch of the fork choice node.
+func (n *Node) JustifiedEpoch() types.Epoch {
+	return n.justifiedEpoch
+}
+
+// FinalizedEpoch of the fork choice node.
+func (n *Node) FinalizedEpoch() types.Epoch {
+	return n.finalizedEpoch
+}
+
+// Weight of the fork choice node.
+func (n *Node) Weight() uint64 {
+	return n.weight
+}
+
+func (n *Node) SpinesData() *SpinesData {
+	return n.spinesData
+}
+
+func (n *Node) AttestationsData() *AttestationsData {
+	return n.attsData
+}
+
 // depth returns the length of the path to the root of Fork Choice
 func (n *Node) depth() uint64 {
 	ret := uint64(0)
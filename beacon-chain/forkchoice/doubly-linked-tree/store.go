@@ -7,6 +7,8 @@ import (
 	types "github.com/prysmaticlabs/eth2-types"
 	fieldparams "gitlab.waterfall.network/waterfall/protocol/coordinator/config/fieldparams"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
 	"go.opencensus.io/trace"
 )
 
@@ -97,32 +99,53 @@ func (s *Store) head(ctx context.Context, justifiedRoot [32]byte) ([32]byte, err
 func (s *Store) insert(ctx context.Context,
 	slot types.Slot,
 	root, parentRoot, payloadHash [fieldparams.RootLength]byte,
-	justifiedEpoch, finalizedEpoch types.Epoch) error {
+	justifiedEpoch, finalizedEpoch types.Epoch,
+	//optimistic consensus params
+	justifiedRoot, finalizedRoot [fieldparams.RootLength]byte,
+	spineData *ethpb.SpineData,
+) error {
 	_, span := trace.StartSpan(ctx, "doublyLinkedForkchoice.insert")
 	defer span.End()
 
-	s.nodesLock.Lock()
-	defer s.nodesLock.Unlock()
-
-	// Return if the block has been inserted into Store before.
-	if _, ok := s.nodeByRoot[root]; ok {
-		return nil
-	}
-
-	parent := s.nodeByRoot[parentRoot]
-
 	n := &Node{
 		slot:           slot,
 		root:           root,
-		parent:         parent,
 		justifiedEpoch: justifiedEpoch,
 		finalizedEpoch: finalizedEpoch,
 		optimistic:     true,
 		payloadHash:    payloadHash,
+		attsData: &AttestationsData{
+			justifiedRoot: justifiedRoot,
+			finalizedRoot: finalizedRoot,
+			votes:         make(map[uint64]Vote),
+		},
+		spinesData: &SpinesData{
+			spines:       gwatCommon.HashArrayFromBytes(spineData.GetSpines()),
+			prefix:       gwatCommon.HashArrayFromBytes(spineData.GetPrefix()),
+			finalization: gwatCommon.HashArrayFromBytes(spineData.GetFinalization()),
+			cpFinalized:  gwatCommon.HashArrayFromBytes(spineData.GetCpFinalized()),
+		},
+	}
+
+	s.nodesLock.Lock()
+	defer s.nodesLock.Unlock()
+
+	return s.insertNode(ctx, n, parentRoot)
+}
+
+// insertNode links the detached node n to its parent and registers it in the store.
+// This function assumes a lock on s.nodesLock.
+func (s *Store) insertNode(ctx context.Context, n *Node, parentRoot [fieldparams.RootLength]byte) error {
+	// Return if the block has been inserted into Store before.
+	if _, ok := s.nodeByRoot[n.root]; ok {
+		return nil
 	}
 
-	s.nodeByPayload[payloadHash] = n
-	s.nodeByRoot[root] = n
+	parent := s.nodeByRoot[parentRoot]
+	n.parent = parent
+
+	s.nodeByPayload[n.payloadHash] = n
+	s.nodeByRoot[n.root] = n
 	if parent != nil {
 		parent.children = append(parent.children, n)
 		if err := s.treeRootNode.updateBestDescendant(ctx, s.justifiedEpoch, s.finalizedEpoch); err != nil {
@@ -166,6 +189,9 @@ func (s *Store) pruneFinalizedNodeByRootMap(ctx context.Context, node, finalized
 	}
 
 	delete(s.nodeByRoot, node.root)
+	s.balancesLock.Lock()
+	delete(s.balances, node.root)
+	s.balancesLock.Unlock()
 	return nil
 }
 
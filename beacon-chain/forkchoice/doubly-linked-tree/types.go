@@ -1,12 +1,10 @@
 package doublylinkedtree
 
 import (
-	"context"
 	"sync"
 
 	types "github.com/prysmaticlabs/eth2-types"
 	fieldparams "gitlab.waterfall.network/waterfall/protocol/coordinator/config/fieldparams"
-	"gitlab.waterfall.network/waterfall/protocol/gwat/common"
 )
 
 // ForkChoice defines the overall fork choice store which includes all block nodes, validator's latest votes and balances.
@@ -15,16 +13,7 @@ type ForkChoice struct {
 	votes     []Vote // tracks individual validator's last vote.
 	votesLock sync.RWMutex
 	balances  []uint64 // tracks individual validator's last justified balances.
-}
-
-func (f *ForkChoice) CollectForkExcludedBlkRoots(leaf common.Hash) common.HashArray {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (f *ForkChoice) GetParentByOptimisticSpines(ctx context.Context, optSpines []common.HashArray, jCpRoot [32]byte) ([32]byte, error) {
-	//TODO implement me
-	panic("implement me")
+	mu        sync.RWMutex
 }
 
 // Store defines the fork choice store which includes block nodes and the last view of checkpoint information.
@@ -41,6 +30,8 @@ type Store struct {
 	nodeByPayload              map[[fieldparams.RootLength]byte]*Node // nodes indexed by payload Hash
 	nodesLock                  sync.RWMutex
 	proposerBoostLock          sync.RWMutex
+	balances                   map[[fieldparams.RootLength]byte][]uint64 // justified balances indexed by justified root.
+	balancesLock               sync.RWMutex
 }
 
 // Node defines the individual block which includes its block parent, ancestor and how much weight accounted for it.
@@ -57,6 +48,9 @@ type Node struct {
 	weight         uint64                       // weight of this node: the total balance including children
 	bestDescendant *Node                        // bestDescendant node of this node.
 	optimistic     bool                         // whether the block has been fully validated or not
+	// optimistic consensus data
+	spinesData *SpinesData
+	attsData   *AttestationsData
 }
 
 // Vote defines an individual validator's vote.
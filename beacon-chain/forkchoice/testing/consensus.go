@@ -0,0 +1,286 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package testing
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/forkchoice"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/assert"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
+)
+
+// ConsensusStore exposes the dag consensus methods of a fork choice store implementation.
+type ConsensusStore struct {
+	forkchoice.ForkChoicer
+	// Fork returns the roots of the fork of the leaf, nil if the leaf is unknown.
+	Fork func(leaf [32]byte) [][32]byte
+	// Forks returns the roots of all forks.
+	Forks func() [][][32]byte
+	// CommonAncestor returns the root of the highest common ancestor of the forks.
+	CommonAncestor func() [32]byte
+	// TgTree returns the roots and the leafs of the T(G) tree collected by the optimistic spines.
+	TgTree func(optSpines []gwatCommon.HashArray, jCpRoot [32]byte) (map[[32]byte]bool, map[[32]byte]int)
+}
+
+type newConsensusStore func() *ConsensusStore
+
+type consensusNode struct {
+	slot      types.Slot
+	root      [32]byte
+	parent    [32]byte
+	spineData *ethpb.SpineData
+}
+
+func nrToHash(i int) [32]byte {
+	return bytesutil.ToBytes32([]byte(fmt.Sprintf("%d", i)))
+}
+
+func insertConsensusNodes(t *testing.T, s forkchoice.BlockProcessor, nodes []consensusNode) {
+	zeroHash := params.BeaconConfig().ZeroHash
+	for _, n := range nodes {
+		require.NoError(t, s.InsertOptimisticBlock(context.Background(), n.slot, n.root, n.parent, 0, 0, zeroHash[:], zeroHash[:], n.spineData))
+	}
+}
+
+// forkNodes returns the tree of 3 forks:
+//
+//	A - B - C - D - E - F
+//	         \   \
+//	          \   I - J - K
+//	           G - H
+func forkNodes() []consensusNode {
+	return []consensusNode{
+		//fork 0
+		{slot: 1, root: [32]byte{'A'}, parent: params.BeaconConfig().ZeroHash},
+		{slot: 2, root: [32]byte{'B'}, parent: [32]byte{'A'}},
+		{slot: 3, root: [32]byte{'C'}, parent: [32]byte{'B'}},
+		{slot: 4, root: [32]byte{'D'}, parent: [32]byte{'C'}},
+		{slot: 5, root: [32]byte{'E'}, parent: [32]byte{'D'}},
+		{slot: 6, root: [32]byte{'F'}, parent: [32]byte{'E'}},
+		//fork 1
+		{slot: 7, root: [32]byte{'G'}, parent: [32]byte{'C'}},
+		{slot: 8, root: [32]byte{'H'}, parent: [32]byte{'G'}},
+		//fork 2
+		{slot: 9, root: [32]byte{'I'}, parent: [32]byte{'D'}},
+		{slot: 10, root: [32]byte{'J'}, parent: [32]byte{'I'}},
+		{slot: 11, root: [32]byte{'K'}, parent: [32]byte{'J'}},
+	}
+}
+
+// InsertForkNodes inserts the tree of 3 forks with the leafs F, H and K to the store.
+func InsertForkNodes(t *testing.T, s forkchoice.BlockProcessor) {
+	insertConsensusNodes(t, s, forkNodes())
+}
+
+// spinesNodes returns the nodes of the optimistic spines tests with the given parents,
+// a negative parent marks the genesis node.
+func spinesNodes(parents [10]int, lastSpines gwatCommon.HashArray) []consensusNode {
+	data := []struct {
+		spines       gwatCommon.HashArray
+		prefix       gwatCommon.HashArray
+		finalization gwatCommon.HashArray
+	}{
+		{spines: gwatCommon.HashArray{}, prefix: gwatCommon.HashArray{}, finalization: gwatCommon.HashArray{{'a', '1'}}},
+		{spines: gwatCommon.HashArray{{'a', '2'}}, prefix: gwatCommon.HashArray{}, finalization: gwatCommon.HashArray{{'a', '1'}}},
+		{spines: gwatCommon.HashArray{{'a', '2'}, {'a', '3'}}, prefix: gwatCommon.HashArray{}, finalization: gwatCommon.HashArray{{'a', '1'}}},
+		{spines: gwatCommon.HashArray{{'a', '2'}, {'a', '3'}, {'a', '4'}}, prefix: gwatCommon.HashArray{{'a', '2'}}, finalization: gwatCommon.HashArray{{'a', '1'}}},
+		{spines: gwatCommon.HashArray{{'a', '2'}, {'a', '3'}, {'a', '4'}}, prefix: gwatCommon.HashArray{{'a', '2'}}, finalization: gwatCommon.HashArray{{'a', '1'}}},
+		{spines: gwatCommon.HashArray{{'a', '3'}, {'a', '4'}, {'a', '5'}}, prefix: gwatCommon.HashArray{{'a', '2'}, {'a', '3'}}, finalization: gwatCommon.HashArray{{'a', '1'}}},
+		{spines: gwatCommon.HashArray{{'a', '4'}, {'a', '5'}, {'a', '6'}}, prefix: gwatCommon.HashArray{{'a', '2'}, {'a', '3'}, {'a', '4'}}, finalization: gwatCommon.HashArray{{'a', '1'}}},
+		{spines: gwatCommon.HashArray{{'a', '5'}, {'a', '6'}, {'a', '7'}}, prefix: gwatCommon.HashArray{{'a', '3'}, {'a', '4'}, {'a', '5'}}, finalization: gwatCommon.HashArray{{'a', '1'}, {'a', '2'}}},
+		{spines: gwatCommon.HashArray{{'a', '6'}, {'a', '7'}, {'a', '8'}}, prefix: gwatCommon.HashArray{{'a', '4'}, {'a', '5'}, {'a', '6'}}, finalization: gwatCommon.HashArray{{'a', '1'}, {'a', '2'}, {'a', '3'}}},
+		{spines: lastSpines, prefix: gwatCommon.HashArray{{'a', '4'}, {'a', '5'}, {'a', '6'}, {'a', '7'}}, finalization: gwatCommon.HashArray{{'a', '1'}, {'a', '2'}, {'a', '3'}}},
+	}
+	cpFinalized := gwatCommon.HashArray{{'x', 'x', 'x'}}
+	nodes := make([]consensusNode, len(data))
+	for i, d := range data {
+		nodes[i] = consensusNode{
+			slot: types.Slot(i),
+			root: nrToHash(i),
+			spineData: &ethpb.SpineData{
+				Spines:       d.spines.ToBytes(),
+				Prefix:       d.prefix.ToBytes(),
+				Finalization: d.finalization.ToBytes(),
+				CpFinalized:  cpFinalized.ToBytes(),
+			},
+		}
+		if parents[i] < 0 {
+			nodes[i].parent = params.BeaconConfig().ZeroHash
+			continue
+		}
+		nodes[i].parent = nrToHash(parents[i])
+	}
+	return nodes
+}
+
+func VerifyForkChoiceForks(t *testing.T, factory newConsensusStore) {
+	tests := []struct {
+		name               string
+		nodes              []consensusNode
+		leaf               [32]byte
+		wantFork           [][32]byte
+		wantForks          [][][32]byte
+		wantCommonAncestor [32]byte
+		wantExcluded       gwatCommon.HashArray
+	}{
+		{
+			name:     "three forks",
+			nodes:    forkNodes(),
+			leaf:     [32]byte{'H'},
+			wantFork: [][32]byte{{'H'}, {'G'}, {'C'}, {'B'}, {'A'}},
+			wantForks: [][][32]byte{
+				{{'K'}, {'J'}, {'I'}, {'D'}, {'C'}, {'B'}, {'A'}},
+				{{'H'}, {'G'}, {'C'}, {'B'}, {'A'}},
+				{{'F'}, {'E'}, {'D'}, {'C'}, {'B'}, {'A'}},
+			},
+			wantCommonAncestor: [32]byte{'C'},
+			wantExcluded:       gwatCommon.HashArray{{'D'}, {'E'}, {'F'}, {'I'}, {'J'}, {'K'}},
+		},
+		{
+			name:               "single node",
+			nodes:              forkNodes()[:1],
+			leaf:               [32]byte{'A'},
+			wantFork:           [][32]byte{{'A'}},
+			wantForks:          [][][32]byte{{{'A'}}},
+			wantCommonAncestor: [32]byte{'A'},
+			wantExcluded:       gwatCommon.HashArray{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := factory()
+			insertConsensusNodes(t, s, tt.nodes)
+
+			require.DeepEqual(t, tt.wantFork, s.Fork(tt.leaf))
+			assert.Equal(t, true, s.Fork([32]byte{'Z'}) == nil)
+			require.DeepEqual(t, tt.wantForks, s.Forks())
+			assert.Equal(t, tt.wantCommonAncestor, s.CommonAncestor())
+
+			excluded := s.CollectForkExcludedBlkRoots(tt.leaf)
+			require.Equal(t, len(tt.wantExcluded), len(excluded))
+			require.Equal(t, 0, len(tt.wantExcluded.Difference(excluded)))
+		})
+	}
+}
+
+func VerifyForkChoiceTgTreeByOptimisticSpines(t *testing.T, factory newConsensusStore) {
+	allRoots := map[[32]byte]bool{}
+	for i := 0; i < 10; i++ {
+		allRoots[nrToHash(i)] = true
+	}
+	extendedSpines := gwatCommon.HashArray{{'a', '7'}, {'a', '8'}, {'a', '9'}, {'a', '1', '0'}}
+	optSpines := []gwatCommon.HashArray{
+		{{'a', '1'}},
+		{nrToHash(0), nrToHash(0), {'a', '2'}, nrToHash(0)},
+		{{'a', '3'}, nrToHash(0), nrToHash(0)},
+		{nrToHash(0), nrToHash(0), nrToHash(0), nrToHash(0), nrToHash(0), {'a', '4'}},
+		{nrToHash(0), {'a', '5'}, nrToHash(0), nrToHash(0)},
+		{nrToHash(0), nrToHash(0), nrToHash(0), {'a', '6'}, nrToHash(0), nrToHash(0)},
+		{{'a', '7'}},
+		{{'a', '8'}, nrToHash(0)},
+		{nrToHash(0), nrToHash(0), {'a', '9'}},
+		{{'a', '1', '0'}},
+	}
+	tests := []struct {
+		name      string
+		nodes     []consensusNode
+		optSpines []gwatCommon.HashArray
+		wantRoots map[[32]byte]bool
+		wantLeafs map[[32]byte]int
+	}{
+		{
+			name:      "prefix extension",
+			nodes:     spinesNodes([10]int{-1, 0, 1, 2, 3, 4, 5, 6, 7, 8}, extendedSpines),
+			optSpines: optSpines,
+			wantRoots: allRoots,
+			wantLeafs: map[[32]byte]int{nrToHash(9): 10},
+		},
+		{
+			name:      "prefix not extension",
+			nodes:     spinesNodes([10]int{-1, 0, 1, 2, 3, 4, 5, 6, 7, 8}, gwatCommon.HashArray{{'a', '8'}, {'a', '9'}, {'a', '1', '0'}}),
+			optSpines: optSpines,
+			wantRoots: allRoots,
+			wantLeafs: map[[32]byte]int{nrToHash(9): 10},
+		},
+		{
+			name:  "3 forks",
+			nodes: spinesNodes([10]int{-1, 0, 1, 2, 1, 4, 2, 6, 5, 8}, extendedSpines),
+			optSpines: []gwatCommon.HashArray{
+				{{'a', '1'}},
+				{nrToHash(0), nrToHash(0), {'a', '2'}, nrToHash(0)},
+				{{'a', '3'}, nrToHash(0), nrToHash(0), nrToHash(0), nrToHash(0), nrToHash(0)},
+				{nrToHash(0), nrToHash(0), nrToHash(0), nrToHash(0), nrToHash(0), {'a', '4'}},
+				{nrToHash(0), {'a', '5'}, nrToHash(0), nrToHash(0)},
+				{nrToHash(0), nrToHash(0), nrToHash(0), {'a', '6'}, nrToHash(0), nrToHash(0)},
+				{{'a', '7'}},
+				{{'a', '8'}, nrToHash(0)},
+				{nrToHash(0), nrToHash(0), {'a', '9'}},
+				{{'a', '1', '0'}},
+			},
+			wantRoots: allRoots,
+			wantLeafs: map[[32]byte]int{
+				nrToHash(9): 6,
+				nrToHash(7): 5,
+				nrToHash(3): 4,
+			},
+		},
+		{
+			name:  "1 fork",
+			nodes: spinesNodes([10]int{-1, 0, 1, 2, 1, 4, 2, 6, 5, 8}, extendedSpines),
+			optSpines: []gwatCommon.HashArray{
+				{{'a', '1'}},
+				{nrToHash(0), nrToHash(0), {'a', '2'}, nrToHash(0)},
+				{{'b', '3'}}, // <<< "b3"
+				{nrToHash(0), nrToHash(0), nrToHash(0), nrToHash(0), nrToHash(0), {'a', '4'}},
+				{{'a', '5'}},
+				{nrToHash(0), nrToHash(0), nrToHash(0), {'a', '6'}, nrToHash(0), nrToHash(0)},
+				{{'a', '7'}},
+				{{'a', '8'}, nrToHash(0)},
+				{nrToHash(0), nrToHash(0), {'a', '9'}},
+				{{'a', '1', '0'}},
+			},
+			wantRoots: map[[32]byte]bool{
+				nrToHash(0): true,
+				nrToHash(1): true,
+				nrToHash(2): true,
+				nrToHash(3): true,
+				nrToHash(4): true,
+			},
+			wantLeafs: map[[32]byte]int{
+				nrToHash(4): 3,
+				nrToHash(2): 3,
+				nrToHash(3): 4,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := factory()
+			insertConsensusNodes(t, s, tt.nodes)
+
+			roots, leafs := s.TgTree(tt.optSpines, nrToHash(0))
+			require.DeepEqual(t, tt.wantRoots, roots)
+			require.DeepEqual(t, tt.wantLeafs, leafs)
+		})
+	}
+}
@@ -57,9 +57,10 @@ func DefaultConfig(enableDebugRPCEndpoints bool, httpModules string) MuxConfig {
 			ethpbservice.RegisterBeaconChainHandler,
 			ethpbservice.RegisterBeaconValidatorHandler,
 			ethpbservice.RegisterEventsHandler,
+			ethpbservice.RegisterWaterfallHandler,
 		}
 		if enableDebugRPCEndpoints {
-			ethRegistrations = append(ethRegistrations, ethpbservice.RegisterBeaconDebugHandler)
+			ethRegistrations = append(ethRegistrations, ethpbservice.RegisterBeaconDebugHandler, ethpbservice.RegisterWaterfallDebugHandler)
 
 		}
 		ethMux := gwruntime.NewServeMux(
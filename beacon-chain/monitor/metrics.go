@@ -90,4 +90,59 @@ var (
 			"validator_index",
 		},
 	)
+	// prevotesSeenCounter used to track prevotes observed by the beacon node
+	prevotesSeenCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "monitor",
+			Name:      "prevotes_seen_total",
+			Help:      "Number of prevotes observed",
+		},
+		[]string{
+			"validator_index",
+		},
+	)
+	// prevotesIncludedCounter used to track prevotes supporting the candidates of blocks
+	prevotesIncludedCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "monitor",
+			Name:      "prevotes_included_total",
+			Help:      "Number of prevotes included in blocks candidates",
+		},
+		[]string{
+			"validator_index",
+		},
+	)
+	// withdrawalsCounter used to track applied withdrawals
+	withdrawalsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "monitor",
+			Name:      "withdrawals_total",
+			Help:      "Number of applied withdrawals",
+		},
+		[]string{
+			"validator_index",
+		},
+	)
+	// withdrawnAmountCounter used to track the amount of applied withdrawals
+	withdrawnAmountCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "monitor",
+			Name:      "withdrawn_gwei_total",
+			Help:      "Amount of applied withdrawals in Gwei",
+		},
+		[]string{
+			"validator_index",
+		},
+	)
+	// withdrawalOpsGauge used to track the length of the validator WithdrawalOps list
+	withdrawalOpsGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "monitor",
+			Name:      "withdrawal_ops",
+			Help:      "Length of the WithdrawalOps list",
+		},
+		[]string{
+			"validator_index",
+		},
+	)
 )
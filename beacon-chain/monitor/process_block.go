@@ -23,6 +23,9 @@ const AggregateReportingPeriod = 5
 // - An Exit by one of our validators was included
 // - A Slashing by one of our tracked validators was included
 // - A Sync Committee Contribution by one of our tracked validators was included
+// - A Prevote by one of our tracked validators was included in the block candidates
+// - A Withdrawal of one of our tracked validators was applied
+// - The WithdrawalOps list of one of our tracked validators grew or was pruned
 func (s *Service) processBlock(ctx context.Context, b block.SignedBeaconBlock) {
 	if b == nil || b.Block() == nil {
 		return
@@ -31,6 +34,7 @@ func (s *Service) processBlock(ctx context.Context, b block.SignedBeaconBlock) {
 
 	s.processSlashings(blk)
 	s.processExitsFromBlock(blk)
+	s.processIncludedPrevotes(blk)
 
 	root, err := blk.HashTreeRoot()
 	if err != nil {
@@ -57,6 +61,8 @@ func (s *Service) processBlock(ctx context.Context, b block.SignedBeaconBlock) {
 	s.processSyncAggregate(state, blk)
 	s.processProposedBlock(state, root, blk)
 	s.processAttestations(ctx, state, blk)
+	s.processWithdrawals(state, blk)
+	s.processWithdrawalOps(state)
 
 	if blk.Slot()%(AggregateReportingPeriod*params.BeaconConfig().SlotsPerEpoch) == 0 {
 		s.logAggregatedPerformance()
@@ -172,6 +178,10 @@ func (s *Service) logAggregatedPerformance() {
 			"TotalProposedBlocks":      p.totalProposedCount,
 			"TotalAggregations":        p.totalAggregations,
 			"TotalSyncContributions":   p.totalSyncComitteeContributions,
+			"TotalPrevotesSeen":        p.totalPrevotesSeen,
+			"TotalPrevotesIncluded":    p.totalPrevotesIncluded,
+			"TotalWithdrawals":         p.totalWithdrawals,
+			"TotalWithdrawnAmount":     p.totalWithdrawnAmount,
 		}).Info("Aggregated performance since launch")
 	}
 }
@@ -0,0 +1,121 @@
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/sirupsen/logrus"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/helpers"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/state"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1/attestation"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1/block"
+	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
+)
+
+// prevotingIndices returns the indices of validators that participated in the given prevote.
+func prevotingIndices(ctx context.Context, state state.BeaconState, pv *ethpb.PreVote) ([]uint64, error) {
+	committee, err := helpers.BeaconCommitteeFromState(ctx, state, pv.Data.Slot, pv.Data.Index)
+	if err != nil {
+		return nil, err
+	}
+	return attestation.AttestingIndices(pv.AggregationBits, committee)
+}
+
+// processPrevote logs when the beacon node observes a prevote from tracked validators.
+// The candidates of the prevote are kept until a block of the prevote slot is processed.
+func (s *Service) processPrevote(ctx context.Context, pv *ethpb.PreVote) {
+	if pv == nil || pv.Data == nil {
+		return
+	}
+	state, err := s.config.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not get head state")
+		return
+	}
+	if state == nil || state.IsNil() {
+		log.WithField("Slot", pv.Data.Slot).Debug("Skipping prevote due to head state not found")
+		return
+	}
+	prevotingIndices, err := prevotingIndices(ctx, state, pv)
+	if err != nil {
+		log.WithError(err).Error("Could not get prevoting indices")
+		return
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	for _, i := range prevotingIndices {
+		idx := types.ValidatorIndex(i)
+		if !s.trackedIndex(idx) {
+			continue
+		}
+		if _, ok := s.seenPrevotes[pv.Data.Slot][idx]; ok {
+			continue
+		}
+		if s.seenPrevotes == nil {
+			s.seenPrevotes = make(map[types.Slot]map[types.ValidatorIndex][]byte)
+		}
+		if s.seenPrevotes[pv.Data.Slot] == nil {
+			s.seenPrevotes[pv.Data.Slot] = make(map[types.ValidatorIndex][]byte)
+		}
+		s.seenPrevotes[pv.Data.Slot][idx] = pv.Data.Candidates
+
+		latestPerf := s.latestPerformance[idx]
+		latestPerf.prevoteSlot = pv.Data.Slot
+		s.latestPerformance[idx] = latestPerf
+
+		aggregatedPerf := s.aggregatedPerformance[idx]
+		aggregatedPerf.totalPrevotesSeen++
+		s.aggregatedPerformance[idx] = aggregatedPerf
+
+		prevotesSeenCounter.WithLabelValues(fmt.Sprintf("%d", idx)).Inc()
+
+		log.WithFields(logrus.Fields{
+			"ValidatorIndex": idx,
+			"Slot":           pv.Data.Slot,
+			"CommitteeIndex": pv.Data.Index,
+			"Candidates":     len(pv.Data.Candidates) / gwatCommon.HashLength,
+		}).Info("Processed prevote")
+	}
+}
+
+// processIncludedPrevotes logs the event for the tracked validators' prevotes of the block slot,
+// a prevote is included if the block candidates is a part of the candidates of the prevote.
+func (s *Service) processIncludedPrevotes(blk block.BeaconBlock) {
+	if blk == nil || blk.Body() == nil {
+		return
+	}
+	candidates := blk.Body().Eth1Data().GetCandidates()
+
+	s.Lock()
+	defer s.Unlock()
+	for idx, pvCandidates := range s.seenPrevotes[blk.Slot()] {
+		included := len(candidates) > 0 && bytes.HasPrefix(pvCandidates, candidates)
+		logFields := logrus.Fields{
+			"ValidatorIndex":     idx,
+			"Slot":               blk.Slot(),
+			"Candidates":         len(pvCandidates) / gwatCommon.HashLength,
+			"IncludedCandidates": len(candidates) / gwatCommon.HashLength,
+		}
+		if !included {
+			log.WithFields(logFields).Info("Prevote was not included")
+			continue
+		}
+		aggregatedPerf := s.aggregatedPerformance[idx]
+		aggregatedPerf.totalPrevotesIncluded++
+		s.aggregatedPerformance[idx] = aggregatedPerf
+
+		prevotesIncludedCounter.WithLabelValues(fmt.Sprintf("%d", idx)).Inc()
+
+		log.WithFields(logFields).Info("Prevote was included")
+	}
+	// prevotes of the previous slots will not be included anymore.
+	for slot := range s.seenPrevotes {
+		if slot <= blk.Slot() {
+			delete(s.seenPrevotes, slot)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package monitor
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1/wrapper"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
+)
+
+func TestProcessPrevote(t *testing.T) {
+	params.UseTestConfig()
+	hook := logTest.NewGlobal()
+	s := setupService(t)
+
+	pv := &ethpb.PreVote{
+		Data: &ethpb.PreVoteData{
+			Slot:       1,
+			Index:      0,
+			Candidates: gwatCommon.HashArray{{0x01}, {0x02}}.ToBytes(),
+		},
+		AggregationBits: bitfield.Bitlist{0b11, 0b1},
+	}
+	s.processPrevote(context.Background(), pv)
+	wanted := "\"Processed prevote\" Candidates=2 CommitteeIndex=0 Slot=1 ValidatorIndex=7 prefix=monitor"
+	require.LogsContain(t, hook, wanted)
+	require.Equal(t, uint64(1), s.aggregatedPerformance[7].totalPrevotesSeen)
+	require.Equal(t, types.Slot(1), s.latestPerformance[7].prevoteSlot)
+	require.DeepEqual(t, pv.Data.Candidates, s.seenPrevotes[1][7])
+
+	// the same prevote is counted once
+	hook.Reset()
+	s.processPrevote(context.Background(), pv)
+	require.LogsDoNotContain(t, hook, "\"Processed prevote\"")
+	require.Equal(t, uint64(1), s.aggregatedPerformance[7].totalPrevotesSeen)
+}
+
+func TestProcessIncludedPrevotes(t *testing.T) {
+	hook := logTest.NewGlobal()
+	s := setupService(t)
+	s.seenPrevotes = map[types.Slot]map[types.ValidatorIndex][]byte{
+		1: {
+			1: gwatCommon.HashArray{{0x01}, {0x02}}.ToBytes(),
+			2: gwatCommon.HashArray{{0x03}}.ToBytes(),
+		},
+		2: {
+			7: gwatCommon.HashArray{{0x01}}.ToBytes(),
+		},
+	}
+
+	block := &ethpb.BeaconBlock{
+		Slot: 1,
+		Body: &ethpb.BeaconBlockBody{
+			Eth1Data: &ethpb.Eth1Data{
+				Candidates: gwatCommon.HashArray{{0x01}}.ToBytes(),
+			},
+		},
+	}
+	s.processIncludedPrevotes(wrapper.WrappedPhase0BeaconBlock(block))
+	require.LogsContain(t, hook, "\"Prevote was included\" Candidates=2 IncludedCandidates=1 Slot=1 ValidatorIndex=1 prefix=monitor")
+	require.LogsContain(t, hook, "\"Prevote was not included\" Candidates=1 IncludedCandidates=1 Slot=1 ValidatorIndex=2 prefix=monitor")
+	require.Equal(t, uint64(1), s.aggregatedPerformance[1].totalPrevotesIncluded)
+	require.Equal(t, uint64(0), s.aggregatedPerformance[2].totalPrevotesIncluded)
+
+	// prevotes of the processed slot are removed
+	_, ok := s.seenPrevotes[1]
+	require.Equal(t, false, ok)
+	_, ok = s.seenPrevotes[2]
+	require.Equal(t, true, ok)
+}
@@ -0,0 +1,105 @@
+package monitor
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/state"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1/block"
+)
+
+// processWithdrawals logs the event when a tracked validators' withdrawal was applied by the block.
+func (s *Service) processWithdrawals(state state.BeaconState, blk block.BeaconBlock) {
+	if blk == nil || blk.Body() == nil {
+		return
+	}
+	s.Lock()
+	defer s.Unlock()
+	for _, withdrawal := range blk.Body().Withdrawals() {
+		idx := withdrawal.ValidatorIndex
+		if !s.trackedIndex(idx) {
+			continue
+		}
+		val, err := state.ValidatorAtIndexReadOnly(idx)
+		if err != nil {
+			log.WithError(err).WithField("ValidatorIndex", idx).Error("Could not get validator")
+			continue
+		}
+		// find the operation applied by the current block.
+		var applied *ethpb.WithdrawalOp
+		for _, wop := range val.WithdrawalOps() {
+			if wop != nil && bytes.Equal(wop.Hash, withdrawal.InitTxHash) && wop.Slot == blk.Slot() {
+				applied = wop
+				break
+			}
+		}
+		if applied == nil {
+			log.WithFields(logrus.Fields{
+				"ValidatorIndex": idx,
+				"Slot":           blk.Slot(),
+				"Amount":         withdrawal.Amount,
+				"InitTxHash":     fmt.Sprintf("%#x", bytesutil.Trunc(withdrawal.InitTxHash)),
+			}).Info("Withdrawal was included but not applied")
+			continue
+		}
+		balance, err := state.BalanceAtIndex(idx)
+		if err != nil {
+			log.WithError(err).WithField("ValidatorIndex", idx).Error("Could not get balance")
+			continue
+		}
+
+		aggregatedPerf := s.aggregatedPerformance[idx]
+		aggregatedPerf.totalWithdrawals++
+		aggregatedPerf.totalWithdrawnAmount += applied.Amount
+		s.aggregatedPerformance[idx] = aggregatedPerf
+
+		withdrawalsCounter.WithLabelValues(fmt.Sprintf("%d", idx)).Inc()
+		withdrawnAmountCounter.WithLabelValues(fmt.Sprintf("%d", idx)).Add(float64(applied.Amount))
+
+		log.WithFields(logrus.Fields{
+			"ValidatorIndex":  idx,
+			"Slot":            blk.Slot(),
+			"AppliedSlot":     applied.Slot,
+			"Amount":          applied.Amount,
+			"RequestedAmount": withdrawal.Amount,
+			"InitTxHash":      fmt.Sprintf("%#x", bytesutil.Trunc(withdrawal.InitTxHash)),
+			"NewBalance":      balance,
+		}).Info("Withdrawal was processed")
+	}
+}
+
+// processWithdrawalOps logs the event when the WithdrawalOps list of tracked validators grew or was pruned.
+func (s *Service) processWithdrawalOps(state state.BeaconState) {
+	s.Lock()
+	defer s.Unlock()
+	for idx := range s.TrackedValidators {
+		val, err := state.ValidatorAtIndexReadOnly(idx)
+		if err != nil {
+			log.WithError(err).WithField("ValidatorIndex", idx).Error("Could not get validator")
+			continue
+		}
+		count := len(val.WithdrawalOps())
+		latestPerf := s.latestPerformance[idx]
+		if count == latestPerf.withdrawalOpsCount {
+			continue
+		}
+		logFields := logrus.Fields{
+			"ValidatorIndex": idx,
+			"Slot":           state.Slot(),
+			"PrevCount":      latestPerf.withdrawalOpsCount,
+			"Count":          count,
+		}
+		if count > latestPerf.withdrawalOpsCount {
+			log.WithFields(logFields).Info("WithdrawalOps list grew")
+		} else {
+			log.WithFields(logFields).Info("WithdrawalOps list was pruned")
+		}
+		latestPerf.withdrawalOpsCount = count
+		s.latestPerformance[idx] = latestPerf
+
+		withdrawalOpsGauge.WithLabelValues(fmt.Sprintf("%d", idx)).Set(float64(count))
+	}
+}
@@ -0,0 +1,64 @@
+package monitor
+
+import (
+	"testing"
+
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1/wrapper"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/util"
+)
+
+func TestProcessWithdrawals(t *testing.T) {
+	hook := logTest.NewGlobal()
+	s := setupService(t)
+	state, _ := util.DeterministicGenesisStateAltair(t, 256)
+	require.NoError(t, state.SetSlot(5))
+
+	initTxHash := make([]byte, 32)
+	initTxHash[0] = 0xaa
+	val, err := state.ValidatorAtIndex(2)
+	require.NoError(t, err)
+	val.WithdrawalOps = []*ethpb.WithdrawalOp{{Amount: 1000, Hash: initTxHash, Slot: 5}}
+	require.NoError(t, state.UpdateValidatorAtIndex(2, val))
+
+	block := &ethpb.BeaconBlock{
+		Slot: 5,
+		Body: &ethpb.BeaconBlockBody{
+			Withdrawals: []*ethpb.Withdrawal{
+				{ValidatorIndex: 2, Amount: 0, InitTxHash: initTxHash},
+				{ValidatorIndex: 3, Amount: 10, InitTxHash: initTxHash},
+				{ValidatorIndex: 7, Amount: 10, InitTxHash: make([]byte, 32)},
+			},
+		},
+	}
+	s.processWithdrawals(state, wrapper.WrappedPhase0BeaconBlock(block))
+	require.LogsContain(t, hook, "\"Withdrawal was processed\" AppliedSlot=5 Amount=1000 InitTxHash=0xaa0000000000 NewBalance=3200000000000 RequestedAmount=0 Slot=5 ValidatorIndex=2 prefix=monitor")
+	require.LogsContain(t, hook, "\"Withdrawal was included but not applied\" Amount=10 InitTxHash=0x000000000000 Slot=5 ValidatorIndex=7 prefix=monitor")
+	require.LogsDoNotContain(t, hook, "ValidatorIndex=3")
+	require.Equal(t, uint64(1), s.aggregatedPerformance[2].totalWithdrawals)
+	require.Equal(t, uint64(1000), s.aggregatedPerformance[2].totalWithdrawnAmount)
+}
+
+func TestProcessWithdrawalOps(t *testing.T) {
+	hook := logTest.NewGlobal()
+	s := setupService(t)
+	state, _ := util.DeterministicGenesisStateAltair(t, 256)
+	require.NoError(t, state.SetSlot(5))
+
+	val, err := state.ValidatorAtIndex(1)
+	require.NoError(t, err)
+	val.WithdrawalOps = []*ethpb.WithdrawalOp{{Amount: 1000, Hash: make([]byte, 32), Slot: 5}}
+	require.NoError(t, state.UpdateValidatorAtIndex(1, val))
+	latestPerf := s.latestPerformance[2]
+	latestPerf.withdrawalOpsCount = 2
+	s.latestPerformance[2] = latestPerf
+
+	s.processWithdrawalOps(state)
+	require.LogsContain(t, hook, "\"WithdrawalOps list grew\" Count=1 PrevCount=0 Slot=5 ValidatorIndex=1 prefix=monitor")
+	require.LogsContain(t, hook, "\"WithdrawalOps list was pruned\" Count=0 PrevCount=2 Slot=5 ValidatorIndex=2 prefix=monitor")
+	require.LogsDoNotContain(t, hook, "ValidatorIndex=7")
+	require.Equal(t, 1, s.latestPerformance[1].withdrawalOpsCount)
+	require.Equal(t, 0, s.latestPerformance[2].withdrawalOpsCount)
+}
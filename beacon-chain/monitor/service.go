@@ -36,6 +36,10 @@ type ValidatorLatestPerformance struct {
 	timelyHead    bool
 	balance       uint64
 	balanceChange int64
+	// prevoteSlot is the slot of the latest observed prevote
+	prevoteSlot types.Slot
+	// withdrawalOpsCount is the length of the validator WithdrawalOps list
+	withdrawalOpsCount int
 }
 
 // ValidatorAggregatedPerformance keeps track of the accumulated performance of
@@ -53,6 +57,10 @@ type ValidatorAggregatedPerformance struct {
 	totalAggregations              uint64
 	totalSyncComitteeContributions uint64
 	totalSyncComitteeAggregations  uint64
+	totalPrevotesSeen              uint64
+	totalPrevotesIncluded          uint64
+	totalWithdrawals               uint64
+	totalWithdrawnAmount           uint64
 }
 
 // ValidatorMonitorConfig contains the list of validator indices that the
@@ -74,7 +82,7 @@ type Service struct {
 	isLogging bool
 
 	// Locks access to TrackedValidators, latestPerformance, aggregatedPerformance,
-	// trackedSyncedCommitteeIndices and lastSyncedEpoch
+	// trackedSyncedCommitteeIndices, lastSyncedEpoch and seenPrevotes
 	sync.RWMutex
 
 	TrackedValidators           map[types.ValidatorIndex]bool
@@ -82,6 +90,9 @@ type Service struct {
 	aggregatedPerformance       map[types.ValidatorIndex]ValidatorAggregatedPerformance
 	trackedSyncCommitteeIndices map[types.ValidatorIndex][]types.CommitteeIndex
 	lastSyncedEpoch             types.Epoch
+	// seenPrevotes keeps the candidates of the tracked validators' prevotes by slot
+	// until a block of the slot is processed.
+	seenPrevotes map[types.Slot]map[types.ValidatorIndex][]byte
 }
 
 // NewService sets up a new validator monitor service instance when given a list of validator indices to track.
@@ -95,6 +106,7 @@ func NewService(ctx context.Context, config *ValidatorMonitorConfig, tracked []t
 		latestPerformance:           make(map[types.ValidatorIndex]ValidatorLatestPerformance),
 		aggregatedPerformance:       make(map[types.ValidatorIndex]ValidatorAggregatedPerformance),
 		trackedSyncCommitteeIndices: make(map[types.ValidatorIndex][]types.CommitteeIndex),
+		seenPrevotes:                make(map[types.Slot]map[types.ValidatorIndex][]byte),
 	}
 	for _, idx := range tracked {
 		r.TrackedValidators[idx] = true
@@ -171,12 +183,17 @@ func (s *Service) initializePerformanceStructures(state state.BeaconState, epoch
 				"Could not fetch starting balance, skipping aggregated logs.")
 			balance = 0
 		}
+		withdrawalOpsCount := 0
+		if val, err := state.ValidatorAtIndexReadOnly(idx); err == nil {
+			withdrawalOpsCount = len(val.WithdrawalOps())
+		}
 		s.aggregatedPerformance[idx] = ValidatorAggregatedPerformance{
 			startEpoch:   epoch,
 			startBalance: balance,
 		}
 		s.latestPerformance[idx] = ValidatorLatestPerformance{
-			balance: balance,
+			balance:            balance,
+			withdrawalOpsCount: withdrawalOpsCount,
 		}
 	}
 }
@@ -221,7 +238,7 @@ func (s *Service) waitForSync(stateChannel chan *feed.Event, stateSub event.Subs
 // monitorRoutine is the main dispatcher, it registers event channels for the
 // state feed and the operation feed. It then calls the appropriate function
 // when we get messages after syncing a block or processing attestations/sync
-// committee contributions.
+// committee contributions/prevotes.
 func (s *Service) monitorRoutine(stateChannel chan *feed.Event, stateSub event.Subscription) {
 	defer stateSub.Unsubscribe()
 
@@ -271,6 +288,13 @@ func (s *Service) monitorRoutine(stateChannel chan *feed.Event, stateSub event.S
 				} else {
 					s.processSyncCommitteeContribution(data.Contribution)
 				}
+			case operation.PrevoteReceived:
+				data, ok := event.Data.(*operation.PrevoteReceivedData)
+				if !ok {
+					log.Error("Event feed data is not of type *operation.PrevoteReceivedData")
+				} else {
+					s.processPrevote(s.ctx, data.Prevote)
+				}
 			}
 		case <-s.ctx.Done():
 			log.Debug("Context closed, exiting goroutine")
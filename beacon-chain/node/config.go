@@ -141,9 +141,10 @@ func configureDataConfig(cliCtx *cli.Context) {
 	params.OverrideBeaconConfig(bCfg)
 }
 
-func configureRewardLogConfig(cliCtx *cli.Context) {
+func configureRewardsConfig(cliCtx *cli.Context) {
 	bCfg := params.BeaconConfig()
-	bCfg.WriteRewardLogFlag = cliCtx.Bool(cmd.WriteRewardLogFlag.Name)
+	bCfg.SaveRewards = cliCtx.Bool(cmd.SaveRewardsFlag.Name)
+	bCfg.RewardsRetentionEpochs = types.Epoch(cliCtx.Uint64(cmd.RewardsRetentionEpochsFlag.Name))
 	params.OverrideBeaconConfig(bCfg)
 }
 
@@ -100,6 +100,9 @@ type BeaconNode struct {
 	syncCommitteePool       synccommittee.Pool
 	depositCache            *depositcache.DepositCache
 	proposerIdsCache        *cache.ProposerPayloadIDsCache
+	prevoteCache            *cache.PrevoteCache
+	prevoteDecisionCache    *cache.PrevoteDecisionCache
+	prevoteInclusionCache   *cache.PrevoteInclusionCache
 	stateFeed               *event.Feed
 	blockFeed               *event.Feed
 	opFeed                  *event.Feed
@@ -108,6 +111,7 @@ type BeaconNode struct {
 	collector               *bcnodeCollector
 	slasherBlockHeadersFeed *event.Feed
 	slasherAttestationsFeed *event.Feed
+	slasherPrevotesFeed     *event.Feed
 	finalizedStateAtStartUp state.BeaconState
 	serviceFlagOpts         *serviceFlagOpts
 	blockchainFlagOpts      []blockchain.Option
@@ -135,7 +139,7 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 	configureNetwork(cliCtx)
 	configureInteropConfig(cliCtx)
 	configureDataConfig(cliCtx)
-	configureRewardLogConfig(cliCtx)
+	configureRewardsConfig(cliCtx)
 	if err := configureExecutionSetting(cliCtx); err != nil {
 		return nil, err
 	}
@@ -157,14 +161,16 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		opFeed:                  new(event.Feed),
 		attestationPool:         attestations.NewPool(),
 		prevotePool:             prevote.NewPool(),
-		exitPool:                voluntaryexits.NewPool(),
-		withdrawalPool:          withdrawals.NewPool(),
 		slashingsPool:           slashings.NewPool(),
 		syncCommitteePool:       synccommittee.NewPool(),
 		slasherBlockHeadersFeed: new(event.Feed),
 		slasherAttestationsFeed: new(event.Feed),
+		slasherPrevotesFeed:     new(event.Feed),
 		serviceFlagOpts:         &serviceFlagOpts{},
 		proposerIdsCache:        cache.NewProposerPayloadIDsCache(),
+		prevoteCache:            cache.NewPrevoteCache(),
+		prevoteDecisionCache:    cache.NewPrevoteDecisionCache(),
+		prevoteInclusionCache:   cache.NewPrevoteInclusionCache(),
 	}
 
 	for _, opt := range opts {
@@ -182,6 +188,20 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	log.Debugln("Restoring Withdrawal Pool")
+	withdrawalPool, err := withdrawals.NewPersistentPool(ctx, beacon.db)
+	if err != nil {
+		return nil, err
+	}
+	beacon.withdrawalPool = withdrawalPool
+
+	log.Debugln("Restoring Exit Pool")
+	exitPool, err := voluntaryexits.NewPersistentPool(ctx, beacon.db)
+	if err != nil {
+		return nil, err
+	}
+	beacon.exitPool = exitPool
+
 	log.Debugln("Starting Slashing DB")
 	if err := beacon.startSlasherDB(cliCtx); err != nil {
 		return nil, err
@@ -603,6 +623,7 @@ func (b *BeaconNode) registerBlockchainService() error {
 		blockchain.WithSlasherAttestationsFeed(b.slasherAttestationsFeed),
 		blockchain.WithFinalizedStateAtStartUp(b.finalizedStateAtStartUp),
 		blockchain.WithProposerIdsCache(b.proposerIdsCache),
+		blockchain.WithPrevoteInclusionCache(b.prevoteInclusionCache),
 		blockchain.WithPrevotePool(b.prevotePool),
 	)
 	blockchainService, err := blockchain.NewService(b.ctx, opts...)
@@ -681,6 +702,8 @@ func (b *BeaconNode) registerSyncService() error {
 		regularsync.WithStateGen(b.stateGen),
 		regularsync.WithSlasherAttestationsFeed(b.slasherAttestationsFeed),
 		regularsync.WithSlasherBlockHeadersFeed(b.slasherBlockHeadersFeed),
+		regularsync.WithSlasherPrevotesFeed(b.slasherPrevotesFeed),
+		regularsync.WithProposerIdsCache(b.proposerIdsCache),
 	)
 	return b.services.RegisterService(rs)
 }
@@ -717,6 +740,7 @@ func (b *BeaconNode) registerSlasherService() error {
 	slasherSrv, err := slasher.New(b.ctx, &slasher.ServiceConfig{
 		IndexedAttestationsFeed: b.slasherAttestationsFeed,
 		BeaconBlockHeadersFeed:  b.slasherBlockHeadersFeed,
+		IndexedPrevotesFeed:     b.slasherPrevotesFeed,
 		Database:                b.slasherDB,
 		StateNotifier:           b,
 		AttestationStateFetcher: chainService,
@@ -802,6 +826,7 @@ func (b *BeaconNode) registerRPCService() error {
 		CanonicalFetcher:        chainService,
 		ForkFetcher:             chainService,
 		FinalizationFetcher:     chainService,
+		GwatFinalizationFetcher: chainService,
 		BlockReceiver:           chainService,
 		AttestationReceiver:     chainService,
 		GenesisTimeFetcher:      chainService,
@@ -815,6 +840,8 @@ func (b *BeaconNode) registerRPCService() error {
 		SyncCommitteeObjectPool: b.syncCommitteePool,
 		POWChainService:         web3Service,
 		POWChainInfoFetcher:     web3Service,
+		DagEndpointsFetcher:     web3Service,
+		DagCoordStateFetcher:    web3Service,
 		ChainStartFetcher:       chainStartFetcher,
 		MockEth1Votes:           mockEth1DataVotes,
 		SyncService:             syncService,
@@ -827,6 +854,9 @@ func (b *BeaconNode) registerRPCService() error {
 		EnableDebugRPCEndpoints: enableDebugRPCEndpoints,
 		MaxMsgSize:              maxMsgSize,
 		ProposerIdsCache:        b.proposerIdsCache,
+		PrevoteCache:            b.prevoteCache,
+		PrevoteDecisionCache:    b.prevoteDecisionCache,
+		PrevoteInclusionCache:   b.prevoteInclusionCache,
 		ExecutionEngineCaller:   web3Service,
 	})
 
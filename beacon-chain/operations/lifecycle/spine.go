@@ -0,0 +1,147 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package lifecycle
+
+import (
+	"fmt"
+
+	types "github.com/prysmaticlabs/eth2-types"
+)
+
+// SpineStage is a stage of the gwat spine lifecycle.
+type SpineStage uint8
+
+const (
+	// SpineCandidate is reached when the spine is carried by the Eth1Data.Candidates of a block.
+	SpineCandidate SpineStage = iota + 1
+	// SpinePrefix is reached when the spine enters the SpineData.Prefix of a block post state.
+	// It is skipped if the spine is finalized by the block carried it.
+	SpinePrefix
+	// SpineFinalization is reached when the BlockVoting of a block
+	// makes the spine enter the SpineData.Finalization of the block post state.
+	SpineFinalization
+	// SpineSent is reached when the spine is sent to gwat by the dag_finalize call.
+	SpineSent
+)
+
+// String returns the name of the spine stage.
+func (s SpineStage) String() string {
+	switch s {
+	case SpineCandidate:
+		return "candidate"
+	case SpinePrefix:
+		return "prefix"
+	case SpineFinalization:
+		return "finalization"
+	case SpineSent:
+		return "sent"
+	default:
+		return fmt.Sprintf("unknown(%d)", s)
+	}
+}
+
+// SpineRecord is a stage of the lifecycle reached by a spine at the slot.
+// BlockRoot is the root of the block reached the stage, it is not set for the SpineSent.
+// The stages reached by blocks can be recorded several times by blocks of different forks.
+type SpineRecord struct {
+	Stage     SpineStage
+	Slot      types.Slot
+	BlockRoot [32]byte
+}
+
+// Spine is the lifecycle of a gwat spine identified by its hash.
+type Spine struct {
+	Hash    [32]byte
+	Records []*SpineRecord
+}
+
+// Merge adds the records of the other spine, which are not recorded yet, to the spine.
+// The stages reached by blocks are recorded once per block, SpineSent is recorded once.
+// Returns true if any record is added.
+func (sp *Spine) Merge(other *Spine) bool {
+	added := false
+	for _, r := range other.Records {
+		if sp.HasRecord(r) {
+			continue
+		}
+		sp.Records = append(sp.Records, &SpineRecord{Stage: r.Stage, Slot: r.Slot, BlockRoot: r.BlockRoot})
+		added = true
+	}
+	return added
+}
+
+// HasRecord returns true if the stage of the record is already reached by the block of the record
+// or, for SpineSent, if the spine is already sent.
+func (sp *Spine) HasRecord(r *SpineRecord) bool {
+	for _, itm := range sp.Records {
+		if itm.Stage != r.Stage {
+			continue
+		}
+		if r.Stage == SpineSent || itm.BlockRoot == r.BlockRoot {
+			return true
+		}
+	}
+	return false
+}
+
+// Canonical returns the lifecycle of the spine on the canonical chain:
+// each stage reached by blocks is represented by the earliest record of a canonical block.
+func (sp *Spine) Canonical(isCanonical func(blockRoot [32]byte) (bool, error)) (*Spine, error) {
+	res := &Spine{Hash: sp.Hash, Records: make([]*SpineRecord, 0, len(sp.Records))}
+	earliest := make(map[SpineStage]int)
+	for _, r := range sp.Records {
+		if r.Stage != SpineSent {
+			ok, err := isCanonical(r.BlockRoot)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+		}
+		i, ok := earliest[r.Stage]
+		if !ok {
+			earliest[r.Stage] = len(res.Records)
+			res.Records = append(res.Records, r)
+			continue
+		}
+		if r.Slot < res.Records[i].Slot {
+			res.Records[i] = r
+		}
+	}
+	return res, nil
+}
+
+// Status returns the latest stage reached by the spine.
+func (sp *Spine) Status() SpineStage {
+	var status SpineStage
+	for _, r := range sp.Records {
+		if r.Stage > status {
+			status = r.Stage
+		}
+	}
+	return status
+}
+
+// LatestSlot returns the slot of the latest record of the spine.
+func (sp *Spine) LatestSlot() types.Slot {
+	var slot types.Slot
+	for _, r := range sp.Records {
+		if r.Slot > slot {
+			slot = r.Slot
+		}
+	}
+	return slot
+}
@@ -0,0 +1,155 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+// Package lifecycle defines the lifecycle records of the operations initiated by gwat transactions
+// (withdrawals and exits), which are indexed by the init tx hash of the operation,
+// and of the gwat spines coordinated by the dag consensus, which are indexed by the spine hash.
+package lifecycle
+
+import (
+	"fmt"
+
+	types "github.com/prysmaticlabs/eth2-types"
+)
+
+// OpType is the type of operation initiated by a gwat transaction.
+type OpType uint8
+
+const (
+	// OpWithdrawal is a withdrawal initiated by a gwat withdrawal log.
+	OpWithdrawal OpType = iota + 1
+	// OpExit is a voluntary exit initiated by a gwat exit request log.
+	OpExit
+)
+
+// String returns the name of the operation type.
+func (t OpType) String() string {
+	switch t {
+	case OpWithdrawal:
+		return "withdrawal"
+	case OpExit:
+		return "exit"
+	default:
+		return fmt.Sprintf("unknown(%d)", t)
+	}
+}
+
+// Stage is a stage of the operation lifecycle.
+type Stage uint8
+
+const (
+	// StageSeen is reached when the gwat log of the operation is processed.
+	StageSeen Stage = iota + 1
+	// StagePooled is reached when the operation is inserted into the operation pool.
+	StagePooled
+	// StageIncluded is reached when the operation is included in a block.
+	// It can be reached several times by inclusions in blocks of different forks.
+	StageIncluded
+	// StageApplied is reached when the operation is applied to the post state of the block included it.
+	// It can be reached several times by blocks of different forks.
+	StageApplied
+	// StagePruned is reached when the WithdrawalOp of the operation is cleaned from the state
+	// after CleanWithdrawalsAftEpochs.
+	StagePruned
+)
+
+// String returns the name of the stage.
+func (s Stage) String() string {
+	switch s {
+	case StageSeen:
+		return "seen"
+	case StagePooled:
+		return "pooled"
+	case StageIncluded:
+		return "included"
+	case StageApplied:
+		return "applied"
+	case StagePruned:
+		return "pruned"
+	default:
+		return fmt.Sprintf("unknown(%d)", s)
+	}
+}
+
+// IsBlockStage returns true if the stage is reached by a block and is recorded once per block.
+func (s Stage) IsBlockStage() bool {
+	return s == StageIncluded || s == StageApplied
+}
+
+// Record is a stage of the lifecycle reached by an operation at the slot.
+// BlockRoot is set for the block stages only.
+type Record struct {
+	Stage     Stage
+	Slot      types.Slot
+	BlockRoot [32]byte
+}
+
+// Operation is the lifecycle of an operation identified by the hash of the gwat transaction initiated it.
+type Operation struct {
+	InitTxHash     [32]byte
+	Type           OpType
+	ValidatorIndex types.ValidatorIndex
+	Amount         uint64
+	Records        []*Record
+}
+
+// Merge adds the records of the other operation, which are not reached yet, to the operation.
+// Each stage is recorded once, except the block stages which are recorded once per block.
+// Returns true if any record is added.
+func (o *Operation) Merge(other *Operation) bool {
+	added := false
+	for _, r := range other.Records {
+		if o.HasRecord(r) {
+			continue
+		}
+		o.Records = append(o.Records, &Record{Stage: r.Stage, Slot: r.Slot, BlockRoot: r.BlockRoot})
+		added = true
+	}
+	return added
+}
+
+// HasRecord returns true if the stage of the record is already reached
+// or, for the block stages, if the stage is already reached by the block of the record.
+func (o *Operation) HasRecord(r *Record) bool {
+	for _, itm := range o.Records {
+		if itm.Stage != r.Stage {
+			continue
+		}
+		if !r.Stage.IsBlockStage() || itm.BlockRoot == r.BlockRoot {
+			return true
+		}
+	}
+	return false
+}
+
+// HasStage returns true if the stage is reached by the operation.
+func (o *Operation) HasStage(stage Stage) bool {
+	for _, itm := range o.Records {
+		if itm.Stage == stage {
+			return true
+		}
+	}
+	return false
+}
+
+// Status returns the latest stage reached by the operation.
+func (o *Operation) Status() Stage {
+	var status Stage
+	for _, r := range o.Records {
+		if r.Stage > status {
+			status = r.Stage
+		}
+	}
+	return status
+}
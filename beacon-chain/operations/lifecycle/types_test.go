@@ -0,0 +1,89 @@
+package lifecycle
+
+import (
+	"testing"
+
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+)
+
+func TestOperation_Merge(t *testing.T) {
+	op := &Operation{
+		Type: OpWithdrawal,
+		Records: []*Record{
+			{Stage: StageSeen, Slot: 10},
+			{Stage: StagePooled, Slot: 10},
+		},
+	}
+	require.Equal(t, StagePooled, op.Status())
+
+	added := op.Merge(&Operation{Records: []*Record{
+		{Stage: StageSeen, Slot: 12},
+		{Stage: StageIncluded, Slot: 14, BlockRoot: [32]byte{'a'}},
+	}})
+	require.Equal(t, true, added)
+	require.Equal(t, 3, len(op.Records))
+	require.Equal(t, StageIncluded, op.Status())
+
+	// inclusion in another block is recorded
+	require.Equal(t, true, op.Merge(&Operation{Records: []*Record{{Stage: StageIncluded, Slot: 15, BlockRoot: [32]byte{'b'}}}}))
+	require.Equal(t, false, op.Merge(&Operation{Records: []*Record{{Stage: StageIncluded, Slot: 15, BlockRoot: [32]byte{'b'}}}}))
+	require.Equal(t, 4, len(op.Records))
+
+	require.Equal(t, true, op.Merge(&Operation{Records: []*Record{{Stage: StageApplied, Slot: 15, BlockRoot: [32]byte{'b'}}}}))
+	require.Equal(t, false, op.Merge(&Operation{Records: []*Record{{Stage: StageApplied, Slot: 15, BlockRoot: [32]byte{'b'}}}}))
+	require.Equal(t, StageApplied, op.Status())
+
+	require.Equal(t, true, op.Merge(&Operation{Records: []*Record{{Stage: StagePruned, Slot: 20}}}))
+	require.Equal(t, false, op.Merge(&Operation{Records: []*Record{{Stage: StagePruned, Slot: 24}}}))
+}
+
+func TestStage_String(t *testing.T) {
+	require.Equal(t, "seen", StageSeen.String())
+	require.Equal(t, "pruned", StagePruned.String())
+	require.Equal(t, "unknown(10)", Stage(10).String())
+	require.Equal(t, "exit", OpExit.String())
+	require.Equal(t, true, StageApplied.IsBlockStage())
+	require.Equal(t, false, StagePooled.IsBlockStage())
+}
+
+func TestSpine_Merge(t *testing.T) {
+	sp := &Spine{Records: []*SpineRecord{{Stage: SpineCandidate, Slot: 10, BlockRoot: [32]byte{'a'}}}}
+	require.Equal(t, SpineCandidate, sp.Status())
+
+	// the stage reached by a block of another fork is recorded
+	added := sp.Merge(&Spine{Records: []*SpineRecord{
+		{Stage: SpineCandidate, Slot: 11, BlockRoot: [32]byte{'b'}},
+		{Stage: SpinePrefix, Slot: 11, BlockRoot: [32]byte{'b'}},
+	}})
+	require.Equal(t, true, added)
+	require.Equal(t, 3, len(sp.Records))
+	require.Equal(t, false, sp.Merge(&Spine{Records: []*SpineRecord{{Stage: SpinePrefix, Slot: 11, BlockRoot: [32]byte{'b'}}}}))
+
+	require.Equal(t, true, sp.Merge(&Spine{Records: []*SpineRecord{{Stage: SpineSent, Slot: 14}}}))
+	require.Equal(t, false, sp.Merge(&Spine{Records: []*SpineRecord{{Stage: SpineSent, Slot: 15}}}))
+	require.Equal(t, SpineSent, sp.Status())
+	require.Equal(t, "finalization", SpineFinalization.String())
+	require.Equal(t, "unknown(7)", SpineStage(7).String())
+}
+
+func TestSpine_Canonical(t *testing.T) {
+	sp := &Spine{Hash: [32]byte{'s'}, Records: []*SpineRecord{
+		{Stage: SpineCandidate, Slot: 10, BlockRoot: [32]byte{'a'}},
+		{Stage: SpineCandidate, Slot: 11, BlockRoot: [32]byte{'b'}},
+		{Stage: SpinePrefix, Slot: 12, BlockRoot: [32]byte{'c'}},
+		{Stage: SpineFinalization, Slot: 13, BlockRoot: [32]byte{'d'}},
+		{Stage: SpinePrefix, Slot: 11, BlockRoot: [32]byte{'b'}},
+		{Stage: SpineSent, Slot: 14},
+	}}
+	canonical := map[[32]byte]bool{{'b'}: true, {'c'}: true}
+	res, err := sp.Canonical(func(root [32]byte) (bool, error) {
+		return canonical[root], nil
+	})
+	require.NoError(t, err)
+	require.DeepEqual(t, &Spine{Hash: [32]byte{'s'}, Records: []*SpineRecord{
+		{Stage: SpineCandidate, Slot: 11, BlockRoot: [32]byte{'b'}},
+		{Stage: SpinePrefix, Slot: 11, BlockRoot: [32]byte{'b'}},
+		{Stage: SpineSent, Slot: 14},
+	}}, res)
+	require.Equal(t, SpineSent, res.Status())
+}
@@ -17,6 +17,7 @@ package prevote
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/patrickmn/go-cache"
 	"github.com/pkg/errors"
@@ -135,6 +136,28 @@ func (c *PrevoteCache) GetPrevoteBySlot(ctx context.Context, slot types.Slot) []
 	return []*ethpb.PreVote{}
 }
 
+// GetPrevotes returns all the prevotes of the pool sorted by slot.
+func (c *PrevoteCache) GetPrevotes(ctx context.Context) []*ethpb.PreVote {
+	_, span := trace.StartSpan(ctx, "operations.prevote.GetPrevotes")
+	defer span.End()
+
+	c.prevoteCacheLock.RLock()
+	defer c.prevoteCacheLock.RUnlock()
+
+	pvSlots := make([]types.Slot, 0, len(c.prevoteCache))
+	for slot := range c.prevoteCache {
+		pvSlots = append(pvSlots, slot)
+	}
+	sort.Slice(pvSlots, func(i, j int) bool {
+		return pvSlots[i] < pvSlots[j]
+	})
+	res := make([]*ethpb.PreVote, 0, len(pvSlots))
+	for _, slot := range pvSlots {
+		res = append(res, c.prevoteCache[slot]...)
+	}
+	return res
+}
+
 func (c *PrevoteCache) PurgeOutdatedPrevote(curSlot types.Slot) error {
 	c.prevoteCacheLock.RLock()
 	defer c.prevoteCacheLock.RUnlock()
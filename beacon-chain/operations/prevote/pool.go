@@ -25,6 +25,7 @@ type Pool interface {
 	HasPrevote(att *ethpb.PreVote) (bool, error)
 	SavePrevote(att *ethpb.PreVote) error
 	GetPrevoteBySlot(ctx context.Context, slot types.Slot) []*ethpb.PreVote
+	GetPrevotes(ctx context.Context) []*ethpb.PreVote
 	PurgeOutdatedPrevote(curSlot types.Slot) error
 }
 
@@ -14,12 +14,12 @@ type PoolMock struct {
 	Exits []*eth.VoluntaryExit
 }
 
-func (m *PoolMock) OnSlot(st state.ReadOnlyBeaconState) {
+func (m *PoolMock) OnSlot(_ context.Context, _ state.ReadOnlyBeaconState) {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (m *PoolMock) Verify(exit *eth.VoluntaryExit) error {
+func (m *PoolMock) Verify(_ context.Context, _ *eth.VoluntaryExit) error {
 	//TODO implement me
 	panic("implement me")
 }
@@ -7,6 +7,7 @@ import (
 	"sort"
 	"sync"
 
+	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	log "github.com/sirupsen/logrus"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/state"
@@ -22,16 +23,26 @@ type PoolManager interface {
 	PendingExits(state state.ReadOnlyBeaconState, slot types.Slot, noLimit bool) []*ethpb.VoluntaryExit
 	InsertVoluntaryExitByGwat(ctx context.Context, exit *ethpb.VoluntaryExit)
 	MarkIncluded(exit *ethpb.VoluntaryExit)
-	OnSlot(st state.ReadOnlyBeaconState)
-	Verify(exit *ethpb.VoluntaryExit) error
+	OnSlot(ctx context.Context, st state.ReadOnlyBeaconState)
+	Verify(ctx context.Context, exit *ethpb.VoluntaryExit) error
 	// Deprecated
 	InsertVoluntaryExit(ctx context.Context, state state.ReadOnlyBeaconState, exit *ethpb.VoluntaryExit)
 }
 
+// PoolStore persists the gwat initiated exits of the pool,
+// so they survive the node restarts and verify the blocks carrying exits already included.
+type PoolStore interface {
+	ExitPool(ctx context.Context) ([]*ethpb.VoluntaryExit, error)
+	ExitPoolItem(ctx context.Context, initTxHash []byte) (*ethpb.VoluntaryExit, error)
+	SaveExitPoolItems(ctx context.Context, exits []*ethpb.VoluntaryExit) error
+	DeleteExitPoolItems(ctx context.Context, initTxHashes [][]byte) error
+}
+
 // Pool is a concrete implementation of PoolManager.
 type Pool struct {
 	lock    sync.RWMutex
 	pending []*ethpb.VoluntaryExit
+	store   PoolStore
 }
 
 // NewPool accepts a head fetcher (for reading the validator set) and returns an initialized
@@ -42,6 +53,39 @@ func NewPool() *Pool {
 	}
 }
 
+// NewPersistentPool returns a voluntary exit pool backed by the given store.
+// The exits saved in the store are loaded into the pool, the already included ones
+// are removed by OnSlot as their validators are exited.
+func NewPersistentPool(ctx context.Context, store PoolStore) (*Pool, error) {
+	stored, err := store.ExitPool(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load exit pool")
+	}
+	sort.Slice(stored, func(i, j int) bool {
+		if stored[i].ValidatorIndex == stored[j].ValidatorIndex {
+			return stored[i].Epoch < stored[j].Epoch
+		}
+		return stored[i].ValidatorIndex < stored[j].ValidatorIndex
+	})
+	// Malformed items are dropped, as they could not be inserted into the pool.
+	// The exit with the earliest epoch is taken for a validator, as by the insertion.
+	pending := make([]*ethpb.VoluntaryExit, 0, len(stored))
+	for _, itm := range stored {
+		if itm.InitTxHash == nil {
+			continue
+		}
+		if len(pending) > 0 && pending[len(pending)-1].ValidatorIndex == itm.ValidatorIndex {
+			continue
+		}
+		pending = append(pending, itm)
+	}
+	log.WithField("count", len(pending)).Info("ExitPool pool: restored exits")
+	return &Pool{
+		pending: pending,
+		store:   store,
+	}, nil
+}
+
 // PendingExits returns exits that are ready for inclusion at the given slot. This method will not
 // return more than the block enforced MaxVoluntaryExits.
 func (p *Pool) PendingExits(state state.ReadOnlyBeaconState, slot types.Slot, noLimit bool) []*ethpb.VoluntaryExit {
@@ -134,6 +178,7 @@ func (p *Pool) InsertVoluntaryExitByGwat(ctx context.Context, exit *ethpb.Volunt
 	if existsInPending {
 		if exit.Epoch < p.pending[index].Epoch {
 			p.pending[index] = exit
+			p.persist(ctx, exit)
 		}
 		return
 	}
@@ -143,11 +188,13 @@ func (p *Pool) InsertVoluntaryExitByGwat(ctx context.Context, exit *ethpb.Volunt
 	sort.Slice(p.pending, func(i, j int) bool {
 		return p.pending[i].ValidatorIndex < p.pending[j].ValidatorIndex
 	})
+	p.persist(ctx, exit)
 }
 
 // MarkIncluded is used when an exit has been included in a beacon block. Every block seen by this
 // node should call this method to include the exit. This will remove the exit from
-// the pending exits slice.
+// the pending exits slice. The persisted exit is kept to verify the other blocks carrying it,
+// it is removed by OnSlot once the validator exit is finalized.
 func (p *Pool) MarkIncluded(exit *ethpb.VoluntaryExit) {
 	p.lock.Lock()
 	defer p.lock.Unlock()
@@ -158,14 +205,27 @@ func (p *Pool) MarkIncluded(exit *ethpb.VoluntaryExit) {
 	}
 }
 
-func (p *Pool) Verify(exit *ethpb.VoluntaryExit) error {
+// Verify checks the exit matches the one of the pool, the exits removed from the pending list
+// are looked up in the pool store.
+func (p *Pool) Verify(ctx context.Context, exit *ethpb.VoluntaryExit) error {
 	p.lock.Lock()
 	defer p.lock.Unlock()
-	exists, index := existsInList(p.pending, exit.ValidatorIndex)
-	if !exists {
+	var poolItm *ethpb.VoluntaryExit
+	if exists, index := existsInList(p.pending, exit.ValidatorIndex); exists {
+		poolItm = p.pending[index]
+	}
+	if (poolItm == nil || !bytes.Equal(poolItm.InitTxHash, exit.InitTxHash)) && p.store != nil && len(exit.InitTxHash) > 0 {
+		stored, err := p.store.ExitPoolItem(ctx, exit.InitTxHash)
+		if err != nil {
+			return errors.Wrap(err, "could not get stored exit")
+		}
+		if stored != nil {
+			poolItm = stored
+		}
+	}
+	if poolItm == nil {
 		return fmt.Errorf("not found")
 	}
-	poolItm := p.pending[index]
 
 	//if poolItm.Epoch != exit.Epoch {
 	//	return fmt.Errorf("mismatch epochs pool=%d received=%d", poolItm.Epoch, exit.Epoch)
@@ -190,8 +250,9 @@ func existsInList(pending []*ethpb.VoluntaryExit, searchingFor types.ValidatorIn
 	return false, -1
 }
 
-// OnSlot removes invalid items from pool
-func (p *Pool) OnSlot(st state.ReadOnlyBeaconState) {
+// OnSlot removes invalid items from pool.
+// At the epoch start the persisted exits of the validators exited by finalized epochs are removed.
+func (p *Pool) OnSlot(ctx context.Context, st state.ReadOnlyBeaconState) {
 	p.lock.Lock()
 	defer p.lock.Unlock()
 
@@ -202,6 +263,47 @@ func (p *Pool) OnSlot(st state.ReadOnlyBeaconState) {
 		}
 	}
 	p.pending = pending
+
+	if p.store != nil && slots.IsEpochStart(st.Slot()) {
+		p.pruneStored(ctx, st)
+	}
+}
+
+// pruneStored deletes the persisted exits whose validators exit epochs are finalized,
+// no block to verify can carry them.
+func (p *Pool) pruneStored(ctx context.Context, st state.ReadOnlyBeaconState) {
+	stored, err := p.store.ExitPool(ctx)
+	if err != nil {
+		log.WithError(err).Error("ExitPool pool: could not load persisted items")
+		return
+	}
+	finalizedEpoch := st.FinalizedCheckpointEpoch()
+	hashes := make([][]byte, 0)
+	for _, itm := range stored {
+		v, err := st.ValidatorAtIndexReadOnly(itm.ValidatorIndex)
+		if err != nil || v == nil {
+			continue
+		}
+		if v.ExitEpoch() != params.BeaconConfig().FarFutureEpoch && v.ExitEpoch() <= finalizedEpoch {
+			hashes = append(hashes, itm.InitTxHash)
+		}
+	}
+	if len(hashes) == 0 {
+		return
+	}
+	if err := p.store.DeleteExitPoolItems(ctx, hashes); err != nil {
+		log.WithError(err).WithField("count", len(hashes)).Error("ExitPool pool: could not delete persisted items")
+	}
+}
+
+// persist saves the given items to the pool store if it is set.
+func (p *Pool) persist(ctx context.Context, items ...*ethpb.VoluntaryExit) {
+	if p.store == nil || len(items) == 0 {
+		return
+	}
+	if err := p.store.SaveExitPoolItems(ctx, items); err != nil {
+		log.WithError(err).WithField("count", len(items)).Error("ExitPool pool: could not persist items")
+	}
 }
 
 func validateVoluntaryExit(itm *ethpb.VoluntaryExit, st state.ReadOnlyBeaconState) bool {
@@ -487,3 +487,94 @@ func TestPool_PendingExits(t *testing.T) {
 		})
 	}
 }
+
+type mockPoolStore struct {
+	items map[string]*ethpb.VoluntaryExit
+}
+
+func (s *mockPoolStore) ExitPool(_ context.Context) ([]*ethpb.VoluntaryExit, error) {
+	res := make([]*ethpb.VoluntaryExit, 0, len(s.items))
+	for _, e := range s.items {
+		res = append(res, e)
+	}
+	return res, nil
+}
+
+func (s *mockPoolStore) ExitPoolItem(_ context.Context, initTxHash []byte) (*ethpb.VoluntaryExit, error) {
+	return s.items[string(initTxHash)], nil
+}
+
+func (s *mockPoolStore) SaveExitPoolItems(_ context.Context, exits []*ethpb.VoluntaryExit) error {
+	for _, e := range exits {
+		s.items[string(e.InitTxHash)] = e
+	}
+	return nil
+}
+
+func (s *mockPoolStore) DeleteExitPoolItems(_ context.Context, initTxHashes [][]byte) error {
+	for _, h := range initTxHashes {
+		delete(s.items, string(h))
+	}
+	return nil
+}
+
+func TestPool_Persistence(t *testing.T) {
+	ctx := context.Background()
+	store := &mockPoolStore{items: map[string]*ethpb.VoluntaryExit{
+		string([]byte{3}): {InitTxHash: []byte{3}, ValidatorIndex: 3, Epoch: 3},
+	}}
+
+	p, err := NewPersistentPool(ctx, store)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(p.pending))
+
+	p.InsertVoluntaryExitByGwat(ctx, &ethpb.VoluntaryExit{InitTxHash: []byte{1}, ValidatorIndex: 1, Epoch: 1})
+	p.InsertVoluntaryExitByGwat(ctx, &ethpb.VoluntaryExit{InitTxHash: []byte{2}, ValidatorIndex: 2, Epoch: 2})
+	require.Equal(t, 3, len(store.items))
+
+	// The included exit is kept in the store to verify the other blocks carrying it.
+	included := &ethpb.VoluntaryExit{InitTxHash: []byte{2}, ValidatorIndex: 2, Epoch: 2}
+	p.MarkIncluded(included)
+	require.Equal(t, 2, len(p.pending))
+	require.Equal(t, 3, len(store.items))
+	require.NoError(t, p.Verify(ctx, included))
+	require.ErrorContains(t, "mismatch init tx hashes", p.Verify(ctx, &ethpb.VoluntaryExit{InitTxHash: []byte{4}, ValidatorIndex: 1}))
+	require.ErrorContains(t, "not found", p.Verify(ctx, &ethpb.VoluntaryExit{InitTxHash: []byte{5}, ValidatorIndex: 5}))
+
+	// A pool created after a restart contains the persisted items in validator index order.
+	restored, err := NewPersistentPool(ctx, store)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(restored.pending))
+	require.DeepEqual(t, []byte{1}, restored.pending[0].InitTxHash)
+	require.DeepEqual(t, []byte{2}, restored.pending[1].InitTxHash)
+	require.DeepEqual(t, []byte{3}, restored.pending[2].InitTxHash)
+}
+
+func TestPool_OnSlot_PrunesFinalizedExits(t *testing.T) {
+	ctx := context.Background()
+	store := &mockPoolStore{items: map[string]*ethpb.VoluntaryExit{
+		string([]byte{0}): {InitTxHash: []byte{0}, ValidatorIndex: 0, Epoch: 1},
+		string([]byte{1}): {InitTxHash: []byte{1}, ValidatorIndex: 1, Epoch: 1},
+		string([]byte{2}): {InitTxHash: []byte{2}, ValidatorIndex: 2, Epoch: 1},
+	}}
+	p, err := NewPersistentPool(ctx, store)
+	require.NoError(t, err)
+
+	st, err := v1.InitializeFromProto(&ethpb.BeaconState{
+		Slot: params.BeaconConfig().SlotsPerEpoch * 10,
+		Validators: []*ethpb.Validator{
+			{ExitEpoch: 5},
+			{ExitEpoch: 12},
+			{ExitEpoch: params.BeaconConfig().FarFutureEpoch},
+		},
+		FinalizedCheckpoint: &ethpb.Checkpoint{Epoch: 8, Root: make([]byte, 32)},
+	})
+	require.NoError(t, err)
+	p.OnSlot(ctx, st)
+
+	require.Equal(t, 1, len(p.pending))
+	require.Equal(t, types.ValidatorIndex(2), p.pending[0].ValidatorIndex)
+	require.Equal(t, 2, len(store.items))
+	_, ok := store.items[string([]byte{0})]
+	require.Equal(t, false, ok)
+}
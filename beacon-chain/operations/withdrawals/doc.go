@@ -1,5 +1,6 @@
 // Package withdrawals defines an in-memory pool of received
 // withdrawals events by the beacon node, handling their lifecycle
 // and performing integrity checks before serving them as objects
-// for validators to include in blocks.
+// for validators to include in blocks. The pool can be backed by
+// a store to keep the pending withdrawals across restarts.
 package withdrawals
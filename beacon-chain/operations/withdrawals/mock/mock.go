@@ -43,7 +43,7 @@ func (m *PoolMock) InsertWithdrawal(_ context.Context, withdrawal *eth.Withdrawa
 }
 
 // MarkIncluded --
-func (m *PoolMock) MarkIncluded(withdrawal *eth.Withdrawal) {
+func (m *PoolMock) MarkIncluded(_ context.Context, withdrawal *eth.Withdrawal) {
 	res := make([]*eth.Withdrawal, 0, len(m.Withdrawals))
 	for _, w := range m.Withdrawals {
 		if bytes.Equal(w.InitTxHash, withdrawal.InitTxHash) {
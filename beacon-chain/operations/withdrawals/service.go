@@ -22,6 +22,7 @@ import (
 	"sort"
 	"sync"
 
+	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	log "github.com/sirupsen/logrus"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/helpers"
@@ -38,15 +39,24 @@ import (
 type PoolManager interface {
 	PendingWithdrawals(slot types.Slot, st state.ReadOnlyBeaconState, noLimit bool) []*ethpb.Withdrawal
 	InsertWithdrawal(ctx context.Context, withdrawal *ethpb.Withdrawal)
-	MarkIncluded(withdrawal *ethpb.Withdrawal)
-	OnSlot(st state.ReadOnlyBeaconState)
+	MarkIncluded(ctx context.Context, withdrawal *ethpb.Withdrawal)
+	OnSlot(ctx context.Context, st state.ReadOnlyBeaconState)
 	Verify(withdrawal *ethpb.Withdrawal) error
 }
 
+// PoolStore persists the pending withdrawals of the pool,
+// so they survive the node restarts.
+type PoolStore interface {
+	WithdrawalPool(ctx context.Context) ([]*ethpb.Withdrawal, error)
+	SaveWithdrawalPoolItems(ctx context.Context, withdrawals []*ethpb.Withdrawal) error
+	DeleteWithdrawalPoolItems(ctx context.Context, initTxHashes [][]byte) error
+}
+
 // Pool is a concrete implementation of PoolManager.
 type Pool struct {
 	lock    sync.RWMutex
 	pending []*ethpb.Withdrawal
+	store   PoolStore
 }
 
 // NewPool accepts a head fetcher (for reading the validator set) and returns an initialized
@@ -57,6 +67,31 @@ func NewPool() *Pool {
 	}
 }
 
+// NewPersistentPool returns a withdrawals pool backed by the given store.
+// The pending withdrawals saved in the store are loaded into the pool.
+func NewPersistentPool(ctx context.Context, store PoolStore) (*Pool, error) {
+	pending, err := store.WithdrawalPool(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load withdrawal pool")
+	}
+	// Malformed items are dropped, as they could not be inserted into the pool.
+	valid := make([]*ethpb.Withdrawal, 0, len(pending))
+	for _, itm := range pending {
+		if itm.InitTxHash == nil {
+			continue
+		}
+		valid = append(valid, itm)
+	}
+	sort.Slice(valid, func(i, j int) bool {
+		return valid[i].Epoch < valid[j].Epoch
+	})
+	log.WithField("count", len(valid)).Info("WithdrawalPool pool: restored pending withdrawals")
+	return &Pool{
+		pending: valid,
+		store:   store,
+	}, nil
+}
+
 // PendingWithdrawals returns withdrawals that are ready for inclusion at the given slot. This method will not
 // return more than the block enforced MaxWithdrawals.
 func (p *Pool) PendingWithdrawals(slot types.Slot, st state.ReadOnlyBeaconState, noLimit bool) []*ethpb.Withdrawal {
@@ -173,18 +208,20 @@ func (p *Pool) InsertWithdrawal(ctx context.Context, withdrawal *ethpb.Withdrawa
 	sort.Slice(p.pending, func(i, j int) bool {
 		return p.pending[i].Epoch < p.pending[j].Epoch
 	})
+	p.persist(ctx, withdrawal)
 }
 
 // MarkIncluded is used when an withdrawal has been included in a beacon block. Every block seen by this
 // node should call this method to include the withdrawal. This will remove the withdrawal from
 // the pending withdrawals slice.
-func (p *Pool) MarkIncluded(withdrawal *ethpb.Withdrawal) {
+func (p *Pool) MarkIncluded(ctx context.Context, withdrawal *ethpb.Withdrawal) {
 	p.lock.Lock()
 	defer p.lock.Unlock()
 	exists, index := existsInList(p.pending, withdrawal)
 	if exists {
 		// WithdrawalPool we want is present at p.pending[index], so we remove it.
 		p.pending = append(p.pending[:index], p.pending[index+1:]...)
+		p.unpersist(ctx, withdrawal)
 	}
 }
 
@@ -229,19 +266,21 @@ func existsInList(pending []*ethpb.Withdrawal, withdrawal *ethpb.Withdrawal) (bo
 }
 
 // OnSlot removes invalid items from pool
-func (p *Pool) OnSlot(st state.ReadOnlyBeaconState) {
+func (p *Pool) OnSlot(ctx context.Context, st state.ReadOnlyBeaconState) {
 	p.lock.Lock()
 	defer p.lock.Unlock()
 
 	// check validator activation
-	p.handleValidatorActivation(st)
+	activated := p.handleValidatorActivation(st)
 
 	// remove invalid or stale items
 	pending := make([]*ethpb.Withdrawal, 0, len(p.pending))
+	removed := make([]*ethpb.Withdrawal, 0)
 	for _, itm := range p.pending {
 		if err := validateWithdrawal(itm, st); err == nil {
 			pending = append(pending, itm)
 		} else {
+			removed = append(removed, itm)
 			log.WithError(err).WithFields(log.Fields{
 				"VIndex":     fmt.Sprintf("%d", itm.ValidatorIndex),
 				"PublicKey":  fmt.Sprintf("%#x", itm.PublicKey),
@@ -252,10 +291,14 @@ func (p *Pool) OnSlot(st state.ReadOnlyBeaconState) {
 		}
 	}
 	p.pending = pending
+	p.persist(ctx, activated...)
+	p.unpersist(ctx, removed...)
 }
 
-// handleValidatorActivation set validator index for activated validators.
-func (p *Pool) handleValidatorActivation(st state.ReadOnlyBeaconState) {
+// handleValidatorActivation set validator index for activated validators
+// and returns the updated items.
+func (p *Pool) handleValidatorActivation(st state.ReadOnlyBeaconState) []*ethpb.Withdrawal {
+	activated := make([]*ethpb.Withdrawal, 0)
 	for i, itm := range p.pending {
 		if itm.ValidatorIndex != math.MaxUint64 {
 			continue
@@ -270,8 +313,34 @@ func (p *Pool) handleValidatorActivation(st state.ReadOnlyBeaconState) {
 				"Amount":     fmt.Sprintf("%d", itm.Amount),
 				"InitTxHash": fmt.Sprintf("%#x", itm.InitTxHash),
 			}).Info("WithdrawalPool pool: validator activation")
+			activated = append(activated, p.pending[i])
 		}
 	}
+	return activated
+}
+
+// persist saves the given items to the pool store if it is set.
+func (p *Pool) persist(ctx context.Context, items ...*ethpb.Withdrawal) {
+	if p.store == nil || len(items) == 0 {
+		return
+	}
+	if err := p.store.SaveWithdrawalPoolItems(ctx, items); err != nil {
+		log.WithError(err).WithField("count", len(items)).Error("WithdrawalPool pool: could not persist items")
+	}
+}
+
+// unpersist deletes the given items from the pool store if it is set.
+func (p *Pool) unpersist(ctx context.Context, items ...*ethpb.Withdrawal) {
+	if p.store == nil || len(items) == 0 {
+		return
+	}
+	hashes := make([][]byte, len(items))
+	for i, itm := range items {
+		hashes[i] = itm.InitTxHash
+	}
+	if err := p.store.DeleteWithdrawalPoolItems(ctx, hashes); err != nil {
+		log.WithError(err).WithField("count", len(items)).Error("WithdrawalPool pool: could not delete persisted items")
+	}
 }
 
 func validateWithdrawal(itm *ethpb.Withdrawal, st state.ReadOnlyBeaconState) error {
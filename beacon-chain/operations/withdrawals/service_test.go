@@ -319,7 +319,7 @@ func TestPool_MarkIncluded(t *testing.T) {
 			p := &Pool{
 				pending: tt.fields.pending,
 			}
-			p.MarkIncluded(tt.args.withdrawal)
+			p.MarkIncluded(context.Background(), tt.args.withdrawal)
 			if len(p.pending) != len(tt.want.pending) {
 				t.Fatalf("Mismatched lengths of pending list. Got %d, wanted %d.", len(p.pending), len(tt.want.pending))
 			}
@@ -465,3 +465,56 @@ func TestPool_PendingWithdrawals(t *testing.T) {
 		})
 	}
 }
+
+type mockPoolStore struct {
+	items map[string]*ethpb.Withdrawal
+}
+
+func (s *mockPoolStore) WithdrawalPool(_ context.Context) ([]*ethpb.Withdrawal, error) {
+	res := make([]*ethpb.Withdrawal, 0, len(s.items))
+	for _, w := range s.items {
+		res = append(res, w)
+	}
+	return res, nil
+}
+
+func (s *mockPoolStore) SaveWithdrawalPoolItems(_ context.Context, withdrawals []*ethpb.Withdrawal) error {
+	for _, w := range withdrawals {
+		s.items[string(w.InitTxHash)] = w
+	}
+	return nil
+}
+
+func (s *mockPoolStore) DeleteWithdrawalPoolItems(_ context.Context, initTxHashes [][]byte) error {
+	for _, h := range initTxHashes {
+		delete(s.items, string(h))
+	}
+	return nil
+}
+
+func TestPool_Persistence(t *testing.T) {
+	ctx := context.Background()
+	store := &mockPoolStore{items: map[string]*ethpb.Withdrawal{
+		string([]byte{3}): {InitTxHash: []byte{3}, Epoch: 3},
+	}}
+
+	p, err := NewPersistentPool(ctx, store)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(p.pending))
+
+	p.InsertWithdrawal(ctx, &ethpb.Withdrawal{InitTxHash: []byte{1}, Epoch: 1})
+	p.InsertWithdrawal(ctx, &ethpb.Withdrawal{InitTxHash: []byte{2}, Epoch: 2})
+	require.Equal(t, 3, len(store.items))
+
+	p.MarkIncluded(ctx, &ethpb.Withdrawal{InitTxHash: []byte{2}})
+	require.Equal(t, 2, len(store.items))
+	_, ok := store.items[string([]byte{2})]
+	require.Equal(t, false, ok)
+
+	// A pool created after a restart contains the persisted items in epoch order.
+	restored, err := NewPersistentPool(ctx, store)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(restored.pending))
+	require.DeepEqual(t, []byte{1}, restored.pending[0].InitTxHash)
+	require.DeepEqual(t, []byte{3}, restored.pending[1].InitTxHash)
+}
@@ -54,6 +54,9 @@ func (s *Service) compareForkENR(record *enr.Record) error {
 			currentForkENR.CurrentForkDigest,
 		)
 	}
+	if err := CompareWaterfallForksENR(record); err != nil {
+		return errors.Wrapf(err, "peer with ENR %s", enrString)
+	}
 	// Clients MAY connect to peers with the same current_fork_version but a
 	// different next_fork_version/next_fork_epoch. Unless ENRForkID is manually
 	// updated to matching prior to the earlier next_fork_epoch of the two clients,
@@ -37,6 +37,12 @@ const PingMessageName = "/ping"
 // MetadataMessageName specifies the name for the metadata message topic.
 const MetadataMessageName = "/metadata"
 
+// PrevotesBySlotMessageName specifies the name for the prevotes by slot message topic.
+const PrevotesBySlotMessageName = "/prevotes_by_slot"
+
+// WaterfallForksMessageName specifies the name for the waterfall forks message topic.
+const WaterfallForksMessageName = "/waterfall_forks"
+
 const (
 	// V1 RPC Topics
 	// RPCStatusTopicV1 defines the v1 topic for the status rpc method.
@@ -51,6 +57,10 @@ const (
 	RPCPingTopicV1 = protocolPrefix + PingMessageName + SchemaVersionV1
 	// RPCMetaDataTopicV1 defines the v1 topic for the metadata rpc method.
 	RPCMetaDataTopicV1 = protocolPrefix + MetadataMessageName + SchemaVersionV1
+	// RPCPrevotesBySlotTopicV1 defines the v1 topic for the prevotes by slot rpc method.
+	RPCPrevotesBySlotTopicV1 = protocolPrefix + PrevotesBySlotMessageName + SchemaVersionV1
+	// RPCWaterfallForksTopicV1 defines the v1 topic for the waterfall forks rpc method.
+	RPCWaterfallForksTopicV1 = protocolPrefix + WaterfallForksMessageName + SchemaVersionV1
 
 	// V2 RPC Topics
 	// RPCBlocksByRangeTopicV2 defines v2 the topic for the blocks by range rpc method.
@@ -83,6 +93,10 @@ var RPCTopicMappings = map[string]interface{}{
 	// RPC Metadata Message
 	RPCMetaDataTopicV1: new(interface{}),
 	RPCMetaDataTopicV2: new(interface{}),
+	// RPC Prevotes By Slot Message
+	RPCPrevotesBySlotTopicV1: new(p2ptypes.PrevotesBySlotReq),
+	// RPC Waterfall Forks Message
+	RPCWaterfallForksTopicV1: new(p2ptypes.WaterfallForksDigest),
 }
 
 // Maps all registered protocol prefixes.
@@ -99,6 +113,8 @@ var messageMapping = map[string]bool{
 	BeaconBlocksByRootsMessageName: true,
 	PingMessageName:                true,
 	MetadataMessageName:            true,
+	PrevotesBySlotMessageName:      true,
+	WaterfallForksMessageName:      true,
 }
 
 // Maps all the RPC messages which are to updated in altair.
@@ -4,6 +4,7 @@ import "errors"
 
 var (
 	ErrWrongForkDigestVersion = errors.New("wrong fork digest version")
+	ErrWaterfallForksMismatch = errors.New("waterfall forks mismatch")
 	ErrInvalidEpoch           = errors.New("invalid epoch")
 	ErrInvalidFinalizedRoot   = errors.New("invalid finalized root")
 	ErrInvalidSequenceNum     = errors.New("invalid sequence number provided")
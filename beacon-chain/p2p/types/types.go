@@ -6,6 +6,7 @@ package types
 import (
 	ssz "github.com/ferranbt/fastssz"
 	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
 )
 
@@ -13,6 +14,12 @@ const rootLength = 32
 
 const maxErrorLength = 256
 
+// prevotesBySlotReqLength is the size of the serialized prevotes by slot request.
+const prevotesBySlotReqLength = 16
+
+// waterfallForksDigestLength is the size of the serialized digest of the Waterfall forks registry.
+const waterfallForksDigestLength = 4
+
 // SSZBytes is a bytes slice that satisfies the fast-ssz interface.
 type SSZBytes []byte
 
@@ -80,6 +87,70 @@ func (r *BeaconBlockByRootsReq) UnmarshalSSZ(buf []byte) error {
 	return nil
 }
 
+// PrevotesBySlotReq specifies the prevotes by slot request type:
+// the prevotes of Count slots starting from StartSlot are requested.
+type PrevotesBySlotReq struct {
+	StartSlot types.Slot
+	Count     uint64
+}
+
+// MarshalSSZTo marshals the prevotes by slot request with the provided byte slice.
+func (r *PrevotesBySlotReq) MarshalSSZTo(dst []byte) ([]byte, error) {
+	dst = ssz.MarshalUint64(dst, uint64(r.StartSlot))
+	dst = ssz.MarshalUint64(dst, r.Count)
+	return dst, nil
+}
+
+// MarshalSSZ Marshals the prevotes by slot request type into the serialized object.
+func (r *PrevotesBySlotReq) MarshalSSZ() ([]byte, error) {
+	return r.MarshalSSZTo(make([]byte, 0, r.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized representation.
+func (r *PrevotesBySlotReq) SizeSSZ() int {
+	return prevotesBySlotReqLength
+}
+
+// UnmarshalSSZ unmarshals the provided bytes buffer into the
+// prevotes by slot request object.
+func (r *PrevotesBySlotReq) UnmarshalSSZ(buf []byte) error {
+	if len(buf) != prevotesBySlotReqLength {
+		return ssz.ErrSize
+	}
+	r.StartSlot = types.Slot(ssz.UnmarshallUint64(buf[0:8]))
+	r.Count = ssz.UnmarshallUint64(buf[8:16])
+	return nil
+}
+
+// WaterfallForksDigest specifies the waterfall forks request and response type:
+// the digest of the Waterfall forks registry of the sender.
+type WaterfallForksDigest [waterfallForksDigestLength]byte
+
+// MarshalSSZTo marshals the waterfall forks digest with the provided byte slice.
+func (d *WaterfallForksDigest) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, d[:]...), nil
+}
+
+// MarshalSSZ Marshals the waterfall forks digest type into the serialized object.
+func (d *WaterfallForksDigest) MarshalSSZ() ([]byte, error) {
+	return d.MarshalSSZTo(make([]byte, 0, d.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized representation.
+func (d *WaterfallForksDigest) SizeSSZ() int {
+	return waterfallForksDigestLength
+}
+
+// UnmarshalSSZ unmarshals the provided bytes buffer into the
+// waterfall forks digest object.
+func (d *WaterfallForksDigest) UnmarshalSSZ(buf []byte) error {
+	if len(buf) != waterfallForksDigestLength {
+		return ssz.ErrSize
+	}
+	copy(d[:], buf)
+	return nil
+}
+
 // ErrorMessage describes the error message type.
 type ErrorMessage []byte
 
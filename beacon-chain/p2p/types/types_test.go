@@ -41,6 +41,8 @@ func TestErrorResponse_Limit(t *testing.T) {
 func TestRoundTripSerialization(t *testing.T) {
 	roundTripTestBlocksByRootReq(t)
 	roundTripTestErrorMessage(t)
+	roundTripTestPrevotesBySlotReq(t)
+	roundTripTestWaterfallForksDigest(t)
 }
 
 func roundTripTestBlocksByRootReq(t *testing.T) {
@@ -71,6 +73,32 @@ func roundTripTestErrorMessage(t *testing.T) {
 	assert.DeepEqual(t, []byte(newVal), errMsg)
 }
 
+func roundTripTestPrevotesBySlotReq(t *testing.T) {
+	req := &PrevotesBySlotReq{StartSlot: 100, Count: 4}
+
+	marshalledObj, err := req.MarshalSSZ()
+	require.NoError(t, err)
+	assert.Equal(t, req.SizeSSZ(), len(marshalledObj))
+	newVal := &PrevotesBySlotReq{}
+
+	require.NoError(t, newVal.UnmarshalSSZ(marshalledObj))
+	assert.DeepEqual(t, req, newVal)
+	require.ErrorContains(t, "incorrect size", newVal.UnmarshalSSZ(marshalledObj[:8]))
+}
+
+func roundTripTestWaterfallForksDigest(t *testing.T) {
+	digest := &WaterfallForksDigest{'w', 'f', 'd', 'g'}
+
+	marshalledObj, err := digest.MarshalSSZ()
+	require.NoError(t, err)
+	assert.Equal(t, digest.SizeSSZ(), len(marshalledObj))
+	newVal := &WaterfallForksDigest{}
+
+	require.NoError(t, newVal.UnmarshalSSZ(marshalledObj))
+	assert.DeepEqual(t, digest, newVal)
+	require.ErrorContains(t, "incorrect size", newVal.UnmarshalSSZ(marshalledObj[:3]))
+}
+
 func TestSSZBytes_HashTreeRoot(t *testing.T) {
 	tests := []struct {
 		name        string
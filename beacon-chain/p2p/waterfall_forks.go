@@ -0,0 +1,66 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package p2p
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pkg/errors"
+	p2ptypes "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/p2p/types"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	"gitlab.waterfall.network/waterfall/protocol/gwat/p2p/enode"
+	"gitlab.waterfall.network/waterfall/protocol/gwat/p2p/enr"
+)
+
+// ENR key used for the digest of the Waterfall forks registry.
+var waterfallForksENRKey = params.BeaconNetworkConfig().WaterfallForksKey
+
+// Adds the digest of the Waterfall forks registry as an ENR record under the WaterfallForksKey.
+// The entry is separate from the fork entry, so the peers not advertising it are still accepted.
+func addWaterfallForksEntry(node *enode.LocalNode) *enode.LocalNode {
+	digest := params.BeaconConfig().WaterfallForksDigest()
+	node.Set(enr.WithEntry(waterfallForksENRKey, digest[:]))
+	return node
+}
+
+// Retrieves the digest of the Waterfall forks registry from an ENR record.
+func waterfallForksEntry(record *enr.Record) ([]byte, error) {
+	digest := make([]byte, 4)
+	if err := record.Load(enr.WithEntry(waterfallForksENRKey, &digest)); err != nil {
+		return nil, err
+	}
+	return digest, nil
+}
+
+// CompareWaterfallForksENR checks the digest of the Waterfall forks registry advertised
+// by the peer ENR record matches the local one. The records without the entry are accepted.
+func CompareWaterfallForksENR(record *enr.Record) error {
+	if record == nil {
+		return nil
+	}
+	peerDigest, err := waterfallForksEntry(record)
+	if enr.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not load waterfall forks entry")
+	}
+	digest := params.BeaconConfig().WaterfallForksDigest()
+	if !bytes.Equal(peerDigest, digest[:]) {
+		return errors.Wrap(p2ptypes.ErrWaterfallForksMismatch, fmt.Sprintf("peer digest %#x, local digest %#x", peerDigest, digest))
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package p2p
+
+import (
+	"testing"
+
+	p2ptypes "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/p2p/types"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	"gitlab.waterfall.network/waterfall/protocol/gwat/p2p/enode"
+	"gitlab.waterfall.network/waterfall/protocol/gwat/p2p/enr"
+)
+
+func TestCompareWaterfallForksENR(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+
+	db, err := enode.OpenDB(t.TempDir())
+	require.NoError(t, err)
+	_, key := createAddrAndPrivKey(t)
+	localNode := enode.NewLocalNode(db, key)
+	localNode = addWaterfallForksEntry(localNode)
+	record := localNode.Node().Record()
+	require.NoError(t, CompareWaterfallForksENR(record))
+
+	// The peers not advertising the registry digest are accepted.
+	require.NoError(t, CompareWaterfallForksENR(&enr.Record{}))
+	require.NoError(t, CompareWaterfallForksENR(nil))
+
+	// The peer activates a Waterfall fork at another slot.
+	cfg := params.BeaconConfig().Copy()
+	cfg.BlockVotingForkSlot++
+	params.OverrideBeaconConfig(cfg)
+	require.ErrorIs(t, CompareWaterfallForksENR(record), p2ptypes.ErrWaterfallForksMismatch)
+}
@@ -17,12 +17,19 @@ package powchain
 import (
 	"context"
 	"fmt"
+	"io"
 	"math/big"
+	"net"
+	"strings"
+	"sync/atomic"
+	"syscall"
 	"time"
 
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/sirupsen/logrus"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/io/logs"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/network"
 	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
 	"gitlab.waterfall.network/waterfall/protocol/gwat/common/hexutil"
 	gwatTypes "gitlab.waterfall.network/waterfall/protocol/gwat/core/types"
@@ -47,6 +54,140 @@ const (
 	ExecutionDepositCountMethod = "wat_validator_DepositCount"
 )
 
+// DagClient defines a client of the gwat DAG api.
+// It is implemented by the JSON-RPC client of a gwat node
+// and can be replaced, e.g. by a simulated DAG in tests.
+type DagClient interface {
+	Finalize(ctx context.Context, params *gwatTypes.FinalizationParams) (*gwatTypes.FinalizationResult, error)
+	CoordinatedState(ctx context.Context) (*gwatTypes.FinalizationResult, error)
+	GetOptimisticSpines(ctx context.Context, fromSpine gwatCommon.Hash) ([]gwatCommon.HashArray, error)
+	GetCandidates(ctx context.Context, slot types.Slot) (gwatCommon.HashArray, error)
+	SyncSlotInfo(ctx context.Context, params *gwatTypes.SlotInfo) (bool, error)
+	ValidateSpines(ctx context.Context, spines gwatCommon.HashArray) (bool, error)
+}
+
+// rpcDagClient implements DagClient by calling the dag api of gwat via JSON-RPC.
+type rpcDagClient struct {
+	client RPCClient
+}
+
+// Finalize calls dag_finalize via JSON-RPC.
+func (c *rpcDagClient) Finalize(ctx context.Context, params *gwatTypes.FinalizationParams) (*gwatTypes.FinalizationResult, error) {
+	start := time.Now()
+	result := &gwatTypes.FinalizationResult{}
+	err := c.client.CallContext(
+		ctx,
+		result,
+		ExecutionDagFinalizeMethod,
+		params,
+	)
+	if result.Error != nil {
+		err = errors.New(*result.Error)
+	}
+	observeDagRPC(ExecutionDagFinalizeMethod, start, err)
+	return result, err
+}
+
+// CoordinatedState calls dag_coordinatedState via JSON-RPC.
+func (c *rpcDagClient) CoordinatedState(ctx context.Context) (*gwatTypes.FinalizationResult, error) {
+	start := time.Now()
+	result := &gwatTypes.FinalizationResult{}
+	err := c.client.CallContext(
+		ctx,
+		result,
+		ExecutionDagCoordinatedStateMethod,
+	)
+	if result.Error != nil {
+		err = errors.New(*result.Error)
+	}
+	observeDagRPC(ExecutionDagCoordinatedStateMethod, start, err)
+	return result, err
+}
+
+// GetOptimisticSpines calls dag_getOptimisticSpines via JSON-RPC.
+func (c *rpcDagClient) GetOptimisticSpines(ctx context.Context, fromSpine gwatCommon.Hash) ([]gwatCommon.HashArray, error) {
+	start := time.Now()
+	result := &gwatTypes.OptimisticSpinesResult{}
+	err := c.client.CallContext(
+		ctx,
+		result,
+		ExecutionDagGetOptimisticSpines,
+		fromSpine,
+	)
+	if result.Error != nil {
+		err = errors.New(*result.Error)
+	}
+	observeDagRPC(ExecutionDagGetOptimisticSpines, start, err)
+	return result.Data, err
+}
+
+// GetCandidates calls dag_getCandidates via JSON-RPC.
+func (c *rpcDagClient) GetCandidates(ctx context.Context, slot types.Slot) (gwatCommon.HashArray, error) {
+	start := time.Now()
+	result := &gwatTypes.CandidatesResult{}
+	err := c.client.CallContext(
+		ctx,
+		result,
+		ExecutionDagGetCandidatesMethod,
+		slot,
+	)
+	if result.Error != nil {
+		err = errors.New(*result.Error)
+	}
+	observeDagRPC(ExecutionDagGetCandidatesMethod, start, err)
+	return result.Candidates, err
+}
+
+// SyncSlotInfo calls dag_syncSlotInfo via JSON-RPC.
+func (c *rpcDagClient) SyncSlotInfo(ctx context.Context, params *gwatTypes.SlotInfo) (bool, error) {
+	start := time.Now()
+	var result bool
+	err := c.client.CallContext(
+		ctx,
+		&result,
+		ExecutionDagSyncSlotInfoMethod,
+		params,
+	)
+	observeDagRPC(ExecutionDagSyncSlotInfoMethod, start, err)
+	return result, err
+}
+
+// ValidateSpines calls dag_validateSpines via JSON-RPC.
+func (c *rpcDagClient) ValidateSpines(ctx context.Context, spines gwatCommon.HashArray) (bool, error) {
+	start := time.Now()
+	var result bool
+	err := c.client.CallContext(
+		ctx,
+		&result,
+		ExecutionDagValidateSpinesMethod,
+		spines,
+	)
+	observeDagRPC(ExecutionDagValidateSpinesMethod, start, err)
+	return result, err
+}
+
+// DialDagClient connects to the dag api of the gwat node at the given endpoint.
+// It is used to access gwat before the powchain service is started, e.g. to check checkpoint sync data.
+func DialDagClient(ctx context.Context, endpoint network.Endpoint) (DagClient, error) {
+	client, err := dialRPCClientWithAuth(ctx, endpoint)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not dial gwat node %s", logs.MaskCredentialsLogging(endpoint.Url))
+	}
+	return &rpcDagClient{client: client}, nil
+}
+
+// dagClient returns the client of the dag api:
+// the one set by options or the JSON-RPC client of the current gwat connection.
+func (s *Service) dagClient() (DagClient, error) {
+	if s.cfg != nil && s.cfg.dagClient != nil {
+		return s.cfg.dagClient, nil
+	}
+	if s.rpcClient == nil {
+		return nil, fmt.Errorf("Rpc Client not init")
+	}
+	return &rpcDagClient{client: s.rpcClient}, nil
+}
+
 // ExecutionDagFinalize executing finalization procedure
 // by calling dag_finalize via JSON-RPC.
 func (s *Service) ExecutionDagFinalize(ctx context.Context, params *gwatTypes.FinalizationParams) (*gwatTypes.FinalizationResult, error) {
@@ -56,29 +197,23 @@ func (s *Service) ExecutionDagFinalize(ctx context.Context, params *gwatTypes.Fi
 		log.WithField("api", ExecutionDagFinalizeMethod).WithField("elapsed", time.Since(start)).Info("Request finish")
 	}(time.Now())
 
-	result := &gwatTypes.FinalizationResult{}
-	if s.rpcClient == nil {
-		return nil, fmt.Errorf("Rpc Client not init")
+	client, err := s.dagClient()
+	if err != nil {
+		return nil, err
 	}
-
-	err := s.rpcClient.CallContext(
-		ctx,
-		result,
-		ExecutionDagFinalizeMethod,
-		params,
-	)
-
+	result, err := client.Finalize(ctx, params)
 	if err != nil {
 		log.WithError(err).WithFields(logrus.Fields{
 			"BaseSpine": params.BaseSpine.Hex(),
 			"Spines":    params.Spines,
 		}).Error("Dag Finalize")
+	} else {
+		s.updateDagFinality(params)
+		go s.finalizeBackupDagEndpoints(atomic.AddUint64(&s.dagFinalizeSeq, 1), params)
 	}
-
-	if result.Error != nil {
-		err = errors.New(*result.Error)
+	if result == nil {
+		result = &gwatTypes.FinalizationResult{}
 	}
-
 	return result, handleDagRPCError(err)
 }
 
@@ -87,26 +222,18 @@ func (s *Service) ExecutionDagFinalize(ctx context.Context, params *gwatTypes.Fi
 func (s *Service) ExecutionDagCoordinatedState(ctx context.Context) (*gwatTypes.FinalizationResult, error) {
 	ctx, span := trace.StartSpan(ctx, "powchain.dag-api-client.DagCoordinatedState")
 	defer span.End()
-	result := &gwatTypes.FinalizationResult{}
 
-	if s.rpcClient == nil {
-		return nil, fmt.Errorf("Rpc Client not init")
+	client, err := s.dagClient()
+	if err != nil {
+		return nil, err
 	}
-
-	err := s.rpcClient.CallContext(
-		ctx,
-		result,
-		ExecutionDagCoordinatedStateMethod,
-	)
-
+	result, err := client.CoordinatedState(ctx)
 	if err != nil {
 		log.WithError(err).Error("Dag Coordinated State")
 	}
-
-	if result.Error != nil {
-		err = errors.New(*result.Error)
+	if result == nil {
+		result = &gwatTypes.FinalizationResult{}
 	}
-
 	return result, handleDagRPCError(err)
 }
 
@@ -115,29 +242,19 @@ func (s *Service) ExecutionDagCoordinatedState(ctx context.Context) (*gwatTypes.
 func (s *Service) ExecutionDagGetOptimisticSpines(ctx context.Context, fromSpine gwatCommon.Hash) ([]gwatCommon.HashArray, error) {
 	ctx, span := trace.StartSpan(ctx, "powchain.dag-api-client.ExecutionDagGetOptimisticSpines")
 	defer span.End()
-	result := &gwatTypes.OptimisticSpinesResult{}
-
 	defer func(start time.Time) {
 		log.WithField("api", ExecutionDagGetOptimisticSpines).WithField("elapsed", time.Since(start)).Info("Request finish")
 	}(time.Now())
 
-	if s.rpcClient == nil {
-		return result.Data, fmt.Errorf("Rpc Client not init")
-	}
-
-	err := s.rpcClient.CallContext(
-		ctx,
-		result,
-		ExecutionDagGetOptimisticSpines,
-		fromSpine,
-	)
-	if result.Error != nil {
-		err = errors.New(*result.Error)
+	client, err := s.dagClient()
+	if err != nil {
+		return nil, err
 	}
-	if result.Data == nil {
-		result.Data = []gwatCommon.HashArray{}
+	spines, err := client.GetOptimisticSpines(ctx, fromSpine)
+	if spines == nil {
+		spines = []gwatCommon.HashArray{}
 	}
-	return result.Data, handleDagRPCError(err)
+	return spines, handleDagRPCError(err)
 }
 
 // ExecutionDagGetCandidates executing consensus procedure
@@ -149,24 +266,15 @@ func (s *Service) ExecutionDagGetCandidates(ctx context.Context, slot types.Slot
 		log.WithField("api", ExecutionDagGetCandidatesMethod).WithField("elapsed", time.Since(start)).Info("Request finish")
 	}(time.Now())
 
-	result := &gwatTypes.CandidatesResult{}
-	if s.rpcClient == nil {
-		return result.Candidates, fmt.Errorf("Rpc Client not init")
-	}
-
-	err := s.rpcClient.CallContext(
-		ctx,
-		result,
-		ExecutionDagGetCandidatesMethod,
-		slot,
-	)
-	if result.Error != nil {
-		err = errors.New(*result.Error)
+	client, err := s.dagClient()
+	if err != nil {
+		return nil, err
 	}
-	if result.Candidates == nil {
-		result.Candidates = gwatCommon.HashArray{}
+	candidates, err := client.GetCandidates(ctx, slot)
+	if candidates == nil {
+		candidates = gwatCommon.HashArray{}
 	}
-	return result.Candidates, handleDagRPCError(err)
+	return candidates, handleDagRPCError(err)
 }
 
 // ExecutionDagSyncSlotInfo executing sync slot info procedure
@@ -174,22 +282,15 @@ func (s *Service) ExecutionDagGetCandidates(ctx context.Context, slot types.Slot
 func (s *Service) ExecutionDagSyncSlotInfo(ctx context.Context, params *gwatTypes.SlotInfo) (bool, error) {
 	ctx, span := trace.StartSpan(ctx, "powchain.dag-api-client.ExecutionDagSyncSlotInfo")
 	defer span.End()
-	var result bool
 
-	if s.rpcClient == nil {
-		return result, fmt.Errorf("Rpc Client not init")
+	client, err := s.dagClient()
+	if err != nil {
+		return false, err
 	}
-	err := s.rpcClient.CallContext(
-		ctx,
-		&result,
-		ExecutionDagSyncSlotInfoMethod,
-		params,
-	)
-
+	result, err := client.SyncSlotInfo(ctx, params)
 	if err != nil {
 		log.WithError(err).Error("ExecutionDagSyncSlotInfo")
 	}
-
 	return result, handleDagRPCError(err)
 }
 
@@ -202,17 +303,11 @@ func (s *Service) ExecutionDagValidateSpines(ctx context.Context, params gwatCom
 		log.WithField("api", ExecutionDagValidateSpinesMethod).WithField("elapsed", time.Since(start)).Info("Request finish")
 	}(time.Now())
 
-	var result bool
-	if s.rpcClient == nil {
-		return result, fmt.Errorf("Rpc Client not init")
+	client, err := s.dagClient()
+	if err != nil {
+		return false, err
 	}
-	err := s.rpcClient.CallContext(
-		ctx,
-		&result,
-		ExecutionDagValidateSpinesMethod,
-		params,
-	)
-
+	result, err := client.ValidateSpines(ctx, params)
 	if err != nil {
 		log.WithError(err).Error("ExecutionDagValidateSpines")
 	}
@@ -281,6 +376,50 @@ func (s *Service) GetDepositCount(ctx context.Context, blockNrOrHash *rpc.BlockN
 	return count, handleDagRPCError(err)
 }
 
+// DagErrorKind classifies errors of the dag api calls.
+type DagErrorKind string
+
+const (
+	// DagErrKindNone means no error.
+	DagErrKindNone DagErrorKind = ""
+	// DagErrKindTimeout is a timeout of the dag api call.
+	DagErrKindTimeout DagErrorKind = "timeout"
+	// DagErrKindUnavailable means the gwat node is not reachable, e.g. restarting.
+	DagErrKindUnavailable DagErrorKind = "unavailable"
+	// DagErrKindInvalidBaseSpine means gwat does not know the base spine of finalization.
+	DagErrKindInvalidBaseSpine DagErrorKind = "invalid_base_spine"
+	// DagErrKindRPC is a JSON-RPC error defined by the specification.
+	DagErrKindRPC DagErrorKind = "rpc"
+	// DagErrKindUnknown is any other error.
+	DagErrKindUnknown DagErrorKind = "unknown"
+)
+
+// DagErrorKindOf returns the kind of the error of the dag api call.
+func DagErrorKindOf(err error) DagErrorKind {
+	switch {
+	case err == nil:
+		return DagErrKindNone
+	case errors.Is(err, ErrDagHTTPTimeout):
+		return DagErrKindTimeout
+	case errors.Is(err, ErrDagUnavailable):
+		return DagErrKindUnavailable
+	// errors received from gwat are not always typed (e.g. from mocks)
+	case errors.Is(err, ErrDagInvalidBaseSpine),
+		strings.Contains(err.Error(), ErrDagInvalidBaseSpine.Error()):
+		return DagErrKindInvalidBaseSpine
+	case errors.Is(err, ErrParse),
+		errors.Is(err, ErrInvalidRequest),
+		errors.Is(err, ErrMethodNotFound),
+		errors.Is(err, ErrInvalidParams),
+		errors.Is(err, ErrInternal),
+		errors.Is(err, ErrUnknownPayload),
+		errors.Is(err, ErrServer):
+		return DagErrKindRPC
+	default:
+		return DagErrKindUnknown
+	}
+}
+
 // handleDagRPCError errors received from the RPC server according to the specification.
 func handleDagRPCError(err error) error {
 	if err == nil {
@@ -289,8 +428,14 @@ func handleDagRPCError(err error) error {
 	if isTimeout(err) {
 		return errors.Wrapf(ErrDagHTTPTimeout, "%s", err)
 	}
+	if isConnectionError(err) {
+		return errors.Wrapf(ErrDagUnavailable, "%s", err)
+	}
 	e, ok := err.(rpc.Error)
 	if !ok {
+		if strings.Contains(err.Error(), ErrDagInvalidBaseSpine.Error()) {
+			return errors.Wrapf(ErrDagInvalidBaseSpine, "%s", err)
+		}
 		return errors.Wrap(err, "got an unexpected error")
 	}
 	switch e.ErrorCode() {
@@ -320,3 +465,15 @@ func handleDagRPCError(err error) error {
 
 // ErrDagHTTPTimeout returns true if the error is a http.Client timeout error.
 var ErrDagHTTPTimeout = errors.New("timeout from http.DagClient")
+
+// isConnectionError returns true if the gwat node can not be reached.
+func isConnectionError(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return true
+	}
+	return errors.Is(err, rpc.ErrClientQuit) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, io.EOF)
+}
@@ -19,11 +19,17 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"syscall"
 	"testing"
+	"time"
 
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	mocks "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/powchain/testing"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
 	"gitlab.waterfall.network/waterfall/protocol/gwat/common"
@@ -35,6 +41,8 @@ import (
 var (
 	_ = EngineCaller(&Service{})
 	_ = EngineCaller(&mocks.EngineClient{})
+	_ = DagClient(&rpcDagClient{})
+	_ = DagClient(&mocks.DagSimulator{})
 )
 
 func TestDagClient_IPC(t *testing.T) {
@@ -335,3 +343,162 @@ func (*testDagEngineService) CoordinatedState(
 	}
 	return item
 }
+
+func TestDagClient_Simulator(t *testing.T) {
+	ctx := context.Background()
+	genesisSpine := common.HexToHash("0x351cd65f6e74ff61322d16c4a808bdce69c30410b3965fbbf188c46fa44da545")
+	dag := mocks.NewDagSimulator(genesisSpine)
+	produced := dag.ProduceSlots(1, 2, 4)
+	require.Equal(t, 3, len(produced))
+
+	srv := &Service{cfg: &config{}}
+	require.NoError(t, WithDagClient(dag)(srv))
+
+	isSet, err := srv.ExecutionDagSyncSlotInfo(ctx, &gwatTypes.SlotInfo{SecondsPerSlot: 4, SlotsPerEpoch: 32})
+	require.NoError(t, err)
+	require.Equal(t, true, isSet)
+
+	candidates, err := srv.ExecutionDagGetCandidates(ctx, 2)
+	require.NoError(t, err)
+	require.DeepEqual(t, gwatCommon.HashArray{produced[0], produced[1]}, candidates)
+
+	optSpines, err := srv.ExecutionDagGetOptimisticSpines(ctx, produced[0])
+	require.NoError(t, err)
+	require.DeepEqual(t, []gwatCommon.HashArray{{produced[1]}, {produced[2]}}, optSpines)
+
+	valid, err := srv.ExecutionDagValidateSpines(ctx, gwatCommon.HashArray{produced[1], produced[0]})
+	require.NoError(t, err)
+	require.Equal(t, false, valid)
+
+	// No coordinated checkpoint before the first finalization with a checkpoint.
+	_, err = srv.ExecutionDagCoordinatedState(ctx)
+	require.ErrorContains(t, "coordinated state not found", err)
+
+	// Finalization on an unknown base spine fails the way gwat does.
+	unknownBase := common.HexToHash("0x01")
+	res, err := srv.ExecutionDagFinalize(ctx, &gwatTypes.FinalizationParams{
+		Spines:    gwatCommon.HashArray{produced[0]},
+		BaseSpine: &unknownBase,
+	})
+	require.ErrorContains(t, "invalid base spine", err)
+	require.Equal(t, genesisSpine, *res.LFSpine)
+
+	cp := &gwatTypes.Checkpoint{Epoch: 1, FinEpoch: 2, Root: common.HexToHash("0x02"), Spine: produced[1]}
+	res, err = srv.ExecutionDagFinalize(ctx, &gwatTypes.FinalizationParams{
+		Spines:     gwatCommon.HashArray{produced[0], produced[1]},
+		BaseSpine:  &genesisSpine,
+		Checkpoint: cp,
+	})
+	require.NoError(t, err)
+	require.Equal(t, produced[1], *res.LFSpine)
+	require.Equal(t, cp.Epoch, *res.CpEpoch)
+	require.Equal(t, cp.Root, *res.CpRoot)
+
+	candidates, err = srv.ExecutionDagGetCandidates(ctx, 4)
+	require.NoError(t, err)
+	require.DeepEqual(t, gwatCommon.HashArray{produced[2]}, candidates)
+
+	state, err := srv.ExecutionDagCoordinatedState(ctx)
+	require.NoError(t, err)
+	require.Equal(t, produced[1], *state.LFSpine)
+	require.Equal(t, cp.Epoch, *state.CpEpoch)
+
+	// Spines out of order are rejected.
+	_, err = srv.ExecutionDagFinalize(ctx, &gwatTypes.FinalizationParams{
+		Spines:    gwatCommon.HashArray{produced[2], produced[1]},
+		BaseSpine: &genesisSpine,
+	})
+	require.ErrorContains(t, "bad order of spines", err)
+}
+
+func TestDagClient_NoClient(t *testing.T) {
+	srv := &Service{}
+	_, err := srv.ExecutionDagGetCandidates(context.Background(), 1)
+	require.ErrorContains(t, "Rpc Client not init", err)
+}
+
+func Test_handleDagRPCError(t *testing.T) {
+	require.NoError(t, handleDagRPCError(nil))
+
+	var tests = []struct {
+		name             string
+		expected         error
+		expectedContains string
+		given            error
+	}{
+		{
+			name:             "not an rpc error",
+			expectedContains: "got an unexpected error",
+			given:            errors.New("foo"),
+		},
+		{
+			name:     "HTTP times out",
+			expected: ErrDagHTTPTimeout,
+			given:    &customError{timeout: true},
+		},
+		{
+			name:     "connection refused",
+			expected: ErrDagUnavailable,
+			given:    &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED},
+		},
+		{
+			name:     "client closed",
+			expected: ErrDagUnavailable,
+			given:    rpc.ErrClientQuit,
+		},
+		{
+			name:     "invalid base spine",
+			expected: ErrDagInvalidBaseSpine,
+			given:    errors.New("finalization failed: invalid base spine"),
+		},
+		{
+			name:     "ErrParse",
+			expected: ErrParse,
+			given:    &customError{code: -32700},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := handleDagRPCError(tt.given)
+			if tt.expected != nil {
+				require.Equal(t, true, errors.Is(got, tt.expected))
+			}
+			if tt.expectedContains != "" {
+				require.ErrorContains(t, tt.expectedContains, got)
+			}
+		})
+	}
+}
+
+func TestDagErrorKindOf(t *testing.T) {
+	var tests = []struct {
+		name string
+		err  error
+		want DagErrorKind
+	}{
+		{name: "nil", err: nil, want: DagErrKindNone},
+		{name: "timeout", err: handleDagRPCError(&customError{timeout: true}), want: DagErrKindTimeout},
+		{name: "unavailable", err: handleDagRPCError(rpc.ErrClientQuit), want: DagErrKindUnavailable},
+		{name: "invalid base spine typed", err: handleDagRPCError(errors.New("invalid base spine")), want: DagErrKindInvalidBaseSpine},
+		{name: "invalid base spine wrapped", err: errors.Wrap(errors.New("invalid base spine"), "Dag finalization: execution failed"), want: DagErrKindInvalidBaseSpine},
+		{name: "rpc", err: handleDagRPCError(&customError{code: -32603}), want: DagErrKindRPC},
+		{name: "unknown", err: handleDagRPCError(errors.New("foo")), want: DagErrKindUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, DagErrorKindOf(tt.err))
+		})
+	}
+}
+
+func TestObserveDagRPC(t *testing.T) {
+	method := "dag_test"
+	observeDagRPC(method, time.Now(), nil)
+	require.Equal(t, 1, testutil.CollectAndCount(dagRPCLatency.WithLabelValues(method).(prometheus.Collector)))
+	require.Equal(t, float64(0), testutil.ToFloat64(dagRPCErrors.WithLabelValues(method, string(DagErrKindUnavailable))))
+
+	observeDagRPC(method, time.Now(), rpc.ErrClientQuit)
+	observeDagRPC(method, time.Now(), errors.New("foo"))
+	require.Equal(t, float64(1), testutil.ToFloat64(dagRPCErrors.WithLabelValues(method, string(DagErrKindUnavailable))))
+	require.Equal(t, float64(1), testutil.ToFloat64(dagRPCErrors.WithLabelValues(method, string(DagErrKindUnknown))))
+}
@@ -0,0 +1,382 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package powchain
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/io/logs"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/network"
+	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
+	gwatTypes "gitlab.waterfall.network/waterfall/protocol/gwat/core/types"
+)
+
+const (
+	// maxDagEndpointScore is the max of the health and the consistency scores of a gwat endpoint.
+	maxDagEndpointScore = 10
+	// dagEndpointCheckTimeout is the timeout of the requests checking a gwat endpoint.
+	dagEndpointCheckTimeout = 2 * time.Second
+	// dagBackupFinalizeTimeout is the timeout of the finalization sent to a backup gwat endpoint.
+	dagBackupFinalizeTimeout = 30 * time.Second
+)
+
+// DagEndpointStatus describes the health and the consistency of a configured gwat endpoint.
+// The consistency is checked against the local finality, which is the last finalization
+// sent to gwat by the coordinator, by comparing dag_coordinatedState and the last finalized spines.
+type DagEndpointStatus struct {
+	Url     string
+	Current bool
+	// Checked is false until the first check of the endpoint.
+	Checked    bool
+	Healthy    bool
+	Consistent bool
+	// HealthScore is increased by successful requests and decreased by failed ones.
+	HealthScore int
+	// ConsistencyScore is increased by consistency checks passed and reset by failed ones.
+	ConsistencyScore int
+	LFSpine          gwatCommon.Hash
+	CpEpoch          uint64
+	CpRoot           gwatCommon.Hash
+	Err              error
+}
+
+// dagEndpoint keeps the dag api client and the status of a configured gwat endpoint.
+type dagEndpoint struct {
+	endpoint network.Endpoint
+	client   DagClient
+	status   DagEndpointStatus
+	// finalizeLock orders the finalizations sent to the endpoint as a backup,
+	// finalizedSeq is the sequence number of the last one sent.
+	finalizeLock sync.Mutex
+	finalizedSeq uint64
+}
+
+// dagFinality is the finality sent to gwat by the last successful finalization of the coordinator.
+type dagFinality struct {
+	lfSpine    gwatCommon.Hash
+	checkpoint *gwatTypes.Checkpoint
+}
+
+// DagEndpointsStatus returns the statuses of the configured gwat endpoints
+// in the order of the configuration.
+func (s *Service) DagEndpointsStatus() []DagEndpointStatus {
+	s.dagEndpointsLock.RLock()
+	defer s.dagEndpointsLock.RUnlock()
+	statuses := make([]DagEndpointStatus, 0, len(s.cfg.httpEndpoints))
+	for _, ep := range s.cfg.httpEndpoints {
+		status := DagEndpointStatus{Url: ep.Url}
+		if e := s.findDagEndpoint(ep); e != nil {
+			status = e.status
+		}
+		status.Current = ep.Equals(s.cfg.currHttpEndpoint)
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// updateDagFinality sets the local finality by the params of a successful finalization.
+// The checkpoint of the previous finalization is kept if the params have no checkpoint.
+func (s *Service) updateDagFinality(params *gwatTypes.FinalizationParams) {
+	if params == nil {
+		return
+	}
+	var lfSpine gwatCommon.Hash
+	switch {
+	case len(params.Spines) > 0:
+		lfSpine = params.Spines[len(params.Spines)-1]
+	case params.BaseSpine != nil:
+		lfSpine = *params.BaseSpine
+	default:
+		return
+	}
+	s.dagEndpointsLock.Lock()
+	defer s.dagEndpointsLock.Unlock()
+	finality := &dagFinality{lfSpine: lfSpine}
+	if params.Checkpoint != nil {
+		finality.checkpoint = params.Checkpoint.Copy()
+	} else if s.dagFinality != nil {
+		finality.checkpoint = s.dagFinality.checkpoint
+	}
+	s.dagFinality = finality
+}
+
+// checkDagEndpointsAsync checks the configured gwat endpoints in background,
+// the check is skipped while the previous one is running.
+func (s *Service) checkDagEndpointsAsync() {
+	if s.cfg.dagClient != nil || !s.dagEndpointsChecking.SetToIf(false, true) {
+		return
+	}
+	go func() {
+		defer s.dagEndpointsChecking.UnSet()
+		s.checkDagEndpoints(s.ctx)
+	}()
+}
+
+// checkDagEndpoints requests dag_coordinatedState of every configured gwat endpoint
+// and updates their health and consistency with the local finality.
+// The endpoints are checked in parallel, every request has its own timeout.
+func (s *Service) checkDagEndpoints(ctx context.Context) {
+	if s.cfg.dagClient != nil {
+		return
+	}
+	endpoints := s.syncDagEndpoints()
+	if len(endpoints) == 0 {
+		return
+	}
+	s.dagEndpointsLock.RLock()
+	finality := s.dagFinality
+	s.dagEndpointsLock.RUnlock()
+
+	results := make([]*gwatTypes.FinalizationResult, len(endpoints))
+	errs := make([]error, len(endpoints))
+	consistent := make([]bool, len(endpoints))
+	var wg sync.WaitGroup
+	for i, e := range endpoints {
+		wg.Add(1)
+		go func(i int, e *dagEndpoint) {
+			defer wg.Done()
+			results[i], errs[i] = s.requestCoordinatedState(ctx, e)
+			if errs[i] != nil {
+				return
+			}
+			// the consistency is not judged before the first finalization.
+			if finality == nil {
+				consistent[i] = true
+				return
+			}
+			consistent[i], errs[i] = isOnDagFinality(ctx, e.client, finality, results[i])
+		}(i, e)
+	}
+	wg.Wait()
+
+	s.dagEndpointsLock.Lock()
+	defer s.dagEndpointsLock.Unlock()
+	for i, e := range endpoints {
+		e.status.Checked = true
+		e.status.Err = errs[i]
+		if results[i] == nil {
+			e.status.Healthy = false
+			e.status.HealthScore = decDagEndpointScore(e.status.HealthScore)
+			continue
+		}
+		e.status.Healthy = true
+		e.status.HealthScore = incDagEndpointScore(e.status.HealthScore)
+		setDagEndpointState(&e.status, results[i])
+		if errs[i] != nil {
+			// the consistency check failed, keep the previous consistency.
+			continue
+		}
+		if !consistent[i] {
+			e.status.Consistent = false
+			e.status.ConsistencyScore = 0
+			e.status.Err = errors.Wrapf(ErrDagEndpointInconsistent, "checkpoint epoch=%d root=%#x lfSpine=%#x",
+				e.status.CpEpoch, e.status.CpRoot, e.status.LFSpine)
+			log.WithFields(logrus.Fields{
+				"endpoint":     logs.MaskCredentialsLogging(e.endpoint.Url),
+				"current":      e.endpoint.Equals(s.cfg.currHttpEndpoint),
+				"cpEpoch":      e.status.CpEpoch,
+				"cpRoot":       fmt.Sprintf("%#x", e.status.CpRoot),
+				"lfSpine":      fmt.Sprintf("%#x", e.status.LFSpine),
+				"localLFSpine": fmt.Sprintf("%#x", finality.lfSpine),
+			}).Warn("Gwat endpoint is not on the coordinated chain")
+			continue
+		}
+		e.status.Consistent = true
+		if finality != nil {
+			e.status.ConsistencyScore = incDagEndpointScore(e.status.ConsistencyScore)
+		}
+	}
+}
+
+// finalizeBackupDagEndpoints sends the finalization of the given sequence number to the healthy gwat endpoints
+// consistent with the local finality, to keep them ready for the failover.
+// The backups are called in parallel with their own timeouts, so a hung backup does not stall the others.
+func (s *Service) finalizeBackupDagEndpoints(seq uint64, params *gwatTypes.FinalizationParams) {
+	if s.cfg.dagClient != nil {
+		return
+	}
+	s.dagEndpointsLock.RLock()
+	backups := make([]*dagEndpoint, 0, len(s.dagEndpoints))
+	for _, e := range s.dagEndpoints {
+		if e.client == nil || e.endpoint.Equals(s.cfg.currHttpEndpoint) || !e.status.Healthy || !e.status.Consistent {
+			continue
+		}
+		backups = append(backups, e)
+	}
+	s.dagEndpointsLock.RUnlock()
+
+	var wg sync.WaitGroup
+	for _, e := range backups {
+		wg.Add(1)
+		go func(e *dagEndpoint) {
+			defer wg.Done()
+			s.finalizeBackupDagEndpoint(e, seq, params)
+		}(e)
+	}
+	wg.Wait()
+}
+
+// finalizeBackupDagEndpoint sends the finalization to the backup gwat endpoint
+// unless a later finalization was already sent to it.
+func (s *Service) finalizeBackupDagEndpoint(e *dagEndpoint, seq uint64, params *gwatTypes.FinalizationParams) {
+	e.finalizeLock.Lock()
+	defer e.finalizeLock.Unlock()
+	if seq <= e.finalizedSeq {
+		return
+	}
+	e.finalizedSeq = seq
+
+	ctx, cancel := context.WithTimeout(s.ctx, dagBackupFinalizeTimeout)
+	defer cancel()
+	res, err := e.client.Finalize(ctx, params)
+	err = handleDagRPCError(err)
+	s.dagEndpointsLock.Lock()
+	defer s.dagEndpointsLock.Unlock()
+	if err != nil {
+		e.status.Err = err
+		e.status.HealthScore = decDagEndpointScore(e.status.HealthScore)
+		log.WithError(err).WithField(
+			"endpoint", logs.MaskCredentialsLogging(e.endpoint.Url),
+		).Warn("Could not send finalization to backup gwat endpoint")
+		return
+	}
+	if res != nil && res.LFSpine != nil {
+		e.status.LFSpine = *res.LFSpine
+	}
+}
+
+// isDagEndpointConsistent returns false if the gwat endpoint was found on another chain,
+// the endpoints not checked yet are considered consistent.
+func (s *Service) isDagEndpointConsistent(endpoint network.Endpoint) bool {
+	s.dagEndpointsLock.RLock()
+	defer s.dagEndpointsLock.RUnlock()
+	e := s.findDagEndpoint(endpoint)
+	if e == nil || !e.status.Checked || !e.status.Healthy {
+		return true
+	}
+	return e.status.Consistent
+}
+
+// dagEndpointError returns the consistency error of the gwat endpoint if any.
+func (s *Service) dagEndpointError(endpoint network.Endpoint) error {
+	s.dagEndpointsLock.RLock()
+	defer s.dagEndpointsLock.RUnlock()
+	e := s.findDagEndpoint(endpoint)
+	if e == nil || !errors.Is(e.status.Err, ErrDagEndpointInconsistent) {
+		return nil
+	}
+	return e.status.Err
+}
+
+// syncDagEndpoints creates the items of the configured gwat endpoints and dials their dag api clients.
+func (s *Service) syncDagEndpoints() []*dagEndpoint {
+	s.dagEndpointsLock.Lock()
+	defer s.dagEndpointsLock.Unlock()
+	endpoints := make([]*dagEndpoint, 0, len(s.cfg.httpEndpoints))
+	for _, ep := range s.cfg.httpEndpoints {
+		e := s.findDagEndpoint(ep)
+		if e == nil {
+			e = &dagEndpoint{endpoint: ep, status: DagEndpointStatus{Url: ep.Url}}
+		}
+		if e.client == nil {
+			client, err := DialDagClient(s.ctx, ep)
+			if err != nil {
+				e.status.Err = err
+			} else {
+				e.client = client
+			}
+		}
+		endpoints = append(endpoints, e)
+	}
+	s.dagEndpoints = endpoints
+	return endpoints
+}
+
+func (s *Service) findDagEndpoint(endpoint network.Endpoint) *dagEndpoint {
+	for _, e := range s.dagEndpoints {
+		if e.endpoint.Equals(endpoint) {
+			return e
+		}
+	}
+	return nil
+}
+
+func (s *Service) requestCoordinatedState(ctx context.Context, e *dagEndpoint) (*gwatTypes.FinalizationResult, error) {
+	if e.client == nil {
+		if e.status.Err != nil {
+			return nil, e.status.Err
+		}
+		return nil, errors.New("gwat endpoint is not connected")
+	}
+	ctx, cancel := context.WithTimeout(ctx, dagEndpointCheckTimeout)
+	defer cancel()
+	res, err := e.client.CoordinatedState(ctx)
+	if err != nil {
+		return nil, handleDagRPCError(err)
+	}
+	if res == nil || res.LFSpine == nil {
+		return nil, errors.New("empty coordinated state")
+	}
+	return res, nil
+}
+
+// isOnDagFinality checks the coordinated state of a gwat node is of the chain finalized by the coordinator:
+// the checkpoint of the epoch of the local checkpoint must match
+// and the last spine finalized by the coordinator must be known by the node.
+func isOnDagFinality(ctx context.Context, client DagClient, finality *dagFinality, res *gwatTypes.FinalizationResult) (bool, error) {
+	if cp := finality.checkpoint; cp != nil && res.CpEpoch != nil && res.CpRoot != nil &&
+		*res.CpEpoch == cp.Epoch && *res.CpRoot != cp.Root {
+		return false, nil
+	}
+	if *res.LFSpine == finality.lfSpine {
+		return true, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, dagEndpointCheckTimeout)
+	defer cancel()
+	known, err := client.ValidateSpines(ctx, gwatCommon.HashArray{finality.lfSpine})
+	if err != nil {
+		return false, handleDagRPCError(err)
+	}
+	return known, nil
+}
+
+func setDagEndpointState(status *DagEndpointStatus, res *gwatTypes.FinalizationResult) {
+	status.LFSpine = *res.LFSpine
+	if res.CpEpoch != nil {
+		status.CpEpoch = *res.CpEpoch
+	}
+	if res.CpRoot != nil {
+		status.CpRoot = *res.CpRoot
+	}
+}
+
+func incDagEndpointScore(score int) int {
+	if score >= maxDagEndpointScore {
+		return maxDagEndpointScore
+	}
+	return score + 1
+}
+
+func decDagEndpointScore(score int) int {
+	if score <= 0 {
+		return 0
+	}
+	return score - 1
+}
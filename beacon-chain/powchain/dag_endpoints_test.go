@@ -0,0 +1,217 @@
+package powchain
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/async/abool"
+	mocks "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/powchain/testing"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/network"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/assert"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
+	gwatTypes "gitlab.waterfall.network/waterfall/protocol/gwat/core/types"
+)
+
+func newDagEndpointsService(dags ...*mocks.DagSimulator) *Service {
+	eps := make([]network.Endpoint, len(dags))
+	dagEps := make([]*dagEndpoint, len(dags))
+	for i, dag := range dags {
+		eps[i] = network.Endpoint{Url: fmt.Sprintf("http://127.0.0.1:%d", 8545+i)}
+		dagEps[i] = &dagEndpoint{endpoint: eps[i], client: dag, status: DagEndpointStatus{Url: eps[i].Url}}
+	}
+	return &Service{
+		ctx: context.Background(),
+		cfg: &config{
+			httpEndpoints:          eps,
+			currHttpEndpoint:       eps[0],
+			beaconNodeStatsUpdater: &NopBeaconNodeStatsUpdater{},
+		},
+		dagEndpoints:         dagEps,
+		dagEndpointsChecking: abool.New(),
+	}
+}
+
+func finalizeDag(t *testing.T, dag *mocks.DagSimulator, spines gwatCommon.HashArray, cp *gwatTypes.Checkpoint) {
+	base := dag.LastFinalizedSpine()
+	_, err := dag.Finalize(context.Background(), &gwatTypes.FinalizationParams{
+		Spines:     spines,
+		BaseSpine:  &base,
+		Checkpoint: cp,
+	})
+	require.NoError(t, err)
+}
+
+func TestService_CheckDagEndpoints(t *testing.T) {
+	genesis := gwatCommon.HexToHash("0x351cd65f6e74ff61322d16c4a808bdce69c30410b3965fbbf188c46fa44da545")
+	current := mocks.NewDagSimulator(genesis)
+	lagging := mocks.NewDagSimulator(genesis)
+	forked := mocks.NewDagSimulator(genesis)
+	notSynced := mocks.NewDagSimulator(genesis)
+	spines := current.ProduceSlots(1, 2)
+	lagging.ProduceSlots(1, 2)
+	forked.ProduceSlots(1, 2)
+
+	cp := &gwatTypes.Checkpoint{Epoch: 1, Root: gwatCommon.HexToHash("0x01"), Spine: spines[1]}
+	finalizeDag(t, current, spines, cp)
+	finalizeDag(t, lagging, spines[:1], &gwatTypes.Checkpoint{Epoch: 0, Root: gwatCommon.HexToHash("0x02"), Spine: spines[0]})
+	finalizeDag(t, forked, spines, &gwatTypes.Checkpoint{Epoch: 1, Root: gwatCommon.HexToHash("0x03"), Spine: spines[1]})
+
+	s := newDagEndpointsService(current, lagging, forked, notSynced)
+	s.updateDagFinality(&gwatTypes.FinalizationParams{Spines: spines, BaseSpine: &genesis, Checkpoint: cp})
+	s.checkDagEndpoints(context.Background())
+
+	statuses := s.DagEndpointsStatus()
+	require.Equal(t, 4, len(statuses))
+
+	assert.Equal(t, true, statuses[0].Current)
+	assert.Equal(t, true, statuses[0].Healthy)
+	assert.Equal(t, true, statuses[0].Consistent)
+	assert.Equal(t, 1, statuses[0].ConsistencyScore)
+	assert.Equal(t, spines[1], statuses[0].LFSpine)
+	assert.Equal(t, uint64(1), statuses[0].CpEpoch)
+
+	// The lagging node knows the spines finalized by the coordinator.
+	assert.Equal(t, false, statuses[1].Current)
+	assert.Equal(t, true, statuses[1].Healthy)
+	assert.Equal(t, true, statuses[1].Consistent)
+	assert.Equal(t, spines[0], statuses[1].LFSpine)
+	assert.NoError(t, statuses[1].Err)
+
+	// The checkpoint of the same epoch differs.
+	assert.Equal(t, true, statuses[2].Healthy)
+	assert.Equal(t, false, statuses[2].Consistent)
+	assert.Equal(t, 0, statuses[2].ConsistencyScore)
+	assert.Equal(t, true, errors.Is(statuses[2].Err, ErrDagEndpointInconsistent))
+	assert.Equal(t, true, errors.Is(s.dagEndpointError(s.cfg.httpEndpoints[2]), ErrDagEndpointInconsistent))
+
+	// No coordinated state yet.
+	assert.Equal(t, false, statuses[3].Healthy)
+	assert.Equal(t, 0, statuses[3].HealthScore)
+	assert.ErrorContains(t, "coordinated state not found", statuses[3].Err)
+	assert.NoError(t, s.dagEndpointError(s.cfg.httpEndpoints[3]))
+
+	// Scores are increased by the next checks up to the max.
+	for i := 0; i < maxDagEndpointScore+1; i++ {
+		s.checkDagEndpoints(context.Background())
+	}
+	statuses = s.DagEndpointsStatus()
+	assert.Equal(t, maxDagEndpointScore, statuses[0].HealthScore)
+	assert.Equal(t, maxDagEndpointScore, statuses[1].ConsistencyScore)
+	assert.Equal(t, 0, statuses[2].ConsistencyScore)
+}
+
+func TestService_CheckDagEndpoints_CurrentDiverged(t *testing.T) {
+	genesis := gwatCommon.HexToHash("0x01")
+	current := mocks.NewDagSimulator(genesis)
+	backup := mocks.NewDagSimulator(genesis)
+	spines := backup.ProduceSlots(1)
+	current.ProduceSlots(2)
+	cp := &gwatTypes.Checkpoint{Epoch: 1, Root: gwatCommon.HexToHash("0x02"), Spine: spines[0]}
+	finalizeDag(t, backup, spines, cp)
+	finalizeDag(t, current, gwatCommon.HashArray{mocks.SpineForSlot(2)}, &gwatTypes.Checkpoint{Epoch: 1, Root: gwatCommon.HexToHash("0x03")})
+
+	s := newDagEndpointsService(current, backup)
+	s.updateDagFinality(&gwatTypes.FinalizationParams{Spines: spines, BaseSpine: &genesis, Checkpoint: cp})
+	s.checkDagEndpoints(context.Background())
+
+	// The current endpoint is judged against the local finality as well.
+	statuses := s.DagEndpointsStatus()
+	assert.Equal(t, true, statuses[0].Current)
+	assert.Equal(t, true, statuses[0].Healthy)
+	assert.Equal(t, false, statuses[0].Consistent)
+	assert.Equal(t, true, errors.Is(s.dagEndpointError(s.cfg.httpEndpoints[0]), ErrDagEndpointInconsistent))
+	assert.Equal(t, true, statuses[1].Consistent)
+	assert.Equal(t, 1, statuses[1].ConsistencyScore)
+}
+
+func TestService_CheckDagEndpoints_NoLocalFinality(t *testing.T) {
+	genesis := gwatCommon.HexToHash("0x01")
+	current := mocks.NewDagSimulator(genesis)
+	backup := mocks.NewDagSimulator(genesis)
+	spines := backup.ProduceSlots(1)
+	finalizeDag(t, backup, spines, &gwatTypes.Checkpoint{Epoch: 1, Root: gwatCommon.HexToHash("0x02"), Spine: spines[0]})
+
+	s := newDagEndpointsService(current, backup)
+	s.checkDagEndpoints(context.Background())
+
+	// The consistency is not judged before the first finalization.
+	statuses := s.DagEndpointsStatus()
+	assert.Equal(t, false, statuses[0].Healthy)
+	assert.Equal(t, true, statuses[1].Healthy)
+	assert.Equal(t, true, statuses[1].Consistent)
+	assert.Equal(t, 0, statuses[1].ConsistencyScore)
+}
+
+func TestService_FallbackToNextEndpoint_SkipsInconsistent(t *testing.T) {
+	genesis := gwatCommon.HexToHash("0x01")
+	first := mocks.NewDagSimulator(genesis)
+	second := mocks.NewDagSimulator(genesis)
+	forked := mocks.NewDagSimulator(genesis)
+	spines := first.ProduceSlots(1)
+	second.ProduceSlots(1)
+	forked.ProduceSlots(1)
+	finalizeDag(t, first, spines, &gwatTypes.Checkpoint{Epoch: 1, Root: gwatCommon.HexToHash("0x02"), Spine: spines[0]})
+	finalizeDag(t, second, spines, &gwatTypes.Checkpoint{Epoch: 1, Root: gwatCommon.HexToHash("0x02"), Spine: spines[0]})
+	finalizeDag(t, forked, spines, &gwatTypes.Checkpoint{Epoch: 1, Root: gwatCommon.HexToHash("0x03"), Spine: spines[0]})
+
+	s := newDagEndpointsService(first, second, forked)
+	s.updateDagFinality(&gwatTypes.FinalizationParams{
+		Spines:     spines,
+		BaseSpine:  &genesis,
+		Checkpoint: &gwatTypes.Checkpoint{Epoch: 1, Root: gwatCommon.HexToHash("0x02"), Spine: spines[0]},
+	})
+	s.checkDagEndpoints(context.Background())
+	eps := s.cfg.httpEndpoints
+
+	s.updateCurrHttpEndpoint(eps[1])
+	s.fallbackToNextEndpoint()
+	assert.Equal(t, eps[0].Url, s.cfg.currHttpEndpoint.Url)
+	s.fallbackToNextEndpoint()
+	assert.Equal(t, eps[1].Url, s.cfg.currHttpEndpoint.Url)
+}
+
+func TestService_FinalizeBackupDagEndpoints(t *testing.T) {
+	genesis := gwatCommon.HexToHash("0x01")
+	current := mocks.NewDagSimulator(genesis)
+	backup := mocks.NewDagSimulator(genesis)
+	forked := mocks.NewDagSimulator(genesis)
+	spines := current.ProduceSlots(1, 2)
+	backup.ProduceSlots(1, 2)
+	forked.ProduceSlots(1, 2)
+	finalizeDag(t, current, spines[:1], &gwatTypes.Checkpoint{Epoch: 1, Root: gwatCommon.HexToHash("0x02"), Spine: spines[0]})
+	finalizeDag(t, backup, spines[:1], &gwatTypes.Checkpoint{Epoch: 1, Root: gwatCommon.HexToHash("0x02"), Spine: spines[0]})
+	finalizeDag(t, forked, spines[:1], &gwatTypes.Checkpoint{Epoch: 1, Root: gwatCommon.HexToHash("0x03"), Spine: spines[0]})
+
+	s := newDagEndpointsService(current, backup, forked)
+	s.updateDagFinality(&gwatTypes.FinalizationParams{
+		Spines:     spines[:1],
+		BaseSpine:  &genesis,
+		Checkpoint: &gwatTypes.Checkpoint{Epoch: 1, Root: gwatCommon.HexToHash("0x02"), Spine: spines[0]},
+	})
+	s.checkDagEndpoints(context.Background())
+
+	params := &gwatTypes.FinalizationParams{
+		Spines:    spines[1:],
+		BaseSpine: &spines[0],
+	}
+	finalizeDag(t, current, params.Spines, nil)
+	s.finalizeBackupDagEndpoints(1, params)
+
+	assert.Equal(t, spines[1], backup.LastFinalizedSpine())
+	assert.Equal(t, spines[0], forked.LastFinalizedSpine())
+	assert.Equal(t, spines[1], s.DagEndpointsStatus()[1].LFSpine)
+
+	// Failed finalization decreases the health of the backup.
+	// A finalization older than the last one sent is skipped.
+	backup.FinalizeErr = errors.New("finalization failed")
+	s.finalizeBackupDagEndpoints(1, params)
+	assert.NoError(t, s.DagEndpointsStatus()[1].Err)
+
+	s.finalizeBackupDagEndpoints(2, params)
+	status := s.DagEndpointsStatus()[1]
+	assert.Equal(t, 0, status.HealthScore)
+	assert.ErrorContains(t, "finalization failed", status.Err)
+}
@@ -63,6 +63,7 @@ type EngineCaller interface {
 	ExecutionDagCoordinatedState(ctx context.Context) (*gwatTypes.FinalizationResult, error)
 	ExecutionDagGetOptimisticSpines(ctx context.Context, fromSpine gwatCommon.Hash) ([]gwatCommon.HashArray, error)
 	ExecutionDagGetCandidates(ctx context.Context, slot types.Slot) (gwatCommon.HashArray, error)
+	ExecutionDagValidateSpines(ctx context.Context, params gwatCommon.HashArray) (bool, error)
 	GetHeaderByHash(ctx context.Context, hash gwatCommon.Hash) (*gwatTypes.Header, error)
 	GetHeaderByNumber(ctx context.Context, nr *big.Int) (*gwatTypes.Header, error)
 }
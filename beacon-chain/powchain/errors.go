@@ -1,6 +1,9 @@
 package powchain
 
-import "github.com/pkg/errors"
+import (
+	"github.com/pkg/errors"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/powchain/types"
+)
 
 var (
 	// ErrParse corresponds to JSON-RPC code -32700.
@@ -30,4 +33,10 @@ var (
 	ErrInvalidPayloadStatus = errors.New("payload status is INVALID")
 	// ErrNilResponse when the response is nil.
 	ErrNilResponse = errors.New("nil response")
+	// ErrDagUnavailable when the dag api of gwat node is not reachable.
+	ErrDagUnavailable = types.ErrDagUnavailable
+	// ErrDagInvalidBaseSpine when gwat rejects finalization due to unknown base spine.
+	ErrDagInvalidBaseSpine = types.ErrDagInvalidBaseSpine
+	// ErrDagEndpointInconsistent when the gwat endpoint is not on the chain of the current endpoint.
+	ErrDagEndpointInconsistent = errors.New("gwat endpoint is not on the coordinated chain")
 )
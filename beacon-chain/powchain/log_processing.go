@@ -13,6 +13,7 @@ import (
 	statefeed "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/feed/state"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/helpers"
 	coreState "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/transition"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/operations/lifecycle"
 	v1 "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/state/v1"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
@@ -139,11 +140,27 @@ func (s *Service) ProcessWithdrawalLog(ctx context.Context, wtdLog gwatTypes.Log
 		Epoch:          curEpoch + 2, // min 1 epoch to propagate op by network
 	}
 	s.cfg.withdrawalPool.InsertWithdrawal(ctx, exit)
+	s.saveOperationPooled(ctx, &lifecycle.Operation{
+		InitTxHash:     bytesutil.ToBytes32(exit.InitTxHash),
+		Type:           lifecycle.OpWithdrawal,
+		ValidatorIndex: exit.ValidatorIndex,
+		Amount:         exit.Amount,
+	}, curSlot)
 
 	return nil
 }
 
 func (s *Service) ProcessExitLog(ctx context.Context, exitLog gwatTypes.Log) error {
+	curSlot := s.lastHandledSlot
+	if !params.BeaconConfig().IsDelegatingStakeSlot(s.lastHandledSlot) {
+		curSlot = slots.CurrentSlot(s.cfg.finalizedStateAtStartup.GenesisTime())
+	}
+	return s.processExitLogAtSlot(ctx, exitLog, curSlot, params.BeaconConfig().IsDelegatingStakeSlot(s.lastHandledSlot))
+}
+
+// processExitLogAtSlot inserts the exit of the log into the pool,
+// the exit epoch is calculated relative to the slot the log is handled at.
+func (s *Service) processExitLogAtSlot(ctx context.Context, exitLog gwatTypes.Log, curSlot types.Slot, isDelegating bool) error {
 	pubkey, creatorAddr, valIndex, exitEpoch, err := gwatValLog.UnpackExitRequestLogData(exitLog.Data)
 
 	log.WithError(err).WithFields(logrus.Fields{
@@ -158,13 +175,8 @@ func (s *Service) ProcessExitLog(ctx context.Context, exitLog gwatTypes.Log) err
 		return errors.Wrap(err, "Could not unpack log (exit)")
 	}
 
-	curSlot := s.lastHandledSlot
-	if !params.BeaconConfig().IsDelegatingStakeSlot(s.lastHandledSlot) {
-		curSlot = slots.CurrentSlot(s.cfg.finalizedStateAtStartup.GenesisTime())
-	}
-
 	valExitEpoch := slots.ToEpoch(curSlot)
-	if !params.BeaconConfig().IsDelegatingStakeSlot(s.lastHandledSlot) {
+	if !isDelegating {
 		if exitEpoch != nil && *exitEpoch > uint64(valExitEpoch) {
 			valExitEpoch = types.Epoch(*exitEpoch)
 		}
@@ -179,6 +191,12 @@ func (s *Service) ProcessExitLog(ctx context.Context, exitLog gwatTypes.Log) err
 	}
 
 	s.cfg.exitPool.InsertVoluntaryExitByGwat(ctx, exit)
+	s.saveOperationPooled(ctx, &lifecycle.Operation{
+		InitTxHash:     bytesutil.ToBytes32(exit.InitTxHash),
+		Type:           lifecycle.OpExit,
+		ValidatorIndex: exit.ValidatorIndex,
+	}, curSlot)
+	s.saveExitLogsLastHandledBlock(ctx, exitLog.BlockNumber)
 
 	log.WithError(err).WithFields(logrus.Fields{
 		"exit.valIndex":   exit.ValidatorIndex,
@@ -189,6 +207,105 @@ func (s *Service) ProcessExitLog(ctx context.Context, exitLog gwatTypes.Log) err
 	return nil
 }
 
+// saveExitLogsLastHandledBlock moves forward the gwat block number of the last handled exit log,
+// the exit logs after it are re-read at startup by catchUpExitLogs.
+func (s *Service) saveExitLogsLastHandledBlock(ctx context.Context, blockNum uint64) {
+	if s.cfg.beaconDB == nil {
+		return
+	}
+	lastHandled, err := s.cfg.beaconDB.ExitLogsLastHandledBlock(ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not get last handled exit log block")
+		return
+	}
+	if blockNum <= lastHandled {
+		return
+	}
+	if err := s.cfg.beaconDB.SaveExitLogsLastHandledBlock(ctx, blockNum); err != nil {
+		log.WithError(err).WithField("blockNum", blockNum).Error("Could not save last handled exit log block")
+	}
+}
+
+// catchUpExitLogs re-reads the exit request logs from the last handled one
+// up to the last requested gwat block, so the exits missed by the persisted
+// exit pool (e.g. by the node stop in between) are inserted into the pool.
+func (s *Service) catchUpExitLogs(ctx context.Context) error {
+	if s.cfg.beaconDB == nil {
+		return nil
+	}
+	endBlock := s.latestEth1Data.LastRequestedBlock
+	lastHandled, err := s.cfg.beaconDB.ExitLogsLastHandledBlock(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get last handled exit log block")
+	}
+	// No exit log is tracked yet (e.g. the node is started on the database created
+	// before the tracking), the exit pool is considered consistent up to the last requested block.
+	if lastHandled == 0 {
+		return s.cfg.beaconDB.SaveExitLogsLastHandledBlock(ctx, endBlock)
+	}
+	if lastHandled >= endBlock {
+		return nil
+	}
+	query := gwat.FilterQuery{
+		Addresses: []gwatCommon.Address{
+			s.cfg.depositContractAddr,
+		},
+		FromBlock: new(big.Int).SetUint64(lastHandled + 1),
+		ToBlock:   new(big.Int).SetUint64(endBlock),
+		Topics:    [][]gwatCommon.Hash{{gwatValLog.EvtExitReqLogSignature}},
+	}
+	logs, err := s.httpLogger.FilterLogs(ctx, query)
+	if err != nil {
+		return errors.Wrap(err, "could not filter exit logs")
+	}
+	for _, exitLog := range logs {
+		if len(exitLog.Topics) == 0 || exitLog.Topics[0] != gwatValLog.EvtExitReqLogSignature {
+			continue
+		}
+		// The exit handled before the node stop keeps the epoch calculated by the log handling.
+		stored, err := s.cfg.beaconDB.ExitPoolItem(ctx, exitLog.TxHash.Bytes())
+		if err != nil {
+			return errors.Wrap(err, "could not get stored exit")
+		}
+		if stored != nil {
+			continue
+		}
+		// The missed exit is handled at the slot of the gwat block of the log,
+		// as it was by the nodes handled it in time.
+		header, err := s.eth1DataFetcher.HeaderByHash(ctx, exitLog.BlockHash)
+		if err != nil {
+			return errors.Wrap(err, "could not get exit log block header")
+		}
+		logSlot := types.Slot(header.Slot)
+		if err := s.processExitLogAtSlot(ctx, exitLog, logSlot, params.BeaconConfig().IsDelegatingStakeSlot(logSlot)); err != nil {
+			return errors.Wrap(err, "could not process exit log")
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"fromBlock": lastHandled + 1,
+		"toBlock":   endBlock,
+		"exits":     len(logs),
+	}).Info("Exit logs caught up")
+	return s.cfg.beaconDB.SaveExitLogsLastHandledBlock(ctx, endBlock)
+}
+
+// saveOperationPooled records the gwat initiated operation as seen in the log and inserted into the pool at the slot.
+func (s *Service) saveOperationPooled(ctx context.Context, op *lifecycle.Operation, slot types.Slot) {
+	if s.cfg.beaconDB == nil {
+		return
+	}
+	op.Records = []*lifecycle.Record{
+		{Stage: lifecycle.StageSeen, Slot: slot},
+		{Stage: lifecycle.StagePooled, Slot: slot},
+	}
+	if err := s.cfg.beaconDB.SaveOperationLifecycles(ctx, []*lifecycle.Operation{op}); err != nil {
+		log.WithError(err).WithFields(logrus.Fields{
+			"opType":     op.Type,
+			"initTxHash": fmt.Sprintf("%#x", op.InitTxHash),
+		}).Error("Could not save operation lifecycle")
+	}
+}
+
 // ProcessDepositLog processes the log which had been received from
 // the ETH1.0 chain by trying to ascertain which participant deposited
 // in the contract.
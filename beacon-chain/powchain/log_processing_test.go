@@ -5,17 +5,22 @@ import (
 	"testing"
 	"time"
 
+	types "github.com/prysmaticlabs/eth2-types"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/cache/depositcache"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/feed"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/db"
 	testDB "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/db/testing"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/operations/voluntaryexits"
 	mockPOW "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/powchain/testing"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/assert"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
 	ethereum "gitlab.waterfall.network/waterfall/protocol/gwat"
 	"gitlab.waterfall.network/waterfall/protocol/gwat/common"
+	gethTypes "gitlab.waterfall.network/waterfall/protocol/gwat/core/types"
+	gwatValLog "gitlab.waterfall.network/waterfall/protocol/gwat/validator/txlog"
 )
 
 func TestProcessETH2GenesisLog_8DuplicatePubkeys(t *testing.T) {
@@ -266,3 +271,89 @@ func newPowchainService(t *testing.T, eth1Backend *mockPOW.TestAccount, beaconDB
 	params.OverrideBeaconConfig(bConfig)
 	return web3Service
 }
+
+func TestCatchUpExitLogs(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	s := &Service{
+		cfg:            &config{beaconDB: beaconDB},
+		httpLogger:     &goodLogger{},
+		latestEth1Data: &ethpb.LatestETH1Data{LastRequestedBlock: 100},
+	}
+
+	// No tracked exit logs: the marker is set to the last requested block.
+	require.NoError(t, s.catchUpExitLogs(ctx))
+	lastHandled, err := beaconDB.ExitLogsLastHandledBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100), lastHandled)
+
+	// The logs which are not exit requests are skipped, the marker moves to the last requested block.
+	s.latestEth1Data.LastRequestedBlock = 150
+	require.NoError(t, s.catchUpExitLogs(ctx))
+	lastHandled, err = beaconDB.ExitLogsLastHandledBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(150), lastHandled)
+
+	// The marker is not moved back.
+	s.saveExitLogsLastHandledBlock(ctx, 120)
+	lastHandled, err = beaconDB.ExitLogsLastHandledBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(150), lastHandled)
+}
+
+type exitLogger struct {
+	goodLogger
+	logs []gethTypes.Log
+}
+
+func (l *exitLogger) FilterLogs(_ context.Context, _ ethereum.FilterQuery) ([]gethTypes.Log, error) {
+	return l.logs, nil
+}
+
+func TestCatchUpExitLogs_MissedExit(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.DelegateForkSlot = 1000
+	params.OverrideBeaconConfig(cfg)
+
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	exitPool, err := voluntaryexits.NewPersistentPool(ctx, beaconDB)
+	require.NoError(t, err)
+	exitEpoch := uint64(7)
+	exitLog := gethTypes.Log{
+		Topics:      []common.Hash{gwatValLog.EvtExitReqLogSignature},
+		Data:        gwatValLog.PackExitRequestLogData(common.BlsPubKey{'p'}, common.Address{'c'}, 3, &exitEpoch),
+		BlockNumber: 120,
+		BlockHash:   common.Hash{'b'},
+		TxHash:      common.Hash{'t', 'x'},
+	}
+	s := &Service{
+		cfg:             &config{beaconDB: beaconDB, exitPool: exitPool},
+		httpLogger:      &exitLogger{logs: []gethTypes.Log{exitLog}},
+		eth1DataFetcher: &goodFetcher{},
+		latestEth1Data:  &ethpb.LatestETH1Data{LastRequestedBlock: 150},
+	}
+	require.NoError(t, beaconDB.SaveExitLogsLastHandledBlock(ctx, 100))
+
+	// The missed exit is inserted into the pool, its epoch is calculated by the slot of the log block.
+	require.NoError(t, s.catchUpExitLogs(ctx))
+	stored, err := beaconDB.ExitPoolItem(ctx, exitLog.TxHash.Bytes())
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	assert.Equal(t, types.ValidatorIndex(3), stored.ValidatorIndex)
+	assert.Equal(t, types.Epoch(7), stored.Epoch)
+	require.NoError(t, exitPool.Verify(ctx, stored))
+	lastHandled, err := beaconDB.ExitLogsLastHandledBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(150), lastHandled)
+
+	// The exit handled before is kept as stored on the repeated catch up.
+	stored.Epoch = 9
+	require.NoError(t, beaconDB.SaveExitPoolItems(ctx, []*ethpb.VoluntaryExit{stored}))
+	require.NoError(t, beaconDB.SaveExitLogsLastHandledBlock(ctx, 100))
+	require.NoError(t, s.catchUpExitLogs(ctx))
+	stored, err = beaconDB.ExitPoolItem(ctx, exitLog.TxHash.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(9), stored.Epoch)
+}
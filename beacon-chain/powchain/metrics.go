@@ -1,6 +1,8 @@
 package powchain
 
 import (
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 )
@@ -27,4 +29,27 @@ var (
 			Buckets: []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000},
 		},
 	)
+	dagRPCLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "dag_rpc_latency_milliseconds",
+			Help:    "Captures RPC latency of the gwat dag api in milliseconds by method",
+			Buckets: []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000, 2000, 5000},
+		},
+		[]string{"method"},
+	)
+	dagRPCErrors = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "dag_rpc_errors_total",
+			Help: "Count the number of failed RPC calls of the gwat dag api by method and kind of error",
+		},
+		[]string{"method", "kind"},
+	)
 )
+
+// observeDagRPC reports the latency and the error of the dag api call started at the start time.
+func observeDagRPC(method string, start time.Time, err error) {
+	dagRPCLatency.WithLabelValues(method).Observe(float64(time.Since(start).Milliseconds()))
+	if err != nil {
+		dagRPCErrors.WithLabelValues(method, string(DagErrorKindOf(handleDagRPCError(err)))).Inc()
+	}
+}
@@ -141,3 +141,12 @@ func WithFinalizedStateAtStartup(st state.BeaconState) Option {
 		return nil
 	}
 }
+
+// WithDagClient to replace the JSON-RPC client of the gwat dag api,
+// e.g. by a simulated DAG.
+func WithDagClient(client DagClient) Option {
+	return func(s *Service) error {
+		s.cfg.dagClient = client
+		return nil
+	}
+}
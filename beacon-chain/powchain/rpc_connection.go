@@ -117,6 +117,11 @@ func (s *Service) checkDefaultEndpoint(ctx context.Context) {
 	if s.cfg.currHttpEndpoint.Equals(primaryEndpoint) {
 		return
 	}
+	// Do not switch to the primary endpoint if it is not on the coordinated chain.
+	if !s.isDagEndpointConsistent(primaryEndpoint) {
+		log.Debug("Primary endpoint is not on the coordinated chain")
+		return
+	}
 
 	currClient := s.rpcClient
 	if err := s.setupExecutionClientConnections(ctx, primaryEndpoint); err != nil {
@@ -141,9 +146,14 @@ func (s *Service) fallbackToNextEndpoint() {
 			break
 		}
 	}
-	nextIndex := currIndex + 1
-	if nextIndex >= totalEndpoints {
-		nextIndex = 0
+	// Skip the endpoints found on another chain.
+	nextIndex := currIndex
+	for i := 1; i <= totalEndpoints; i++ {
+		idx := (currIndex + i) % totalEndpoints
+		if s.isDagEndpointConsistent(s.cfg.httpEndpoints[idx]) {
+			nextIndex = idx
+			break
+		}
 	}
 	s.updateCurrHttpEndpoint(s.cfg.httpEndpoints[nextIndex])
 	if nextIndex != currIndex {
@@ -153,6 +163,12 @@ func (s *Service) fallbackToNextEndpoint() {
 
 // Initializes an RPC connection with authentication headers.
 func (s *Service) newRPCClientWithAuth(ctx context.Context, endpoint network.Endpoint) (*gethRPC.Client, error) {
+	return dialRPCClientWithAuth(ctx, endpoint)
+}
+
+// dialRPCClientWithAuth dials the http or ipc endpoint
+// and sets the authorization header of the endpoint if any.
+func dialRPCClientWithAuth(ctx context.Context, endpoint network.Endpoint) (*gethRPC.Client, error) {
 	// Need to handle ipc and http
 	var client *gethRPC.Client
 	u, err := url.Parse(endpoint.Url)
@@ -18,6 +18,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	ethTypes "github.com/prysmaticlabs/eth2-types"
 	"github.com/sirupsen/logrus"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/async/abool"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/cache/depositcache"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/feed"
 	statefeed "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/feed/state"
@@ -92,6 +93,16 @@ type ChainInfoFetcher interface {
 	ETH1ConnectionErrors() []error
 }
 
+// DagEndpointsFetcher retrieves the health and the consistency of the configured gwat endpoints.
+type DagEndpointsFetcher interface {
+	DagEndpointsStatus() []DagEndpointStatus
+}
+
+// DagCoordinatedStateFetcher retrieves the coordinated state of gwat.
+type DagCoordinatedStateFetcher interface {
+	ExecutionDagCoordinatedState(ctx context.Context) (*gwatTypes.FinalizationResult, error)
+}
+
 // POWBlockFetcher defines a struct that can retrieve mainchain blocks.
 type POWBlockFetcher interface {
 	BlockTimeByHeight(ctx context.Context, height *big.Int) (uint64, error)
@@ -142,6 +153,7 @@ type config struct {
 	httpEndpoints           []network.Endpoint
 	currHttpEndpoint        network.Endpoint
 	finalizedStateAtStartup state.BeaconState
+	dagClient               DagClient
 }
 
 // Service fetches important information about the canonical
@@ -173,6 +185,12 @@ type Service struct {
 	lastHandledBlock [32]byte          // last handled beacon block root
 	lastHandledSlot  ethTypes.Slot     // last handled beacon block slot
 	lastHandledState state.BeaconState // last handled beacon state
+	// health and consistency of the configured gwat endpoints
+	dagEndpoints         []*dagEndpoint
+	dagEndpointsLock     sync.RWMutex
+	dagEndpointsChecking *abool.AtomicBool
+	dagFinality          *dagFinality // the local finality the endpoints are checked against
+	dagFinalizeSeq       uint64       // the sequence number of the finalizations sent to backup endpoints
 }
 
 // NewService sets up a new instance with an ethclient when given a web3 endpoint as a string in the config.
@@ -211,7 +229,8 @@ func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 		lastReceivedMerkleIndex: -1,
 		preGenesisState:         genState,
 		//headTicker:              time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerETH1Block) * time.Second),
-		headTicker: time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second),
+		headTicker:           time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second),
+		dagEndpointsChecking: abool.New(),
 	}
 
 	for _, opt := range opts {
@@ -451,6 +470,7 @@ func (s *Service) StateTracker() {
 				s.processBlockHeader(header, &baseSpine)
 				s.handleETH1FollowDistance()
 				s.checkDefaultEndpoint(s.ctx)
+				s.checkDagEndpointsAsync()
 
 				s.lastHandledBlock = bytesutil.ToBytes32(data.Block)
 				//s.lastHandledSlot = st.Slot()
@@ -583,22 +603,23 @@ func (s *Service) ETH1Endpoints() []string {
 }
 
 // ETH1ConnectionErrors returns a slice of errors for each HTTP endpoint. An error
-// of nil means the connection was successful.
+// of nil means the connection was successful and the endpoint is on the coordinated chain.
 func (s *Service) ETH1ConnectionErrors() []error {
 	var errs []error
 	for _, ep := range s.cfg.httpEndpoints {
 		client, err := s.newRPCClientWithAuth(s.ctx, ep)
 		if err != nil {
-			client.Close()
 			errs = append(errs, err)
 			continue
 		}
-		if err := ensureCorrectExecutionChain(s.ctx, ethclient.NewClient(client)); err != nil {
-			client.Close()
+		err = ensureCorrectExecutionChain(s.ctx, ethclient.NewClient(client))
+		client.Close()
+		if err == nil {
+			err = s.dagEndpointError(ep)
+		}
+		if err != nil {
 			errs = append(errs, err)
-			continue
 		}
-		client.Close()
 	}
 	return errs
 }
@@ -839,6 +860,11 @@ func (s *Service) initPOWService() {
 				"lastReceivedMerkleIndex": s.lastReceivedMerkleIndex,
 			}).Info("=== LogProcessing: initPOWService: 00000")
 
+			if err := s.catchUpExitLogs(ctx); err != nil {
+				s.retryExecutionClientConnection(ctx, err)
+				errorLogger(err, "Unable to catch up exit request logs")
+				continue
+			}
 			if err := s.processPastLogs(ctx); err != nil {
 				s.retryExecutionClientConnection(ctx, err)
 				errorLogger(err, "Unable to process past deposit contract logs")
@@ -952,6 +978,7 @@ func (s *Service) run(done <-chan struct{}) {
 			s.processBlockHeader(head, nil)
 			s.handleETH1FollowDistance()
 			s.checkDefaultEndpoint(s.ctx)
+			s.checkDagEndpointsAsync()
 		case <-chainstartTicker.C:
 			if s.chainStartData.Chainstarted {
 				chainstartTicker.Stop()
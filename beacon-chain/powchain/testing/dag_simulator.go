@@ -0,0 +1,224 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package testing
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	powchaintypes "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/powchain/types"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
+	gwatTypes "gitlab.waterfall.network/waterfall/protocol/gwat/core/types"
+)
+
+// DagSimulator is an in-process simulation of the gwat DAG.
+// It produces a spine per slot, tracks the finalized spines
+// and the coordinated checkpoint, and serves the dag api
+// the way a gwat node does, so it can replace the JSON-RPC
+// dag client of the powchain service in tests.
+type DagSimulator struct {
+	lock        sync.RWMutex
+	spines      []gwatCommon.Hash
+	spineSlots  map[gwatCommon.Hash]types.Slot
+	finalized   []gwatCommon.Hash
+	coordinated *gwatTypes.Checkpoint
+	slotInfo    *gwatTypes.SlotInfo
+	// FinalizeErr, if set, is returned by every finalization call.
+	FinalizeErr error
+}
+
+// NewDagSimulator creates a simulated DAG with the given genesis spine finalized.
+func NewDagSimulator(genesisSpine gwatCommon.Hash) *DagSimulator {
+	return &DagSimulator{
+		spines:     []gwatCommon.Hash{genesisSpine},
+		spineSlots: map[gwatCommon.Hash]types.Slot{genesisSpine: 0},
+		finalized:  []gwatCommon.Hash{genesisSpine},
+	}
+}
+
+// SpineForSlot returns the deterministic spine hash the simulator produces for the slot.
+func SpineForSlot(slot types.Slot) gwatCommon.Hash {
+	return gwatCommon.BytesToHash(bytesutil.Bytes8(uint64(slot) + 1))
+}
+
+// ProduceSlots adds a spine for each of the given slots to the DAG.
+// Slots must be greater than the slot of the last produced spine.
+func (d *DagSimulator) ProduceSlots(slots ...types.Slot) []gwatCommon.Hash {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	produced := make([]gwatCommon.Hash, 0, len(slots))
+	for _, slot := range slots {
+		last := d.spines[len(d.spines)-1]
+		if slot <= d.spineSlots[last] {
+			continue
+		}
+		spine := SpineForSlot(slot)
+		d.spines = append(d.spines, spine)
+		d.spineSlots[spine] = slot
+		produced = append(produced, spine)
+	}
+	return produced
+}
+
+// LastFinalizedSpine returns the last finalized spine.
+func (d *DagSimulator) LastFinalizedSpine() gwatCommon.Hash {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	return d.finalized[len(d.finalized)-1]
+}
+
+// FinalizedSpines returns the finalized spines in finalization order.
+func (d *DagSimulator) FinalizedSpines() gwatCommon.HashArray {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	return append(gwatCommon.HashArray{}, d.finalized...)
+}
+
+// SlotInfo returns the slot info set by the coordinator.
+func (d *DagSimulator) SlotInfo() *gwatTypes.SlotInfo {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	return d.slotInfo
+}
+
+// Finalize simulates dag_finalize. The base spine must be a finalized spine,
+// and the spines to finalize must be known and follow the base spine in order.
+// Finalization may roll back to any finalized base spine.
+func (d *DagSimulator) Finalize(_ context.Context, params *gwatTypes.FinalizationParams) (*gwatTypes.FinalizationResult, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	lfSpine := d.finalized[len(d.finalized)-1]
+	result := &gwatTypes.FinalizationResult{LFSpine: &lfSpine}
+	if d.FinalizeErr != nil {
+		return result, d.FinalizeErr
+	}
+	if params == nil || params.BaseSpine == nil {
+		return result, powchaintypes.ErrDagInvalidBaseSpine
+	}
+	baseIdx := -1
+	for i, h := range d.finalized {
+		if h == *params.BaseSpine {
+			baseIdx = i
+			break
+		}
+	}
+	if baseIdx < 0 {
+		return result, powchaintypes.ErrDagInvalidBaseSpine
+	}
+	prevSlot := d.spineSlots[*params.BaseSpine]
+	for _, spine := range params.Spines {
+		slot, ok := d.spineSlots[spine]
+		if !ok {
+			return result, errors.Wrapf(powchaintypes.ErrDagUnknownSpine, "%#x", spine)
+		}
+		if slot <= prevSlot {
+			return result, errors.Wrapf(powchaintypes.ErrDagBadSpinesOrder, "spine %#x", spine)
+		}
+		prevSlot = slot
+	}
+	d.finalized = append(d.finalized[:baseIdx+1:baseIdx+1], params.Spines...)
+	lfSpine = d.finalized[len(d.finalized)-1]
+	result.LFSpine = &lfSpine
+	if params.Checkpoint != nil {
+		cp := params.Checkpoint.Copy()
+		d.coordinated = cp
+		result.CpEpoch = &cp.Epoch
+		result.CpRoot = &cp.Root
+	}
+	return result, nil
+}
+
+// CoordinatedState simulates dag_coordinatedState.
+func (d *DagSimulator) CoordinatedState(_ context.Context) (*gwatTypes.FinalizationResult, error) {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	lfSpine := d.finalized[len(d.finalized)-1]
+	result := &gwatTypes.FinalizationResult{LFSpine: &lfSpine}
+	if d.coordinated == nil {
+		return result, powchaintypes.ErrDagNoCoordinatedData
+	}
+	cp := d.coordinated.Copy()
+	result.CpEpoch = &cp.Epoch
+	result.CpRoot = &cp.Root
+	return result, nil
+}
+
+// GetOptimisticSpines simulates dag_getOptimisticSpines returning the spines
+// produced after the given spine, grouped by slot.
+func (d *DagSimulator) GetOptimisticSpines(_ context.Context, fromSpine gwatCommon.Hash) ([]gwatCommon.HashArray, error) {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	fromSlot, ok := d.spineSlots[fromSpine]
+	if !ok {
+		return nil, errors.Wrapf(powchaintypes.ErrDagUnknownSpine, "%#x", fromSpine)
+	}
+	res := make([]gwatCommon.HashArray, 0)
+	for _, spine := range d.spines {
+		if d.spineSlots[spine] > fromSlot {
+			res = append(res, gwatCommon.HashArray{spine})
+		}
+	}
+	return res, nil
+}
+
+// GetCandidates simulates dag_getCandidates returning the not finalized spines
+// produced up to the given slot.
+func (d *DagSimulator) GetCandidates(_ context.Context, slot types.Slot) (gwatCommon.HashArray, error) {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	lfSlot := d.spineSlots[d.finalized[len(d.finalized)-1]]
+	res := gwatCommon.HashArray{}
+	for _, spine := range d.spines {
+		spineSlot := d.spineSlots[spine]
+		if spineSlot > lfSlot && spineSlot <= slot {
+			res = append(res, spine)
+		}
+	}
+	return res, nil
+}
+
+// SyncSlotInfo simulates dag_syncSlotInfo.
+func (d *DagSimulator) SyncSlotInfo(_ context.Context, params *gwatTypes.SlotInfo) (bool, error) {
+	if params == nil {
+		return false, fmt.Errorf("invalid slot info")
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.slotInfo = params
+	return true, nil
+}
+
+// ValidateSpines simulates dag_validateSpines: spines are valid
+// if all of them are known and ordered by slot.
+func (d *DagSimulator) ValidateSpines(_ context.Context, spines gwatCommon.HashArray) (bool, error) {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	var prevSlot types.Slot
+	for i, spine := range spines {
+		slot, ok := d.spineSlots[spine]
+		if !ok {
+			return false, nil
+		}
+		if i > 0 && slot <= prevSlot {
+			return false, nil
+		}
+		prevSlot = slot
+	}
+	return true, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/holiman/uint256"
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
+	powchaintypes "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/powchain/types"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
 	pb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/engine/v1"
@@ -31,30 +32,56 @@ type EngineClient struct {
 	BlockByHashMap          map[[32]byte]*pb.ExecutionBlock
 	TerminalBlockHash       []byte
 	TerminalBlockHashExists bool
+	// Dag serves the dag api calls if set, otherwise they fail as unavailable.
+	Dag *DagSimulator
 }
 
+// ExecutionDagSyncSlotInfo --
 func (e *EngineClient) ExecutionDagSyncSlotInfo(ctx context.Context, params *gwatTypes.SlotInfo) (bool, error) {
-	panic("implement me")
+	if e.Dag == nil {
+		return false, powchaintypes.ErrDagUnavailable
+	}
+	return e.Dag.SyncSlotInfo(ctx, params)
 }
 
+// ExecutionDagGetCandidates --
 func (e *EngineClient) ExecutionDagGetCandidates(ctx context.Context, slot types.Slot) (gwatCommon.HashArray, error) {
-	panic("implement me")
+	if e.Dag == nil {
+		return nil, powchaintypes.ErrDagUnavailable
+	}
+	return e.Dag.GetCandidates(ctx, slot)
 }
 
+// ExecutionDagGetOptimisticSpines --
 func (e *EngineClient) ExecutionDagGetOptimisticSpines(ctx context.Context, fromSpine gwatCommon.Hash) ([]gwatCommon.HashArray, error) {
-	panic("implement me")
+	if e.Dag == nil {
+		return nil, powchaintypes.ErrDagUnavailable
+	}
+	return e.Dag.GetOptimisticSpines(ctx, fromSpine)
 }
 
+// ExecutionDagFinalize --
 func (e *EngineClient) ExecutionDagFinalize(ctx context.Context, finParams *gwatTypes.FinalizationParams) (*gwatTypes.FinalizationResult, error) {
-	panic("implement me")
+	if e.Dag == nil {
+		return &gwatTypes.FinalizationResult{}, powchaintypes.ErrDagUnavailable
+	}
+	return e.Dag.Finalize(ctx, finParams)
 }
 
+// ExecutionDagCoordinatedState --
 func (e *EngineClient) ExecutionDagCoordinatedState(ctx context.Context) (*gwatTypes.FinalizationResult, error) {
-	panic("implement me")
+	if e.Dag == nil {
+		return &gwatTypes.FinalizationResult{}, powchaintypes.ErrDagUnavailable
+	}
+	return e.Dag.CoordinatedState(ctx)
 }
 
+// ExecutionDagValidateSpines --
 func (e *EngineClient) ExecutionDagValidateSpines(ctx context.Context, params gwatCommon.HashArray) (bool, error) {
-	panic("implement me")
+	if e.Dag == nil {
+		return false, powchaintypes.ErrDagUnavailable
+	}
+	return e.Dag.ValidateSpines(ctx, params)
 }
 
 func (e *EngineClient) GetHeaderByHash(ctx context.Context, hash gwatCommon.Hash) (*gwatTypes.Header, error) {
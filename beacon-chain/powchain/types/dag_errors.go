@@ -0,0 +1,31 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package types
+
+import "github.com/pkg/errors"
+
+// Errors of the gwat dag api.
+var (
+	// ErrDagUnavailable when the dag api of gwat node is not reachable.
+	ErrDagUnavailable = errors.New("dag api is unavailable")
+	// ErrDagInvalidBaseSpine when gwat rejects finalization due to unknown base spine.
+	ErrDagInvalidBaseSpine = errors.New("invalid base spine")
+	// ErrDagUnknownSpine when gwat does not know a spine of the request.
+	ErrDagUnknownSpine = errors.New("unknown spine")
+	// ErrDagBadSpinesOrder when the spines of the request are not ordered by slot.
+	ErrDagBadSpinesOrder = errors.New("bad order of spines")
+	// ErrDagNoCoordinatedData when gwat has no coordinated checkpoint yet.
+	ErrDagNoCoordinatedData = errors.New("coordinated state not found")
+)
@@ -245,6 +245,10 @@ func receiveEvents(eventChan <-chan *sse.Event, w http.ResponseWriter, req *http
 				data = &eventChainReorgJson{}
 			case events.SyncCommitteeContributionTopic:
 				data = &signedContributionAndProofJson{}
+			case events.PrevoteTopic:
+				data = &prevoteJson{}
+			case events.GwatFinalizationTopic:
+				data = &gwatFinalizationJson{}
 			case "error":
 				data = &eventErrorJson{}
 			default:
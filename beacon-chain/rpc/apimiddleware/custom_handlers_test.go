@@ -277,3 +277,37 @@ func TestWriteEvent(t *testing.T) {
 	written := w.Body.String()
 	assert.Equal(t, "event: test_event\ndata: {\"block\":\"0x666f6f\",\"state\":\"0x666f6f\",\"epoch\":\"1\",\"execution_optimistic\":false}\n\n", written)
 }
+
+func TestWriteEvent_GwatFinalization(t *testing.T) {
+	base64Val := "Zm9v"
+	data := &gwatFinalizationJson{
+		Slot:      "8",
+		SyncMode:  "head",
+		Spines:    []string{base64Val},
+		BaseSpine: base64Val,
+		Checkpoint: &gwatCheckpointJson{
+			Epoch:    "1",
+			FinEpoch: "0",
+			Root:     base64Val,
+			Spine:    base64Val,
+		},
+		LFSpine: base64Val,
+		Success: true,
+		Time:    "100",
+	}
+	bData, err := json.Marshal(data)
+	require.NoError(t, err)
+	msg := &sse.Event{
+		Data:  bData,
+		Event: []byte("gwat_finalization"),
+	}
+	w := httptest.NewRecorder()
+	w.Body = &bytes.Buffer{}
+
+	errJson := writeEvent(msg, w, &gwatFinalizationJson{})
+	require.Equal(t, true, errJson == nil)
+	written := w.Body.String()
+	assert.Equal(t, "event: gwat_finalization\ndata: {\"slot\":\"8\",\"sync_mode\":\"head\",\"spines\":[\"0x666f6f\"],\"base_spine\":\"0x666f6f\","+
+		"\"checkpoint\":{\"epoch\":\"1\",\"fin_epoch\":\"0\",\"root\":\"0x666f6f\",\"spine\":\"0x666f6f\"},"+
+		"\"lf_spine\":\"0x666f6f\",\"success\":true,\"error\":\"\",\"time\":\"100\"}\n\n", written)
+}
@@ -62,6 +62,28 @@ func wrapAttestationsArray(
 	return true, nil
 }
 
+// /eth/v1/beacon/pool/prevotes expects posting a top-level array, in the same way as the attestations pool.
+// We make it more proto-friendly by wrapping it in a struct with a 'data' field.
+func wrapPrevotesArray(
+	endpoint *apimiddleware.Endpoint,
+	_ http.ResponseWriter,
+	req *http.Request,
+) (apimiddleware.RunDefault, apimiddleware.ErrorJSON) {
+	if _, ok := endpoint.PostRequest.(*submitPrevotesRequestJson); ok {
+		prevotes := make([]*prevoteJson, 0)
+		if err := json.NewDecoder(req.Body).Decode(&prevotes); err != nil {
+			return false, apimiddleware.InternalServerErrorWithMessage(err, "could not decode body")
+		}
+		j := &submitPrevotesRequestJson{Data: prevotes}
+		b, err := json.Marshal(j)
+		if err != nil {
+			return false, apimiddleware.InternalServerErrorWithMessage(err, "could not marshal wrapped body")
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(b))
+	}
+	return true, nil
+}
+
 // Some endpoints e.g. https://ethereum.github.io/beacon-apis/#/Validator/getAttesterDuties expect posting a top-level array.
 // We make it more proto-friendly by wrapping it in a struct with an 'Index' field.
 func wrapValidatorIndicesArray(
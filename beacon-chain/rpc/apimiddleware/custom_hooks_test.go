@@ -59,6 +59,46 @@ func TestWrapAttestationArray(t *testing.T) {
 	})
 }
 
+func TestWrapPrevotesArray(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		endpoint := &apimiddleware.Endpoint{
+			PostRequest: &submitPrevotesRequestJson{},
+		}
+		unwrappedPrevotes := []*prevoteJson{{AggregationBits: "1010"}}
+		unwrappedPrevotesJson, err := json.Marshal(unwrappedPrevotes)
+		require.NoError(t, err)
+
+		var body bytes.Buffer
+		_, err = body.Write(unwrappedPrevotesJson)
+		require.NoError(t, err)
+		request := httptest.NewRequest("POST", "http://foo.example", &body)
+
+		runDefault, errJson := wrapPrevotesArray(endpoint, nil, request)
+		require.Equal(t, true, errJson == nil)
+		assert.Equal(t, apimiddleware.RunDefault(true), runDefault)
+		wrappedPrevotes := &submitPrevotesRequestJson{}
+		require.NoError(t, json.NewDecoder(request.Body).Decode(wrappedPrevotes))
+		require.Equal(t, 1, len(wrappedPrevotes.Data), "wrong number of wrapped items")
+		assert.Equal(t, "1010", wrappedPrevotes.Data[0].AggregationBits)
+	})
+
+	t.Run("invalid_body", func(t *testing.T) {
+		endpoint := &apimiddleware.Endpoint{
+			PostRequest: &submitPrevotesRequestJson{},
+		}
+		var body bytes.Buffer
+		_, err := body.Write([]byte("invalid"))
+		require.NoError(t, err)
+		request := httptest.NewRequest("POST", "http://foo.example", &body)
+
+		runDefault, errJson := wrapPrevotesArray(endpoint, nil, request)
+		require.Equal(t, false, errJson == nil)
+		assert.Equal(t, apimiddleware.RunDefault(false), runDefault)
+		assert.Equal(t, true, strings.Contains(errJson.Msg(), "could not decode body"))
+		assert.Equal(t, http.StatusInternalServerError, errJson.StatusCode())
+	})
+}
+
 func TestWrapValidatorIndicesArray(t *testing.T) {
 	t.Run("ok", func(t *testing.T) {
 		endpoint := &apimiddleware.Endpoint{
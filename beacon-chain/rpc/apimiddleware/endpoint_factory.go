@@ -40,6 +40,7 @@ func (_ *BeaconEndpointFactory) Paths() []string {
 		"/eth/v1/beacon/pool/proposer_slashings",
 		"/eth/v1/beacon/pool/voluntary_exits",
 		"/eth/v1/beacon/pool/sync_committees",
+		"/eth/v1/beacon/pool/prevotes",
 		"/eth/v1/beacon/weak_subjectivity",
 		"/eth/v1/node/identity",
 		"/eth/v1/node/peers",
@@ -69,6 +70,16 @@ func (_ *BeaconEndpointFactory) Paths() []string {
 		"/eth/v1/validator/sync_committee_contribution",
 		"/eth/v1/validator/contribution_and_proofs",
 		"/eth/v1/validator/prepare_beacon_proposer",
+		"/eth/v1/waterfall/finalization",
+		"/eth/v1/waterfall/fork_schedule",
+		"/eth/v1/waterfall/states/{state_id}/coordination",
+		"/eth/v1/waterfall/node/gwat_endpoints",
+		"/eth/v1/waterfall/operations/{tx_hash}",
+		"/eth/v1/waterfall/spines/{hash}",
+		"/eth/v1/waterfall/rewards/epochs/{epoch}",
+		"/eth/v1/waterfall/rewards/blocks/{block_id}",
+		"/eth/v1/waterfall/validator/prevote_data",
+		"/eth/v1/waterfall/debug/prevote_decisions/{block_id}",
 	}
 }
 
@@ -153,6 +164,14 @@ func (_ *BeaconEndpointFactory) Create(path string) (*apimiddleware.Endpoint, er
 		endpoint.Hooks = apimiddleware.HookCollection{
 			OnPreDeserializeRequestBodyIntoContainer: wrapSyncCommitteeSignaturesArray,
 		}
+	case "/eth/v1/beacon/pool/prevotes":
+		endpoint.RequestQueryParams = []apimiddleware.QueryParam{{Name: "slot"}}
+		endpoint.GetResponse = &prevotesPoolResponseJson{}
+		endpoint.PostRequest = &submitPrevotesRequestJson{}
+		endpoint.Err = &indexedVerificationFailureErrorJson{}
+		endpoint.Hooks = apimiddleware.HookCollection{
+			OnPreDeserializeRequestBodyIntoContainer: wrapPrevotesArray,
+		}
 	case "/eth/v1/beacon/weak_subjectivity":
 		endpoint.GetResponse = &WeakSubjectivityResponse{}
 	case "/eth/v1/node/identity":
@@ -259,6 +278,30 @@ func (_ *BeaconEndpointFactory) Create(path string) (*apimiddleware.Endpoint, er
 		endpoint.Hooks = apimiddleware.HookCollection{
 			OnPreDeserializeRequestBodyIntoContainer: wrapFeeRecipientsArray,
 		}
+	case "/eth/v1/waterfall/finalization":
+		endpoint.GetResponse = &gwatFinalizationResponseJson{}
+	case "/eth/v1/waterfall/fork_schedule":
+		endpoint.GetResponse = &waterfallForkScheduleResponseJson{}
+	case "/eth/v1/waterfall/states/{state_id}/coordination":
+		endpoint.GetResponse = &stateCoordinationResponseJson{}
+	case "/eth/v1/waterfall/node/gwat_endpoints":
+		endpoint.GetResponse = &gwatEndpointsResponseJson{}
+	case "/eth/v1/waterfall/operations/{tx_hash}":
+		endpoint.GetResponse = &operationLifecycleResponseJson{}
+	case "/eth/v1/waterfall/spines/{hash}":
+		endpoint.GetResponse = &spineLifecycleResponseJson{}
+	case "/eth/v1/waterfall/rewards/epochs/{epoch}":
+		endpoint.RequestURLLiterals = []string{"epoch"}
+		endpoint.RequestQueryParams = []apimiddleware.QueryParam{{Name: "validator_index"}}
+		endpoint.GetResponse = &rewardsResponseJson{}
+	case "/eth/v1/waterfall/rewards/blocks/{block_id}":
+		endpoint.RequestQueryParams = []apimiddleware.QueryParam{{Name: "validator_index"}}
+		endpoint.GetResponse = &rewardsResponseJson{}
+	case "/eth/v1/waterfall/validator/prevote_data":
+		endpoint.RequestQueryParams = []apimiddleware.QueryParam{{Name: "slot"}, {Name: "committee_index"}}
+		endpoint.GetResponse = &prevoteDataResponseJson{}
+	case "/eth/v1/waterfall/debug/prevote_decisions/{block_id}":
+		endpoint.GetResponse = &prevoteDecisionResponseJson{}
 	default:
 		return nil, errors.New("invalid path")
 	}
@@ -339,6 +339,61 @@ type submitContributionAndProofsRequestJson struct {
 	Data []*signedContributionAndProofJson `json:"data"`
 }
 
+// prevotesPoolResponseJson is used in /beacon/pool/prevotes GET API endpoint.
+type prevotesPoolResponseJson struct {
+	Data []*prevoteJson `json:"data"`
+}
+
+// submitPrevotesRequestJson is used in /beacon/pool/prevotes POST API endpoint.
+type submitPrevotesRequestJson struct {
+	Data []*prevoteJson `json:"data"`
+}
+
+// gwatFinalizationResponseJson is used in /waterfall/finalization API endpoint.
+type gwatFinalizationResponseJson struct {
+	Data *gwatFinalizationStatusJson `json:"data"`
+}
+
+// waterfallForkScheduleResponseJson is used in /waterfall/fork_schedule API endpoint.
+type waterfallForkScheduleResponseJson struct {
+	Data *waterfallForkScheduleJson `json:"data"`
+}
+
+// stateCoordinationResponseJson is used in /waterfall/states/{state_id}/coordination API endpoint.
+type stateCoordinationResponseJson struct {
+	Data *stateCoordinationJson `json:"data"`
+}
+
+// gwatEndpointsResponseJson is used in /waterfall/node/gwat_endpoints API endpoint.
+type gwatEndpointsResponseJson struct {
+	Data []*gwatEndpointJson `json:"data"`
+}
+
+// operationLifecycleResponseJson is used in /waterfall/operations/{tx_hash} API endpoint.
+type operationLifecycleResponseJson struct {
+	Data *operationLifecycleJson `json:"data"`
+}
+
+// spineLifecycleResponseJson is used in /waterfall/spines/{hash} API endpoint.
+type spineLifecycleResponseJson struct {
+	Data *spineLifecycleJson `json:"data"`
+}
+
+// rewardsResponseJson is used in /waterfall/rewards/epochs/{epoch} and /waterfall/rewards/blocks/{block_id} API endpoints.
+type rewardsResponseJson struct {
+	Data []*balanceChangeJson `json:"data"`
+}
+
+// prevoteDataResponseJson is used in /waterfall/validator/prevote_data API endpoint.
+type prevoteDataResponseJson struct {
+	Data *prevoteDataJson `json:"data"`
+}
+
+// prevoteDecisionResponseJson is used in /waterfall/debug/prevote_decisions/{block_id} API endpoint.
+type prevoteDecisionResponseJson struct {
+	Data *prevoteDecisionJson `json:"data"`
+}
+
 //----------------
 // Reusable types.
 //----------------
@@ -548,6 +603,18 @@ type attestationDataJson struct {
 	Target          *checkpointJson `json:"target"`
 }
 
+type prevoteJson struct {
+	AggregationBits string           `json:"aggregation_bits" hex:"true"`
+	Data            *prevoteDataJson `json:"data"`
+	Signature       string           `json:"signature" hex:"true"`
+}
+
+type prevoteDataJson struct {
+	Slot       string `json:"slot"`
+	Index      string `json:"index"`
+	Candidates string `json:"candidates" hex:"true"`
+}
+
 type depositJson struct {
 	Proof []string          `json:"proof" hex:"true"`
 	Data  *deposit_DataJson `json:"data"`
@@ -840,6 +907,138 @@ type syncCommitteeContributionJson struct {
 	Signature         string `json:"signature" hex:"true"`
 }
 
+type gwatFinalizationStatusJson struct {
+	LastFinalization *gwatFinalizationJson `json:"last_finalization"`
+	CoordinatedState *gwatCheckpointJson   `json:"coordinated_state"`
+	IsSyncing        bool                  `json:"is_syncing"`
+	IsRepairing      bool                  `json:"is_repairing"`
+	RecoveryStage    string                `json:"recovery_stage"`
+	RecoveryAttempts string                `json:"recovery_attempts"`
+}
+
+type gwatFinalizationJson struct {
+	Slot       string              `json:"slot"`
+	SyncMode   string              `json:"sync_mode"`
+	Spines     []string            `json:"spines" hex:"true"`
+	BaseSpine  string              `json:"base_spine" hex:"true"`
+	Checkpoint *gwatCheckpointJson `json:"checkpoint"`
+	LFSpine    string              `json:"lf_spine" hex:"true"`
+	Success    bool                `json:"success"`
+	Error      string              `json:"error"`
+	Time       string              `json:"time"`
+}
+
+type gwatCheckpointJson struct {
+	Epoch    string `json:"epoch"`
+	FinEpoch string `json:"fin_epoch"`
+	Root     string `json:"root" hex:"true"`
+	Spine    string `json:"spine" hex:"true"`
+}
+
+type waterfallForkScheduleJson struct {
+	Forks  []*waterfallForkJson `json:"forks"`
+	Digest string               `json:"digest" hex:"true"`
+}
+
+type waterfallForkJson struct {
+	Name  string `json:"name"`
+	Slot  string `json:"slot"`
+	Epoch string `json:"epoch"`
+}
+
+type stateCoordinationJson struct {
+	Slot           string              `json:"slot"`
+	BlockRoot      string              `json:"block_root" hex:"true"`
+	SpineData      *spineDataKeysJson  `json:"spine_data"`
+	GwatCheckpoint *gwatCheckpointJson `json:"gwat_checkpoint"`
+	Spines         []*spinesListJson   `json:"spines"`
+}
+
+type spineDataKeysJson struct {
+	Spines       string `json:"spines" hex:"true"`
+	Prefix       string `json:"prefix" hex:"true"`
+	Finalization string `json:"finalization" hex:"true"`
+	CpFinalized  string `json:"cp_finalized" hex:"true"`
+}
+
+type spinesListJson struct {
+	Key  string `json:"key" hex:"true"`
+	Data string `json:"data" hex:"true"`
+}
+
+type gwatEndpointJson struct {
+	Url              string `json:"url"`
+	Current          bool   `json:"current"`
+	Checked          bool   `json:"checked"`
+	Healthy          bool   `json:"healthy"`
+	Consistent       bool   `json:"consistent"`
+	HealthScore      string `json:"health_score"`
+	ConsistencyScore string `json:"consistency_score"`
+	LFSpine          string `json:"lf_spine" hex:"true"`
+	CpEpoch          string `json:"cp_epoch"`
+	CpRoot           string `json:"cp_root" hex:"true"`
+	Error            string `json:"error"`
+}
+
+type operationLifecycleJson struct {
+	InitTxHash     string                 `json:"init_tx_hash" hex:"true"`
+	Type           string                 `json:"type"`
+	ValidatorIndex string                 `json:"validator_index"`
+	Amount         string                 `json:"amount"`
+	Status         string                 `json:"status"`
+	Records        []*lifecycleRecordJson `json:"records"`
+}
+
+type lifecycleRecordJson struct {
+	Stage     string `json:"stage"`
+	Slot      string `json:"slot"`
+	BlockRoot string `json:"block_root,omitempty" hex:"true"`
+}
+
+type spineLifecycleJson struct {
+	Hash    string                 `json:"hash" hex:"true"`
+	Status  string                 `json:"status"`
+	Records []*lifecycleRecordJson `json:"records"`
+}
+
+type balanceChangeJson struct {
+	ValidatorIndex string   `json:"validator_index"`
+	BlockRoot      string   `json:"block_root" hex:"true"`
+	Slot           string   `json:"slot"`
+	Epoch          string   `json:"epoch"`
+	Operation      string   `json:"operation"`
+	Role           string   `json:"role"`
+	Amount         string   `json:"amount"`
+	VotesIncluded  []string `json:"votes_included"`
+	BalanceBefore  string   `json:"balance_before"`
+	BalanceAfter   string   `json:"balance_after"`
+}
+
+type prevoteDecisionJson struct {
+	Slot           string                   `json:"slot"`
+	BlockRoot      string                   `json:"block_root,omitempty" hex:"true"`
+	ParentRoot     string                   `json:"parent_root" hex:"true"`
+	OptCandidates  []string                 `json:"optimistic_candidates" hex:"true"`
+	Prevotes       []*consideredPrevoteJson `json:"prevotes"`
+	Chains         []*votedChainJson        `json:"chains"`
+	Chosen         []string                 `json:"chosen" hex:"true"`
+	Reason         string                   `json:"reason"`
+	SpinesLimitCut string                   `json:"spines_limit_cut"`
+	Candidates     []string                 `json:"candidates" hex:"true"`
+}
+
+type consideredPrevoteJson struct {
+	Candidates []string `json:"candidates" hex:"true"`
+	Votes      string   `json:"votes"`
+	Aggregated bool     `json:"aggregated"`
+	Accepted   string   `json:"accepted"`
+}
+
+type votedChainJson struct {
+	Chain []string `json:"chain" hex:"true"`
+	Votes string   `json:"votes"`
+}
+
 //----------------
 // SSZ
 // ---------------
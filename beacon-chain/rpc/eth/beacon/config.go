@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/network/forks"
 	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/time/slots"
 	"gitlab.waterfall.network/waterfall/protocol/gwat/common/hexutil"
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc/codes"
@@ -18,17 +21,13 @@ import (
 )
 
 // GetForkSchedule retrieve all scheduled upcoming forks this node is aware of.
+// The Waterfall forks of the registry do not change the fork version,
+// so they are scheduled with the version active at their epoch.
 func (_ *Server) GetForkSchedule(ctx context.Context, _ *emptypb.Empty) (*ethpb.ForkScheduleResponse, error) {
 	_, span := trace.StartSpan(ctx, "beacon.GetForkSchedule")
 	defer span.End()
 
 	schedule := params.BeaconConfig().ForkVersionSchedule
-	if len(schedule) == 0 {
-		return &ethpb.ForkScheduleResponse{
-			Data: make([]*ethpb.Fork, 0),
-		}, nil
-	}
-
 	versions := forks.SortedForkVersions(schedule)
 	chainForks := make([]*ethpb.Fork, len(schedule))
 	var previous, current []byte
@@ -47,6 +46,23 @@ func (_ *Server) GetForkSchedule(ctx context.Context, _ *emptypb.Empty) (*ethpb.
 		}
 	}
 
+	ordered := forks.NewOrderedSchedule(params.BeaconConfig())
+	for _, wf := range params.BeaconConfig().WaterfallForks() {
+		epoch := slots.ToEpoch(wf.Slot)
+		version, err := ordered.VersionForEpoch(epoch)
+		if err != nil {
+			version = bytesutil.ToBytes4(params.BeaconConfig().GenesisForkVersion)
+		}
+		chainForks = append(chainForks, &ethpb.Fork{
+			PreviousVersion: version[:],
+			CurrentVersion:  version[:],
+			Epoch:           epoch,
+		})
+	}
+	sort.SliceStable(chainForks, func(i, j int) bool {
+		return chainForks[i].Epoch < chainForks[j].Epoch
+	})
+
 	return &ethpb.ForkScheduleResponse{
 		Data: chainForks,
 	}, nil
@@ -117,6 +133,9 @@ func prepareConfigSpec() (map[string]string, error) {
 			return nil, fmt.Errorf("unsupported config field type: %s (tagValue=%s)", vField.Kind().String(), tagValue)
 		}
 	}
+	// The digest of the Waterfall forks registry exchanged by the peers after the status handshake.
+	wfDigest := config.WaterfallForksDigest()
+	data["WATERFALL_FORKS_DIGEST"] = hexutil.Encode(wfDigest[:])
 
 	return data, nil
 }
@@ -154,7 +154,8 @@ func TestGetSpec(t *testing.T) {
 	resp, err := server.GetSpec(context.Background(), &emptypb.Empty{})
 	require.NoError(t, err)
 
-	assert.Equal(t, 117, len(resp.Data))
+	assert.Equal(t, 118, len(resp.Data))
+	wfDigest := config.WaterfallForksDigest()
 	for k, v := range resp.Data {
 		switch k {
 		case "CONFIG_NAME":
@@ -407,6 +408,8 @@ func TestGetSpec(t *testing.T) {
 			assert.Equal(t, "128", v)
 		case "PREVOTING_DISABLED":
 			assert.Equal(t, "true", v)
+		case "WATERFALL_FORKS_DIGEST":
+			assert.Equal(t, "0x"+hex.EncodeToString(wfDigest[:]), v)
 		default:
 			t.Errorf("Incorrect key: %s", k)
 		}
@@ -444,30 +447,51 @@ func TestForkSchedule_Ok(t *testing.T) {
 	schedule[bytesutil.ToBytes4(firstForkVersion)] = firstForkEpoch
 	schedule[bytesutil.ToBytes4(thirdForkVersion)] = thirdForkEpoch
 	config.ForkVersionSchedule = schedule
+	// The Waterfall forks are scheduled between the version forks.
+	config.DelegateForkSlot = 0
+	config.PrefixFinForkSlot = config.SlotsPerEpoch.Mul(150)
+	config.FinEth1ForkSlot = config.SlotsPerEpoch.Mul(250)
+	config.BlockVotingForkSlot = config.SlotsPerEpoch.Mul(400)
 	params.OverrideBeaconConfig(config)
 
 	s := &Server{}
 	resp, err := s.GetForkSchedule(context.Background(), &emptypb.Empty{})
 	require.NoError(t, err)
-	require.Equal(t, 3, len(resp.Data))
-	fork := resp.Data[0]
+	require.Equal(t, 7, len(resp.Data))
+	fork := resp.Data[1]
 	assert.DeepEqual(t, genesisForkVersion, fork.PreviousVersion)
 	assert.DeepEqual(t, string(firstForkVersion), string(fork.CurrentVersion))
 	assert.Equal(t, firstForkEpoch, fork.Epoch)
-	fork = resp.Data[1]
+	fork = resp.Data[3]
 	assert.DeepEqual(t, firstForkVersion, fork.PreviousVersion)
 	assert.DeepEqual(t, secondForkVersion, fork.CurrentVersion)
 	assert.Equal(t, secondForkEpoch, fork.Epoch)
-	fork = resp.Data[2]
+	fork = resp.Data[5]
 	assert.DeepEqual(t, secondForkVersion, fork.PreviousVersion)
 	assert.DeepEqual(t, thirdForkVersion, fork.CurrentVersion)
 	assert.Equal(t, thirdForkEpoch, fork.Epoch)
+
+	// The Waterfall forks keep the version active at their epoch.
+	for i, want := range map[int]struct {
+		version []byte
+		epoch   types.Epoch
+	}{
+		0: {version: genesisForkVersion[:4], epoch: 0},
+		2: {version: firstForkVersion, epoch: 150},
+		4: {version: secondForkVersion, epoch: 250},
+		6: {version: thirdForkVersion, epoch: 400},
+	} {
+		fork = resp.Data[i]
+		assert.DeepEqual(t, want.version, fork.PreviousVersion)
+		assert.DeepEqual(t, want.version, fork.CurrentVersion)
+		assert.Equal(t, want.epoch, fork.Epoch)
+	}
 }
 
 func TestForkSchedule_CorrectNumberOfForks(t *testing.T) {
 	s := &Server{}
 	resp, err := s.GetForkSchedule(context.Background(), &emptypb.Empty{})
 	require.NoError(t, err)
-	// Genesis and Altair.
-	assert.Equal(t, 3, len(resp.Data))
+	// Genesis and Altair, along with the Waterfall forks.
+	assert.Equal(t, 3+len(params.BeaconConfig().WaterfallForks()), len(resp.Data))
 }
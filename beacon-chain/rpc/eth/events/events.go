@@ -9,6 +9,7 @@ import (
 	blockfeed "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/feed/block"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/feed/operation"
 	statefeed "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/feed/state"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/rpc/eth/helpers"
 	ethpbservice "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/service"
 	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v1"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/migration"
@@ -33,6 +34,10 @@ const (
 	ChainReorgTopic = "chain_reorg"
 	// SyncCommitteeContributionTopic represents a new sync committee contribution event topic.
 	SyncCommitteeContributionTopic = "contribution_and_proof"
+	// PrevoteTopic represents a new received prevote event topic.
+	PrevoteTopic = "prevote"
+	// GwatFinalizationTopic represents a gwat finalization call event topic.
+	GwatFinalizationTopic = "gwat_finalization"
 )
 
 var casesHandled = map[string]bool{
@@ -43,6 +48,8 @@ var casesHandled = map[string]bool{
 	FinalizedCheckpointTopic:       true,
 	ChainReorgTopic:                true,
 	SyncCommitteeContributionTopic: true,
+	PrevoteTopic:                   true,
+	GwatFinalizationTopic:          true,
 }
 
 // StreamEvents allows requesting all events from a set of topics defined in the Ethereum consensus API standard.
@@ -178,6 +185,16 @@ func handleBlockOperationEvents(
 		}
 		v2Data := migration.V1Alpha1SignedContributionAndProofToV2(contributionData.Contribution)
 		return streamData(stream, SyncCommitteeContributionTopic, v2Data)
+	case operation.PrevoteReceived:
+		if _, ok := requestedTopics[PrevoteTopic]; !ok {
+			return nil
+		}
+		prevoteData, ok := event.Data.(*operation.PrevoteReceivedData)
+		if !ok {
+			return nil
+		}
+		v1Data := migration.V1Alpha1PrevoteToV1(prevoteData.Prevote)
+		return streamData(stream, PrevoteTopic, v1Data)
 	default:
 		return nil
 	}
@@ -214,6 +231,15 @@ func handleStateEvents(
 			return nil
 		}
 		return streamData(stream, ChainReorgTopic, reorg)
+	case statefeed.GwatFinalization:
+		if _, ok := requestedTopics[GwatFinalizationTopic]; !ok {
+			return nil
+		}
+		finData, ok := event.Data.(*statefeed.GwatFinalizationData)
+		if !ok {
+			return nil
+		}
+		return streamData(stream, GwatFinalizationTopic, helpers.GwatFinalizationToV1(finData))
 	default:
 		return nil
 	}
@@ -3,6 +3,7 @@ package events
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/proto/gateway"
@@ -13,6 +14,7 @@ import (
 	blockfeed "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/feed/block"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/feed/operation"
 	statefeed "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/feed/state"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
 	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v1"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/migration"
 	eth "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
@@ -229,6 +231,52 @@ func TestStreamEvents_OperationsEvents(t *testing.T) {
 			feed: srv.OperationNotifier.OperationFeed(),
 		})
 	})
+	t.Run(PrevoteTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		prevote := &eth.PreVote{
+			AggregationBits: bitfield.Bitlist{0b00000011},
+			Data: &eth.PreVoteData{
+				Slot:       8,
+				Index:      1,
+				Candidates: make([]byte, 64),
+			},
+			Signature: make([]byte, 96),
+		}
+		wantedPrevote := &ethpb.Prevote{
+			AggregationBits: bitfield.Bitlist{0b00000011},
+			Data: &ethpb.PrevoteData{
+				Slot:       8,
+				Index:      1,
+				Candidates: make([]byte, 64),
+			},
+			Signature: make([]byte, 96),
+		}
+		genericResponse, err := anypb.New(wantedPrevote)
+		require.NoError(t, err)
+
+		wantedMessage := &gateway.EventSource{
+			Event: PrevoteTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{PrevoteTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: operation.PrevoteReceived,
+				Data: &operation.PrevoteReceivedData{
+					Prevote: prevote,
+				},
+			},
+			feed: srv.OperationNotifier.OperationFeed(),
+		})
+	})
 }
 
 func TestStreamEvents_StateEvents(t *testing.T) {
@@ -332,6 +380,56 @@ func TestStreamEvents_StateEvents(t *testing.T) {
 			feed: srv.StateNotifier.StateFeed(),
 		})
 	})
+	t.Run(GwatFinalizationTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		finData := &statefeed.GwatFinalizationData{
+			Slot:      8,
+			SyncMode:  "head",
+			Spines:    [][32]byte{{1}, {2}},
+			BaseSpine: [32]byte{3},
+			CpEpoch:   1,
+			Error:     "invalid base spine",
+			Time:      time.Unix(100, 0),
+		}
+		wantedFinalization := &ethpb.GwatFinalization{
+			Slot:      8,
+			SyncMode:  "head",
+			Spines:    [][]byte{bytesutil.PadTo([]byte{1}, 32), bytesutil.PadTo([]byte{2}, 32)},
+			BaseSpine: bytesutil.PadTo([]byte{3}, 32),
+			Checkpoint: &ethpb.GwatCheckpoint{
+				Epoch:    1,
+				FinEpoch: 0,
+				Root:     make([]byte, 32),
+				Spine:    make([]byte, 32),
+			},
+			LfSpine: make([]byte, 32),
+			Success: false,
+			Error:   "invalid base spine",
+			Time:    100,
+		}
+		genericResponse, err := anypb.New(wantedFinalization)
+		require.NoError(t, err)
+		wantedMessage := &gateway.EventSource{
+			Event: GwatFinalizationTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{GwatFinalizationTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: statefeed.GwatFinalization,
+				Data: finData,
+			},
+			feed: srv.StateNotifier.StateFeed(),
+		})
+	})
 }
 
 func TestStreamEvents_CommaSeparatedTopics(t *testing.T) {
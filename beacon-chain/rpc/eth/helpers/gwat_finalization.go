@@ -0,0 +1,35 @@
+package helpers
+
+import (
+	types "github.com/prysmaticlabs/eth2-types"
+	statefeed "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/feed/state"
+	ethpbv1 "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v1"
+)
+
+// GwatFinalizationToV1 converts the gwat finalization data to its API representation,
+// shared by the gwat finalization endpoint and the gwat finalization event.
+func GwatFinalizationToV1(data *statefeed.GwatFinalizationData) *ethpbv1.GwatFinalization {
+	if data == nil {
+		return nil
+	}
+	spines := make([][]byte, len(data.Spines))
+	for i := range data.Spines {
+		spines[i] = data.Spines[i][:]
+	}
+	return &ethpbv1.GwatFinalization{
+		Slot:      data.Slot,
+		SyncMode:  data.SyncMode,
+		Spines:    spines,
+		BaseSpine: data.BaseSpine[:],
+		Checkpoint: &ethpbv1.GwatCheckpoint{
+			Epoch:    types.Epoch(data.CpEpoch),
+			FinEpoch: types.Epoch(data.CpFinEpoch),
+			Root:     data.CpRoot[:],
+			Spine:    data.CpSpine[:],
+		},
+		LfSpine: data.LFSpine[:],
+		Success: data.Error == "",
+		Error:   data.Error,
+		Time:    uint64(data.Time.Unix()),
+	}
+}
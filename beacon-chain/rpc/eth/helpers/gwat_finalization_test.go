@@ -0,0 +1,49 @@
+package helpers
+
+import (
+	"testing"
+	"time"
+
+	statefeed "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/feed/state"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/assert"
+)
+
+func TestGwatFinalizationToV1(t *testing.T) {
+	assert.Equal(t, (*ethpb.GwatFinalization)(nil), GwatFinalizationToV1(nil))
+
+	data := &statefeed.GwatFinalizationData{
+		Slot:       8,
+		SyncMode:   "head",
+		Spines:     [][32]byte{{1}, {2}},
+		BaseSpine:  [32]byte{3},
+		CpEpoch:    2,
+		CpFinEpoch: 1,
+		CpRoot:     [32]byte{4},
+		CpSpine:    [32]byte{5},
+		LFSpine:    [32]byte{2},
+		Time:       time.Unix(100, 0),
+	}
+	want := &ethpb.GwatFinalization{
+		Slot:      8,
+		SyncMode:  "head",
+		Spines:    [][]byte{bytesutil.PadTo([]byte{1}, 32), bytesutil.PadTo([]byte{2}, 32)},
+		BaseSpine: bytesutil.PadTo([]byte{3}, 32),
+		Checkpoint: &ethpb.GwatCheckpoint{
+			Epoch:    2,
+			FinEpoch: 1,
+			Root:     bytesutil.PadTo([]byte{4}, 32),
+			Spine:    bytesutil.PadTo([]byte{5}, 32),
+		},
+		LfSpine: bytesutil.PadTo([]byte{2}, 32),
+		Success: true,
+		Time:    100,
+	}
+	assert.DeepEqual(t, want, GwatFinalizationToV1(data))
+
+	data.Error = "invalid base spine"
+	got := GwatFinalizationToV1(data)
+	assert.Equal(t, false, got.Success)
+	assert.Equal(t, data.Error, got.Error)
+}
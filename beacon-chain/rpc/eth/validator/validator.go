@@ -530,6 +530,11 @@ func (vs *Server) SubmitBeaconCommitteeSubscription(ctx context.Context, req *et
 		if sub.IsAggregator {
 			cache.SubnetIDs.AddAggregatorSubnetID(sub.Slot, subnet)
 		}
+		if !params.BeaconConfig().PrevotingDisabled {
+			// prevoting
+			subnetPv := helpers.ComputeSubnetPrevotingBySlot(currValsLen, sub.Slot)
+			cache.SubnetIDs.AddPrevotingSubnetID(sub.Slot-1, subnetPv)
+		}
 	}
 
 	return &emptypb.Empty{}, nil
@@ -1320,6 +1320,7 @@ func TestSubmitBeaconCommitteeSubscription(t *testing.T) {
 		subnets := cache.SubnetIDs.GetAttesterSubnetIDs(1)
 		require.Equal(t, 1, len(subnets))
 		assert.Equal(t, uint64(4), subnets[0])
+		assert.Equal(t, 1, len(cache.SubnetIDs.GetPrevotingSubnetIDs(0)))
 	})
 
 	t.Run("Multiple subscriptions", func(t *testing.T) {
@@ -0,0 +1,137 @@
+package waterfall
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	corehelpers "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/helpers"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/rpc/eth/helpers"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/state"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	ethpbv1 "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1/wrapper"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/time/slots"
+	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetStateCoordination returns the SpineData of the requested state, the spines lists it refers to
+// and the coordinated checkpoint of the gwat node, which are used to coordinate gwat
+// with a node started from the state by checkpoint sync.
+// The checkpoint spine is the terminal finalized spine of the checkpoint state, not the gwat LFSpine,
+// which goes ahead of the checkpoint by the spines finalized after it.
+func (s *Server) GetStateCoordination(ctx context.Context, req *ethpbv1.StateRequest) (*ethpbv1.StateCoordinationResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "waterfall.GetStateCoordination")
+	defer span.End()
+
+	st, err := s.StateFetcher.State(ctx, req.StateId)
+	if err != nil {
+		return nil, helpers.PrepareStateFetchGRPCError(err)
+	}
+	blockRoot, err := stateBlockRoot(ctx, st)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute block root of state: %v", err)
+	}
+	coordState, err := s.CoordStateFetcher.ExecutionDagCoordinatedState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Could not get gwat coordinated state: %v", err)
+	}
+	// gwat at genesis state has no coordinated checkpoint to coordinate a node with.
+	if coordState.LFSpine == nil || coordState.CpRoot == nil || coordState.CpEpoch == nil || *coordState.CpRoot == (gwatCommon.Hash{}) {
+		return nil, status.Error(codes.Unavailable, "Gwat has no coordinated checkpoint")
+	}
+	gwatCp, err := s.coordinatedCheckpoint(ctx, bytesutil.ToBytes32(coordState.CpRoot.Bytes()))
+	if err != nil {
+		return nil, err
+	}
+	if gwatCp.Epoch > slots.ToEpoch(st.Slot()) {
+		return nil, status.Errorf(codes.InvalidArgument, "Gwat coordinated checkpoint epoch %d is ahead of the state slot %d", gwatCp.Epoch, st.Slot())
+	}
+
+	spineData := st.SpineData()
+	lists := []wrapper.Spines{spineData.Spines, spineData.Prefix, spineData.Finalization, spineData.CpFinalized}
+	keys := make([][]byte, len(lists))
+	spines := make([]*ethpbv1.SpinesList, 0, len(lists))
+	known := make(map[[32]byte]bool, len(lists))
+	for i, list := range lists {
+		key := list.Key()
+		keys[i] = bytesutil.SafeCopyBytes(key[:])
+		if len(list) == 0 || known[key] {
+			continue
+		}
+		known[key] = true
+		spines = append(spines, &ethpbv1.SpinesList{
+			Key:  bytesutil.SafeCopyBytes(key[:]),
+			Data: list,
+		})
+	}
+
+	return &ethpbv1.StateCoordinationResponse{Data: &ethpbv1.StateCoordination{
+		Slot:      st.Slot(),
+		BlockRoot: blockRoot[:],
+		SpineData: &ethpbv1.SpineDataKeys{
+			Spines:       keys[0],
+			Prefix:       keys[1],
+			Finalization: keys[2],
+			CpFinalized:  keys[3],
+		},
+		GwatCheckpoint: gwatCp,
+		Spines:         spines,
+	}}, nil
+}
+
+// coordinatedCheckpoint builds the gwat coordinated checkpoint from the state of the checkpoint block,
+// as the node does on start up, and takes the finalization epoch from the gwat finalization procedure.
+func (s *Server) coordinatedCheckpoint(ctx context.Context, cpRoot [32]byte) (*ethpbv1.GwatCheckpoint, error) {
+	cpState, err := s.StateGenService.StateByRoot(ctx, cpRoot)
+	if err != nil || cpState == nil || cpState.IsNil() {
+		return nil, status.Errorf(codes.Internal, "Could not get state of gwat coordinated checkpoint %#x: %v", cpRoot, err)
+	}
+	spineData := cpState.SpineData()
+	if len(spineData.Finalization) == 0 && len(spineData.CpFinalized) == 0 {
+		return nil, status.Errorf(codes.Internal, "State of gwat coordinated checkpoint %#x has no finalized spines", cpRoot)
+	}
+	finEpoch, ok := s.coordinatedFinEpoch(cpRoot)
+	if !ok {
+		return nil, status.Errorf(codes.Unavailable, "Finalization epoch of gwat coordinated checkpoint %#x is not known yet", cpRoot)
+	}
+	lfSpine := corehelpers.GetTerminalFinalizedSpine(cpState)
+	return &ethpbv1.GwatCheckpoint{
+		Epoch:    slots.ToEpoch(cpState.Slot()),
+		FinEpoch: finEpoch,
+		Root:     bytesutil.SafeCopyBytes(cpRoot[:]),
+		Spine:    lfSpine.Bytes(),
+	}, nil
+}
+
+// coordinatedFinEpoch returns the finalization epoch of the checkpoint
+// from the last gwat finalization or from the cached coordinated checkpoint.
+func (s *Server) coordinatedFinEpoch(cpRoot [32]byte) (types.Epoch, bool) {
+	st := s.FinalizationFetcher.GwatFinalizationStatus()
+	if st == nil {
+		return 0, false
+	}
+	if lf := st.LastFinalization; lf != nil && lf.Error == "" && lf.CpRoot == cpRoot {
+		return types.Epoch(lf.CpFinEpoch), true
+	}
+	if cp := st.CoordinatedState; cp != nil && cp.FinEpoch != 0 && cp.Root == gwatCommon.BytesToHash(cpRoot[:]) {
+		return types.Epoch(cp.FinEpoch), true
+	}
+	return 0, false
+}
+
+// stateBlockRoot computes the root of the block the state integrates
+// from the latest block header of the state.
+func stateBlockRoot(ctx context.Context, st state.BeaconState) ([32]byte, error) {
+	header := st.LatestBlockHeader()
+	if bytesutil.ZeroRoot(header.StateRoot) {
+		stateRoot, err := st.HashTreeRoot(ctx)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		header.StateRoot = stateRoot[:]
+	}
+	return header.HashTreeRoot()
+}
@@ -0,0 +1,232 @@
+package waterfall
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/blockchain"
+	statefeed "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/feed/state"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/rpc/statefetcher"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/rpc/testutil"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/state"
+	mockstategen "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/state/stategen/mock"
+	ethpbv1 "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v1"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1/wrapper"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/assert"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/util"
+	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
+	gwatTypes "gitlab.waterfall.network/waterfall/protocol/gwat/core/types"
+	"google.golang.org/grpc/codes"
+)
+
+type errStateFetcher struct {
+	testutil.MockFetcher
+	err error
+}
+
+func (m *errStateFetcher) State(context.Context, []byte) (state.BeaconState, error) {
+	return nil, m.err
+}
+
+type mockCoordStateFetcher struct {
+	res *gwatTypes.FinalizationResult
+	err error
+}
+
+func (m *mockCoordStateFetcher) ExecutionDagCoordinatedState(context.Context) (*gwatTypes.FinalizationResult, error) {
+	return m.res, m.err
+}
+
+func coordinatedState(epoch uint64, root, spine gwatCommon.Hash) *gwatTypes.FinalizationResult {
+	return &gwatTypes.FinalizationResult{CpEpoch: &epoch, CpRoot: &root, LFSpine: &spine}
+}
+
+// checkpointState returns the state of the gwat checkpoint block finalizing the spines.
+func checkpointState(t *testing.T, slot types.Slot, finalization gwatCommon.HashArray) state.BeaconState {
+	st, err := util.NewBeaconState(util.WithStateSlot(slot))
+	require.NoError(t, err)
+	require.NoError(t, st.SetSpineData(&ethpb.SpineData{
+		Spines:       finalization.ToBytes(),
+		Prefix:       []byte{},
+		Finalization: finalization.ToBytes(),
+		CpFinalized:  []byte{},
+	}))
+	return st
+}
+
+// lastFinalization returns the status of the gwat finalization which sent the checkpoint to gwat.
+func lastFinalization(cpRoot gwatCommon.Hash, cpEpoch, cpFinEpoch uint64) *mockFinalizationFetcher {
+	return &mockFinalizationFetcher{status: &blockchain.GwatFinalizationStatus{
+		LastFinalization: &statefeed.GwatFinalizationData{
+			CpEpoch:    cpEpoch,
+			CpFinEpoch: cpFinEpoch,
+			CpRoot:     cpRoot,
+		},
+	}}
+}
+
+func TestServer_GetStateCoordination(t *testing.T) {
+	finalization := wrapper.Spines(gwatCommon.HashArray{{0x01}, {0x02}}.ToBytes())
+	cpFinalized := wrapper.Spines(gwatCommon.HashArray{{0x03}}.ToBytes())
+	st, err := util.NewBeaconState(util.WithStateSlot(64))
+	require.NoError(t, err)
+	require.NoError(t, st.SetSpineData(&ethpb.SpineData{
+		Spines:       finalization,
+		Prefix:       []byte{},
+		Finalization: finalization,
+		CpFinalized:  cpFinalized,
+	}))
+	blockRoot, err := stateBlockRoot(context.Background(), st)
+	require.NoError(t, err)
+
+	gwatCpRoot := gwatCommon.Hash{'c', 'p'}
+	stateGen := mockstategen.NewMockService()
+	stateGen.AddStateForRoot(checkpointState(t, 32, gwatCommon.HashArray{{0x05}, {0x06}}), gwatCpRoot)
+	s := &Server{
+		StateFetcher:        &testutil.MockFetcher{BeaconState: st},
+		StateGenService:     stateGen,
+		FinalizationFetcher: lastFinalization(gwatCpRoot, 1, 2),
+		// the gwat last finalized spine goes ahead of the checkpoint.
+		CoordStateFetcher: &mockCoordStateFetcher{res: coordinatedState(1, gwatCpRoot, gwatCommon.Hash{0x09})},
+	}
+
+	resp, err := s.GetStateCoordination(context.Background(), &ethpbv1.StateRequest{StateId: []byte("64")})
+	require.NoError(t, err)
+	require.NotNil(t, resp.Data)
+	assert.Equal(t, uint64(64), uint64(resp.Data.Slot))
+	assert.DeepEqual(t, blockRoot[:], resp.Data.BlockRoot)
+
+	finKey := finalization.Key()
+	cpKey := cpFinalized.Key()
+	emptyKey := wrapper.Spines{}.Key()
+	require.NotNil(t, resp.Data.SpineData)
+	assert.DeepEqual(t, finKey[:], resp.Data.SpineData.Spines)
+	assert.DeepEqual(t, emptyKey[:], resp.Data.SpineData.Prefix)
+	assert.DeepEqual(t, finKey[:], resp.Data.SpineData.Finalization)
+	assert.DeepEqual(t, cpKey[:], resp.Data.SpineData.CpFinalized)
+
+	// spines lists are unique and empty ones are omitted.
+	require.Equal(t, 2, len(resp.Data.Spines))
+	assert.DeepEqual(t, finKey[:], resp.Data.Spines[0].Key)
+	assert.DeepEqual(t, []byte(finalization), resp.Data.Spines[0].Data)
+	assert.DeepEqual(t, cpKey[:], resp.Data.Spines[1].Key)
+	assert.DeepEqual(t, []byte(cpFinalized), resp.Data.Spines[1].Data)
+
+	// the checkpoint spine is the terminal finalized spine of the checkpoint state, not the gwat LFSpine.
+	cp := resp.Data.GwatCheckpoint
+	require.NotNil(t, cp)
+	assert.Equal(t, uint64(1), uint64(cp.Epoch))
+	assert.Equal(t, uint64(2), uint64(cp.FinEpoch))
+	assert.DeepEqual(t, gwatCpRoot.Bytes(), cp.Root)
+	assert.DeepEqual(t, gwatCommon.Hash{0x06}.Bytes(), cp.Spine)
+}
+
+func TestServer_GetStateCoordination_FinEpochOfCoordinatedState(t *testing.T) {
+	st, err := util.NewBeaconState(util.WithStateSlot(64))
+	require.NoError(t, err)
+	gwatCpRoot := gwatCommon.Hash{'c', 'p'}
+	stateGen := mockstategen.NewMockService()
+	stateGen.AddStateForRoot(checkpointState(t, 32, gwatCommon.HashArray{{0x05}}), gwatCpRoot)
+	s := &Server{
+		StateFetcher:    &testutil.MockFetcher{BeaconState: st},
+		StateGenService: stateGen,
+		// the last finalization sent another checkpoint, the cached coordinated one is used.
+		FinalizationFetcher: &mockFinalizationFetcher{status: &blockchain.GwatFinalizationStatus{
+			LastFinalization: &statefeed.GwatFinalizationData{CpEpoch: 2, CpFinEpoch: 3, CpRoot: [32]byte{'n', 'e', 'x', 't'}},
+			CoordinatedState: &gwatTypes.Checkpoint{Epoch: 1, FinEpoch: 2, Root: gwatCpRoot, Spine: gwatCommon.Hash{0x05}},
+		}},
+		CoordStateFetcher: &mockCoordStateFetcher{res: coordinatedState(1, gwatCpRoot, gwatCommon.Hash{0x09})},
+	}
+
+	resp, err := s.GetStateCoordination(context.Background(), &ethpbv1.StateRequest{StateId: []byte("64")})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), uint64(resp.Data.GwatCheckpoint.FinEpoch))
+	assert.DeepEqual(t, gwatCommon.Hash{0x05}.Bytes(), resp.Data.GwatCheckpoint.Spine)
+}
+
+func TestServer_GetStateCoordination_GwatNotCoordinated(t *testing.T) {
+	st, err := util.NewBeaconState(util.WithStateSlot(64))
+	require.NoError(t, err)
+	gwatCpRoot := gwatCommon.Hash{'c', 'p'}
+	stateGen := mockstategen.NewMockService()
+	stateGen.AddStateForRoot(checkpointState(t, 32, gwatCommon.HashArray{{0x01}}), gwatCpRoot)
+	aheadRoot := gwatCommon.Hash{'a', 'h', 'e', 'a', 'd'}
+	stateGen.AddStateForRoot(checkpointState(t, 96, gwatCommon.HashArray{{0x01}}), aheadRoot)
+	noSpinesRoot := gwatCommon.Hash{'n', 'o'}
+	stateGen.AddStateForRoot(checkpointState(t, 32, gwatCommon.HashArray{}), noSpinesRoot)
+
+	tests := []struct {
+		name         string
+		fetcher      *mockCoordStateFetcher
+		finalization *mockFinalizationFetcher
+		wantCode     codes.Code
+		wantMsg      string
+	}{
+		{
+			name:     "gwat unavailable",
+			fetcher:  &mockCoordStateFetcher{err: errors.New("connection refused")},
+			wantCode: codes.Unavailable,
+			wantMsg:  "Could not get gwat coordinated state",
+		},
+		{
+			name:     "gwat at genesis",
+			fetcher:  &mockCoordStateFetcher{res: coordinatedState(0, gwatCommon.Hash{}, gwatCommon.Hash{0x01})},
+			wantCode: codes.Unavailable,
+			wantMsg:  "Gwat has no coordinated checkpoint",
+		},
+		{
+			name:     "checkpoint state not found",
+			fetcher:  &mockCoordStateFetcher{res: coordinatedState(1, gwatCommon.Hash{'u'}, gwatCommon.Hash{0x01})},
+			wantCode: codes.Internal,
+			wantMsg:  "Could not get state of gwat coordinated checkpoint",
+		},
+		{
+			name:     "checkpoint state has no finalized spines",
+			fetcher:  &mockCoordStateFetcher{res: coordinatedState(1, noSpinesRoot, gwatCommon.Hash{0x01})},
+			wantCode: codes.Internal,
+			wantMsg:  "has no finalized spines",
+		},
+		{
+			name:         "finalization epoch not known",
+			fetcher:      &mockCoordStateFetcher{res: coordinatedState(1, gwatCpRoot, gwatCommon.Hash{0x01})},
+			finalization: lastFinalization(gwatCommon.Hash{'n', 'e', 'x', 't'}, 2, 3),
+			wantCode:     codes.Unavailable,
+			wantMsg:      "is not known yet",
+		},
+		{
+			name:         "checkpoint ahead of state",
+			fetcher:      &mockCoordStateFetcher{res: coordinatedState(3, aheadRoot, gwatCommon.Hash{0x01})},
+			finalization: lastFinalization(aheadRoot, 3, 4),
+			wantCode:     codes.InvalidArgument,
+			wantMsg:      "is ahead of the state slot 64",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Server{
+				StateFetcher:        &testutil.MockFetcher{BeaconState: st},
+				StateGenService:     stateGen,
+				FinalizationFetcher: tt.finalization,
+				CoordStateFetcher:   tt.fetcher,
+			}
+			if tt.finalization == nil {
+				s.FinalizationFetcher = &mockFinalizationFetcher{}
+			}
+			_, err := s.GetStateCoordination(context.Background(), &ethpbv1.StateRequest{StateId: []byte("64")})
+			assert.ErrorContains(t, tt.wantMsg, err)
+			assertStatusCode(t, tt.wantCode, err)
+		})
+	}
+}
+
+func TestServer_GetStateCoordination_StateNotFound(t *testing.T) {
+	notFoundErr := statefetcher.NewStateNotFoundError(10)
+	s := &Server{StateFetcher: &errStateFetcher{err: &notFoundErr}}
+	_, err := s.GetStateCoordination(context.Background(), &ethpbv1.StateRequest{StateId: []byte("1000")})
+	assert.ErrorContains(t, "State not found", err)
+	assertStatusCode(t, codes.NotFound, err)
+}
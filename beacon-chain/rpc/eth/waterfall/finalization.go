@@ -0,0 +1,41 @@
+package waterfall
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/rpc/eth/helpers"
+	ethpbv1 "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v1"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// GetGwatFinalization returns the spines last sent to gwat for finalization,
+// the cached gwat coordinated checkpoint and the state of the gwat synchronization.
+func (s *Server) GetGwatFinalization(ctx context.Context, _ *emptypb.Empty) (*ethpbv1.GwatFinalizationResponse, error) {
+	_, span := trace.StartSpan(ctx, "waterfall.GetGwatFinalization")
+	defer span.End()
+
+	st := s.FinalizationFetcher.GwatFinalizationStatus()
+	if st == nil {
+		return nil, status.Error(codes.Unavailable, "Gwat finalization status is not available")
+	}
+	data := &ethpbv1.GwatFinalizationStatus{
+		LastFinalization: helpers.GwatFinalizationToV1(st.LastFinalization),
+		IsSyncing:        st.IsSyncing,
+		IsRepairing:      st.IsRepairing,
+		RecoveryStage:    st.RecoveryStage,
+		RecoveryAttempts: st.RecoveryAttempts,
+	}
+	if cp := st.CoordinatedState; cp != nil {
+		data.CoordinatedState = &ethpbv1.GwatCheckpoint{
+			Epoch:    types.Epoch(cp.Epoch),
+			FinEpoch: types.Epoch(cp.FinEpoch),
+			Root:     cp.Root.Bytes(),
+			Spine:    cp.Spine.Bytes(),
+		}
+	}
+	return &ethpbv1.GwatFinalizationResponse{Data: data}, nil
+}
@@ -0,0 +1,98 @@
+package waterfall
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/blockchain"
+	statefeed "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/feed/state"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/assert"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
+	gwatTypes "gitlab.waterfall.network/waterfall/protocol/gwat/core/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type mockFinalizationFetcher struct {
+	status *blockchain.GwatFinalizationStatus
+}
+
+func (m *mockFinalizationFetcher) GwatFinalizationStatus() *blockchain.GwatFinalizationStatus {
+	return m.status
+}
+
+func assertStatusCode(t *testing.T, code codes.Code, err error) {
+	st, ok := status.FromError(err)
+	require.Equal(t, true, ok)
+	assert.Equal(t, code, st.Code())
+}
+
+func TestServer_GetGwatFinalization(t *testing.T) {
+	finData := &statefeed.GwatFinalizationData{
+		Slot:      10,
+		SyncMode:  "head",
+		Spines:    [][32]byte{{1}, {2}},
+		BaseSpine: [32]byte{3},
+		CpEpoch:   1,
+		CpRoot:    [32]byte{4},
+		CpSpine:   [32]byte{3},
+		Error:     "invalid base spine",
+		Time:      time.Unix(100, 0),
+	}
+	coordState := &gwatTypes.Checkpoint{
+		Epoch: 1,
+		Root:  gwatCommon.Hash{4},
+		Spine: gwatCommon.Hash{3},
+	}
+	s := &Server{FinalizationFetcher: &mockFinalizationFetcher{status: &blockchain.GwatFinalizationStatus{
+		LastFinalization: finData,
+		CoordinatedState: coordState,
+		IsRepairing:      true,
+		RecoveryStage:    "walk_back",
+		RecoveryAttempts: 2,
+	}}}
+
+	resp, err := s.GetGwatFinalization(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	require.NotNil(t, resp.Data)
+	assert.Equal(t, false, resp.Data.IsSyncing)
+	assert.Equal(t, true, resp.Data.IsRepairing)
+	assert.Equal(t, "walk_back", resp.Data.RecoveryStage)
+	assert.Equal(t, uint64(2), resp.Data.RecoveryAttempts)
+	require.NotNil(t, resp.Data.CoordinatedState)
+	assert.Equal(t, uint64(1), uint64(resp.Data.CoordinatedState.Epoch))
+	assert.DeepEqual(t, coordState.Root.Bytes(), resp.Data.CoordinatedState.Root)
+	assert.DeepEqual(t, coordState.Spine.Bytes(), resp.Data.CoordinatedState.Spine)
+
+	last := resp.Data.LastFinalization
+	require.NotNil(t, last)
+	assert.Equal(t, uint64(10), uint64(last.Slot))
+	assert.Equal(t, "head", last.SyncMode)
+	assert.DeepEqual(t, [][]byte{finData.Spines[0][:], finData.Spines[1][:]}, last.Spines)
+	assert.DeepEqual(t, finData.BaseSpine[:], last.BaseSpine)
+	assert.Equal(t, uint64(1), uint64(last.Checkpoint.Epoch))
+	assert.DeepEqual(t, finData.CpRoot[:], last.Checkpoint.Root)
+	assert.Equal(t, false, last.Success)
+	assert.Equal(t, "invalid base spine", last.Error)
+	assert.Equal(t, uint64(100), last.Time)
+}
+
+func TestServer_GetGwatFinalization_NoFinalizationYet(t *testing.T) {
+	s := &Server{FinalizationFetcher: &mockFinalizationFetcher{status: &blockchain.GwatFinalizationStatus{
+		IsSyncing: true,
+	}}}
+	resp, err := s.GetGwatFinalization(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, true, resp.Data.IsSyncing)
+	assert.Equal(t, true, resp.Data.LastFinalization == nil)
+	assert.Equal(t, true, resp.Data.CoordinatedState == nil)
+}
+
+func TestServer_GetGwatFinalization_Unavailable(t *testing.T) {
+	s := &Server{FinalizationFetcher: &mockFinalizationFetcher{}}
+	_, err := s.GetGwatFinalization(context.Background(), &emptypb.Empty{})
+	assertStatusCode(t, codes.Unavailable, err)
+}
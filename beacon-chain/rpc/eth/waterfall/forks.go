@@ -0,0 +1,35 @@
+package waterfall
+
+import (
+	"context"
+
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	ethpbv1 "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/time/slots"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// GetForkSchedule returns the Waterfall forks ordered by activation slot
+// and their digest exchanged by peers after the status handshake.
+// The fork schedule endpoint of the beacon API serves them along with the version forks.
+func (s *Server) GetForkSchedule(ctx context.Context, _ *emptypb.Empty) (*ethpbv1.WaterfallForkScheduleResponse, error) {
+	_, span := trace.StartSpan(ctx, "waterfall.GetForkSchedule")
+	defer span.End()
+
+	cfg := params.BeaconConfig()
+	wForks := cfg.WaterfallForks()
+	forks := make([]*ethpbv1.WaterfallFork, len(wForks))
+	for i, f := range wForks {
+		forks[i] = &ethpbv1.WaterfallFork{
+			Name:  f.Name,
+			Slot:  f.Slot,
+			Epoch: slots.ToEpoch(f.Slot),
+		}
+	}
+	digest := cfg.WaterfallForksDigest()
+	return &ethpbv1.WaterfallForkScheduleResponse{Data: &ethpbv1.WaterfallForkSchedule{
+		Forks:  forks,
+		Digest: digest[:],
+	}}, nil
+}
@@ -0,0 +1,35 @@
+package waterfall
+
+import (
+	"context"
+	"testing"
+
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	ethpbv1 "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/assert"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestServer_GetForkSchedule(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.SlotsPerEpoch = 32
+	cfg.DelegateForkSlot = 64
+	cfg.PrefixFinForkSlot = 0
+	cfg.FinEth1ForkSlot = 32
+	cfg.BlockVotingForkSlot = 100
+	params.OverrideBeaconConfig(cfg)
+
+	s := &Server{}
+	resp, err := s.GetForkSchedule(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*ethpbv1.WaterfallFork{
+		{Name: params.PrefixFinForkName, Slot: 0, Epoch: 0},
+		{Name: params.FinEth1ForkName, Slot: 32, Epoch: 1},
+		{Name: params.DelegateForkName, Slot: 64, Epoch: 2},
+		{Name: params.BlockVotingForkName, Slot: 100, Epoch: 3},
+	}, resp.Data.Forks)
+	digest := cfg.WaterfallForksDigest()
+	assert.DeepEqual(t, digest[:], resp.Data.Digest)
+}
@@ -0,0 +1,39 @@
+package waterfall
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/io/logs"
+	ethpbv1 "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v1"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// GetGwatEndpoints returns the health and the consistency scores of the configured gwat endpoints,
+// the consistency is checked against the current endpoint by the coordinated state and the finalized spines.
+func (s *Server) GetGwatEndpoints(ctx context.Context, _ *emptypb.Empty) (*ethpbv1.GwatEndpointsResponse, error) {
+	_, span := trace.StartSpan(ctx, "waterfall.GetGwatEndpoints")
+	defer span.End()
+
+	statuses := s.DagEndpointsFetcher.DagEndpointsStatus()
+	data := make([]*ethpbv1.GwatEndpoint, len(statuses))
+	for i, st := range statuses {
+		data[i] = &ethpbv1.GwatEndpoint{
+			Url:              logs.MaskCredentialsLogging(st.Url),
+			Current:          st.Current,
+			Checked:          st.Checked,
+			Healthy:          st.Healthy,
+			Consistent:       st.Consistent,
+			HealthScore:      int64(st.HealthScore),
+			ConsistencyScore: int64(st.ConsistencyScore),
+			LfSpine:          st.LFSpine.Bytes(),
+			CpEpoch:          types.Epoch(st.CpEpoch),
+			CpRoot:           st.CpRoot.Bytes(),
+		}
+		if st.Err != nil {
+			data[i].Error = st.Err.Error()
+		}
+	}
+	return &ethpbv1.GwatEndpointsResponse{Data: data}, nil
+}
@@ -0,0 +1,52 @@
+package waterfall
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/db"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	ethpbv1 "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v1"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetOperationLifecycle returns the lifecycle of the withdrawal or the exit initiated by the gwat transaction:
+// seen in the gwat log, pooled, included in blocks, applied to the states of the blocks
+// and pruned from the state after CleanWithdrawalsAftEpochs.
+// The block records of the orphaned forks are removed on finalization.
+func (s *Server) GetOperationLifecycle(ctx context.Context, req *ethpbv1.OperationLifecycleRequest) (*ethpbv1.OperationLifecycleResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "waterfall.GetOperationLifecycle")
+	defer span.End()
+
+	if len(req.TxHash) != 32 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid transaction hash: must be 32 bytes, got %d", len(req.TxHash))
+	}
+	op, err := s.BeaconDB.OperationLifecycle(ctx, bytesutil.ToBytes32(req.TxHash))
+	if errors.Is(err, db.ErrNotFoundOperationLifecycle) {
+		return nil, status.Error(codes.NotFound, "Operation not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get operation lifecycle: %v", err)
+	}
+
+	records := make([]*ethpbv1.LifecycleRecord, len(op.Records))
+	for i, rec := range op.Records {
+		records[i] = &ethpbv1.LifecycleRecord{
+			Stage: rec.Stage.String(),
+			Slot:  rec.Slot,
+		}
+		if rec.Stage.IsBlockStage() {
+			records[i].BlockRoot = bytesutil.SafeCopyBytes(rec.BlockRoot[:])
+		}
+	}
+	return &ethpbv1.OperationLifecycleResponse{Data: &ethpbv1.OperationLifecycle{
+		InitTxHash:     bytesutil.SafeCopyBytes(op.InitTxHash[:]),
+		Type:           op.Type.String(),
+		ValidatorIndex: op.ValidatorIndex,
+		Amount:         op.Amount,
+		Status:         op.Status().String(),
+		Records:        records,
+	}}, nil
+}
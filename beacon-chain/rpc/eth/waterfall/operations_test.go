@@ -0,0 +1,56 @@
+package waterfall
+
+import (
+	"context"
+	"testing"
+
+	dbTest "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/db/testing"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/operations/lifecycle"
+	ethpbv1 "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/assert"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestServer_GetOperationLifecycle(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	initTxHash := [32]byte{'t', 'x'}
+	blockRoot := [32]byte{'r', 'o', 'o', 't'}
+	require.NoError(t, beaconDB.SaveOperationLifecycles(context.Background(), []*lifecycle.Operation{{
+		InitTxHash:     initTxHash,
+		Type:           lifecycle.OpWithdrawal,
+		ValidatorIndex: 3,
+		Amount:         1000,
+		Records: []*lifecycle.Record{
+			{Stage: lifecycle.StageSeen, Slot: 10},
+			{Stage: lifecycle.StagePooled, Slot: 10},
+			{Stage: lifecycle.StageIncluded, Slot: 12, BlockRoot: blockRoot},
+		},
+	}}))
+
+	s := &Server{BeaconDB: beaconDB}
+	resp, err := s.GetOperationLifecycle(context.Background(), &ethpbv1.OperationLifecycleRequest{TxHash: initTxHash[:]})
+	require.NoError(t, err)
+	require.NotNil(t, resp.Data)
+	assert.DeepEqual(t, initTxHash[:], resp.Data.InitTxHash)
+	assert.Equal(t, "withdrawal", resp.Data.Type)
+	assert.Equal(t, uint64(3), uint64(resp.Data.ValidatorIndex))
+	assert.Equal(t, uint64(1000), resp.Data.Amount)
+	assert.Equal(t, "included", resp.Data.Status)
+	require.Equal(t, 3, len(resp.Data.Records))
+	assert.Equal(t, "seen", resp.Data.Records[0].Stage)
+	assert.Equal(t, 0, len(resp.Data.Records[0].BlockRoot))
+	assert.Equal(t, uint64(12), uint64(resp.Data.Records[2].Slot))
+	assert.DeepEqual(t, blockRoot[:], resp.Data.Records[2].BlockRoot)
+}
+
+func TestServer_GetOperationLifecycle_Errors(t *testing.T) {
+	s := &Server{BeaconDB: dbTest.SetupDB(t)}
+
+	_, err := s.GetOperationLifecycle(context.Background(), &ethpbv1.OperationLifecycleRequest{TxHash: []byte{0x12, 0x34}})
+	assertStatusCode(t, codes.InvalidArgument, err)
+
+	unknown := [32]byte{'u'}
+	_, err = s.GetOperationLifecycle(context.Background(), &ethpbv1.OperationLifecycleRequest{TxHash: unknown[:]})
+	assertStatusCode(t, codes.NotFound, err)
+}
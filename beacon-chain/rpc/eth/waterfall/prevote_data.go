@@ -0,0 +1,40 @@
+package waterfall
+
+import (
+	"context"
+
+	ethpbv1 "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/migration"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PrevoteDataFetcher produces the prevote data to be signed by the validators of a committee.
+type PrevoteDataFetcher interface {
+	GetPrevoteData(ctx context.Context, req *ethpb.PreVoteRequest) (*ethpb.PreVoteData, error)
+}
+
+// GetPrevoteData requests that the beacon node produces the prevote data
+// for the requested slot and committee index.
+func (s *Server) GetPrevoteData(ctx context.Context, req *ethpbv1.PrevoteDataRequest) (*ethpbv1.PrevoteDataResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "waterfall.GetPrevoteData")
+	defer span.End()
+
+	data, err := s.PrevoteDataFetcher.GetPrevoteData(ctx, &ethpb.PreVoteRequest{
+		Slot:           req.Slot,
+		CommitteeIndex: req.CommitteeIndex,
+	})
+	if err != nil {
+		code, msg := codes.Internal, err.Error()
+		if st, ok := status.FromError(err); ok {
+			msg = st.Message()
+			if st.Code() == codes.Unavailable {
+				code = codes.Unavailable
+			}
+		}
+		return nil, status.Errorf(code, "Could not get prevote data: %s", msg)
+	}
+	return &ethpbv1.PrevoteDataResponse{Data: migration.V1Alpha1PrevoteDataToV1(data)}, nil
+}
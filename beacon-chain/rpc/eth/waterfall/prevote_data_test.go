@@ -0,0 +1,57 @@
+package waterfall
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	ethpbv1 "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v1"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/assert"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockPrevoteDataFetcher struct {
+	err error
+}
+
+func (m *mockPrevoteDataFetcher) GetPrevoteData(_ context.Context, req *ethpb.PreVoteRequest) (*ethpb.PreVoteData, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &ethpb.PreVoteData{
+		Slot:       req.Slot,
+		Index:      req.CommitteeIndex,
+		Candidates: bytes.Repeat([]byte{0x01}, 64),
+	}, nil
+}
+
+func TestServer_GetPrevoteData(t *testing.T) {
+	fetcher := &mockPrevoteDataFetcher{}
+	s := &Server{PrevoteDataFetcher: fetcher}
+	req := &ethpbv1.PrevoteDataRequest{Slot: 5, CommitteeIndex: 2}
+
+	t.Run("ok", func(t *testing.T) {
+		resp, err := s.GetPrevoteData(context.Background(), req)
+		require.NoError(t, err)
+		require.NotNil(t, resp.Data)
+		assert.Equal(t, uint64(5), uint64(resp.Data.Slot))
+		assert.Equal(t, uint64(2), uint64(resp.Data.Index))
+		assert.Equal(t, 64, len(resp.Data.Candidates))
+	})
+	t.Run("unavailable", func(t *testing.T) {
+		fetcher.err = status.Error(codes.Unavailable, "Syncing to latest head, not ready to respond")
+		defer func() { fetcher.err = nil }()
+		_, err := s.GetPrevoteData(context.Background(), req)
+		assertStatusCode(t, codes.Unavailable, err)
+		assert.ErrorContains(t, "Could not get prevote data: Syncing to latest head, not ready to respond", err)
+	})
+	t.Run("internal", func(t *testing.T) {
+		fetcher.err = status.Error(codes.Internal, "Could not get head state")
+		defer func() { fetcher.err = nil }()
+		_, err := s.GetPrevoteData(context.Background(), req)
+		assertStatusCode(t, codes.Internal, err)
+	})
+}
@@ -0,0 +1,80 @@
+package waterfall
+
+import (
+	"context"
+	"strconv"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/cache"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	ethpbv1 "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v1"
+	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetPrevoteDecision returns the trace of the decision of the proposer on the candidates
+// of the block produced by the node: the prevotes considered, the voted chains,
+// the chosen chain and the reason. The block is identified by slot or by block root.
+func (s *Server) GetPrevoteDecision(ctx context.Context, req *ethpbv1.PrevoteDecisionRequest) (*ethpbv1.PrevoteDecisionResponse, error) {
+	_, span := trace.StartSpan(ctx, "waterfall.GetPrevoteDecision")
+	defer span.End()
+
+	var (
+		decision *cache.PrevoteDecision
+		ok       bool
+	)
+	if len(req.BlockId) == 32 {
+		decision, ok = s.PrevoteDecisionCache.ByRoot(bytesutil.ToBytes32(req.BlockId))
+	} else {
+		slot, err := strconv.ParseUint(string(req.BlockId), 10, 64)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "Invalid block ID: must be a slot or a block root")
+		}
+		decision, ok = s.PrevoteDecisionCache.BySlot(types.Slot(slot))
+	}
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Prevote decision not found")
+	}
+
+	prevotes := make([]*ethpbv1.ConsideredPrevote, len(decision.Prevotes))
+	for i, pv := range decision.Prevotes {
+		prevotes[i] = &ethpbv1.ConsideredPrevote{
+			Candidates: hashesToBytes(pv.Candidates),
+			Votes:      pv.Votes,
+			Aggregated: pv.Aggregated,
+			Accepted:   int64(pv.Accepted),
+		}
+	}
+	chains := make([]*ethpbv1.VotedChain, len(decision.Chains))
+	for i, c := range decision.Chains {
+		chains[i] = &ethpbv1.VotedChain{
+			Chain: hashesToBytes(c.Chain),
+			Votes: c.Votes,
+		}
+	}
+	data := &ethpbv1.PrevoteDecision{
+		Slot:                 decision.Slot,
+		ParentRoot:           bytesutil.SafeCopyBytes(decision.ParentRoot[:]),
+		OptimisticCandidates: hashesToBytes(decision.OptCandidates),
+		Prevotes:             prevotes,
+		Chains:               chains,
+		Chosen:               hashesToBytes(decision.Chosen),
+		Reason:               string(decision.Reason),
+		SpinesLimitCut:       int64(decision.SpinesLimitCut),
+		Candidates:           hashesToBytes(decision.Candidates),
+	}
+	if decision.BlockRoot != ([32]byte{}) {
+		data.BlockRoot = bytesutil.SafeCopyBytes(decision.BlockRoot[:])
+	}
+	return &ethpbv1.PrevoteDecisionResponse{Data: data}, nil
+}
+
+func hashesToBytes(hashes gwatCommon.HashArray) [][]byte {
+	res := make([][]byte, len(hashes))
+	for i, h := range hashes {
+		res[i] = h.Bytes()
+	}
+	return res
+}
@@ -0,0 +1,67 @@
+package waterfall
+
+import (
+	"context"
+	"testing"
+
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/cache"
+	ethpbv1 "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/assert"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
+	"google.golang.org/grpc/codes"
+)
+
+func TestServer_GetPrevoteDecision(t *testing.T) {
+	h1, h2 := gwatCommon.Hash{0x01}, gwatCommon.Hash{0x02}
+	candidates := gwatCommon.HashArray{h1, h2}
+	root := [32]byte{'r', 'o', 'o', 't'}
+	decisions := cache.NewPrevoteDecisionCache()
+	decisions.Put(&cache.PrevoteDecision{
+		Slot:          12,
+		ParentRoot:    [32]byte{'p'},
+		OptCandidates: candidates,
+		Prevotes: []*cache.ConsideredPrevote{
+			{Candidates: candidates, Votes: 3, Aggregated: true, Accepted: 2},
+		},
+		Chains: []*cache.VotedChain{
+			{Chain: gwatCommon.HashArray{h1}, Votes: 3},
+			{Chain: candidates, Votes: 3},
+		},
+		Chosen:     candidates,
+		Reason:     cache.ReasonMostVotedChain,
+		Candidates: candidates,
+	})
+	require.Equal(t, true, decisions.SetBlockRoot(12, candidates, root))
+
+	s := &Server{PrevoteDecisionCache: decisions}
+	for _, blockId := range [][]byte{[]byte("12"), root[:]} {
+		resp, err := s.GetPrevoteDecision(context.Background(), &ethpbv1.PrevoteDecisionRequest{BlockId: blockId})
+		require.NoError(t, err)
+		require.NotNil(t, resp.Data)
+		assert.Equal(t, uint64(12), uint64(resp.Data.Slot))
+		assert.DeepEqual(t, root[:], resp.Data.BlockRoot)
+		assert.Equal(t, "most_voted_chain", resp.Data.Reason)
+		assert.DeepEqual(t, [][]byte{h1.Bytes(), h2.Bytes()}, resp.Data.Candidates)
+		require.Equal(t, 1, len(resp.Data.Prevotes))
+		assert.Equal(t, uint64(3), resp.Data.Prevotes[0].Votes)
+		assert.Equal(t, int64(2), resp.Data.Prevotes[0].Accepted)
+		assert.Equal(t, true, resp.Data.Prevotes[0].Aggregated)
+		require.Equal(t, 2, len(resp.Data.Chains))
+		assert.Equal(t, 2, len(resp.Data.Chosen))
+	}
+}
+
+func TestServer_GetPrevoteDecision_Errors(t *testing.T) {
+	s := &Server{PrevoteDecisionCache: cache.NewPrevoteDecisionCache()}
+
+	for blockId, code := range map[string]codes.Code{
+		"head":                     codes.InvalidArgument,
+		string([]byte{0x12, 0x34}): codes.InvalidArgument,
+		"12":                       codes.NotFound,
+		string(make([]byte, 32)):   codes.NotFound,
+	} {
+		_, err := s.GetPrevoteDecision(context.Background(), &ethpbv1.PrevoteDecisionRequest{BlockId: []byte(blockId)})
+		assertStatusCode(t, code, err)
+	}
+}
@@ -0,0 +1,100 @@
+package waterfall
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/api/grpc"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/rpc/eth/helpers"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	ethpbv1 "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/migration"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// PrevoteProposer submits a prevote to the node, which broadcasts it to the network.
+type PrevoteProposer interface {
+	ProposePrevote(ctx context.Context, pv *ethpb.PreVote) (*ethpb.PrevoteResponse, error)
+}
+
+// ListPoolPrevotes retrieves prevotes known by the node but
+// not necessarily incorporated into any block. Allows filtering by slot.
+func (s *Server) ListPoolPrevotes(ctx context.Context, req *ethpbv1.PrevotesPoolRequest) (*ethpbv1.PrevotesPoolResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "waterfall.ListPoolPrevotes")
+	defer span.End()
+
+	var prevotes []*ethpb.PreVote
+	if req.Slot != nil {
+		prevotes = s.PrevotePool.GetPrevoteBySlot(ctx, *req.Slot)
+	} else {
+		prevotes = s.PrevotePool.GetPrevotes(ctx)
+	}
+
+	data := make([]*ethpbv1.Prevote, len(prevotes))
+	for i, pv := range prevotes {
+		data[i] = migration.V1Alpha1PrevoteToV1(pv)
+	}
+	return &ethpbv1.PrevotesPoolResponse{Data: data}, nil
+}
+
+// SubmitPrevotes submits prevote objects to the node. Each prevote is proposed
+// in the same way as the ones of the connected validators, which publishes it on the appropriate subnet.
+func (s *Server) SubmitPrevotes(ctx context.Context, req *ethpbv1.SubmitPrevotesRequest) (*emptypb.Empty, error) {
+	ctx, span := trace.StartSpan(ctx, "waterfall.SubmitPrevotes")
+	defer span.End()
+
+	var failures []*helpers.SingleIndexedVerificationFailure
+	for i, item := range req.Data {
+		pv, err := prevoteFromV1(item)
+		if err == nil {
+			_, err = s.PrevoteProposer.ProposePrevote(ctx, pv)
+		}
+		if err != nil {
+			msg := err.Error()
+			if st, ok := status.FromError(err); ok {
+				msg = st.Message()
+			}
+			failures = append(failures, &helpers.SingleIndexedVerificationFailure{
+				Index:   i,
+				Message: msg,
+			})
+		}
+	}
+
+	if len(failures) > 0 {
+		failuresContainer := &helpers.IndexedVerificationFailure{Failures: failures}
+		err := grpc.AppendCustomErrorHeader(ctx, failuresContainer)
+		if err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"One or more prevotes failed validation. Could not prepare prevote failure information: %v",
+				err,
+			)
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "One or more prevotes failed validation")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func prevoteFromV1(pv *ethpbv1.Prevote) (*ethpb.PreVote, error) {
+	if pv == nil || pv.Data == nil {
+		return nil, errors.New("prevote data is nil")
+	}
+	if len(pv.Data.Candidates)%32 != 0 {
+		return nil, fmt.Errorf("invalid candidates length %d", len(pv.Data.Candidates))
+	}
+	return &ethpb.PreVote{
+		AggregationBits: bytesutil.SafeCopyBytes(pv.AggregationBits),
+		Data: &ethpb.PreVoteData{
+			Slot:       pv.Data.Slot,
+			Index:      pv.Data.Index,
+			Candidates: bytesutil.SafeCopyBytes(pv.Data.Candidates),
+		},
+		Signature: bytesutil.SafeCopyBytes(pv.Signature),
+	}, nil
+}
@@ -0,0 +1,98 @@
+package waterfall
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	grpcutil "gitlab.waterfall.network/waterfall/protocol/coordinator/api/grpc"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/operations/prevote"
+	ethpbv1 "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/migration"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/assert"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockPrevoteProposer struct {
+	proposed []*ethpb.PreVote
+}
+
+func (m *mockPrevoteProposer) ProposePrevote(_ context.Context, pv *ethpb.PreVote) (*ethpb.PrevoteResponse, error) {
+	if len(pv.Signature) != 96 {
+		return nil, status.Error(codes.InvalidArgument, "Incorrect prevote signature")
+	}
+	m.proposed = append(m.proposed, pv)
+	return &ethpb.PrevoteResponse{}, nil
+}
+
+func createPrevote(slot uint64, bits bitfield.Bitlist) *ethpb.PreVote {
+	return &ethpb.PreVote{
+		AggregationBits: bits,
+		Data: &ethpb.PreVoteData{
+			Slot:       types.Slot(slot),
+			Candidates: bytes.Repeat([]byte{byte(slot)}, 32),
+		},
+		Signature: make([]byte, 96),
+	}
+}
+
+func TestServer_ListPoolPrevotes(t *testing.T) {
+	pool := prevote.NewPool()
+	pv1 := createPrevote(1, bitfield.Bitlist{0b00000011})
+	pv2 := createPrevote(2, bitfield.Bitlist{0b00000101})
+	require.NoError(t, pool.SavePrevote(pv2))
+	require.NoError(t, pool.SavePrevote(pv1))
+	s := &Server{PrevotePool: pool}
+
+	t.Run("all", func(t *testing.T) {
+		resp, err := s.ListPoolPrevotes(context.Background(), &ethpbv1.PrevotesPoolRequest{})
+		require.NoError(t, err)
+		require.Equal(t, 2, len(resp.Data))
+		assert.DeepEqual(t, migration.V1Alpha1PrevoteToV1(pv1), resp.Data[0])
+		assert.DeepEqual(t, migration.V1Alpha1PrevoteToV1(pv2), resp.Data[1])
+	})
+	t.Run("by slot", func(t *testing.T) {
+		slot := types.Slot(2)
+		resp, err := s.ListPoolPrevotes(context.Background(), &ethpbv1.PrevotesPoolRequest{Slot: &slot})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, slot, resp.Data[0].Data.Slot)
+	})
+}
+
+func TestServer_SubmitPrevotes(t *testing.T) {
+	proposer := &mockPrevoteProposer{}
+	s := &Server{PrevoteProposer: proposer}
+
+	valid := createPrevote(3, bitfield.Bitlist{0b00000011})
+	invalid := migration.V1Alpha1PrevoteToV1(createPrevote(3, bitfield.Bitlist{0b00000101}))
+	invalid.Signature = []byte{0x01}
+	req := &ethpbv1.SubmitPrevotesRequest{Data: []*ethpbv1.Prevote{migration.V1Alpha1PrevoteToV1(valid), invalid, {}}}
+
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), &runtime.ServerTransportStream{})
+	_, err := s.SubmitPrevotes(ctx, req)
+	assertStatusCode(t, codes.InvalidArgument, err)
+	assert.ErrorContains(t, "One or more prevotes failed validation", err)
+
+	sts, ok := grpc.ServerTransportStreamFromContext(ctx).(*runtime.ServerTransportStream)
+	require.Equal(t, true, ok, "type assertion failed")
+	md := sts.Header()
+	v, ok := md[strings.ToLower(grpcutil.CustomErrorMetadataKey)]
+	require.Equal(t, true, ok, "could not retrieve custom error metadata value")
+	assert.DeepEqual(
+		t,
+		[]string{"{\"failures\":[{\"index\":1,\"message\":\"Incorrect prevote signature\"},{\"index\":2,\"message\":\"prevote data is nil\"}]}"},
+		v,
+	)
+
+	require.Equal(t, 1, len(proposer.proposed))
+	assert.DeepEqual(t, valid, proposer.proposed[0])
+}
@@ -0,0 +1,157 @@
+package waterfall
+
+import (
+	"context"
+	"strconv"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/rewards"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	ethpbv1 "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/time/slots"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetEpochRewards returns the rewards and penalties applied to the validators balances in the epoch
+// by the canonical blocks, both by the block processing and by the epoch processing.
+// The result can be filtered by the validator_index query parameters.
+func (s *Server) GetEpochRewards(ctx context.Context, req *ethpbv1.EpochRewardsRequest) (*ethpbv1.RewardsResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "waterfall.GetEpochRewards")
+	defer span.End()
+
+	if err := rewardsSaved(); err != nil {
+		return nil, err
+	}
+	changes, err := s.BeaconDB.EpochRewards(ctx, req.Epoch)
+	if err == nil {
+		changes, err = s.canonicalChanges(ctx, changes)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get epoch rewards: %v", err)
+	}
+	return &ethpbv1.RewardsResponse{Data: balanceChangesToProto(changes, validatorsFilter(req.ValidatorIndex), false)}, nil
+}
+
+// GetBlockRewards returns the rewards and penalties applied to the validators balances
+// by the block processing of the block. The block is identified by block root
+// or by slot, in which case the canonical block of the slot is used.
+// The result can be filtered by the validator_index query parameters.
+func (s *Server) GetBlockRewards(ctx context.Context, req *ethpbv1.BlockRewardsRequest) (*ethpbv1.RewardsResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "waterfall.GetBlockRewards")
+	defer span.End()
+
+	if err := rewardsSaved(); err != nil {
+		return nil, err
+	}
+	var slot types.Slot
+	var blockRoot *[32]byte
+	if len(req.BlockId) == 32 {
+		root := bytesutil.ToBytes32(req.BlockId)
+		blk, err := s.BeaconDB.Block(ctx, root)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get block: %v", err)
+		}
+		if blk == nil || blk.IsNil() {
+			return nil, status.Error(codes.NotFound, "Block not found")
+		}
+		slot = blk.Block().Slot()
+		blockRoot = &root
+	} else {
+		num, err := strconv.ParseUint(string(req.BlockId), 10, 64)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "Invalid block ID: must be a slot or a block root")
+		}
+		slot = types.Slot(num)
+	}
+	changes, err := s.BeaconDB.SlotRewards(ctx, slot)
+	if err == nil {
+		if blockRoot != nil {
+			changes = blockChanges(changes, *blockRoot)
+		} else {
+			changes, err = s.canonicalChanges(ctx, changes)
+		}
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get block rewards: %v", err)
+	}
+	return &ethpbv1.RewardsResponse{Data: balanceChangesToProto(changes, validatorsFilter(req.ValidatorIndex), true)}, nil
+}
+
+// canonicalChanges returns the changes applied by the canonical blocks.
+func (s *Server) canonicalChanges(ctx context.Context, changes []*rewards.BalanceChange) ([]*rewards.BalanceChange, error) {
+	canonical := make(map[[32]byte]bool)
+	res := make([]*rewards.BalanceChange, 0, len(changes))
+	for _, ch := range changes {
+		isCanonical, ok := canonical[ch.BlockRoot]
+		if !ok {
+			var err error
+			isCanonical, err = s.CanonicalFetcher.IsCanonical(ctx, ch.BlockRoot)
+			if err != nil {
+				return nil, err
+			}
+			canonical[ch.BlockRoot] = isCanonical
+		}
+		if isCanonical {
+			res = append(res, ch)
+		}
+	}
+	return res, nil
+}
+
+// blockChanges returns the changes applied by the block.
+func blockChanges(changes []*rewards.BalanceChange, blockRoot [32]byte) []*rewards.BalanceChange {
+	res := make([]*rewards.BalanceChange, 0, len(changes))
+	for _, ch := range changes {
+		if ch.BlockRoot == blockRoot {
+			res = append(res, ch)
+		}
+	}
+	return res
+}
+
+func rewardsSaved() error {
+	if params.BeaconConfig().SaveRewards {
+		return nil
+	}
+	return status.Error(codes.NotFound, "Rewards and penalties are not saved by the node")
+}
+
+// validatorsFilter returns the set of the requested validators, nil means all validators.
+func validatorsFilter(indices []types.ValidatorIndex) map[types.ValidatorIndex]bool {
+	if len(indices) == 0 {
+		return nil
+	}
+	validators := make(map[types.ValidatorIndex]bool, len(indices))
+	for _, idx := range indices {
+		validators[idx] = true
+	}
+	return validators
+}
+
+func balanceChangesToProto(changes []*rewards.BalanceChange, validators map[types.ValidatorIndex]bool, blockOnly bool) []*ethpbv1.BalanceChange {
+	res := make([]*ethpbv1.BalanceChange, 0, len(changes))
+	for _, ch := range changes {
+		if blockOnly && !ch.Role.IsBlockRole() {
+			continue
+		}
+		if validators != nil && !validators[ch.Validator] {
+			continue
+		}
+		res = append(res, &ethpbv1.BalanceChange{
+			ValidatorIndex: ch.Validator,
+			BlockRoot:      bytesutil.SafeCopyBytes(ch.BlockRoot[:]),
+			Slot:           ch.Slot,
+			Epoch:          slots.ToEpoch(ch.Slot),
+			Operation:      ch.Operation.String(),
+			Role:           ch.Role.String(),
+			Amount:         ch.Amount,
+			VotesIncluded:  ch.VotesIncluded,
+			BalanceBefore:  ch.Before,
+			BalanceAfter:   ch.After,
+		})
+	}
+	return res
+}
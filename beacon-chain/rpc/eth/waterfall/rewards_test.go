@@ -0,0 +1,119 @@
+package waterfall
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/blockchain/testing"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/rewards"
+	dbTest "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/db/testing"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	ethpbv1 "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1/wrapper"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/assert"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/util"
+	"google.golang.org/grpc/codes"
+)
+
+func TestServer_GetRewards(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.SaveRewards = true
+	params.OverrideBeaconConfig(cfg)
+
+	ctx := context.Background()
+	beaconDB := dbTest.SetupDB(t)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	blockSlot := 2*slotsPerEpoch - 1
+	b := util.NewBeaconBlock()
+	b.Block.Slot = blockSlot
+	wsb, err := wrapper.WrappedSignedBeaconBlock(b)
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, wsb))
+	blockRoot, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	fb := util.NewBeaconBlock()
+	fb.Block.Slot = blockSlot
+	fb.Block.ProposerIndex = 1
+	wsb, err = wrapper.WrappedSignedBeaconBlock(fb)
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, wsb))
+	forkRoot, err := fb.Block.HashTreeRoot()
+	require.NoError(t, err)
+	parentRoot := [32]byte{'p'}
+	require.NoError(t, beaconDB.SaveRewards(ctx, []*rewards.BalanceChange{
+		{Slot: blockSlot - 1, BlockRoot: parentRoot, Validator: 2, Operation: rewards.Increase, Role: rewards.RoleSyncAggregation, Amount: 3, Before: 10, After: 13},
+		{Slot: blockSlot, BlockRoot: blockRoot, Validator: 1, Operation: rewards.Increase, Role: rewards.RoleProposing, Amount: 5, VotesIncluded: []uint64{3, 4}, Before: 10, After: 15},
+		{Slot: blockSlot, BlockRoot: blockRoot, Validator: 3, Operation: rewards.Decrease, Role: rewards.RoleAttestation, Amount: 2, Before: 10, After: 8},
+		// The change applied by the block of the non-canonical fork.
+		{Slot: blockSlot, BlockRoot: forkRoot, Validator: 1, Operation: rewards.Increase, Role: rewards.RoleProposing, Amount: 7, Before: 10, After: 17},
+	}))
+
+	s := &Server{
+		BeaconDB:         beaconDB,
+		CanonicalFetcher: &mock.ChainService{CanonicalRoots: map[[32]byte]bool{parentRoot: true, blockRoot: true}},
+	}
+	blockRewards := func(blockId []byte) *ethpbv1.RewardsResponse {
+		resp, err := s.GetBlockRewards(ctx, &ethpbv1.BlockRewardsRequest{BlockId: blockId})
+		require.NoError(t, err)
+		return resp
+	}
+
+	resp, err := s.GetEpochRewards(ctx, &ethpbv1.EpochRewardsRequest{Epoch: 1})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(resp.Data))
+	resp, err = s.GetEpochRewards(ctx, &ethpbv1.EpochRewardsRequest{Epoch: 1, ValidatorIndex: []types.ValidatorIndex{1, 3}})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Data))
+
+	// The block rewards exclude the ones of the epoch processing.
+	resp = blockRewards(blockRoot[:])
+	require.Equal(t, 1, len(resp.Data))
+	assert.Equal(t, types.ValidatorIndex(1), resp.Data[0].ValidatorIndex)
+	assert.DeepEqual(t, blockRoot[:], resp.Data[0].BlockRoot)
+	assert.Equal(t, types.Epoch(1), resp.Data[0].Epoch)
+	assert.Equal(t, "+", resp.Data[0].Operation)
+	assert.Equal(t, "blk-props", resp.Data[0].Role)
+	assert.Equal(t, uint64(5), resp.Data[0].Amount)
+	assert.DeepEqual(t, []uint64{3, 4}, resp.Data[0].VotesIncluded)
+	assert.Equal(t, uint64(10), resp.Data[0].BalanceBefore)
+	assert.Equal(t, uint64(15), resp.Data[0].BalanceAfter)
+
+	// The block of the slot is the canonical one.
+	resp = blockRewards([]byte(strconv.FormatUint(uint64(blockSlot), 10)))
+	require.Equal(t, 1, len(resp.Data))
+	assert.Equal(t, uint64(5), resp.Data[0].Amount)
+
+	// The block of the non-canonical fork is still available by root.
+	resp = blockRewards(forkRoot[:])
+	require.Equal(t, 1, len(resp.Data))
+	assert.Equal(t, uint64(7), resp.Data[0].Amount)
+
+	resp = blockRewards([]byte("14"))
+	require.Equal(t, 0, len(resp.Data))
+}
+
+func TestServer_GetRewards_Errors(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	ctx := context.Background()
+	s := &Server{BeaconDB: dbTest.SetupDB(t)}
+
+	_, err := s.GetEpochRewards(ctx, &ethpbv1.EpochRewardsRequest{Epoch: 1})
+	assertStatusCode(t, codes.NotFound, err)
+
+	cfg := params.BeaconConfig().Copy()
+	cfg.SaveRewards = true
+	params.OverrideBeaconConfig(cfg)
+
+	for _, blockId := range [][]byte{[]byte("abc"), {0x12, 0x34}} {
+		_, err = s.GetBlockRewards(ctx, &ethpbv1.BlockRewardsRequest{BlockId: blockId})
+		assertStatusCode(t, codes.InvalidArgument, err)
+	}
+
+	unknown := [32]byte{'u'}
+	_, err = s.GetBlockRewards(ctx, &ethpbv1.BlockRewardsRequest{BlockId: unknown[:]})
+	assertStatusCode(t, codes.NotFound, err)
+}
@@ -0,0 +1,28 @@
+// Package waterfall defines a gRPC service implementation of the Waterfall specific part of the beacon API,
+// which is served by the gateway along with the Ethereum beacon API.
+package waterfall
+
+import (
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/blockchain"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/cache"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/db"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/operations/prevote"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/powchain"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/rpc/statefetcher"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/state/stategen"
+)
+
+// Server defines a server implementation of the gRPC Waterfall and Waterfall debug services.
+type Server struct {
+	BeaconDB             db.ReadOnlyDatabase
+	FinalizationFetcher  blockchain.GwatFinalizationFetcher
+	CanonicalFetcher     blockchain.CanonicalFetcher
+	DagEndpointsFetcher  powchain.DagEndpointsFetcher
+	CoordStateFetcher    powchain.DagCoordinatedStateFetcher
+	PrevotePool          prevote.Pool
+	PrevoteProposer      PrevoteProposer
+	PrevoteDataFetcher   PrevoteDataFetcher
+	PrevoteDecisionCache *cache.PrevoteDecisionCache
+	StateFetcher         statefetcher.Fetcher
+	StateGenService      stategen.StateManager
+}
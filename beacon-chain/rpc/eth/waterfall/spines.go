@@ -0,0 +1,53 @@
+package waterfall
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/db"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/operations/lifecycle"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	ethpbv1 "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v1"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetSpineLifecycle returns the lifecycle of the gwat spine on the canonical chain: carried by the candidates of a block,
+// entered the prefix, reached the finalization by the block voting and sent to gwat by dag_finalize.
+func (s *Server) GetSpineLifecycle(ctx context.Context, req *ethpbv1.SpineLifecycleRequest) (*ethpbv1.SpineLifecycleResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "waterfall.GetSpineLifecycle")
+	defer span.End()
+
+	if len(req.Hash) != 32 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid spine hash: must be 32 bytes, got %d", len(req.Hash))
+	}
+	sp, err := s.BeaconDB.SpineLifecycle(ctx, bytesutil.ToBytes32(req.Hash))
+	if err == nil {
+		sp, err = sp.Canonical(func(blockRoot [32]byte) (bool, error) {
+			return s.CanonicalFetcher.IsCanonical(ctx, blockRoot)
+		})
+	}
+	if errors.Is(err, db.ErrNotFoundSpineLifecycle) || (err == nil && len(sp.Records) == 0) {
+		return nil, status.Error(codes.NotFound, "Spine not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get spine lifecycle: %v", err)
+	}
+
+	records := make([]*ethpbv1.LifecycleRecord, len(sp.Records))
+	for i, rec := range sp.Records {
+		records[i] = &ethpbv1.LifecycleRecord{
+			Stage: rec.Stage.String(),
+			Slot:  rec.Slot,
+		}
+		if rec.Stage != lifecycle.SpineSent {
+			records[i].BlockRoot = bytesutil.SafeCopyBytes(rec.BlockRoot[:])
+		}
+	}
+	return &ethpbv1.SpineLifecycleResponse{Data: &ethpbv1.SpineLifecycle{
+		Hash:    bytesutil.SafeCopyBytes(sp.Hash[:]),
+		Status:  sp.Status().String(),
+		Records: records,
+	}}, nil
+}
@@ -0,0 +1,70 @@
+package waterfall
+
+import (
+	"context"
+	"testing"
+
+	mock "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/blockchain/testing"
+	dbTest "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/db/testing"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/operations/lifecycle"
+	ethpbv1 "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/assert"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestServer_GetSpineLifecycle(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	hash := [32]byte{'s', 'p', 'i', 'n', 'e'}
+	blockRoot, forkRoot := [32]byte{'r', 'o', 'o', 't'}, [32]byte{'f', 'o', 'r', 'k'}
+	require.NoError(t, beaconDB.SaveSpineLifecycles(context.Background(), []*lifecycle.Spine{{
+		Hash: hash,
+		Records: []*lifecycle.SpineRecord{
+			{Stage: lifecycle.SpineCandidate, Slot: 9, BlockRoot: forkRoot},
+			{Stage: lifecycle.SpineCandidate, Slot: 10, BlockRoot: blockRoot},
+			{Stage: lifecycle.SpineFinalization, Slot: 11, BlockRoot: forkRoot},
+			{Stage: lifecycle.SpineFinalization, Slot: 12, BlockRoot: blockRoot},
+			{Stage: lifecycle.SpineSent, Slot: 13},
+		},
+	}}))
+
+	s := &Server{
+		BeaconDB:         beaconDB,
+		CanonicalFetcher: &mock.ChainService{CanonicalRoots: map[[32]byte]bool{blockRoot: true}},
+	}
+	resp, err := s.GetSpineLifecycle(context.Background(), &ethpbv1.SpineLifecycleRequest{Hash: hash[:]})
+	require.NoError(t, err)
+	require.NotNil(t, resp.Data)
+	assert.DeepEqual(t, hash[:], resp.Data.Hash)
+	assert.Equal(t, "sent", resp.Data.Status)
+	require.Equal(t, 3, len(resp.Data.Records))
+	assert.Equal(t, "candidate", resp.Data.Records[0].Stage)
+	assert.Equal(t, uint64(10), uint64(resp.Data.Records[0].Slot))
+	assert.DeepEqual(t, blockRoot[:], resp.Data.Records[0].BlockRoot)
+	assert.Equal(t, uint64(12), uint64(resp.Data.Records[1].Slot))
+	assert.Equal(t, 0, len(resp.Data.Records[2].BlockRoot))
+}
+
+func TestServer_GetSpineLifecycle_Errors(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	orphaned := [32]byte{'o'}
+	require.NoError(t, beaconDB.SaveSpineLifecycles(context.Background(), []*lifecycle.Spine{{
+		Hash:    orphaned,
+		Records: []*lifecycle.SpineRecord{{Stage: lifecycle.SpineCandidate, Slot: 10, BlockRoot: [32]byte{'f'}}},
+	}}))
+	s := &Server{
+		BeaconDB:         beaconDB,
+		CanonicalFetcher: &mock.ChainService{CanonicalRoots: map[[32]byte]bool{}},
+	}
+
+	_, err := s.GetSpineLifecycle(context.Background(), &ethpbv1.SpineLifecycleRequest{Hash: []byte{0x12, 0x34}})
+	assertStatusCode(t, codes.InvalidArgument, err)
+
+	unknown := [32]byte{'u'}
+	_, err = s.GetSpineLifecycle(context.Background(), &ethpbv1.SpineLifecycleRequest{Hash: unknown[:]})
+	assertStatusCode(t, codes.NotFound, err)
+
+	// the spine is carried by the blocks of an orphaned fork only
+	_, err = s.GetSpineLifecycle(context.Background(), &ethpbv1.SpineLifecycleRequest{Hash: orphaned[:]})
+	assertStatusCode(t, codes.NotFound, err)
+}
@@ -717,7 +717,7 @@ func (bs *Server) GetValidatorPerformance(
 
 		log.WithField("headState.slot", headState.Slot()).Info("Process rewards and penalties Altair")
 
-		headState, err = altair.ProcessRewardsAndPenaltiesPrecompute(headState, bp, vp)
+		headState, err = altair.ProcessRewardsAndPenaltiesPrecompute(ctx, headState, bp, vp)
 		if err != nil {
 			return nil, err
 		}
@@ -771,6 +771,13 @@ func (bs *Server) GetValidatorPerformance(
 	correctlyVotedTarget := make([]bool, 0, responseCap)
 	correctlyVotedHead := make([]bool, 0, responseCap)
 	inactivityScores := make([]uint64, 0, responseCap)
+	var prevotesSeen, prevotesIncluded []uint64
+	var seenByIndex, includedByIndex map[types.ValidatorIndex]uint64
+	if bs.PrevoteInclusionCache != nil {
+		prevotesSeen = make([]uint64, 0, responseCap)
+		prevotesIncluded = make([]uint64, 0, responseCap)
+		seenByIndex, includedByIndex = bs.PrevoteInclusionCache.EpochSummary(coreTime.PrevEpoch(headState))
+	}
 	// Append performance summaries.
 	// Also track missing validators using public keys.
 	for _, idx := range validatorIndices {
@@ -797,6 +804,10 @@ func (bs *Server) GetValidatorPerformance(
 		afterTransitionBalances = append(afterTransitionBalances, summary.AfterEpochTransitionBalance)
 		correctlyVotedTarget = append(correctlyVotedTarget, summary.IsPrevEpochTargetAttester)
 		correctlyVotedHead = append(correctlyVotedHead, summary.IsPrevEpochHeadAttester)
+		if bs.PrevoteInclusionCache != nil {
+			prevotesSeen = append(prevotesSeen, seenByIndex[idx])
+			prevotesIncluded = append(prevotesIncluded, includedByIndex[idx])
+		}
 
 		if headState.Version() == version.Phase0 {
 			correctlyVotedSource = append(correctlyVotedSource, summary.IsPrevEpochAttester)
@@ -820,6 +831,8 @@ func (bs *Server) GetValidatorPerformance(
 		InclusionSlots:                inclusionSlots,     // Only populated in phase0
 		InclusionDistances:            inclusionDistances, // Only populated in phase 0
 		InactivityScores:              inactivityScores,   // Only populated in Altair
+		PrevotesSeen:                  prevotesSeen,       // Only populated if the prevotes inclusion is tracked
+		PrevotesIncluded:              prevotesIncluded,   // Only populated if the prevotes inclusion is tracked
 	}, nil
 }
 
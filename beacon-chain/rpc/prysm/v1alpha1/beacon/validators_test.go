@@ -12,6 +12,7 @@ import (
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/go-bitfield"
 	mock "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/blockchain/testing"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/cache"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/altair"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/epoch/precompute"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/helpers"
@@ -2071,6 +2072,52 @@ func TestGetValidatorPerformance_Indices(t *testing.T) {
 	}
 }
 
+func TestGetValidatorPerformance_Prevotes(t *testing.T) {
+	ctx := context.Background()
+	epoch := types.Epoch(1)
+	headState, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, headState.SetSlot(params.BeaconConfig().SlotsPerEpoch.Mul(uint64(epoch+1))))
+	defaultBal := params.BeaconConfig().MaxEffectiveBalance
+	require.NoError(t, headState.SetBalances([]uint64{defaultBal, defaultBal}))
+	validators := make([]*ethpb.Validator, 2)
+	for i := range validators {
+		publicKey := bytesutil.ToBytes48([]byte{byte(i + 1)})
+		validators[i] = &ethpb.Validator{
+			PublicKey:        publicKey[:],
+			EffectiveBalance: defaultBal,
+			ExitEpoch:        params.BeaconConfig().FarFutureEpoch,
+			ActivationHash:   (params.BeaconConfig().ZeroHash)[:],
+			ExitHash:         (params.BeaconConfig().ZeroHash)[:],
+			WithdrawalOps:    []*ethpb.WithdrawalOp{},
+		}
+	}
+	require.NoError(t, headState.SetValidators(validators))
+
+	inclusionCache := cache.NewPrevoteInclusionCache()
+	prevEpochSlot := params.BeaconConfig().SlotsPerEpoch.Mul(uint64(epoch))
+	inclusionCache.Put(prevEpochSlot, map[types.ValidatorIndex]bool{0: true, 1: false})
+	inclusionCache.Put(prevEpochSlot+1, map[types.ValidatorIndex]bool{0: true})
+	// The prevotes of the current epoch are not reported.
+	inclusionCache.Put(headState.Slot(), map[types.ValidatorIndex]bool{1: true})
+
+	offset := int64(headState.Slot().Mul(params.BeaconConfig().SecondsPerSlot))
+	bs := &Server{
+		HeadFetcher: &mock.ChainService{
+			State: headState,
+		},
+		SyncChecker:           &mockSync.Sync{IsSyncing: false},
+		GenesisTimeFetcher:    &mock.ChainService{Genesis: time.Now().Add(time.Duration(-1*offset) * time.Second)},
+		PrevoteInclusionCache: inclusionCache,
+	}
+	res, err := bs.GetValidatorPerformance(ctx, &ethpb.ValidatorPerformanceRequest{
+		Indices: []types.ValidatorIndex{1, 0},
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{2, 1}, res.PrevotesSeen)
+	assert.DeepEqual(t, []uint64{2, 0}, res.PrevotesIncluded)
+}
+
 func TestGetValidatorPerformance_IndicesPubkeys(t *testing.T) {
 	ctx := context.Background()
 	epoch := types.Epoch(1)
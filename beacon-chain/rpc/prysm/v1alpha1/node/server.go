@@ -233,9 +233,13 @@ func (ns *Server) GetETH1ConnectionStatus(ctx context.Context, _ *empty.Empty) (
 			errStrs = append(errStrs, err.Error())
 		}
 	}
+	var currErrStr string
+	if currErr := ns.POWChainInfoFetcher.CurrentETH1ConnectionError(); currErr != nil {
+		currErrStr = currErr.Error()
+	}
 	return &ethpb.ETH1ConnectionStatus{
 		CurrentAddress:         ns.POWChainInfoFetcher.CurrentETH1Endpoint(),
-		CurrentConnectionError: ns.POWChainInfoFetcher.CurrentETH1ConnectionError().Error(),
+		CurrentConnectionError: currErrStr,
 		Addresses:              ns.POWChainInfoFetcher.ETH1Endpoints(),
 		ConnectionErrors:       errStrs,
 	}, nil
@@ -0,0 +1,55 @@
+package slasher
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	slashertypes "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/slasher/types"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// IsSlashablePrevote returns prevote slashings if an input
+// indexed prevote is found to be slashable.
+func (s *Server) IsSlashablePrevote(
+	ctx context.Context, req *ethpb.IndexedPreVote,
+) (*ethpb.PrevoteSlashingResponse, error) {
+	doubleVotes, err := s.SlashingChecker.IsSlashablePrevote(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not determine if prevote is slashable: %v", err)
+	}
+	return &ethpb.PrevoteSlashingResponse{
+		PrevoteSlashings: prevoteSlashingsFromDoubleVotes(doubleVotes),
+	}, nil
+}
+
+// PrevoteSlashings returns the evidence of double prevotes detected by slasher.
+func (s *Server) PrevoteSlashings(
+	ctx context.Context, req *ethpb.PrevoteSlashingRequest,
+) (*ethpb.PrevoteSlashingResponse, error) {
+	valIndices := make([]types.ValidatorIndex, len(req.ValidatorIndices))
+	for i, valIdx := range req.ValidatorIndices {
+		valIndices[i] = types.ValidatorIndex(valIdx)
+	}
+	doubleVotes, err := s.SlashingChecker.PrevoteDoubleVotes(ctx, valIndices)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get prevote slashings: %v", err)
+	}
+	return &ethpb.PrevoteSlashingResponse{
+		PrevoteSlashings: prevoteSlashingsFromDoubleVotes(doubleVotes),
+	}, nil
+}
+
+func prevoteSlashingsFromDoubleVotes(doubleVotes []*slashertypes.PrevoteDoubleVote) []*ethpb.PrevoteSlashing {
+	slashings := make([]*ethpb.PrevoteSlashing, 0, len(doubleVotes))
+	for _, doubleVote := range doubleVotes {
+		slashings = append(slashings, &ethpb.PrevoteSlashing{
+			Slot:           doubleVote.Slot,
+			ValidatorIndex: doubleVote.ValidatorIndex,
+			Prevote_1:      doubleVote.PrevPrevoteWrapper.IndexedPreVote,
+			Prevote_2:      doubleVote.PrevoteWrapper.IndexedPreVote,
+		})
+	}
+	return slashings
+}
@@ -0,0 +1,61 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/slasher/mock"
+	slashertypes "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/slasher/types"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+)
+
+func TestServer_IsSlashablePrevote(t *testing.T) {
+	ctx := context.Background()
+	prevote := &ethpb.IndexedPreVote{
+		AttestingIndices: []uint64{3},
+		Data:             &ethpb.PreVoteData{Slot: 2},
+	}
+
+	s := Server{SlashingChecker: &mock.MockSlashingChecker{}}
+	resp, err := s.IsSlashablePrevote(ctx, prevote)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(resp.PrevoteSlashings))
+
+	s = Server{SlashingChecker: &mock.MockSlashingChecker{PrevoteSlashingFound: true}}
+	resp, err = s.IsSlashablePrevote(ctx, prevote)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.PrevoteSlashings))
+	require.Equal(t, types.Slot(2), resp.PrevoteSlashings[0].Slot)
+	require.Equal(t, types.ValidatorIndex(3), resp.PrevoteSlashings[0].ValidatorIndex)
+	require.DeepEqual(t, prevote, resp.PrevoteSlashings[0].Prevote_2)
+}
+
+func TestServer_PrevoteSlashings(t *testing.T) {
+	ctx := context.Background()
+	doubleVote := &slashertypes.PrevoteDoubleVote{
+		Slot:           5,
+		ValidatorIndex: 1,
+		PrevPrevoteWrapper: &slashertypes.IndexedPrevoteWrapper{
+			IndexedPreVote: &ethpb.IndexedPreVote{Data: &ethpb.PreVoteData{Slot: 5, Candidates: []byte{1}}},
+		},
+		PrevoteWrapper: &slashertypes.IndexedPrevoteWrapper{
+			IndexedPreVote: &ethpb.IndexedPreVote{Data: &ethpb.PreVoteData{Slot: 5, Candidates: []byte{2}}},
+		},
+	}
+	s := Server{SlashingChecker: &mock.MockSlashingChecker{
+		DoublePrevotes: map[types.ValidatorIndex]*slashertypes.PrevoteDoubleVote{1: doubleVote},
+	}}
+
+	resp, err := s.PrevoteSlashings(ctx, &ethpb.PrevoteSlashingRequest{ValidatorIndices: []uint64{1, 2}})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.PrevoteSlashings))
+	require.Equal(t, types.ValidatorIndex(1), resp.PrevoteSlashings[0].ValidatorIndex)
+	require.DeepEqual(t, doubleVote.PrevPrevoteWrapper.IndexedPreVote, resp.PrevoteSlashings[0].Prevote_1)
+	require.DeepEqual(t, doubleVote.PrevoteWrapper.IndexedPreVote, resp.PrevoteSlashings[0].Prevote_2)
+
+	resp, err = s.PrevoteSlashings(ctx, &ethpb.PrevoteSlashingRequest{ValidatorIndices: []uint64{2}})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(resp.PrevoteSlashings))
+}
@@ -21,7 +21,11 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/cache"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/blocks"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/feed"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/feed/operation"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/helpers"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/state"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/crypto/bls"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
@@ -162,11 +166,32 @@ func (vs *Server) ProposePrevote(ctx context.Context, pv *ethpb.PreVote) (*ethpb
 		return nil, status.Error(codes.InvalidArgument, "Incorrect prevote signature")
 	}
 
+	headState, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve head state: %v", err)
+	}
+	if err := validatePrevote(ctx, headState, pv); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid prevote: %v", err)
+	}
+
 	root, err := pv.Data.HashTreeRoot()
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not tree hash prevote: %v", err)
 	}
 
+	if err := vs.PrevotePool.SavePrevote(pv); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not save prevote: %v", err)
+	}
+
+	// Broadcast the prevote on a feed to notify other services in the beacon node
+	// of a received prevote.
+	vs.OperationNotifier.OperationFeed().Send(&feed.Event{
+		Type: operation.PrevoteReceived,
+		Data: &operation.PrevoteReceivedData{
+			Prevote: pv,
+		},
+	})
+
 	// Determine subnet to broadcast prevote to
 	wantedEpoch := slots.ToEpoch(pv.Data.Slot)
 	vals, err := vs.HeadFetcher.HeadValidatorsIndices(ctx, wantedEpoch)
@@ -184,3 +209,44 @@ func (vs *Server) ProposePrevote(ctx context.Context, pv *ethpb.PreVote) (*ethpb
 		PrevoteDataRoot: root[:],
 	}, nil
 }
+
+// validatePrevote checks the submitted prevote the same way the gossip validation does:
+// the committee index, the aggregation bits and the signature against the given state.
+func validatePrevote(ctx context.Context, st state.ReadOnlyBeaconState, pv *ethpb.PreVote) error {
+	if pv.Data == nil {
+		return errors.New("nil prevote data")
+	}
+	if pv.Data.Slot == 0 {
+		return errors.New("prevote of slot 0")
+	}
+	valCount, err := helpers.ActiveValidatorCount(ctx, st, slots.ToEpoch(pv.Data.Slot))
+	if err != nil {
+		return err
+	}
+	if count := helpers.SlotCommitteeCount(valCount); uint64(pv.Data.Index) >= count {
+		return errors.Errorf("committee index %d >= %d", pv.Data.Index, count)
+	}
+	committee, err := helpers.BeaconCommitteeFromState(ctx, st, pv.Data.Slot, pv.Data.Index)
+	if err != nil {
+		return err
+	}
+	if err := helpers.VerifyBitfieldLength(pv.AggregationBits, uint64(len(committee))); err != nil {
+		return err
+	}
+	// Prevote must be unaggregated and the bit index must exist in the range of committee indices.
+	if pv.AggregationBits.Count() != 1 || pv.AggregationBits.BitIndices()[0] >= len(committee) {
+		return errors.New("prevote bitfield is invalid")
+	}
+	set, err := blocks.PrevoteSignatureBatch(ctx, st, []*ethpb.PreVote{pv})
+	if err != nil {
+		return err
+	}
+	verified, err := set.Verify()
+	if err != nil {
+		return err
+	}
+	if !verified {
+		return errors.New("prevote signature is invalid")
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/blockchain/testing"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/helpers"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/signing"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/operations/prevote"
+	mockp2p "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/p2p/testing"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/state"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/crypto/bls"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/assert"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/util"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/time/slots"
+)
+
+func TestProposePrevote_OK(t *testing.T) {
+	ctx := context.Background()
+	bState, keys := util.DeterministicGenesisState(t, 64)
+	pool := prevote.NewPool()
+	vs := &Server{
+		HeadFetcher:       &mock.ChainService{State: bState},
+		P2P:               &mockp2p.MockBroadcaster{},
+		PrevotePool:       pool,
+		OperationNotifier: (&mock.ChainService{}).OperationNotifier(),
+	}
+
+	pv := signedPrevote(t, bState, keys, 1, 0)
+	_, err := vs.ProposePrevote(ctx, pv)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(pool.GetPrevoteBySlot(ctx, 1)))
+}
+
+func TestProposePrevote_Invalid(t *testing.T) {
+	ctx := context.Background()
+	bState, keys := util.DeterministicGenesisState(t, 64)
+
+	tests := []struct {
+		name    string
+		prevote func() *ethpb.PreVote
+		wantErr string
+	}{
+		{
+			name: "committee index out of range",
+			prevote: func() *ethpb.PreVote {
+				pv := signedPrevote(t, bState, keys, 1, 0)
+				pv.Data.Index = 1000
+				return pv
+			},
+			wantErr: "committee index",
+		},
+		{
+			name: "aggregated bits",
+			prevote: func() *ethpb.PreVote {
+				pv := signedPrevote(t, bState, keys, 1, 0)
+				pv.AggregationBits.SetBitAt(1, true)
+				return pv
+			},
+			wantErr: "prevote bitfield is invalid",
+		},
+		{
+			name: "wrong signer",
+			prevote: func() *ethpb.PreVote {
+				pv := signedPrevote(t, bState, keys, 1, 0)
+				pv.AggregationBits = bitfield.NewBitlist(pv.AggregationBits.Len())
+				pv.AggregationBits.SetBitAt(1, true)
+				return pv
+			},
+			wantErr: "prevote signature is invalid",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pool := prevote.NewPool()
+			vs := &Server{
+				HeadFetcher:       &mock.ChainService{State: bState},
+				P2P:               &mockp2p.MockBroadcaster{},
+				PrevotePool:       pool,
+				OperationNotifier: (&mock.ChainService{}).OperationNotifier(),
+			}
+			_, err := vs.ProposePrevote(ctx, tt.prevote())
+			assert.ErrorContains(t, tt.wantErr, err)
+			assert.Equal(t, 0, len(pool.GetPrevoteBySlot(ctx, 1)))
+		})
+	}
+}
+
+func signedPrevote(t *testing.T, bState state.BeaconState, keys []bls.SecretKey, slot types.Slot, index types.CommitteeIndex) *ethpb.PreVote {
+	committee, err := helpers.BeaconCommitteeFromState(context.Background(), bState, slot, index)
+	require.NoError(t, err)
+	bits := bitfield.NewBitlist(uint64(len(committee)))
+	bits.SetBitAt(0, true)
+	pv := &ethpb.PreVote{
+		AggregationBits: bits,
+		Data: &ethpb.PreVoteData{
+			Slot:       slot,
+			Index:      index,
+			Candidates: make([]byte, 32),
+		},
+	}
+	domain, err := signing.Domain(bState.Fork(), slots.ToEpoch(slot), params.BeaconConfig().DomainBeaconAttester, bState.GenesisValidatorsRoot())
+	require.NoError(t, err)
+	root, err := signing.ComputeSigningRoot(pv.Data, domain)
+	require.NoError(t, err)
+	pv.Signature = keys[committee[0]].Sign(root[:]).Marshal()
+	return pv
+}
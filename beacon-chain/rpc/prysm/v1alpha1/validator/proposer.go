@@ -153,6 +153,11 @@ func (vs *Server) proposeGenericBeaconBlock(ctx context.Context, blk block.Signe
 		"blockRoot": hex.EncodeToString(root[:]),
 	}).Debug("Broadcasting block")
 
+	if vs.PrevoteDecisionCache != nil {
+		candidates := common.HashArrayFromBytes(blk.Block().Body().Eth1Data().GetCandidates())
+		vs.PrevoteDecisionCache.SetBlockRoot(blk.Block().Slot(), candidates, root)
+	}
+
 	if err := vs.BlockReceiver.ReceiveBlock(ctx, blk, root); err != nil {
 		return nil, fmt.Errorf("could not process beacon block: %v", err)
 	}
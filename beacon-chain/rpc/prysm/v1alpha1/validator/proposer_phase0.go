@@ -7,6 +7,7 @@ import (
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/sirupsen/logrus"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/cache"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/blocks"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/helpers"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/transition"
@@ -191,15 +192,23 @@ func (vs *Server) buildPhase0BlockData(ctx context.Context, req *ethpb.BlockRequ
 		"1:prevotes": len(prevoteData),
 	}).Infof("Build block data: get prevotes")
 
+	decision := &cache.PrevoteDecision{
+		Slot:          req.Slot,
+		ParentRoot:    parentRoot,
+		OptCandidates: candidates.Copy(),
+		Reason:        cache.ReasonNoPrevotes,
+	}
 	if len(prevoteData) == 0 {
 		log.Warnf("Build block data: no prevote data was retrieved for slot %v", req.Slot)
 	} else {
-		prevoteCandidates := vs.prepareAndProcessPrevoteData(candidates.Copy(), prevoteData, head)
+		prevoteCandidates := vs.prepareAndProcessPrevoteData(candidates.Copy(), prevoteData, head, decision)
 		if len(prevoteCandidates) == 0 {
 			log.Warn("Build block data: prevote data was processed but returned empty candidates array, fallback to candidates" +
 				" retrieved using optimistic spines")
+			decision.Reason = cache.ReasonNoVotedChain
 		} else {
 			candidates = prevoteCandidates
+			decision.Reason = cache.ReasonMostVotedChain
 		}
 	}
 
@@ -226,9 +235,11 @@ func (vs *Server) buildPhase0BlockData(ctx context.Context, req *ethpb.BlockRequ
 			"newLen":      candidatesLen,
 		}).Error("Build block data: reduce candidates to AllSpinesLimit")
 		candidates = candidates[0:candidatesLen]
+		decision.SpinesLimitCut = dif
 	}
 
 	eth1Data.Candidates = candidates.ToBytes()
+	decision.Candidates = candidates
 	log.WithFields(logrus.Fields{
 		"1.req.Slot":   req.Slot,
 		"2.candidates": candidates,
@@ -300,6 +311,10 @@ func (vs *Server) buildPhase0BlockData(ctx context.Context, req *ethpb.BlockRequ
 		"req.Slot":          req.Slot,
 	}).Info("Build block data: eth1Data")
 
+	if vs.PrevoteDecisionCache != nil {
+		vs.PrevoteDecisionCache.Put(decision)
+	}
+
 	return &blockData{
 		ParentRoot:        parentRoot[:],
 		Graffiti:          graffiti,
@@ -314,7 +329,12 @@ func (vs *Server) buildPhase0BlockData(ctx context.Context, req *ethpb.BlockRequ
 	}, nil
 }
 
-func (vs *Server) prepareAndProcessPrevoteData(optCandidates gwatCommon.HashArray, prevoteData []*ethpb.PreVote, head state.BeaconState) gwatCommon.HashArray {
+func (vs *Server) prepareAndProcessPrevoteData(
+	optCandidates gwatCommon.HashArray,
+	prevoteData []*ethpb.PreVote,
+	head state.BeaconState,
+	decision *cache.PrevoteDecision,
+) gwatCommon.HashArray {
 	// Make every prevote candidate hash as a separate hasharray to trim non-relevant spines
 	// using head
 	for i, pv := range prevoteData {
@@ -330,7 +350,7 @@ func (vs *Server) prepareAndProcessPrevoteData(optCandidates gwatCommon.HashArra
 	}
 
 	// Process prevote data and calculate longest chain of spines with most of the votes
-	return vs.processPrevoteData(prevoteData, optCandidates)
+	return vs.processPrevoteData(prevoteData, optCandidates, decision)
 }
 
 func CountUniqSpinesWithCandidates(beaconState state.BeaconState, candidates gwatCommon.HashArray) int {
@@ -16,24 +16,33 @@ package validator
 
 import (
 	"bytes"
+	"sort"
+
 	"github.com/sirupsen/logrus"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/cache"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/helpers"
 	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
 	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
 )
 
-// processPrevoteData method processes prevote data to define chain which has most votes
-func (vs *Server) processPrevoteData(prevoteData []*ethpb.PreVote, optCandidates gwatCommon.HashArray) gwatCommon.HashArray {
+// processPrevoteData method processes prevote data to define chain which has most votes.
+// If decision is not nil the considered prevotes, the voted chains and the chosen chain are traced to it.
+func (vs *Server) processPrevoteData(prevoteData []*ethpb.PreVote, optCandidates gwatCommon.HashArray, decision *cache.PrevoteDecision) gwatCommon.HashArray {
 	// Divide prevote candidates to subchains of spines
-	chains, votes := vs.getChainsAndVotes(prevoteData, optCandidates)
+	chains, votes := vs.getChainsAndVotes(prevoteData, optCandidates, decision)
 
-	return vs.defineMostVotedChain(chains, votes)
+	chain := vs.defineMostVotedChain(chains, votes)
+	if decision != nil {
+		decision.Chains = votedChains(chains, votes)
+		decision.Chosen = chain
+	}
+	return chain
 }
 
 // getChainsAndVotes receives an array of prevote structs, defines unique subchains of spines and calculates total
 // amount of votes for these subchains and return data in corresponding maps: map[spinesSubchainHash]spinesSubchain and
 // map [spinesSubchainHash]amount of votes
-func (vs *Server) getChainsAndVotes(prevote []*ethpb.PreVote, optCandidates gwatCommon.HashArray) (map[[gwatCommon.HashLength]byte]gwatCommon.HashArray,
+func (vs *Server) getChainsAndVotes(prevote []*ethpb.PreVote, optCandidates gwatCommon.HashArray, decision *cache.PrevoteDecision) (map[[gwatCommon.HashLength]byte]gwatCommon.HashArray,
 	map[[gwatCommon.HashLength]byte]uint64) {
 	hashAndChain := make(map[[gwatCommon.HashLength]byte]gwatCommon.HashArray)
 	hashAndVotes := make(map[[gwatCommon.HashLength]byte]uint64)
@@ -41,24 +50,35 @@ func (vs *Server) getChainsAndVotes(prevote []*ethpb.PreVote, optCandidates gwat
 
 	for _, pv := range prevote {
 		can := gwatCommon.HashArrayFromBytes(pv.Data.Candidates)
+		aggregated := helpers.IsAggregatedPrevote(pv)
+		votes := uint64(1)
+		if aggregated {
+			votes = pv.GetAggregationBits().Count()
+		}
+		accepted := 0
 		for i := 1; i <= len(can); i++ {
 			chain := can[:i]
 			// Check if optimistic candidates contain subchain got from prevote data
 			if !bytes.Contains(opc, chain.ToBytes()) {
 				break
 			}
+			accepted = i
 			if chain.IsUniq() {
 				h := chain.Key()
 				hashAndChain[h] = chain
-				if helpers.IsAggregatedPrevote(pv) {
-					hashAndVotes[h] += pv.GetAggregationBits().Count()
-				} else {
-					hashAndVotes[h]++
-				}
+				hashAndVotes[h] += votes
 			} else {
 				log.Warnf("Prevote spine subchain contains duplicates of hashes for prevote: %v", can)
 			}
 		}
+		if decision != nil {
+			decision.Prevotes = append(decision.Prevotes, &cache.ConsideredPrevote{
+				Candidates: can,
+				Votes:      votes,
+				Aggregated: aggregated,
+				Accepted:   accepted,
+			})
+		}
 	}
 
 	return hashAndChain, hashAndVotes
@@ -90,3 +110,22 @@ func (vs *Server) defineMostVotedChain(chainsMap map[[gwatCommon.HashLength]byte
 	}).Info("defineMostVotedChain: longest prevote candidates chain with the most votes")
 	return chain
 }
+
+// votedChains returns the voted subchains ordered by votes and length descending.
+func votedChains(chainsMap map[[gwatCommon.HashLength]byte]gwatCommon.HashArray,
+	votesMap map[[gwatCommon.HashLength]byte]uint64) []*cache.VotedChain {
+	chains := make([]*cache.VotedChain, 0, len(chainsMap))
+	for k, chain := range chainsMap {
+		chains = append(chains, &cache.VotedChain{Chain: chain, Votes: votesMap[k]})
+	}
+	sort.Slice(chains, func(i, j int) bool {
+		if chains[i].Votes != chains[j].Votes {
+			return chains[i].Votes > chains[j].Votes
+		}
+		if len(chains[i].Chain) != len(chains[j].Chain) {
+			return len(chains[i].Chain) > len(chains[j].Chain)
+		}
+		return bytes.Compare(chains[i].Chain.ToBytes(), chains[j].Chain.ToBytes()) < 0
+	})
+	return chains
+}
@@ -0,0 +1,54 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/cache"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/assert"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
+)
+
+func TestProcessPrevoteData_Decision(t *testing.T) {
+	h1, h2, h3, h4 := gwatCommon.Hash{0x01}, gwatCommon.Hash{0x02}, gwatCommon.Hash{0x03}, gwatCommon.Hash{0x04}
+	optCandidates := gwatCommon.HashArray{h1, h2, h3}
+	prevote := func(bits bitfield.Bitlist, candidates gwatCommon.HashArray) *ethpb.PreVote {
+		return &ethpb.PreVote{
+			AggregationBits: bits,
+			Data:            &ethpb.PreVoteData{Slot: 1, Candidates: candidates.ToBytes()},
+			Signature:       make([]byte, 96),
+		}
+	}
+	prevotes := []*ethpb.PreVote{
+		prevote(bitfield.Bitlist{0b00001111}, gwatCommon.HashArray{h1, h2}),
+		prevote(bitfield.Bitlist{0b00000011}, gwatCommon.HashArray{h1, h2, h3}),
+		prevote(bitfield.Bitlist{0b00000011}, gwatCommon.HashArray{h4}),
+	}
+
+	vs := &Server{}
+	decision := &cache.PrevoteDecision{}
+	chain := vs.processPrevoteData(prevotes, optCandidates, decision)
+	assert.DeepEqual(t, gwatCommon.HashArray{h1, h2}, chain)
+	assert.DeepEqual(t, chain, decision.Chosen)
+
+	require.Equal(t, 3, len(decision.Prevotes))
+	assert.Equal(t, uint64(3), decision.Prevotes[0].Votes)
+	assert.Equal(t, true, decision.Prevotes[0].Aggregated)
+	assert.Equal(t, 2, decision.Prevotes[0].Accepted)
+	assert.Equal(t, uint64(1), decision.Prevotes[1].Votes)
+	assert.Equal(t, 3, decision.Prevotes[1].Accepted)
+	assert.Equal(t, 0, decision.Prevotes[2].Accepted, "prevote candidates cut by optimistic spines")
+
+	require.Equal(t, 3, len(decision.Chains))
+	assert.DeepEqual(t, gwatCommon.HashArray{h1, h2}, decision.Chains[0].Chain)
+	assert.Equal(t, uint64(4), decision.Chains[0].Votes)
+	assert.DeepEqual(t, gwatCommon.HashArray{h1}, decision.Chains[1].Chain)
+	assert.Equal(t, uint64(4), decision.Chains[1].Votes)
+	assert.DeepEqual(t, gwatCommon.HashArray{h1, h2, h3}, decision.Chains[2].Chain)
+	assert.Equal(t, uint64(1), decision.Chains[2].Votes)
+
+	// Tracing is optional.
+	assert.DeepEqual(t, chain, vs.processPrevoteData(prevotes, optCandidates, nil))
+}
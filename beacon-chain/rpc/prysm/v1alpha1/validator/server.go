@@ -48,6 +48,7 @@ type Server struct {
 	AttestationCache       *cache.AttestationCache
 	PrevoteCache           *cache.PrevoteCache
 	ProposerSlotIndexCache *cache.ProposerPayloadIDsCache
+	PrevoteDecisionCache   *cache.PrevoteDecisionCache
 	HeadFetcher            blockchain.HeadFetcher
 	ForkFetcher            blockchain.ForkFetcher
 	FinalizationFetcher    blockchain.FinalizationFetcher
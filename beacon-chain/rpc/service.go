@@ -34,6 +34,7 @@ import (
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/rpc/eth/events"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/rpc/eth/node"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/rpc/eth/validator"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/rpc/eth/waterfall"
 	beaconv1alpha1 "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/rpc/prysm/v1alpha1/beacon"
 	debugv1alpha1 "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/rpc/prysm/v1alpha1/debug"
 	nodev1alpha1 "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/rpc/prysm/v1alpha1/node"
@@ -84,11 +85,14 @@ type Config struct {
 	CanonicalFetcher        blockchain.CanonicalFetcher
 	ForkFetcher             blockchain.ForkFetcher
 	FinalizationFetcher     blockchain.FinalizationFetcher
+	GwatFinalizationFetcher blockchain.GwatFinalizationFetcher
 	AttestationReceiver     blockchain.AttestationReceiver
 	BlockReceiver           blockchain.BlockReceiver
 	POWChainService         powchain.Chain
 	ChainStartFetcher       powchain.ChainStartFetcher
 	POWChainInfoFetcher     powchain.ChainInfoFetcher
+	DagEndpointsFetcher     powchain.DagEndpointsFetcher
+	DagCoordStateFetcher    powchain.DagCoordinatedStateFetcher
 	GenesisTimeFetcher      blockchain.TimeFetcher
 	GenesisFetcher          blockchain.GenesisFetcher
 	EnableDebugRPCEndpoints bool
@@ -114,6 +118,9 @@ type Config struct {
 	MaxMsgSize              int
 	ExecutionEngineCaller   powchain.EngineCaller
 	ProposerIdsCache        *cache.ProposerPayloadIDsCache
+	PrevoteCache            *cache.PrevoteCache
+	PrevoteDecisionCache    *cache.PrevoteDecisionCache
+	PrevoteInclusionCache   *cache.PrevoteInclusionCache
 }
 
 // NewService instantiates a new RPC service instance that will
@@ -187,7 +194,7 @@ func (s *Service) Start() {
 	validatorServer := &validatorv1alpha1.Server{
 		Ctx:                    s.ctx,
 		AttestationCache:       cache.NewAttestationCache(),
-		PrevoteCache:           cache.NewPrevoteCache(),
+		PrevoteCache:           s.cfg.PrevoteCache,
 		AttPool:                s.cfg.AttestationsPool,
 		PrevotePool:            s.cfg.PrevotePool,
 		ExitPool:               s.cfg.ExitPool,
@@ -216,6 +223,7 @@ func (s *Service) Start() {
 		ExecutionEngineCaller:  s.cfg.ExecutionEngineCaller,
 		BeaconDB:               s.cfg.BeaconDB,
 		ProposerSlotIndexCache: s.cfg.ProposerIdsCache,
+		PrevoteDecisionCache:   s.cfg.PrevoteDecisionCache,
 	}
 	validatorServerV1 := &validator.Server{
 		HeadFetcher:      s.cfg.HeadFetcher,
@@ -281,6 +289,7 @@ func (s *Service) Start() {
 		ReceivedAttestationsBuffer:  make(chan *ethpbv1alpha1.Attestation, attestationBufferSize),
 		CollectedAttestationsBuffer: make(chan []*ethpbv1alpha1.Attestation, attestationBufferSize),
 		ReplayerBuilder:             ch,
+		PrevoteInclusionCache:       s.cfg.PrevoteInclusionCache,
 	}
 	beaconChainServerV1 := &beacon.Server{
 		CanonicalHistory:   ch,
@@ -308,6 +317,25 @@ func (s *Service) Start() {
 		V1Alpha1ValidatorServer: validatorServer,
 		SyncChecker:             s.cfg.SyncService,
 	}
+	waterfallServer := &waterfall.Server{
+		BeaconDB:             s.cfg.BeaconDB,
+		FinalizationFetcher:  s.cfg.GwatFinalizationFetcher,
+		CanonicalFetcher:     s.cfg.CanonicalFetcher,
+		DagEndpointsFetcher:  s.cfg.DagEndpointsFetcher,
+		CoordStateFetcher:    s.cfg.DagCoordStateFetcher,
+		PrevotePool:          s.cfg.PrevotePool,
+		PrevoteProposer:      validatorServer,
+		PrevoteDataFetcher:   validatorServer,
+		PrevoteDecisionCache: s.cfg.PrevoteDecisionCache,
+		StateFetcher: &statefetcher.StateProvider{
+			BeaconDB:           s.cfg.BeaconDB,
+			ChainInfoFetcher:   s.cfg.ChainInfoFetcher,
+			GenesisTimeFetcher: s.cfg.GenesisTimeFetcher,
+			StateGenService:    s.cfg.StateGen,
+			ReplayerBuilder:    ch,
+		},
+		StateGenService: s.cfg.StateGen,
+	}
 	ethpbv1alpha1.RegisterNodeServer(s.grpcServer, nodeServer)
 	ethpbservice.RegisterBeaconNodeServer(s.grpcServer, nodeServerV1)
 	ethpbv1alpha1.RegisterHealthServer(s.grpcServer, nodeServer)
@@ -319,6 +347,7 @@ func (s *Service) Start() {
 		BlockNotifier:     s.cfg.BlockNotifier,
 		OperationNotifier: s.cfg.OperationNotifier,
 	})
+	ethpbservice.RegisterWaterfallServer(s.grpcServer, waterfallServer)
 	if s.cfg.EnableDebugRPCEndpoints {
 		log.Info("Enabled debug gRPC endpoints")
 		debugServer := &debugv1alpha1.Server{
@@ -343,6 +372,7 @@ func (s *Service) Start() {
 		}
 		ethpbv1alpha1.RegisterDebugServer(s.grpcServer, debugServer)
 		ethpbservice.RegisterBeaconDebugServer(s.grpcServer, debugServerV1)
+		ethpbservice.RegisterWaterfallDebugServer(s.grpcServer, waterfallServer)
 	}
 	ethpbv1alpha1.RegisterBeaconNodeValidatorServer(s.grpcServer, validatorServer)
 	ethpbservice.RegisterBeaconValidatorServer(s.grpcServer, validatorServerV1)
@@ -0,0 +1,90 @@
+package slasher
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	slashertypes "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/slasher/types"
+	"go.opencensus.io/trace"
+)
+
+// detectPrevoteDoubleVotes takes in indexed prevote wrappers and returns a list of double prevotes,
+// which are validators signing prevotes with different candidates for the same slot.
+func (s *Service) detectPrevoteDoubleVotes(
+	ctx context.Context,
+	prevotes []*slashertypes.IndexedPrevoteWrapper,
+) ([]*slashertypes.PrevoteDoubleVote, error) {
+	ctx, span := trace.StartSpan(ctx, "slasher.detectPrevoteDoubleVotes")
+	defer span.End()
+	// We check if there are any double prevotes in the input list
+	// of prevotes with respect to each other.
+	doubleVotes := make([]*slashertypes.PrevoteDoubleVote, 0)
+	existingPrevotes := make(map[string]*slashertypes.IndexedPrevoteWrapper)
+	for _, prevote := range prevotes {
+		slot := prevote.IndexedPreVote.Data.Slot
+		for _, valIdx := range prevote.IndexedPreVote.AttestingIndices {
+			key := prevoteKey(slot, types.ValidatorIndex(valIdx))
+			existingPrevote, ok := existingPrevotes[key]
+			if !ok {
+				existingPrevotes[key] = prevote
+				continue
+			}
+			if existingPrevote.SigningRoot != prevote.SigningRoot {
+				doubleVotes = append(doubleVotes, &slashertypes.PrevoteDoubleVote{
+					Slot:               slot,
+					ValidatorIndex:     types.ValidatorIndex(valIdx),
+					PrevPrevoteWrapper: existingPrevote,
+					PrevoteWrapper:     prevote,
+				})
+			}
+		}
+	}
+
+	dbDoubleVotes, err := s.serviceCfg.Database.CheckDoublePrevotes(ctx, prevotes)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not check for double prevotes on disk")
+	}
+	// The database keeps the first prevote seen for a validator and slot,
+	// so saving all of them is safe with respect to future detection.
+	if err := s.serviceCfg.Database.SavePrevoteRecords(ctx, prevotes); err != nil {
+		return nil, errors.Wrap(err, "could not save prevote records")
+	}
+	// A validator's double prevote for a slot, found either on disk
+	// or within the batch, is reported once.
+	result := make([]*slashertypes.PrevoteDoubleVote, 0, len(dbDoubleVotes)+len(doubleVotes))
+	reported := make(map[string]bool, len(dbDoubleVotes)+len(doubleVotes))
+	for _, doubleVote := range append(dbDoubleVotes, doubleVotes...) {
+		key := prevoteKey(doubleVote.Slot, doubleVote.ValidatorIndex)
+		if reported[key] {
+			continue
+		}
+		reported[key] = true
+		result = append(result, doubleVote)
+	}
+	return result, nil
+}
+
+// processPrevoteDoubleVotes stores the evidence of the detected
+// double prevotes in the database and logs them.
+func (s *Service) processPrevoteDoubleVotes(
+	ctx context.Context, doubleVotes []*slashertypes.PrevoteDoubleVote,
+) error {
+	ctx, span := trace.StartSpan(ctx, "slasher.processPrevoteDoubleVotes")
+	defer span.End()
+	if len(doubleVotes) == 0 {
+		return nil
+	}
+	if err := s.serviceCfg.Database.SavePrevoteDoubleVotes(ctx, doubleVotes); err != nil {
+		return errors.Wrap(err, "could not save double prevotes")
+	}
+	for _, doubleVote := range doubleVotes {
+		doublePrevotesTotal.Inc()
+		logPrevoteDoubleVote(doubleVote)
+	}
+	return nil
+}
+
+func prevoteKey(slot types.Slot, validatorIdx types.ValidatorIndex) string {
+	return uintToString(uint64(slot)) + ":" + uintToString(uint64(validatorIdx))
+}
@@ -0,0 +1,154 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	mock "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/blockchain/testing"
+	dbtest "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/db/testing"
+	slashertypes "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/slasher/types"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+)
+
+func Test_processQueuedPrevotes_DetectsDoublePrevotes(t *testing.T) {
+	hook := logTest.NewGlobal()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	s := &Service{
+		serviceCfg: &ServiceConfig{
+			Database:         slasherDB,
+			StateNotifier:    &mock.MockStateNotifier{},
+			HeadStateFetcher: &mock.ChainService{},
+		},
+		params:        DefaultParams(),
+		prevotesQueue: newPrevotesQueue(),
+	}
+	currentSlotChan := make(chan types.Slot)
+	exitChan := make(chan struct{})
+	go func() {
+		s.processQueuedPrevotes(ctx, currentSlotChan)
+		exitChan <- struct{}{}
+	}()
+
+	s.prevotesQueue.push(createPrevoteWrapper(t, 4, []uint64{1, 2}, []byte{1}))
+	s.prevotesQueue.push(createPrevoteWrapper(t, 4, []uint64{1, 2}, []byte{1}))
+	s.prevotesQueue.push(createPrevoteWrapper(t, 4, []uint64{2}, []byte{2}))
+
+	currentSlotChan <- types.Slot(4)
+	cancel()
+	<-exitChan
+	require.LogsContain(t, hook, "Double prevote detected")
+
+	doubleVotes, err := slasherDB.PrevoteDoubleVotes(context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(doubleVotes))
+	require.Equal(t, types.ValidatorIndex(2), doubleVotes[0].ValidatorIndex)
+	require.Equal(t, types.Slot(4), doubleVotes[0].Slot)
+}
+
+func Test_processQueuedPrevotes_NotSlashable(t *testing.T) {
+	hook := logTest.NewGlobal()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	s := &Service{
+		serviceCfg: &ServiceConfig{
+			Database:         slasherDB,
+			StateNotifier:    &mock.MockStateNotifier{},
+			HeadStateFetcher: &mock.ChainService{},
+		},
+		params:        DefaultParams(),
+		prevotesQueue: newPrevotesQueue(),
+	}
+	currentSlotChan := make(chan types.Slot)
+	exitChan := make(chan struct{})
+	go func() {
+		s.processQueuedPrevotes(ctx, currentSlotChan)
+		exitChan <- struct{}{}
+	}()
+
+	s.prevotesQueue.push(createPrevoteWrapper(t, 4, []uint64{1}, []byte{1}))
+	s.prevotesQueue.push(createPrevoteWrapper(t, 5, []uint64{1}, []byte{2}))
+	s.prevotesQueue.push(createPrevoteWrapper(t, 4, []uint64{2}, []byte{2}))
+
+	currentSlotChan <- types.Slot(5)
+	cancel()
+	<-exitChan
+	require.LogsDoNotContain(t, hook, "Double prevote detected")
+}
+
+func Test_detectPrevoteDoubleVotes_AcrossBatches(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	s := &Service{
+		serviceCfg: &ServiceConfig{
+			Database: slasherDB,
+		},
+		params: DefaultParams(),
+	}
+
+	doubleVotes, err := s.detectPrevoteDoubleVotes(ctx, []*slashertypes.IndexedPrevoteWrapper{
+		createPrevoteWrapper(t, 3, []uint64{1, 2, 3}, []byte{1}),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(doubleVotes))
+
+	// The same validator is reported once even if it conflicts
+	// both with the stored prevote and within the batch.
+	doubleVotes, err = s.detectPrevoteDoubleVotes(ctx, []*slashertypes.IndexedPrevoteWrapper{
+		createPrevoteWrapper(t, 3, []uint64{2}, []byte{2}),
+		createPrevoteWrapper(t, 3, []uint64{2}, []byte{3}),
+		createPrevoteWrapper(t, 3, []uint64{3}, []byte{1}),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(doubleVotes))
+	require.Equal(t, types.ValidatorIndex(2), doubleVotes[0].ValidatorIndex)
+
+	// The first prevote seen for a slot is kept as the reference one.
+	record, err := slasherDB.PrevoteRecordForValidator(ctx, 2, 3)
+	require.NoError(t, err)
+	wanted := createPrevoteWrapper(t, 3, []uint64{1, 2, 3}, []byte{1})
+	require.Equal(t, wanted.SigningRoot, record.SigningRoot)
+}
+
+func Test_validatePrevoteIntegrity(t *testing.T) {
+	valid := createPrevoteWrapper(t, 1, []uint64{1}, []byte{1}).IndexedPreVote
+	require.Equal(t, true, validatePrevoteIntegrity(valid))
+	require.Equal(t, false, validatePrevoteIntegrity(nil))
+	require.Equal(t, false, validatePrevoteIntegrity(&ethpb.IndexedPreVote{
+		AttestingIndices: []uint64{1},
+		Signature:        params.BeaconConfig().EmptySignature[:],
+	}))
+	require.Equal(t, false, validatePrevoteIntegrity(&ethpb.IndexedPreVote{
+		Data:      &ethpb.PreVoteData{},
+		Signature: params.BeaconConfig().EmptySignature[:],
+	}))
+	require.Equal(t, false, validatePrevoteIntegrity(&ethpb.IndexedPreVote{
+		AttestingIndices: []uint64{1},
+		Data:             &ethpb.PreVoteData{},
+		Signature:        []byte{1},
+	}))
+}
+
+func createPrevoteWrapper(t testing.TB, slot types.Slot, indices []uint64, candidates []byte) *slashertypes.IndexedPrevoteWrapper {
+	data := &ethpb.PreVoteData{
+		Slot:       slot,
+		Candidates: bytesutil.PadTo(candidates, 32),
+	}
+	signingRoot, err := data.HashTreeRoot()
+	require.NoError(t, err)
+	return &slashertypes.IndexedPrevoteWrapper{
+		IndexedPreVote: &ethpb.IndexedPreVote{
+			AttestingIndices: indices,
+			Data:             data,
+			Signature:        params.BeaconConfig().EmptySignature[:],
+		},
+		SigningRoot: signingRoot,
+	}
+}
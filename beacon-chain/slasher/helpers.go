@@ -2,6 +2,7 @@ package slasher
 
 import (
 	"bytes"
+	"fmt"
 	"strconv"
 
 	types "github.com/prysmaticlabs/eth2-types"
@@ -119,6 +120,19 @@ func validateBlockHeaderIntegrity(header *ethpb.SignedBeaconBlockHeader) bool {
 	return true
 }
 
+// Validates the indexed prevote integrity, ensuring we have no nil values
+// and the prevote is signed by at least one validator.
+func validatePrevoteIntegrity(prevote *ethpb.IndexedPreVote) bool {
+	// If a prevote is malformed, we drop it.
+	if prevote == nil ||
+		prevote.Data == nil ||
+		len(prevote.AttestingIndices) == 0 ||
+		len(prevote.Signature) != fieldparams.BLSSignatureLength {
+		return false
+	}
+	return true
+}
+
 func logAttesterSlashing(slashing *ethpb.AttesterSlashing) {
 	indices := slice.IntersectionUint64(slashing.Attestation_1.AttestingIndices, slashing.Attestation_2.AttestingIndices)
 	log.WithFields(logrus.Fields{
@@ -137,6 +151,17 @@ func logProposerSlashing(slashing *ethpb.ProposerSlashing) {
 	}).Info("Proposer slashing detected")
 }
 
+func logPrevoteDoubleVote(doubleVote *slashertypes.PrevoteDoubleVote) {
+	log.WithFields(logrus.Fields{
+		"validatorIndex":     doubleVote.ValidatorIndex,
+		"slot":               doubleVote.Slot,
+		"prevSigningRoot":    fmt.Sprintf("%#x", doubleVote.PrevPrevoteWrapper.SigningRoot),
+		"signingRoot":        fmt.Sprintf("%#x", doubleVote.PrevoteWrapper.SigningRoot),
+		"prevCandidatesSize": len(doubleVote.PrevPrevoteWrapper.IndexedPreVote.Data.Candidates),
+		"candidatesSize":     len(doubleVote.PrevoteWrapper.IndexedPreVote.Data.Candidates),
+	}).Info("Double prevote detected")
+}
+
 // Turns a uint64 value to a string representation.
 func uintToString(val uint64) string {
 	return strconv.FormatUint(val, 10)
@@ -41,6 +41,18 @@ var (
 		Name: "slasher_double_proposals_total",
 		Help: "Total slashable proposals successfully detected by slasher",
 	})
+	receivedPrevotesTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_prevotes_received_total",
+		Help: "Total number of prevotes received by slasher",
+	})
+	processedPrevotesTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_prevotes_processed_total",
+		Help: "Total number of prevotes successfully processed by slasher",
+	})
+	doublePrevotesTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_double_prevotes_total",
+		Help: "Total slashable double prevotes successfully detected by slasher",
+	})
 	doubleVotesTotal = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "slasher_double_votes_total",
 		Help: "Total slashable double votes successfully detected by slasher",
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	types "github.com/prysmaticlabs/eth2-types"
+	slashertypes "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/slasher/types"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
 	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
 )
@@ -11,7 +12,9 @@ import (
 type MockSlashingChecker struct {
 	AttesterSlashingFound bool
 	ProposerSlashingFound bool
+	PrevoteSlashingFound  bool
 	HighestAtts           map[types.ValidatorIndex]*ethpb.HighestAttestation
+	DoublePrevotes        map[types.ValidatorIndex]*slashertypes.PrevoteDoubleVote
 }
 
 func (s *MockSlashingChecker) HighestAttestations(
@@ -71,3 +74,37 @@ func (s *MockSlashingChecker) IsSlashableAttestation(_ context.Context, _ *ethpb
 	}
 	return nil, nil
 }
+
+func (s *MockSlashingChecker) IsSlashablePrevote(_ context.Context, prevote *ethpb.IndexedPreVote) ([]*slashertypes.PrevoteDoubleVote, error) {
+	if s.PrevoteSlashingFound {
+		return []*slashertypes.PrevoteDoubleVote{
+			{
+				Slot:           prevote.Data.Slot,
+				ValidatorIndex: types.ValidatorIndex(prevote.AttestingIndices[0]),
+				PrevPrevoteWrapper: &slashertypes.IndexedPrevoteWrapper{
+					IndexedPreVote: &ethpb.IndexedPreVote{
+						Data: &ethpb.PreVoteData{},
+					},
+				},
+				PrevoteWrapper: &slashertypes.IndexedPrevoteWrapper{
+					IndexedPreVote: prevote,
+				},
+			},
+		}, nil
+	}
+	return nil, nil
+}
+
+func (s *MockSlashingChecker) PrevoteDoubleVotes(
+	_ context.Context, indices []types.ValidatorIndex,
+) ([]*slashertypes.PrevoteDoubleVote, error) {
+	doubleVotes := make([]*slashertypes.PrevoteDoubleVote, 0, len(indices))
+	for _, valIdx := range indices {
+		doubleVote, ok := s.DoublePrevotes[valIdx]
+		if !ok {
+			continue
+		}
+		doubleVotes = append(doubleVotes, doubleVote)
+	}
+	return doubleVotes, nil
+}
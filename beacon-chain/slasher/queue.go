@@ -18,6 +18,12 @@ type blocksQueue struct {
 	items []*slashertypes.SignedBlockHeaderWrapper
 }
 
+// Struct for handling a thread-safe list of indexed prevote wrappers.
+type prevotesQueue struct {
+	lock  sync.RWMutex
+	items []*slashertypes.IndexedPrevoteWrapper
+}
+
 func newAttestationsQueue() *attestationsQueue {
 	return &attestationsQueue{
 		items: make([]*slashertypes.IndexedAttestationWrapper, 0),
@@ -30,6 +36,12 @@ func newBlocksQueue() *blocksQueue {
 	}
 }
 
+func newPrevotesQueue() *prevotesQueue {
+	return &prevotesQueue{
+		items: make([]*slashertypes.IndexedPrevoteWrapper, 0),
+	}
+}
+
 func (q *attestationsQueue) push(att *slashertypes.IndexedAttestationWrapper) {
 	q.Lock()
 	defer q.Unlock()
@@ -81,3 +93,23 @@ func (q *blocksQueue) extend(blks []*slashertypes.SignedBlockHeaderWrapper) {
 	defer q.lock.Unlock()
 	q.items = append(q.items, blks...)
 }
+
+func (q *prevotesQueue) push(prevote *slashertypes.IndexedPrevoteWrapper) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.items = append(q.items, prevote)
+}
+
+func (q *prevotesQueue) dequeue() []*slashertypes.IndexedPrevoteWrapper {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	items := q.items
+	q.items = make([]*slashertypes.IndexedPrevoteWrapper, 0)
+	return items
+}
+
+func (q *prevotesQueue) size() int {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+	return len(q.items)
+}
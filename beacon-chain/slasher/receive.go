@@ -72,6 +72,36 @@ func (s *Service) receiveBlocks(ctx context.Context, beaconBlockHeadersChan chan
 	}
 }
 
+// Receive indexed prevotes from some source event feed,
+// validating their integrity before appending them to a prevote queue
+// for batch processing in a separate routine.
+func (s *Service) receivePrevotes(ctx context.Context, indexedPrevotesChan chan *ethpb.IndexedPreVote) {
+	sub := s.serviceCfg.IndexedPrevotesFeed.Subscribe(indexedPrevotesChan)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case prevote := <-indexedPrevotesChan:
+			if !validatePrevoteIntegrity(prevote) {
+				continue
+			}
+			signingRoot, err := prevote.Data.HashTreeRoot()
+			if err != nil {
+				log.WithError(err).Error("Could not get hash tree root of prevote")
+				continue
+			}
+			s.prevotesQueue.push(&slashertypes.IndexedPrevoteWrapper{
+				IndexedPreVote: prevote,
+				SigningRoot:    signingRoot,
+			})
+		case err := <-sub.Err():
+			log.WithError(err).Debug("Subscriber closed with error")
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
 // Process queued attestations every time a slot ticker fires. We retrieve
 // these attestations from a queue, then group them all by validator chunk index.
 // This grouping will allow us to perform detection on batches of attestations
@@ -170,6 +200,43 @@ func (s *Service) processQueuedBlocks(ctx context.Context, slotTicker <-chan typ
 	}
 }
 
+// Process queued prevotes every time a slot ticker fires. We retrieve
+// these prevotes from a queue, then perform double prevote detection.
+func (s *Service) processQueuedPrevotes(ctx context.Context, slotTicker <-chan types.Slot) {
+	for {
+		select {
+		case currentSlot := <-slotTicker:
+			prevotes := s.prevotesQueue.dequeue()
+
+			receivedPrevotesTotal.Add(float64(len(prevotes)))
+
+			log.WithFields(logrus.Fields{
+				"currentSlot": currentSlot,
+				"numPrevotes": len(prevotes),
+			}).Debug("Processing queued prevotes for slashing detection")
+
+			start := time.Now()
+			doubleVotes, err := s.detectPrevoteDoubleVotes(ctx, prevotes)
+			if err != nil {
+				log.WithError(err).Error("Could not detect double prevotes")
+				continue
+			}
+
+			// Store the evidence of detected double prevotes and log them.
+			if err := s.processPrevoteDoubleVotes(ctx, doubleVotes); err != nil {
+				log.WithError(err).Error("Could not process double prevotes")
+				continue
+			}
+
+			log.WithField("elapsed", time.Since(start)).Debug("Done checking slashable prevotes")
+
+			processedPrevotesTotal.Add(float64(len(prevotes)))
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
 // Prunes slasher data on each slot tick to prevent unnecessary build-up of disk space usage.
 func (s *Service) pruneSlasherData(ctx context.Context, slotTicker <-chan types.Slot) {
 	for {
@@ -203,7 +270,7 @@ func (s *Service) pruneSlasherDataWithinSlidingWindow(ctx context.Context, curre
 	log.WithFields(logrus.Fields{
 		"currentEpoch":          currentEpoch,
 		"pruningAllBeforeEpoch": maxPruningEpoch,
-	}).Info("Pruning old attestations and proposals for slasher")
+	}).Info("Pruning old attestations, proposals and prevotes for slasher")
 	numPrunedAtts, err := s.serviceCfg.Database.PruneAttestationsAtEpoch(
 		ctx, maxPruningEpoch,
 	)
@@ -216,6 +283,12 @@ func (s *Service) pruneSlasherDataWithinSlidingWindow(ctx context.Context, curre
 	if err != nil {
 		return errors.Wrap(err, "Could not prune proposals")
 	}
+	numPrunedPrevotes, err := s.serviceCfg.Database.PrunePrevotesAtEpoch(
+		ctx, maxPruningEpoch,
+	)
+	if err != nil {
+		return errors.Wrap(err, "Could not prune prevotes")
+	}
 	fields := logrus.Fields{}
 	if numPrunedAtts > 0 {
 		fields["numPrunedAtts"] = numPrunedAtts
@@ -223,7 +296,10 @@ func (s *Service) pruneSlasherDataWithinSlidingWindow(ctx context.Context, curre
 	if numPrunedProposals > 0 {
 		fields["numPrunedProposals"] = numPrunedProposals
 	}
+	if numPrunedPrevotes > 0 {
+		fields["numPrunedPrevotes"] = numPrunedPrevotes
+	}
 	fields["elapsed"] = time.Since(start)
-	log.WithFields(fields).Info("Done pruning old attestations and proposals for slasher")
+	log.WithFields(fields).Info("Done pruning old attestations, proposals and prevotes for slasher")
 	return nil
 }
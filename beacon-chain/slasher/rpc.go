@@ -77,3 +77,45 @@ func (s *Service) IsSlashableAttestation(
 	}
 	return attesterSlashings, nil
 }
+
+// IsSlashablePrevote checks if an input indexed prevote is slashable
+// with respect to historical prevote data. Non-slashable prevotes are
+// recorded to help with future detection, detected double votes are saved as evidence.
+func (s *Service) IsSlashablePrevote(
+	ctx context.Context, prevote *ethpb.IndexedPreVote,
+) ([]*slashertypes.PrevoteDoubleVote, error) {
+	if !validatePrevoteIntegrity(prevote) {
+		return nil, status.Error(codes.InvalidArgument, "Invalid indexed prevote")
+	}
+	dataRoot, err := prevote.Data.HashTreeRoot()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get prevote data hash tree root: %v", err)
+	}
+	indexedPrevoteWrapper := &slashertypes.IndexedPrevoteWrapper{
+		IndexedPreVote: prevote,
+		SigningRoot:    dataRoot,
+	}
+	doubleVotes, err := s.detectPrevoteDoubleVotes(ctx, []*slashertypes.IndexedPrevoteWrapper{indexedPrevoteWrapper})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not check if prevote is slashable: %v", err)
+	}
+	if len(doubleVotes) == 0 {
+		return nil, nil
+	}
+	if err := s.processPrevoteDoubleVotes(ctx, doubleVotes); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not save double prevotes: %v", err)
+	}
+	return doubleVotes, nil
+}
+
+// PrevoteDoubleVotes returns the double prevotes detected by slasher
+// for an input list of validator indices, or all of them if the list is empty.
+func (s *Service) PrevoteDoubleVotes(
+	ctx context.Context, validatorIndices []types.ValidatorIndex,
+) ([]*slashertypes.PrevoteDoubleVote, error) {
+	doubleVotes, err := s.serviceCfg.Database.PrevoteDoubleVotes(ctx, validatorIndices)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get double prevotes from database")
+	}
+	return doubleVotes, nil
+}
@@ -198,3 +198,65 @@ func TestService_HighestAttestations(t *testing.T) {
 		require.DeepEqual(t, &ethpb.HighestAttestation{ValidatorIndex: 1, HighestSourceEpoch: 0, HighestTargetEpoch: 1}, atts[0])
 	})
 }
+
+func TestIsSlashablePrevote(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	s := &Service{
+		serviceCfg: &ServiceConfig{
+			Database: slasherDB,
+		},
+		params: DefaultParams(),
+	}
+	err := slasherDB.SavePrevoteRecords(ctx, []*slashertypes.IndexedPrevoteWrapper{
+		createPrevoteWrapper(t, 2, []uint64{0, 1}, []byte{1}),
+	})
+	require.NoError(t, err)
+
+	_, err = s.IsSlashablePrevote(ctx, &ethpb.IndexedPreVote{})
+	require.ErrorContains(t, "Invalid indexed prevote", err)
+
+	doubleVotes, err := s.IsSlashablePrevote(ctx, createPrevoteWrapper(t, 2, []uint64{1}, []byte{1}).IndexedPreVote)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(doubleVotes))
+
+	doubleVotes, err = s.IsSlashablePrevote(ctx, createPrevoteWrapper(t, 2, []uint64{1}, []byte{2}).IndexedPreVote)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(doubleVotes))
+	require.Equal(t, types.ValidatorIndex(1), doubleVotes[0].ValidatorIndex)
+	require.Equal(t, types.Slot(2), doubleVotes[0].Slot)
+
+	// The detected double vote is persisted as evidence.
+	saved, err := slasherDB.PrevoteDoubleVotes(ctx, []types.ValidatorIndex{1})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(saved))
+	require.Equal(t, types.Slot(2), saved[0].Slot)
+}
+
+func TestService_PrevoteDoubleVotes(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	s := &Service{
+		serviceCfg: &ServiceConfig{
+			Database: slasherDB,
+		},
+	}
+	err := slasherDB.SavePrevoteDoubleVotes(ctx, []*slashertypes.PrevoteDoubleVote{
+		{
+			Slot:               3,
+			ValidatorIndex:     4,
+			PrevPrevoteWrapper: createPrevoteWrapper(t, 3, []uint64{4}, []byte{1}),
+			PrevoteWrapper:     createPrevoteWrapper(t, 3, []uint64{4}, []byte{2}),
+		},
+	})
+	require.NoError(t, err)
+
+	doubleVotes, err := s.PrevoteDoubleVotes(ctx, []types.ValidatorIndex{4})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(doubleVotes))
+	require.Equal(t, types.ValidatorIndex(4), doubleVotes[0].ValidatorIndex)
+
+	doubleVotes, err = s.PrevoteDoubleVotes(ctx, []types.ValidatorIndex{5})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(doubleVotes))
+}
@@ -15,6 +15,7 @@ import (
 	statefeed "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/feed/state"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/db"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/operations/slashings"
+	slashertypes "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/slasher/types"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/state/stategen"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/sync"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
@@ -32,6 +33,7 @@ const (
 type ServiceConfig struct {
 	IndexedAttestationsFeed *event.Feed
 	BeaconBlockHeadersFeed  *event.Feed
+	IndexedPrevotesFeed     *event.Feed
 	Database                db.SlasherDatabase
 	StateNotifier           statefeed.Notifier
 	AttestationStateFetcher blockchain.AttestationStateFetcher
@@ -45,9 +47,13 @@ type ServiceConfig struct {
 type SlashingChecker interface {
 	IsSlashableBlock(ctx context.Context, proposal *ethpb.SignedBeaconBlockHeader) (*ethpb.ProposerSlashing, error)
 	IsSlashableAttestation(ctx context.Context, attestation *ethpb.IndexedAttestation) ([]*ethpb.AttesterSlashing, error)
+	IsSlashablePrevote(ctx context.Context, prevote *ethpb.IndexedPreVote) ([]*slashertypes.PrevoteDoubleVote, error)
 	HighestAttestations(
 		ctx context.Context, indices []types.ValidatorIndex,
 	) ([]*ethpb.HighestAttestation, error)
+	PrevoteDoubleVotes(
+		ctx context.Context, indices []types.ValidatorIndex,
+	) ([]*slashertypes.PrevoteDoubleVote, error)
 }
 
 // Service defining a slasher implementation as part of
@@ -59,11 +65,13 @@ type Service struct {
 	beaconBlockHeadersChan         chan *ethpb.SignedBeaconBlockHeader
 	attsQueue                      *attestationsQueue
 	blksQueue                      *blocksQueue
+	prevotesQueue                  *prevotesQueue
 	ctx                            context.Context
 	cancel                         context.CancelFunc
 	genesisTime                    time.Time
 	attsSlotTicker                 *slots.SlotTicker
 	blocksSlotTicker               *slots.SlotTicker
+	prevotesSlotTicker             *slots.SlotTicker
 	pruningSlotTicker              *slots.SlotTicker
 	latestEpochWrittenForValidator map[types.ValidatorIndex]types.Epoch
 }
@@ -78,6 +86,7 @@ func New(ctx context.Context, srvCfg *ServiceConfig) (*Service, error) {
 		beaconBlockHeadersChan:         make(chan *ethpb.SignedBeaconBlockHeader, 1),
 		attsQueue:                      newAttestationsQueue(),
 		blksQueue:                      newBlocksQueue(),
+		prevotesQueue:                  newPrevotesQueue(),
 		ctx:                            ctx,
 		cancel:                         cancel,
 		latestEpochWrittenForValidator: make(map[types.ValidatorIndex]types.Epoch),
@@ -125,15 +134,19 @@ func (s *Service) run() {
 
 	indexedAttsChan := make(chan *ethpb.IndexedAttestation, 1)
 	beaconBlockHeadersChan := make(chan *ethpb.SignedBeaconBlockHeader, 1)
+	indexedPrevotesChan := make(chan *ethpb.IndexedPreVote, 1)
 	go s.receiveAttestations(s.ctx, indexedAttsChan)
 	go s.receiveBlocks(s.ctx, beaconBlockHeadersChan)
+	go s.receivePrevotes(s.ctx, indexedPrevotesChan)
 
 	secondsPerSlot := params.BeaconConfig().SecondsPerSlot
 	s.attsSlotTicker = slots.NewSlotTicker(s.genesisTime, secondsPerSlot)
 	s.blocksSlotTicker = slots.NewSlotTicker(s.genesisTime, secondsPerSlot)
+	s.prevotesSlotTicker = slots.NewSlotTicker(s.genesisTime, secondsPerSlot)
 	s.pruningSlotTicker = slots.NewSlotTicker(s.genesisTime, secondsPerSlot)
 	go s.processQueuedAttestations(s.ctx, s.attsSlotTicker.C())
 	go s.processQueuedBlocks(s.ctx, s.blocksSlotTicker.C())
+	go s.processQueuedPrevotes(s.ctx, s.prevotesSlotTicker.C())
 	go s.pruneSlasherData(s.ctx, s.pruningSlotTicker.C())
 }
 
@@ -146,6 +159,9 @@ func (s *Service) Stop() error {
 	if s.blocksSlotTicker != nil {
 		s.blocksSlotTicker.Done()
 	}
+	if s.prevotesSlotTicker != nil {
+		s.prevotesSlotTicker.Done()
+	}
 	if s.pruningSlotTicker != nil {
 		s.pruningSlotTicker.Done()
 	}
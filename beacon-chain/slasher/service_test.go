@@ -45,6 +45,7 @@ func TestService_StartStop_ChainInitialized(t *testing.T) {
 	srv, err := New(context.Background(), &ServiceConfig{
 		IndexedAttestationsFeed: new(event.Feed),
 		BeaconBlockHeadersFeed:  new(event.Feed),
+		IndexedPrevotesFeed:     new(event.Feed),
 		StateNotifier:           &mock.MockStateNotifier{},
 		Database:                slasherDB,
 		HeadStateFetcher:        mockChain,
@@ -60,6 +61,7 @@ func TestService_StartStop_ChainInitialized(t *testing.T) {
 	time.Sleep(time.Millisecond * 100)
 	srv.attsSlotTicker = &slots.SlotTicker{}
 	srv.blocksSlotTicker = &slots.SlotTicker{}
+	srv.prevotesSlotTicker = &slots.SlotTicker{}
 	srv.pruningSlotTicker = &slots.SlotTicker{}
 	require.NoError(t, srv.Stop())
 	require.NoError(t, srv.Status())
@@ -45,6 +45,22 @@ type SignedBlockHeaderWrapper struct {
 	SigningRoot             [32]byte
 }
 
+// IndexedPrevoteWrapper contains an indexed prevote with its
+// signing root to reduce duplicated computation.
+type IndexedPrevoteWrapper struct {
+	IndexedPreVote *ethpb.IndexedPreVote
+	SigningRoot    [32]byte
+}
+
+// PrevoteDoubleVote represents a validator signing two prevotes
+// with different candidates for the same slot.
+type PrevoteDoubleVote struct {
+	Slot               types.Slot
+	ValidatorIndex     types.ValidatorIndex
+	PrevPrevoteWrapper *IndexedPrevoteWrapper
+	PrevoteWrapper     *IndexedPrevoteWrapper
+}
+
 // AttestedEpochForValidator encapsulates a previously attested epoch
 // for a validator index.
 type AttestedEpochForValidator struct {
@@ -6,22 +6,25 @@ import (
 	"github.com/pkg/errors"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/api/client/beacon"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/db"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/powchain"
 )
 
 // APIInitializer manages initializing the beacon node using checkpoint sync, retrieving the checkpoint state and root
 // from the remote beacon node api.
 type APIInitializer struct {
-	c *beacon.Client
+	c   *beacon.Client
+	dag powchain.DagClient
 }
 
 // NewAPIInitializer creates an APIInitializer, handling the set up of a beacon node api client
-// using the provided host string.
-func NewAPIInitializer(beaconNodeHost string) (*APIInitializer, error) {
+// using the provided host string. The gwat coordination data of the checkpoint is checked
+// against the gwat node of the given dag client, if any.
+func NewAPIInitializer(beaconNodeHost string, dag powchain.DagClient) (*APIInitializer, error) {
 	c, err := beacon.NewClient(beaconNodeHost)
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to parse beacon node url or hostname - %s", beaconNodeHost)
 	}
-	return &APIInitializer{c: c}, nil
+	return &APIInitializer{c: c, dag: dag}, nil
 }
 
 // Initialize downloads origin state and block for checkpoint sync and initializes database records to
@@ -31,5 +34,5 @@ func (dl *APIInitializer) Initialize(ctx context.Context, d db.Database) error {
 	if err != nil {
 		return errors.Wrap(err, "Error retrieving checkpoint origin state and block")
 	}
-	return d.SaveOrigin(ctx, od.StateBytes(), od.BlockBytes())
+	return saveOrigin(ctx, d, dl.dag, od.StateBytes(), od.BlockBytes(), od.CoordinationData())
 }
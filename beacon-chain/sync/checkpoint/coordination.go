@@ -0,0 +1,72 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package checkpoint
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/api/client/beacon"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/db"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/powchain"
+	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
+)
+
+// checkCoordination checks the finalized spines of the coordination data against the local gwat node.
+// The check is skipped if no gwat node is provided.
+func checkCoordination(ctx context.Context, dag powchain.DagClient, cd *beacon.CoordinationData) error {
+	if dag == nil {
+		log.Warn("No gwat node to check the checkpoint coordination data against, skipping")
+		return nil
+	}
+	spines := gwatCommon.HashArrayFromBytes(cd.SpineData.Finalization)
+	if len(spines) == 0 {
+		spines = gwatCommon.HashArray{cd.GwatCheckpoint.Spine}
+	}
+	valid, err := dag.ValidateSpines(ctx, spines)
+	if err != nil {
+		return errors.Wrap(err, "could not validate checkpoint spines by gwat node")
+	}
+	if !valid {
+		return fmt.Errorf("checkpoint spines unknown to gwat node: checkpoint spine=%#x", cd.GwatCheckpoint.Spine)
+	}
+	return nil
+}
+
+// saveOrigin saves the checkpoint state and block and, if provided, the gwat checkpoint of the coordination data,
+// which allows the node to coordinate gwat from the checkpoint.
+func saveOrigin(ctx context.Context, d db.Database, dag powchain.DagClient, serState, serBlock []byte, cd *beacon.CoordinationData) error {
+	if cd == nil {
+		log.Warn("Checkpoint sync without gwat coordination data: gwat coordinated state will be rebuilt from the chain history")
+		return d.SaveOrigin(ctx, serState, serBlock)
+	}
+	if err := checkCoordination(ctx, dag, cd); err != nil {
+		return err
+	}
+	if err := d.SaveOrigin(ctx, serState, serBlock); err != nil {
+		return err
+	}
+	if err := d.SaveOriginGwatCheckpoint(ctx, cd.GwatCheckpoint); err != nil {
+		return errors.Wrap(err, "could not save origin gwat checkpoint")
+	}
+	log.WithFields(logrus.Fields{
+		"epoch": cd.GwatCheckpoint.Epoch,
+		"root":  fmt.Sprintf("%#x", cd.GwatCheckpoint.Root),
+		"spine": fmt.Sprintf("%#x", cd.GwatCheckpoint.Spine),
+	}).Info("Saved origin gwat checkpoint")
+	return nil
+}
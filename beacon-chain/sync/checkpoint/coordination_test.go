@@ -0,0 +1,36 @@
+package checkpoint
+
+import (
+	"context"
+	"testing"
+
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/api/client/beacon"
+	mockPOW "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/powchain/testing"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
+	gwatTypes "gitlab.waterfall.network/waterfall/protocol/gwat/core/types"
+)
+
+func TestCheckCoordination(t *testing.T) {
+	ctx := context.Background()
+	dag := mockPOW.NewDagSimulator(gwatCommon.Hash{0x01})
+	spines := dag.ProduceSlots(1, 2, 3)
+
+	cd := &beacon.CoordinationData{
+		SpineData: &ethpb.SpineData{
+			Finalization: gwatCommon.HashArray{spines[0], spines[1]}.ToBytes(),
+		},
+		GwatCheckpoint: &gwatTypes.Checkpoint{Spine: spines[1]},
+	}
+	require.NoError(t, checkCoordination(ctx, dag, cd))
+	require.NoError(t, checkCoordination(ctx, nil, cd))
+
+	// checkpoint spine is checked if no spines are finalized by the state
+	cd.SpineData.Finalization = []byte{}
+	cd.GwatCheckpoint.Spine = spines[2]
+	require.NoError(t, checkCoordination(ctx, dag, cd))
+
+	cd.GwatCheckpoint.Spine = gwatCommon.Hash{0xff}
+	require.ErrorContains(t, "checkpoint spines unknown to gwat node", checkCoordination(ctx, dag, cd))
+}
@@ -6,7 +6,10 @@ import (
 	"os"
 
 	"github.com/pkg/errors"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/api/client/beacon"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/db"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/powchain"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/ssz/detect"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/io/file"
 )
 
@@ -19,7 +22,9 @@ type Initializer interface {
 
 // NewFileInitializer validates the given path information and creates an Initializer which will
 // use the provided state and block files to prepare the node for checkpoint sync.
-func NewFileInitializer(blockPath string, statePath string) (*FileInitializer, error) {
+// The coordination path is optional, if provided the gwat coordination data file is checked
+// against the state and the gwat node of the given dag client, if any.
+func NewFileInitializer(blockPath string, statePath string, coordinationPath string, dag powchain.DagClient) (*FileInitializer, error) {
 	var err error
 	if err = existsAndIsFile(blockPath); err != nil {
 		return nil, err
@@ -27,15 +32,22 @@ func NewFileInitializer(blockPath string, statePath string) (*FileInitializer, e
 	if err = existsAndIsFile(statePath); err != nil {
 		return nil, err
 	}
+	if coordinationPath != "" {
+		if err = existsAndIsFile(coordinationPath); err != nil {
+			return nil, err
+		}
+	}
 	// stat just to make sure it actually exists and is a file
-	return &FileInitializer{blockPath: blockPath, statePath: statePath}, nil
+	return &FileInitializer{blockPath: blockPath, statePath: statePath, coordinationPath: coordinationPath, dag: dag}, nil
 }
 
 // FileInitializer initializes a beacon-node database to use checkpoint sync,
 // using ssz-encoded block and state data stored in files on the local filesystem.
 type FileInitializer struct {
-	blockPath string
-	statePath string
+	blockPath        string
+	statePath        string
+	coordinationPath string
+	dag              powchain.DagClient
 }
 
 // Initialize is called in the BeaconNode db startup code if an Initializer is present.
@@ -49,7 +61,46 @@ func (fi *FileInitializer) Initialize(ctx context.Context, d db.Database) error
 	if err != nil {
 		return errors.Wrapf(err, "error reading state file %s for checkpoint sync init", fi.blockPath)
 	}
-	return d.SaveOrigin(ctx, serState, serBlock)
+	cd, err := fi.coordinationData(serState, serBlock)
+	if err != nil {
+		return err
+	}
+	return saveOrigin(ctx, d, fi.dag, serState, serBlock, cd)
+}
+
+// coordinationData reads the gwat coordination data file and verifies it against the checkpoint state and block.
+func (fi *FileInitializer) coordinationData(serState, serBlock []byte) (*beacon.CoordinationData, error) {
+	if fi.coordinationPath == "" {
+		return nil, nil
+	}
+	enc, err := file.ReadFileAsBytes(fi.coordinationPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading coordination file %s for checkpoint sync init", fi.coordinationPath)
+	}
+	cd, err := beacon.UnmarshalCoordinationData(enc)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error decoding coordination file %s for checkpoint sync init", fi.coordinationPath)
+	}
+	cf, err := detect.FromState(serState)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not sniff config+fork for origin state bytes")
+	}
+	st, err := cf.UnmarshalBeaconState(serState)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal origin state")
+	}
+	blk, err := cf.UnmarshalBeaconBlock(serBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal origin block")
+	}
+	blockRoot, err := blk.Block().HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute HashTreeRoot of checkpoint block")
+	}
+	if err := cd.Verify(st, blockRoot); err != nil {
+		return nil, errors.Wrapf(err, "coordination file %s does not match the checkpoint", fi.coordinationPath)
+	}
+	return cd, nil
 }
 
 var _ Initializer = &FileInitializer{}
@@ -2,6 +2,7 @@ package sync
 
 import (
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/async/event"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/cache"
 	blockfeed "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/feed/block"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/feed/operation"
 	statefeed "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/feed/state"
@@ -136,3 +137,17 @@ func WithSlasherBlockHeadersFeed(slasherBlockHeadersFeed *event.Feed) Option {
 		return nil
 	}
 }
+
+func WithSlasherPrevotesFeed(slasherPrevotesFeed *event.Feed) Option {
+	return func(s *Service) error {
+		s.cfg.slasherPrevotesFeed = slasherPrevotesFeed
+		return nil
+	}
+}
+
+func WithProposerIdsCache(proposerIdsCache *cache.ProposerPayloadIDsCache) Option {
+	return func(s *Service) error {
+		s.cfg.proposerIdsCache = proposerIdsCache
+		return nil
+	}
+}
@@ -50,6 +50,14 @@ func (s *Service) registerRPCHandlers() {
 			p2p.RPCPingTopicV1,
 			s.pingHandler,
 		)
+		s.registerRPC(
+			p2p.RPCPrevotesBySlotTopicV1,
+			s.prevotesBySlotRPCHandler,
+		)
+		s.registerRPC(
+			p2p.RPCWaterfallForksTopicV1,
+			s.waterfallForksRPCHandler,
+		)
 		s.registerRPCHandlersAltair()
 		return
 	}
@@ -77,6 +85,14 @@ func (s *Service) registerRPCHandlers() {
 		p2p.RPCMetaDataTopicV1,
 		s.metaDataHandler,
 	)
+	s.registerRPC(
+		p2p.RPCPrevotesBySlotTopicV1,
+		s.prevotesBySlotRPCHandler,
+	)
+	s.registerRPC(
+		p2p.RPCWaterfallForksTopicV1,
+		s.waterfallForksRPCHandler,
+	)
 }
 
 // registerRPCHandlers for altair.
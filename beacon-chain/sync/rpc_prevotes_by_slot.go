@@ -0,0 +1,280 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package sync
+
+import (
+	"context"
+	"io"
+
+	libp2pcore "github.com/libp2p/go-libp2p/core"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/sirupsen/logrus"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/helpers"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/p2p"
+	p2ptypes "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/p2p/types"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/state"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	eth "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1/prevote"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/time/slots"
+)
+
+const (
+	// maxRequestPrevoteSlots is the max number of the slots of a prevotes by slot request.
+	maxRequestPrevoteSlots = 4
+	// maxPrevotesBySlotResponse is the max number of the prevotes of a prevotes by slot response.
+	maxPrevotesBySlotResponse = maxRequestPrevoteSlots * 64
+	// maxPrevotesBackfillPeers is the max number of the peers requested for the missed prevotes of a slot.
+	maxPrevotesBackfillPeers = 3
+)
+
+// prevotesBySlotRPCHandler responds with the aggregated prevotes of the requested slots from the prevote pool.
+func (s *Service) prevotesBySlotRPCHandler(ctx context.Context, msg interface{}, stream libp2pcore.Stream) error {
+	ctx, cancel := context.WithTimeout(ctx, ttfbTimeout)
+	defer cancel()
+	SetRPCStreamDeadlines(stream)
+	log := log.WithField("handler", "prevotes_by_slot")
+
+	req, ok := msg.(*p2ptypes.PrevotesBySlotReq)
+	if !ok {
+		return errors.New("message is not type PrevotesBySlotReq")
+	}
+	if err := s.rateLimiter.validateRequest(stream, 1); err != nil {
+		return err
+	}
+	s.rateLimiter.add(stream, 1)
+
+	if req.Count == 0 || req.Count > maxRequestPrevoteSlots {
+		s.cfg.p2p.Peers().Scorers().BadResponsesScorer().Increment(stream.Conn().RemotePeer())
+		s.writeErrorResponseToStream(responseCodeInvalidRequest, p2ptypes.ErrInvalidRequest.Error(), stream)
+		return p2ptypes.ErrInvalidRequest
+	}
+
+	sent := 0
+	for slot := req.StartSlot; slot < req.StartSlot.Add(req.Count); slot++ {
+		prevotes, err := prevote.Aggregate(s.cfg.prevotePool.GetPrevoteBySlot(ctx, slot))
+		if err != nil {
+			log.WithError(err).Debug("Could not aggregate prevotes")
+			s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
+			return err
+		}
+		for _, pv := range prevotes {
+			if sent >= maxPrevotesBySlotResponse {
+				break
+			}
+			if err := s.chunkPrevoteWriter(stream, pv); err != nil {
+				return err
+			}
+			sent++
+		}
+	}
+	closeStream(stream, log)
+	return nil
+}
+
+// chunkPrevoteWriter writes the prevote as a chunked response to the stream.
+// response_chunk  ::= <result> | <encoding-dependent-header> | <encoded-payload>
+func (s *Service) chunkPrevoteWriter(stream libp2pcore.Stream, pv *eth.PreVote) error {
+	SetStreamWriteDeadline(stream, defaultWriteDuration)
+	if _, err := stream.Write([]byte{responseCodeSuccess}); err != nil {
+		return err
+	}
+	_, err := s.cfg.p2p.Encoding().EncodeWithMaxLength(stream, pv)
+	return err
+}
+
+// readChunkedPrevote reads a response chunk of the prevotes by slot request.
+func readChunkedPrevote(stream libp2pcore.Stream, p2p p2p.P2P, isFirstChunk bool) (*eth.PreVote, error) {
+	var (
+		code   uint8
+		errMsg string
+		err    error
+	)
+	// Handle deadlines differently for first chunk
+	if isFirstChunk {
+		code, errMsg, err = ReadStatusCode(stream, p2p.Encoding())
+	} else {
+		SetStreamReadDeadline(stream, respTimeout)
+		code, errMsg, err = readStatusCodeNoDeadline(stream, p2p.Encoding())
+	}
+	if err != nil {
+		return nil, err
+	}
+	if code != 0 {
+		return nil, errors.New(errMsg)
+	}
+	pv := &eth.PreVote{}
+	err = p2p.Encoding().DecodeWithMaxLength(stream, pv)
+	return pv, err
+}
+
+// sendPrevotesBySlotRequest requests the prevotes of the slots from the peer and saves
+// the valid ones missed by the prevote pool. It returns the number of the saved prevotes.
+func (s *Service) sendPrevotesBySlotRequest(
+	ctx context.Context, bState state.ReadOnlyBeaconState, req *p2ptypes.PrevotesBySlotReq, id peer.ID,
+) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, respTimeout)
+	defer cancel()
+
+	topic, err := p2p.TopicFromMessage(p2p.PrevotesBySlotMessageName, slots.ToEpoch(s.cfg.chain.CurrentSlot()))
+	if err != nil {
+		return 0, err
+	}
+	stream, err := s.cfg.p2p.Send(ctx, req, topic, id)
+	if err != nil {
+		return 0, err
+	}
+	defer closeStream(stream, log)
+
+	saved := 0
+	for i := 0; ; i++ {
+		pv, err := readChunkedPrevote(stream, s.cfg.p2p, i == 0)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return saved, err
+		}
+		if i >= maxPrevotesBySlotResponse {
+			return saved, ErrInvalidFetchedData
+		}
+		if err := validatePrevoteBySlot(ctx, bState, req, pv); err != nil {
+			s.cfg.p2p.Peers().Scorers().BadResponsesScorer().Increment(id)
+			return saved, errors.Wrap(ErrInvalidFetchedData, err.Error())
+		}
+		exists, err := s.cfg.prevotePool.HasPrevote(pv)
+		if err != nil {
+			return saved, err
+		}
+		if exists {
+			continue
+		}
+		if err := s.cfg.prevotePool.SavePrevote(pv); err != nil {
+			return saved, err
+		}
+		saved++
+	}
+	return saved, nil
+}
+
+// validatePrevoteBySlot checks the prevote received in response to the prevotes by slot request
+// is of the requested slots and is signed by the committee members of its aggregation bits.
+func validatePrevoteBySlot(ctx context.Context, bState state.ReadOnlyBeaconState, req *p2ptypes.PrevotesBySlotReq, pv *eth.PreVote) error {
+	if pv.Data == nil {
+		return errNilMessage
+	}
+	if pv.Data.Slot < req.StartSlot || pv.Data.Slot >= req.StartSlot.Add(req.Count) {
+		return errors.Errorf("prevote slot %d is out of the requested range", pv.Data.Slot)
+	}
+	valCount, err := helpers.ActiveValidatorCount(ctx, bState, slots.ToEpoch(pv.Data.Slot))
+	if err != nil {
+		return err
+	}
+	if count := helpers.SlotCommitteeCount(valCount); uint64(pv.Data.Index) >= count {
+		return errors.Errorf("committee index %d >= %d", pv.Data.Index, count)
+	}
+	committee, err := helpers.BeaconCommitteeFromState(ctx, bState, pv.Data.Slot, pv.Data.Index)
+	if err != nil {
+		return err
+	}
+	if err := helpers.VerifyBitfieldLength(pv.AggregationBits, uint64(len(committee))); err != nil {
+		return err
+	}
+	if pv.AggregationBits.Count() == 0 {
+		return errors.New("prevote bitfield is empty")
+	}
+	return verifyPrevoteSignature(ctx, bState, pv)
+}
+
+// backfillPrevotesRoutine requests the missed prevotes from peers. The gossiped prevotes are missed
+// while the node is syncing or has no peers, so the prevotes of the recent slots are requested
+// once it is synced and connected again. Otherwise, the prevotes of the next slot are requested
+// only if a validator of the node proposes it and the prevote pool has none by the end of the current slot.
+func (s *Service) backfillPrevotesRoutine() {
+	fiveSixthsASlot := 5 * slots.DivideSlotBy(6) /* 5/6 slot duration */
+	ticker := slots.NewSlotTickerWithOffset(s.cfg.chain.GenesisTime(), fiveSixthsASlot, params.BeaconConfig().SecondsPerSlot)
+	// the routine starts once the node is synced, the prevotes gossiped meanwhile are missed.
+	caughtUp := false
+	for {
+		select {
+		case slot := <-ticker.C():
+			if s.cfg.initialSync.Syncing() || len(s.cfg.p2p.Peers().Connected()) == 0 {
+				caughtUp = false
+				continue
+			}
+			if req, ok := s.prevotesBackfillRequest(s.ctx, slot, caughtUp); ok {
+				s.backfillPrevotes(s.ctx, req)
+			}
+			caughtUp = true
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting goroutine")
+			ticker.Done()
+			return
+		}
+	}
+}
+
+// prevotesBackfillRequest returns the prevotes by slot request to backfill the prevote pool
+// at the end of the slot, if any.
+func (s *Service) prevotesBackfillRequest(ctx context.Context, slot types.Slot, caughtUp bool) (*p2ptypes.PrevotesBySlotReq, bool) {
+	nextSlot := slot + 1
+	if !caughtUp {
+		startSlot := types.Slot(0)
+		if nextSlot >= maxRequestPrevoteSlots {
+			startSlot = nextSlot.Sub(maxRequestPrevoteSlots - 1)
+		}
+		return &p2ptypes.PrevotesBySlotReq{StartSlot: startSlot, Count: uint64(nextSlot-startSlot) + 1}, true
+	}
+	if _, _, ok := s.cfg.proposerIdsCache.GetProposerPayloadIDs(nextSlot); !ok {
+		return nil, false
+	}
+	if len(s.cfg.prevotePool.GetPrevoteBySlot(ctx, nextSlot)) > 0 {
+		return nil, false
+	}
+	return &p2ptypes.PrevotesBySlotReq{StartSlot: nextSlot, Count: 1}, true
+}
+
+// backfillPrevotes requests the prevotes of the slots from a few of the best peers.
+func (s *Service) backfillPrevotes(ctx context.Context, req *p2ptypes.PrevotesBySlotReq) {
+	optimistic, err := s.cfg.chain.IsOptimistic(ctx)
+	if err != nil || optimistic {
+		return
+	}
+	bState, err := s.cfg.chain.HeadState(ctx)
+	if err != nil {
+		log.WithError(err).Error("Prevotes backfill: could not retrieve head state")
+		return
+	}
+	_, bestPeers := s.cfg.p2p.Peers().BestFinalized(maxPeerRequest, s.cfg.chain.FinalizedCheckpt().Epoch)
+	if len(bestPeers) > maxPrevotesBackfillPeers {
+		bestPeers = bestPeers[:maxPrevotesBackfillPeers]
+	}
+	saved := 0
+	for _, pid := range bestPeers {
+		n, err := s.sendPrevotesBySlotRequest(ctx, bState, req, pid)
+		saved += n
+		if err != nil {
+			log.WithError(err).WithField("peer", pid).Debug("Prevotes backfill: could not request prevotes")
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"startSlot": req.StartSlot,
+		"count":     req.Count,
+		"peers":     len(bestPeers),
+		"saved":     saved,
+	}).Debug("Prevotes backfill: done")
+}
@@ -0,0 +1,241 @@
+package sync
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kevinms/leakybucket-go"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/blockchain/testing"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/cache"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/helpers"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/signing"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/operations/prevote"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/p2p"
+	p2ptest "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/p2p/testing"
+	p2pTypes "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/p2p/types"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/state"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/crypto/bls"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/assert"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/util"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/time/slots"
+)
+
+func TestPrevotesBySlotRPCHandler_ReturnsAggregatedPrevotes(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	assert.Equal(t, 1, len(p1.BHost.Network().Peers()), "Expected peers to be connected")
+
+	pool := prevote.NewPool()
+	for i := uint64(0); i < 2; i++ {
+		require.NoError(t, pool.SavePrevote(testPrevote(t, 5, i)))
+	}
+	require.NoError(t, pool.SavePrevote(testPrevote(t, 7, 0)))
+
+	r := &Service{cfg: &config{p2p: p1, prevotePool: pool}, rateLimiter: newRateLimiter(p1)}
+	pcl := protocol.ID(p2p.RPCPrevotesBySlotTopicV1)
+	r.rateLimiter.limiterMap[string(pcl)] = leakybucket.NewCollector(10000, 10000, false)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectSuccess(t, stream)
+		res := &ethpb.PreVote{}
+		assert.NoError(t, r.cfg.p2p.Encoding().DecodeWithMaxLength(stream, res))
+		assert.Equal(t, types.Slot(5), res.Data.Slot)
+		assert.Equal(t, uint64(2), res.AggregationBits.Count())
+		_, _, err := ReadStatusCode(stream, r.cfg.p2p.Encoding())
+		assert.ErrorContains(t, "EOF", err)
+	})
+
+	stream1, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	req := &p2pTypes.PrevotesBySlotReq{StartSlot: 5, Count: 2}
+	assert.NoError(t, r.prevotesBySlotRPCHandler(context.Background(), req, stream1))
+
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestPrevotesBySlotRPCHandler_InvalidCount(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+
+	r := &Service{cfg: &config{p2p: p1, prevotePool: prevote.NewPool()}, rateLimiter: newRateLimiter(p1)}
+	pcl := protocol.ID(p2p.RPCPrevotesBySlotTopicV1)
+	r.rateLimiter.limiterMap[string(pcl)] = leakybucket.NewCollector(10000, 10000, false)
+
+	for _, count := range []uint64{0, maxRequestPrevoteSlots + 1} {
+		var wg sync.WaitGroup
+		wg.Add(1)
+		p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+			defer wg.Done()
+			expectFailure(t, responseCodeInvalidRequest, p2pTypes.ErrInvalidRequest.Error(), stream)
+		})
+
+		stream1, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+		require.NoError(t, err)
+		req := &p2pTypes.PrevotesBySlotReq{StartSlot: 5, Count: count}
+		assert.ErrorContains(t, p2pTypes.ErrInvalidRequest.Error(), r.prevotesBySlotRPCHandler(context.Background(), req, stream1))
+
+		if util.WaitTimeout(&wg, 1*time.Second) {
+			t.Fatal("Did not receive stream within 1 sec")
+		}
+	}
+}
+
+func TestPrevotesBackfillRequest(t *testing.T) {
+	ctx := context.Background()
+	pool := prevote.NewPool()
+	proposerIdsCache := cache.NewProposerPayloadIDsCache()
+	r := &Service{cfg: &config{prevotePool: pool, proposerIdsCache: proposerIdsCache}}
+
+	// the recent slots are requested once the node is caught up.
+	req, ok := r.prevotesBackfillRequest(ctx, 10, false)
+	require.Equal(t, true, ok)
+	assert.DeepEqual(t, &p2pTypes.PrevotesBySlotReq{StartSlot: 8, Count: maxRequestPrevoteSlots}, req)
+	req, ok = r.prevotesBackfillRequest(ctx, 1, false)
+	require.Equal(t, true, ok)
+	assert.DeepEqual(t, &p2pTypes.PrevotesBySlotReq{StartSlot: 0, Count: 3}, req)
+
+	// no proposal of the node validators at the next slot.
+	_, ok = r.prevotesBackfillRequest(ctx, 10, true)
+	assert.Equal(t, false, ok)
+
+	proposerIdsCache.SetProposerAndPayloadIDs(11, 1, [8]byte{})
+	req, ok = r.prevotesBackfillRequest(ctx, 10, true)
+	require.Equal(t, true, ok)
+	assert.DeepEqual(t, &p2pTypes.PrevotesBySlotReq{StartSlot: 11, Count: 1}, req)
+
+	// the pool has the prevotes of the proposal slot.
+	require.NoError(t, pool.SavePrevote(testPrevote(t, 11, 0)))
+	_, ok = r.prevotesBackfillRequest(ctx, 10, true)
+	assert.Equal(t, false, ok)
+}
+
+func TestSendPrevotesBySlotRequest(t *testing.T) {
+	helpers.ClearCache()
+	params.SetupTestConfigCleanup(t)
+	ctx := context.Background()
+	bState, keys := util.DeterministicGenesisState(t, 64)
+	slot := types.Slot(1)
+
+	tests := []struct {
+		name      string
+		prevotes  func() []*ethpb.PreVote
+		wantSaved int
+		wantErr   string
+	}{
+		{
+			name: "valid prevotes saved",
+			prevotes: func() []*ethpb.PreVote {
+				return []*ethpb.PreVote{signedTestPrevote(t, bState, keys, slot, 0)}
+			},
+			wantSaved: 1,
+		},
+		{
+			name: "out of range slot",
+			prevotes: func() []*ethpb.PreVote {
+				return []*ethpb.PreVote{signedTestPrevote(t, bState, keys, slot+1, 0)}
+			},
+			wantErr: ErrInvalidFetchedData.Error(),
+		},
+		{
+			name: "invalid signature",
+			prevotes: func() []*ethpb.PreVote {
+				pv := signedTestPrevote(t, bState, keys, slot, 0)
+				pv.Data.Candidates = []byte{'c'}
+				return []*ethpb.PreVote{pv}
+			},
+			wantErr: ErrInvalidFetchedData.Error(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p1 := p2ptest.NewTestP2P(t)
+			p2 := p2ptest.NewTestP2P(t)
+			pool := prevote.NewPool()
+			r := &Service{
+				cfg: &config{
+					p2p:         p1,
+					prevotePool: pool,
+					chain:       &mock.ChainService{Genesis: time.Now(), ValidatorsRoot: [32]byte{}},
+				},
+				ctx:         ctx,
+				rateLimiter: newRateLimiter(p1),
+			}
+
+			pcl := protocol.ID(p2p.RPCPrevotesBySlotTopicV1 + p2.Encoding().ProtocolSuffix())
+			p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+				out := new(p2pTypes.PrevotesBySlotReq)
+				assert.NoError(t, p2.Encoding().DecodeWithMaxLength(stream, out))
+				for _, pv := range tt.prevotes() {
+					_, err := stream.Write([]byte{responseCodeSuccess})
+					assert.NoError(t, err, "Could not write to stream")
+					_, err = p2.Encoding().EncodeWithMaxLength(stream, pv)
+					assert.NoError(t, err, "Could not send response back")
+				}
+				assert.NoError(t, stream.Close())
+			})
+			p1.Connect(p2)
+
+			req := &p2pTypes.PrevotesBySlotReq{StartSlot: slot, Count: 1}
+			saved, err := r.sendPrevotesBySlotRequest(ctx, bState, req, p2.PeerID())
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, tt.wantErr, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantSaved, saved)
+			assert.Equal(t, tt.wantSaved, len(pool.GetPrevoteBySlot(ctx, slot)))
+		})
+	}
+}
+
+func testPrevote(t *testing.T, slot types.Slot, bit uint64) *ethpb.PreVote {
+	sk, err := bls.RandKey()
+	require.NoError(t, err)
+	bits := bitfield.NewBitlist(4)
+	bits.SetBitAt(bit, true)
+	return &ethpb.PreVote{
+		AggregationBits: bits,
+		Data: &ethpb.PreVoteData{
+			Slot:       slot,
+			Candidates: []byte{'a'},
+		},
+		Signature: sk.Sign([]byte("prevote")).Marshal(),
+	}
+}
+
+func signedTestPrevote(t *testing.T, bState state.BeaconState, keys []bls.SecretKey, slot types.Slot, index types.CommitteeIndex) *ethpb.PreVote {
+	committee, err := helpers.BeaconCommitteeFromState(context.Background(), bState, slot, index)
+	require.NoError(t, err)
+	bits := bitfield.NewBitlist(uint64(len(committee)))
+	bits.SetBitAt(0, true)
+	pv := &ethpb.PreVote{
+		AggregationBits: bits,
+		Data: &ethpb.PreVoteData{
+			Slot:       slot,
+			Index:      index,
+			Candidates: make([]byte, 32),
+		},
+	}
+	domain, err := signing.Domain(bState.Fork(), slots.ToEpoch(slot), params.BeaconConfig().DomainBeaconAttester, bState.GenesisValidatorsRoot())
+	require.NoError(t, err)
+	root, err := signing.ComputeSigningRoot(pv.Data, domain)
+	require.NoError(t, err)
+	pv.Signature = keys[committee[0]].Sign(root[:]).Marshal()
+	return pv
+}
@@ -3,6 +3,7 @@ package sync
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
@@ -182,6 +183,9 @@ func (s *Service) sendRPCStatusRequest(ctx context.Context, id peer.ID) error {
 
 	// If validation fails, validation error is logged, and peer status scorer will mark peer as bad.
 	err = s.validateStatusMessage(ctx, msg)
+	if err == nil {
+		err = s.validateWaterfallForks(ctx, id)
+	}
 	s.cfg.p2p.Peers().Scorers().PeerStatusScorer().SetPeerStatus(id, msg, err)
 
 	if s.cfg.p2p.Peers().IsBad(id) {
@@ -225,7 +229,11 @@ func (s *Service) statusRPCHandler(ctx context.Context, msg interface{}, stream
 	s.rateLimiter.add(stream, 1)
 
 	remotePeer := stream.Conn().RemotePeer()
-	if err := s.validateStatusMessage(ctx, m); err != nil {
+	err := s.validateStatusMessage(ctx, m)
+	if err == nil {
+		err = s.validateWaterfallForks(ctx, remotePeer)
+	}
+	if err != nil {
 		log.WithFields(logrus.Fields{
 			"peer":  remotePeer,
 			"error": err,
@@ -235,7 +243,7 @@ func (s *Service) statusRPCHandler(ctx context.Context, msg interface{}, stream
 		switch err {
 		case p2ptypes.ErrGeneric:
 			respCode = responseCodeServerError
-		case p2ptypes.ErrWrongForkDigestVersion:
+		case p2ptypes.ErrWrongForkDigestVersion, p2ptypes.ErrWaterfallForksMismatch:
 
 			log.WithError(err).WithFields(logrus.Fields{
 				"func": "statusRPCHandler",
@@ -381,3 +389,33 @@ func (s *Service) validateStatusMessage(ctx context.Context, msg *pb.Status) err
 	}
 	return p2ptypes.ErrInvalidEpoch
 }
+
+// validateWaterfallForks checks the peer activates the Waterfall forks at the same slots
+// by the digest of the Waterfall forks registry requested from the peer after the status handshake,
+// which keeps the standard fork digest. So the inbound peers, stored without an ENR, are checked too.
+// The peers not serving the request are checked by the digest advertised in the ENR,
+// and the peers without the digest in the ENR are accepted.
+func (s *Service) validateWaterfallForks(ctx context.Context, id peer.ID) error {
+	peerDigest, err := s.sendWaterfallForksRequest(ctx, id)
+	if err != nil {
+		log.WithError(err).WithField("peer", id).Debug("Peer status: could not request waterfall forks, check the ENR")
+		record, err := s.cfg.p2p.Peers().ENR(id)
+		if err != nil {
+			return nil
+		}
+		if err := p2p.CompareWaterfallForksENR(record); err != nil {
+			log.WithError(err).WithField("peer", id).Debug("Peer status: waterfall forks mismatch")
+			return p2ptypes.ErrWaterfallForksMismatch
+		}
+		return nil
+	}
+	if digest := params.BeaconConfig().WaterfallForksDigest(); *peerDigest != digest {
+		log.WithFields(logrus.Fields{
+			"peer":        id,
+			"peerDigest":  fmt.Sprintf("%#x", *peerDigest),
+			"localDigest": fmt.Sprintf("%#x", digest),
+		}).Debug("Peer status: waterfall forks mismatch")
+		return p2ptypes.ErrWaterfallForksMismatch
+	}
+	return nil
+}
@@ -869,6 +869,137 @@ func TestStatusRPC_ValidGenesisMessage(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestStatusRPCHandler_Disconnects_OnWaterfallForksMismatch(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	// The peer dials, so it is stored as an inbound peer without an ENR.
+	p2.Connect(p1)
+	p1.Peers().Add(nil, p2.PeerID(), nil, network.DirInbound)
+	assert.Equal(t, 1, len(p1.BHost.Network().Peers()), "Expected peers to be connected")
+
+	r := &Service{
+		cfg: &config{
+			p2p: p1,
+			chain: &mock.ChainService{
+				Fork: &ethpb.Fork{
+					PreviousVersion: params.BeaconConfig().GenesisForkVersion,
+					CurrentVersion:  params.BeaconConfig().GenesisForkVersion,
+				},
+				FinalizedCheckPoint: &ethpb.Checkpoint{
+					Epoch: 0,
+					Root:  params.BeaconConfig().ZeroHash[:],
+				},
+				Genesis:        time.Now(),
+				ValidatorsRoot: [32]byte{'A'},
+				Root:           make([]byte, 32),
+			},
+		},
+		rateLimiter: newRateLimiter(p1),
+	}
+	pcl := protocol.ID(p2p.RPCStatusTopicV1)
+	topic := string(pcl)
+	r.rateLimiter.limiterMap[topic] = leakybucket.NewCollector(1, 1, false)
+
+	// The peer activates a Waterfall fork at another slot.
+	peerDigest := params.BeaconConfig().WaterfallForksDigest()
+	peerDigest[0]++
+	var wgForks sync.WaitGroup
+	wgForks.Add(1)
+	serveWaterfallForks(t, p2, peerDigest, &wgForks)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectSuccess(t, stream)
+		out := &ethpb.Status{}
+		assert.NoError(t, r.cfg.p2p.Encoding().DecodeWithMaxLength(stream, out))
+		assert.NoError(t, stream.Close())
+	})
+
+	pcl2 := protocol.ID("/eth2/beacon_chain/req/goodbye/1/ssz_snappy")
+	r.rateLimiter.limiterMap[string(pcl2)] = leakybucket.NewCollector(1, 1, false)
+	var wg2 sync.WaitGroup
+	wg2.Add(1)
+	p2.BHost.SetStreamHandler(pcl2, func(stream network.Stream) {
+		defer wg2.Done()
+		msg := new(types.SSZUint64)
+		assert.NoError(t, r.cfg.p2p.Encoding().DecodeWithMaxLength(stream, msg))
+		assert.Equal(t, p2ptypes.GoodbyeCodeWrongNetwork, *msg)
+		assert.NoError(t, stream.Close())
+	})
+
+	stream1, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	digest, err := r.currentForkDigest()
+	require.NoError(t, err)
+	assert.NoError(t, r.statusRPCHandler(context.Background(), &ethpb.Status{ForkDigest: digest[:], HeadRoot: make([]byte, 32), FinalizedRoot: params.BeaconConfig().ZeroHash[:]}, stream1))
+
+	if util.WaitTimeout(&wgForks, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+	if util.WaitTimeout(&wg2, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+
+	assert.Equal(t, 0, len(p1.BHost.Network().Peers()), "handler did not disconnect peer")
+}
+
+func TestValidateWaterfallForks(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p3 := p2ptest.NewTestP2P(t)
+	p4 := p2ptest.NewTestP2P(t)
+	r := &Service{cfg: &config{p2p: p1, chain: &mock.ChainService{Genesis: time.Now()}}}
+
+	// The peers not serving the waterfall forks request are checked by the ENR.
+	digest := params.BeaconConfig().WaterfallForksDigest()
+	record := new(enr.Record)
+	record.Set(enr.WithEntry(params.BeaconNetworkConfig().WaterfallForksKey, digest[:]))
+	p1.Peers().Add(record, p2.PeerID(), nil, network.DirOutbound)
+	require.NoError(t, r.validateWaterfallForks(context.Background(), p2.PeerID()))
+
+	// The peers without the digest in the ENR are accepted.
+	p1.Peers().Add(new(enr.Record), p3.PeerID(), nil, network.DirOutbound)
+	require.NoError(t, r.validateWaterfallForks(context.Background(), p3.PeerID()))
+
+	// The peers serving the request are checked by the digest in the response.
+	p4.Connect(p1)
+	p1.Peers().Add(nil, p4.PeerID(), nil, network.DirInbound)
+	serveWaterfallForks(t, p4, digest, nil)
+	require.NoError(t, r.validateWaterfallForks(context.Background(), p4.PeerID()))
+
+	// The peer activates a Waterfall fork at another slot.
+	cfg := params.BeaconConfig().Copy()
+	cfg.BlockVotingForkSlot++
+	params.OverrideBeaconConfig(cfg)
+	require.ErrorIs(t, r.validateWaterfallForks(context.Background(), p2.PeerID()), p2ptypes.ErrWaterfallForksMismatch)
+	require.NoError(t, r.validateWaterfallForks(context.Background(), p3.PeerID()))
+	require.ErrorIs(t, r.validateWaterfallForks(context.Background(), p4.PeerID()), p2ptypes.ErrWaterfallForksMismatch)
+}
+
+// serveWaterfallForks makes the peer respond to the waterfall forks requests with the digest.
+func serveWaterfallForks(t *testing.T, p *p2ptest.TestP2P, digest [4]byte, wg *sync.WaitGroup) {
+	pcl := protocol.ID(p2p.RPCWaterfallForksTopicV1 + p.Encoding().ProtocolSuffix())
+	p.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		if wg != nil {
+			defer wg.Done()
+		}
+		req := new(p2ptypes.WaterfallForksDigest)
+		assert.NoError(t, p.Encoding().DecodeWithMaxLength(stream, req))
+		_, err := stream.Write([]byte{responseCodeSuccess})
+		assert.NoError(t, err)
+		resp := p2ptypes.WaterfallForksDigest(digest)
+		_, err = p.Encoding().EncodeWithMaxLength(stream, &resp)
+		assert.NoError(t, err)
+		assert.NoError(t, stream.Close())
+	})
+}
+
 func TestShouldResync(t *testing.T) {
 	type args struct {
 		genesis  time.Time
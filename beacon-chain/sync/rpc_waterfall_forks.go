@@ -0,0 +1,84 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package sync
+
+import (
+	"context"
+
+	libp2pcore "github.com/libp2p/go-libp2p/core"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/p2p"
+	p2ptypes "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/p2p/types"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/time/slots"
+)
+
+// waterfallForksRPCHandler reads the digest of the Waterfall forks registry of the peer
+// and responds with the local one, so that both sides of the status handshake check it.
+func (s *Service) waterfallForksRPCHandler(_ context.Context, msg interface{}, stream libp2pcore.Stream) error {
+	SetRPCStreamDeadlines(stream)
+
+	if _, ok := msg.(*p2ptypes.WaterfallForksDigest); !ok {
+		return errors.New("message is not type WaterfallForksDigest")
+	}
+	if err := s.rateLimiter.validateRequest(stream, 1); err != nil {
+		return err
+	}
+	s.rateLimiter.add(stream, 1)
+
+	if _, err := stream.Write([]byte{responseCodeSuccess}); err != nil {
+		return err
+	}
+	digest := p2ptypes.WaterfallForksDigest(params.BeaconConfig().WaterfallForksDigest())
+	if _, err := s.cfg.p2p.Encoding().EncodeWithMaxLength(stream, &digest); err != nil {
+		return err
+	}
+	closeStream(stream, log)
+	return nil
+}
+
+// sendWaterfallForksRequest exchanges the digests of the Waterfall forks registry with the peer
+// and returns the digest of the peer.
+func (s *Service) sendWaterfallForksRequest(ctx context.Context, id peer.ID) (*p2ptypes.WaterfallForksDigest, error) {
+	ctx, cancel := context.WithTimeout(ctx, respTimeout)
+	defer cancel()
+
+	digest := p2ptypes.WaterfallForksDigest(params.BeaconConfig().WaterfallForksDigest())
+	topic, err := p2p.TopicFromMessage(p2p.WaterfallForksMessageName, slots.ToEpoch(s.cfg.chain.CurrentSlot()))
+	if err != nil {
+		return nil, err
+	}
+	stream, err := s.cfg.p2p.Send(ctx, &digest, topic, id)
+	if err != nil {
+		return nil, err
+	}
+	defer closeStream(stream, log)
+
+	code, errMsg, err := ReadStatusCode(stream, s.cfg.p2p.Encoding())
+	if err != nil {
+		return nil, err
+	}
+	if code != 0 {
+		log.WithField("fn", "sendWaterfallForksRequest").WithField("peer", id.String()).WithField("code", code).Debug("Disconnect: incr BadResponses")
+		s.cfg.p2p.Peers().Scorers().BadResponsesScorer().Increment(id)
+		return nil, errors.New(errMsg)
+	}
+	msg := new(p2ptypes.WaterfallForksDigest)
+	if err := s.cfg.p2p.Encoding().DecodeWithMaxLength(stream, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
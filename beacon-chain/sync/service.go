@@ -19,6 +19,7 @@ import (
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/async/abool"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/async/event"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/blockchain"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/cache"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/feed"
 	blockfeed "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/feed/block"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/feed/operation"
@@ -88,6 +89,8 @@ type config struct {
 	stateGen                *stategen.State
 	slasherAttestationsFeed *event.Feed
 	slasherBlockHeadersFeed *event.Feed
+	slasherPrevotesFeed     *event.Feed
+	proposerIdsCache        *cache.ProposerPayloadIDsCache
 }
 
 // This defines the interface for interacting with block chain service
@@ -270,6 +273,9 @@ func (s *Service) registerHandlers() {
 				currentEpoch := slots.ToEpoch(slots.CurrentSlot(uint64(s.cfg.chain.GenesisTime().Unix())))
 				s.registerSubscribers(currentEpoch, digest)
 				go s.forkWatcher()
+				if !params.BeaconConfig().PrevotingDisabled {
+					go s.backfillPrevotesRoutine()
+				}
 				return
 			}
 		case <-s.ctx.Done():
@@ -25,10 +25,13 @@ import (
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/blocks"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/feed"
+	opfeed "gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/feed/operation"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/helpers"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/signing"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/p2p"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/state"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/features"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/crypto/bls"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
@@ -88,7 +91,10 @@ func (s *Service) validateCommitteeIndexPrevote(ctx context.Context, pid peer.ID
 	}
 
 	// Verify this the first attestation received for the participating validator for the slot.
-	if s.hasSeenPrevoteSlot(pv.Data.Slot, pv.Data.Index, pv.AggregationBits) {
+	// A conflicting prevote of the same validator is seen as well, so slasher still validates it
+	// to be able to detect the double vote.
+	seen := s.hasSeenPrevoteSlot(pv.Data.Slot, pv.Data.Index, pv.AggregationBits)
+	if seen && !features.Get().EnableSlasher {
 		return pubsub.ValidationIgnore, nil
 	}
 
@@ -108,8 +114,27 @@ func (s *Service) validateCommitteeIndexPrevote(ctx context.Context, pid peer.ID
 		return validationRes, err
 	}
 
+	if features.Get().EnableSlasher {
+		// Feed the indexed prevote to slasher if enabled. This action
+		// is done in the background to avoid adding more load to this critical code path.
+		go s.feedSlasherPrevote(pv, bState)
+	}
+
+	if seen {
+		return pubsub.ValidationIgnore, nil
+	}
+
 	s.setSeenSeenPrevoteSlot(pv.Data.Slot, pv.Data.Index, pv.AggregationBits)
 
+	// Broadcast the prevote on a feed to notify other services in the beacon node
+	// of a received prevote.
+	s.cfg.operationNotifier.OperationFeed().Send(&feed.Event{
+		Type: opfeed.PrevoteReceived,
+		Data: &opfeed.PrevoteReceivedData{
+			Prevote: pv,
+		},
+	})
+
 	msg.ValidatorData = pv
 	return pubsub.ValidationAccept, nil
 }
@@ -170,6 +195,24 @@ func (s *Service) validateUnaggregatedPrevoteWithState(ctx context.Context, p *e
 	return s.validateWithBatchVerifier(ctx, "prevote", set)
 }
 
+// Converts the validated prevote to an indexed one and sends it to slasher.
+func (s *Service) feedSlasherPrevote(pv *eth.PreVote, bState state.ReadOnlyBeaconState) {
+	// Using a different context to prevent timeouts as this operation can be expensive
+	// and we want to avoid affecting the critical code path.
+	ctx := context.TODO()
+	committee, err := helpers.BeaconCommitteeFromState(ctx, bState, pv.Data.Slot, pv.Data.Index)
+	if err != nil {
+		log.WithError(err).Error("Prevote: incoming: Could not get prevote committee")
+		return
+	}
+	indexedPrevote, err := prevote.ConvertToIndexed(ctx, pv, committee)
+	if err != nil {
+		log.WithError(err).Error("Prevote: incoming: Could not convert to indexed prevote")
+		return
+	}
+	s.cfg.slasherPrevotesFeed.Send(indexedPrevote)
+}
+
 func verifyPrevoteSignature(ctx context.Context, beaconState state.ReadOnlyBeaconState, pv *eth.PreVote) error {
 	committee, err := helpers.BeaconCommitteeFromState(ctx, beaconState, pv.Data.Slot, pv.Data.Index)
 	if err != nil {
@@ -31,5 +31,19 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name:        "prune-spines",
+			Description: `removes the spines lists which are not referred by any stored state`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := beacondb.PruneSpines(cliCtx); err != nil {
+					log.Fatalf("Could not prune spines: %v", err)
+				}
+				return nil
+			},
+		},
 	},
 }
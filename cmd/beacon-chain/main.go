@@ -115,7 +115,8 @@ var appFlags = []cli.Flag{
 	debug.BlockProfileRateFlag,
 	debug.MutexProfileFractionFlag,
 	cmd.LogFileName,
-	cmd.WriteRewardLogFlag,
+	cmd.SaveRewardsFlag,
+	cmd.RewardsRetentionEpochsFlag,
 	cmd.EnableUPnPFlag,
 	cmd.ConfigFileFlag,
 	cmd.ChainConfigFileFlag,
@@ -128,6 +129,7 @@ var appFlags = []cli.Flag{
 	cmd.ApiTimeoutFlag,
 	checkpoint.BlockPath,
 	checkpoint.StatePath,
+	checkpoint.CoordinationPath,
 	checkpoint.RemoteURL,
 	genesis.StatePath,
 	genesis.BeaconAPIURL,
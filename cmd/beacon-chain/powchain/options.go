@@ -11,6 +11,8 @@ import (
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/powchain"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/cmd/beacon-chain/flags"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/io/file"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/network"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/network/authorization"
 )
 
 var log = logrus.WithField("prefix", "cmd-powchain")
@@ -32,6 +34,21 @@ func FlagOptions(c *cli.Context) ([]powchain.Option, error) {
 	return opts, nil
 }
 
+// DagEndpoint returns the endpoint of the primary gwat node with the authorization
+// by the JWT secret if provided, which is used to access the dag api before the powchain service is started.
+func DagEndpoint(c *cli.Context) (network.Endpoint, error) {
+	jwtSecret, err := parseJWTSecretFromFile(c)
+	if err != nil {
+		return network.Endpoint{}, errors.Wrap(err, "could not read JWT secret file for authenticating execution API")
+	}
+	endpoint := powchain.HttpEndpoint(parsePowchainEndpoints(c)[0])
+	if len(jwtSecret) > 0 {
+		endpoint.Auth.Method = authorization.Bearer
+		endpoint.Auth.Value = string(jwtSecret)
+	}
+	return endpoint, nil
+}
+
 // Parses a JWT secret from a file path. This secret is required when connecting to execution nodes
 // over HTTP, and must be the same one used in Prysm and the execution node server Prysm is connecting to.
 // The engine API specification here https://github.com/ethereum/execution-apis/blob/main/src/engine/authentication.md
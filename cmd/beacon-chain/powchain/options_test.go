@@ -12,6 +12,7 @@ import (
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/cmd/beacon-chain/flags"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/io/file"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/network/authorization"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/assert"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
 )
@@ -129,3 +130,23 @@ func TestPowchainPreregistration_EmptyWeb3Provider(t *testing.T) {
 	parsePowchainEndpoints(ctx)
 	assert.LogsContain(t, hook, "No shard node specified to run with the beacon node")
 }
+
+func TestDagEndpoint(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.HTTPWeb3ProviderFlag.Name, "http://primary", "")
+	fallback := cli.StringSlice{}
+	require.NoError(t, fallback.Set("http://fallback"))
+	set.Var(&fallback, flags.FallbackWeb3ProviderFlag.Name, "")
+	fullPath := filepath.Join(t.TempDir(), "foohex")
+	secret := bytesutil.ToBytes32([]byte("foo"))
+	require.NoError(t, file.WriteFile(fullPath, []byte(fmt.Sprintf("%#x", secret))))
+	set.String(flags.ExecutionJWTSecretFlag.Name, fullPath, "")
+	ctx := cli.NewContext(&app, set, nil)
+
+	endpoint, err := DagEndpoint(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "http://primary", endpoint.Url)
+	assert.Equal(t, authorization.Bearer, endpoint.Auth.Method)
+	assert.Equal(t, string(secret[:]), endpoint.Auth.Value)
+}
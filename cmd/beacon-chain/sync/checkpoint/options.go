@@ -6,7 +6,9 @@ import (
 	"github.com/pkg/errors"
 	"github.com/urfave/cli/v2"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/node"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/powchain"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/sync/checkpoint"
+	powchaincmd "gitlab.waterfall.network/waterfall/protocol/coordinator/cmd/beacon-chain/powchain"
 )
 
 var (
@@ -22,6 +24,12 @@ var (
 		Usage: "Rather than syncing from genesis, you can start processing from a ssz-serialized BeaconState+Block." +
 			" This flag allows you to specify a local file containing the checkpoint Block to load.",
 	}
+	// CoordinationPath is optional with StatePath to also provide the gwat coordination data of the checkpoint.
+	CoordinationPath = &cli.PathFlag{
+		Name: "checkpoint-coordination",
+		Usage: "Rather than rebuilding the gwat coordinated state from the chain history, you can provide the gwat coordination data " +
+			"of the checkpoint BeaconState+Block. This flag allows you to specify a local json file containing the coordination data to load.",
+	}
 	RemoteURL = &cli.StringFlag{
 		Name: "checkpoint-sync-url",
 		Usage: "URL of a synced beacon node to trust in obtaining checkpoint sync data. " +
@@ -36,11 +44,15 @@ var (
 func BeaconNodeOptions(c *cli.Context) (node.Option, error) {
 	blockPath := c.Path(BlockPath.Name)
 	statePath := c.Path(StatePath.Name)
+	coordinationPath := c.Path(CoordinationPath.Name)
 	remoteURL := c.String(RemoteURL.Name)
 	if remoteURL != "" {
 		return func(node *node.BeaconNode) error {
-			var err error
-			node.CheckpointInitializer, err = checkpoint.NewAPIInitializer(remoteURL)
+			dag, err := dialDagClient(c)
+			if err != nil {
+				return err
+			}
+			node.CheckpointInitializer, err = checkpoint.NewAPIInitializer(remoteURL, dag)
 			if err != nil {
 				return errors.Wrap(err, "error while constructing beacon node api client for checkpoint sync")
 			}
@@ -49,6 +61,9 @@ func BeaconNodeOptions(c *cli.Context) (node.Option, error) {
 	}
 
 	if blockPath == "" && statePath == "" {
+		if coordinationPath != "" {
+			return nil, fmt.Errorf("--checkpoint-coordination specified, but not --checkpoint-state and --checkpoint-block")
+		}
 		return nil, nil
 	}
 	if blockPath != "" && statePath == "" {
@@ -59,10 +74,28 @@ func BeaconNodeOptions(c *cli.Context) (node.Option, error) {
 	}
 
 	return func(node *node.BeaconNode) (err error) {
-		node.CheckpointInitializer, err = checkpoint.NewFileInitializer(blockPath, statePath)
+		dag, err := dialDagClient(c)
+		if err != nil {
+			return err
+		}
+		node.CheckpointInitializer, err = checkpoint.NewFileInitializer(blockPath, statePath, coordinationPath, dag)
 		if err != nil {
 			return errors.Wrap(err, "error preparing to initialize checkpoint from local ssz files")
 		}
 		return nil
 	}, nil
 }
+
+// dialDagClient connects to the dag api of the gwat node the beacon node is configured with,
+// to check the gwat coordination data of the checkpoint against it.
+func dialDagClient(c *cli.Context) (powchain.DagClient, error) {
+	endpoint, err := powchaincmd.DagEndpoint(c)
+	if err != nil {
+		return nil, err
+	}
+	dag, err := powchain.DialDagClient(c.Context, endpoint)
+	if err != nil {
+		return nil, errors.Wrap(err, "error connecting gwat node for checkpoint sync")
+	}
+	return dag, nil
+}
@@ -133,6 +133,7 @@ var appHelpFlagGroups = []flagGroup{
 			flags.VotingRequiredSlots,
 			checkpoint.BlockPath,
 			checkpoint.StatePath,
+			checkpoint.CoordinationPath,
 			checkpoint.RemoteURL,
 			genesis.StatePath,
 			genesis.BeaconAPIURL,
@@ -166,7 +167,8 @@ var appHelpFlagGroups = []flagGroup{
 		Flags: []cli.Flag{
 			cmd.LogFormat,
 			cmd.LogFileName,
-			cmd.WriteRewardLogFlag,
+			cmd.SaveRewardsFlag,
+			cmd.RewardsRetentionEpochsFlag,
 		},
 	},
 	{
@@ -199,10 +199,17 @@ var (
 		Usage: "Specify log formatting. Supports: text, json, fluentd, journald.",
 		Value: "text",
 	}
-	// WriteRewardLogFlag Write log of rewards and penalties.
-	WriteRewardLogFlag = &cli.BoolFlag{
-		Name:  "write-reward-log",
-		Usage: "Enable write log file of rewards and penalties.",
+	// SaveRewardsFlag enables saving of the rewards and penalties to the db.
+	SaveRewardsFlag = &cli.BoolFlag{
+		Name:    "save-rewards",
+		Aliases: []string{"write-reward-log"},
+		Usage:   "Enable saving of the per epoch rewards and penalties of the validators to the db, which are served by the rewards API endpoints.",
+	}
+	// RewardsRetentionEpochsFlag defines the number of epochs the saved rewards and penalties are kept.
+	RewardsRetentionEpochsFlag = &cli.Uint64Flag{
+		Name:  "rewards-retention-epochs",
+		Usage: "The number of epochs the saved rewards and penalties are kept in the db, 0 keeps them forever.",
+		Value: 4096,
 	}
 	// MaxGoroutines specifies the maximum amount of goroutines tolerated, before a status check fails.
 	MaxGoroutines = &cli.IntFlag{
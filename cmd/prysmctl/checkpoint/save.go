@@ -67,5 +67,13 @@ func cliActionSave(_ *cli.Context) error {
 	}
 	log.Printf("saved ssz-encoded state to %s", statePath)
 
+	coordinationPath, err := od.SaveCoordinationData(cwd)
+	if err != nil {
+		return err
+	}
+	if coordinationPath != "" {
+		log.Printf("saved gwat coordination data to %s", coordinationPath)
+	}
+
 	return nil
 }
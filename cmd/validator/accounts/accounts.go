@@ -145,5 +145,40 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name: "deposit-data",
+			Description: "generates the signed deposit data of the validator accounts in a user's wallet " +
+				"and writes it to a JSON file accepted by the gwat deposit contract and the genesis state generator. " +
+				"Accounts can also be specified programmatically via a --deposit-public-keys flag which specifies " +
+				"a comma-separated list of hex string public keys",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				flags.DepositPublicKeysFlag,
+				flags.DepositCreatorAddressFlag,
+				flags.DepositWithdrawalAddressFlag,
+				flags.DepositAmountFlag,
+				flags.DepositDataFileFlag,
+				features.Mainnet,
+				features.PyrmontTestnet,
+				features.Testnet8,
+				features.Testnet5,
+				features.Testnet9,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				features.ConfigureValidator(cliCtx)
+				if err := accounts.DepositDataCli(cliCtx); err != nil {
+					log.Fatalf("Could not generate deposit data: %v", err)
+				}
+				return nil
+			},
+		},
 	},
 }
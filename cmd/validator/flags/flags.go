@@ -39,6 +39,12 @@ var (
 		Usage: "Beacon node RPC gateway provider endpoint",
 		Value: "127.0.0.1:3500",
 	}
+	// BeaconRESTApiProviderFlag defines a beacon node REST API endpoint used instead of the gRPC connection.
+	BeaconRESTApiProviderFlag = &cli.StringFlag{
+		Name:  "beacon-rest-api-provider",
+		Usage: "Beacon node REST API provider endpoint. If set, the validator talks to the beacon node over the standard beacon REST API instead of gRPC",
+		Value: "",
+	}
 	// CertFlag defines a flag for the node's TLS certificate.
 	CertFlag = &cli.StringFlag{
 		Name:  "tls-cert",
@@ -211,6 +217,37 @@ var (
 		Usage: "Path to a directory where accounts will be backed up into a zip file",
 		Value: DefaultValidatorDir(),
 	}
+	// DepositPublicKeysFlag defines a comma-separated list of hex string public keys
+	// for accounts which a user desires to generate the deposit data for.
+	DepositPublicKeysFlag = &cli.StringFlag{
+		Name:  "deposit-public-keys",
+		Usage: "Comma-separated list of public key hex strings to specify which validator accounts to generate the deposit data for",
+		Value: "",
+	}
+	// DepositCreatorAddressFlag defines the creator address of the validators in the deposit data.
+	DepositCreatorAddressFlag = &cli.StringFlag{
+		Name:  "deposit-creator-address",
+		Usage: "Hex encoded address of the validators creator in the gwat network",
+		Value: "",
+	}
+	// DepositWithdrawalAddressFlag defines the withdrawal address of the validators in the deposit data.
+	DepositWithdrawalAddressFlag = &cli.StringFlag{
+		Name:  "deposit-withdrawal-address",
+		Usage: "Hex encoded address in the gwat network which receives the withdrawals of the validators",
+		Value: "",
+	}
+	// DepositAmountFlag defines the amount of the deposits in Gwei.
+	DepositAmountFlag = &cli.Uint64Flag{
+		Name:  "deposit-amount",
+		Usage: "Amount of each deposit in Gwei. Defaults to the max effective balance",
+		Value: 0,
+	}
+	// DepositDataFileFlag defines the path of the generated deposit data JSON file.
+	DepositDataFileFlag = &cli.StringFlag{
+		Name:  "deposit-data-file",
+		Usage: "Path to the JSON file where the deposit data of the validator accounts will be written to",
+		Value: "deposit_data.json",
+	}
 	// SlashingProtectionJSONFileFlag is used to enter the file path of the slashing protection JSON.
 	SlashingProtectionJSONFileFlag = &cli.StringFlag{
 		Name:  "slashing-protection-json-file",
@@ -141,8 +141,10 @@ type BeaconChainConfig struct {
 	BlockVotingMinSupportPrc       int           // BlockVotingMinSupportPrc defines minimum percentage of votes for accept of consensus for block.
 	SpinePublicationsPefixSupport  int           // SpinePublicationsPefixSupport defines number of publications of spine to accept it as prefix.
 	CtxBlockFetcherKey             CtxFnKey      // CtxBlockFetcherKey defines the key of block fetcher for context of state transition.
+	CtxRewardsRecorderKey          CtxFnKey      // CtxRewardsRecorderKey defines the key of rewards and penalties recorder for context of state transition.
 	DataDir                        string
-	WriteRewardLogFlag             bool
+	SaveRewards                    bool        // SaveRewards defines whether the rewards and penalties applied by the state transition are saved to the db.
+	RewardsRetentionEpochs         types.Epoch // RewardsRetentionEpochs defines the number of epochs the saved rewards and penalties are kept in the db, 0 keeps them forever.
 
 	// Slasher constants.
 	WeakSubjectivityPeriod    types.Epoch // WeakSubjectivityPeriod defines the time period expressed in number of epochs were proof of stake network should validate block headers and attestations for slashable events.
@@ -243,17 +245,17 @@ func configForkSchedule(b *BeaconChainConfig) map[[fieldparams.VersionLength]byt
 }
 
 func (b *BeaconChainConfig) IsDelegatingStakeSlot(slot types.Slot) bool {
-	return b.DelegateForkSlot <= slot
+	return b.IsWaterfallForkActive(DelegateForkName, slot)
 }
 
 func (b *BeaconChainConfig) IsPrefixFinForkSlot(slot types.Slot) bool {
-	return b.PrefixFinForkSlot <= slot
+	return b.IsWaterfallForkActive(PrefixFinForkName, slot)
 }
 
 func (b *BeaconChainConfig) IsFinEth1ForkSlot(slot types.Slot) bool {
-	return b.FinEth1ForkSlot <= slot
+	return b.IsWaterfallForkActive(FinEth1ForkName, slot)
 }
 
 func (b *BeaconChainConfig) IsBlockVotingForkSlot(slot types.Slot) bool {
-	return b.BlockVotingForkSlot <= slot
+	return b.IsWaterfallForkActive(BlockVotingForkName, slot)
 }
@@ -51,6 +51,7 @@ var mainnetNetworkConfig = &NetworkConfig{
 	ETH2Key:                         "eth2",
 	AttSubnetKey:                    "attnets",
 	SyncCommsSubnetKey:              "syncnets",
+	WaterfallForksKey:               "wfforks",
 	MinimumPeersInSubnetSearch:      20,
 	BootstrapNodes: []string{
 		"enr:-LG4QAGJyiJYWVjQnJ2ANfWE_AtbYnYEVcYS3k5iyUaALWKBI7OL30dc_-Nxigt7FpiB4b0cfmq62iGXB76C8BmRVGeGAZBd0Ihph2F0dG5ldHOIAAAAAAAAAACEZXRoMpB5pkc4AAAgCf__________gmlkgnY0gmlwhCImhDKJc2VjcDI1NmsxoQND0I1D6IIk-kwev1LftepaWrPOyN3pgkTbDrHfJN0bGIN1ZHCCD6A",
@@ -210,6 +211,7 @@ var mainnetBeaconConfig = &BeaconChainConfig{
 	BeaconStateAltairFieldCount:    24 + 2,
 	BeaconStateBellatrixFieldCount: 25 + 2,
 	CtxBlockFetcherKey:             CtxFnKey("CtxBlockFetcher"),
+	CtxRewardsRecorderKey:          CtxFnKey("CtxRewardsRecorder"),
 
 	// Slasher related values.
 	WeakSubjectivityPeriod:          54000,
@@ -0,0 +1,92 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package params
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+
+	types "github.com/prysmaticlabs/eth2-types"
+)
+
+// Names of the Waterfall forks activated by slot.
+const (
+	DelegateForkName    = "DELEGATE"
+	PrefixFinForkName   = "PREFIX_FIN"
+	FinEth1ForkName     = "FIN_ETH1"
+	BlockVotingForkName = "BLOCK_VOTING"
+)
+
+// WaterfallFork is a Waterfall behavior activated at a slot.
+// Unlike the version forks, it does not change the fork version,
+// so the nodes with different fork slots are told apart by WaterfallForksDigest.
+type WaterfallFork struct {
+	Name string
+	Slot types.Slot
+}
+
+// waterfallForksRegistry lists the Waterfall forks with the config fields of their activation slots.
+// A new slot-activated behavior must be added here to be exposed by the spec
+// and fork-schedule APIs and to be checked in the peers handshake.
+var waterfallForksRegistry = []struct {
+	name string
+	slot func(b *BeaconChainConfig) types.Slot
+}{
+	{name: DelegateForkName, slot: func(b *BeaconChainConfig) types.Slot { return b.DelegateForkSlot }},
+	{name: PrefixFinForkName, slot: func(b *BeaconChainConfig) types.Slot { return b.PrefixFinForkSlot }},
+	{name: FinEth1ForkName, slot: func(b *BeaconChainConfig) types.Slot { return b.FinEth1ForkSlot }},
+	{name: BlockVotingForkName, slot: func(b *BeaconChainConfig) types.Slot { return b.BlockVotingForkSlot }},
+}
+
+// WaterfallForks returns the registry of the Waterfall forks ordered by activation slot.
+func (b *BeaconChainConfig) WaterfallForks() []WaterfallFork {
+	wForks := make([]WaterfallFork, len(waterfallForksRegistry))
+	for i, f := range waterfallForksRegistry {
+		wForks[i] = WaterfallFork{Name: f.name, Slot: f.slot(b)}
+	}
+	sort.SliceStable(wForks, func(i, j int) bool {
+		return wForks[i].Slot < wForks[j].Slot
+	})
+	return wForks
+}
+
+// WaterfallForksDigest returns the 4 bytes digest of the names and the slots of the Waterfall forks.
+func (b *BeaconChainConfig) WaterfallForksDigest() [4]byte {
+	wForks := b.WaterfallForks()
+	sort.SliceStable(wForks, func(i, j int) bool {
+		return wForks[i].Name < wForks[j].Name
+	})
+	h := sha256.New()
+	slot := make([]byte, 8)
+	for _, f := range wForks {
+		h.Write([]byte(f.Name))
+		binary.LittleEndian.PutUint64(slot, uint64(f.Slot))
+		h.Write(slot)
+	}
+	var digest [4]byte
+	copy(digest[:], h.Sum(nil))
+	return digest
+}
+
+// IsWaterfallForkActive checks the Waterfall fork with the name is activated at the slot.
+func (b *BeaconChainConfig) IsWaterfallForkActive(name string, slot types.Slot) bool {
+	for _, f := range waterfallForksRegistry {
+		if f.name == name {
+			return f.slot(b) <= slot
+		}
+	}
+	return false
+}
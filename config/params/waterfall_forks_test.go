@@ -0,0 +1,52 @@
+package params_test
+
+import (
+	"testing"
+
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/assert"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+)
+
+func TestBeaconChainConfig_WaterfallForks(t *testing.T) {
+	cfg := params.MainnetConfig().Copy()
+	cfg.DelegateForkSlot = 300
+	cfg.PrefixFinForkSlot = 100
+	cfg.FinEth1ForkSlot = 200
+	cfg.BlockVotingForkSlot = 100
+
+	wForks := cfg.WaterfallForks()
+	require.Equal(t, 4, len(wForks))
+	assert.DeepEqual(t, []params.WaterfallFork{
+		{Name: params.PrefixFinForkName, Slot: 100},
+		{Name: params.BlockVotingForkName, Slot: 100},
+		{Name: params.FinEth1ForkName, Slot: 200},
+		{Name: params.DelegateForkName, Slot: 300},
+	}, wForks)
+
+	assert.Equal(t, true, cfg.IsWaterfallForkActive(params.FinEth1ForkName, 200))
+	assert.Equal(t, false, cfg.IsWaterfallForkActive(params.DelegateForkName, 299))
+	assert.Equal(t, false, cfg.IsWaterfallForkActive("UNKNOWN", 1000))
+
+	// The slot gates are resolved by the registry.
+	assert.Equal(t, false, cfg.IsDelegatingStakeSlot(299))
+	assert.Equal(t, true, cfg.IsDelegatingStakeSlot(300))
+	assert.Equal(t, true, cfg.IsPrefixFinForkSlot(100))
+	assert.Equal(t, false, cfg.IsFinEth1ForkSlot(199))
+	assert.Equal(t, true, cfg.IsBlockVotingForkSlot(100))
+}
+
+func TestBeaconChainConfig_WaterfallForksDigest(t *testing.T) {
+	cfg := params.MainnetConfig().Copy()
+	digest := cfg.WaterfallForksDigest()
+	assert.Equal(t, digest, cfg.Copy().WaterfallForksDigest())
+
+	// Swapping the slots of the forks changes the digest.
+	other := cfg.Copy()
+	other.PrefixFinForkSlot, other.BlockVotingForkSlot = cfg.BlockVotingForkSlot, cfg.PrefixFinForkSlot
+	assert.NotEqual(t, digest, other.WaterfallForksDigest())
+
+	other = cfg.Copy()
+	other.BlockVotingForkSlot++
+	assert.NotEqual(t, digest, other.WaterfallForksDigest())
+}
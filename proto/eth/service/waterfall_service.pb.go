@@ -0,0 +1,766 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.15.8
+// source: proto/eth/service/waterfall_service.proto
+
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	v1 "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/descriptorpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_proto_eth_service_waterfall_service_proto protoreflect.FileDescriptor
+
+var file_proto_eth_service_waterfall_service_proto_rawDesc = []byte{
+	0x0a, 0x29, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2f, 0x77, 0x61, 0x74, 0x65, 0x72, 0x66, 0x61, 0x6c, 0x6c, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x65, 0x74, 0x68,
+	0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x61,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x6c, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xfe, 0x0c,
+	0x0a, 0x09, 0x57, 0x61, 0x74, 0x65, 0x72, 0x66, 0x61, 0x6c, 0x6c, 0x12, 0x89, 0x01, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x47, 0x77, 0x61, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x29, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x77,
+	0x61, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27,
+	0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31,
+	0x2f, 0x77, 0x61, 0x74, 0x65, 0x72, 0x66, 0x61, 0x6c, 0x6c, 0x2f, 0x66, 0x69, 0x6e, 0x61, 0x6c,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x8b, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x46,
+	0x6f, 0x72, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x2e, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65,
+	0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x65, 0x72, 0x66, 0x61, 0x6c, 0x6c, 0x46,
+	0x6f, 0x72, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x61,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x6c, 0x6c, 0x2f, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0xa4, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d,
+	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x3b, 0x12, 0x39, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x65, 0x74, 0x68,
+	0x2f, 0x76, 0x31, 0x2f, 0x77, 0x61, 0x74, 0x65, 0x72, 0x66, 0x61, 0x6c, 0x6c, 0x2f, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x8a, 0x01, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x47, 0x77, 0x61, 0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x26, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x77, 0x61, 0x74,
+	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e, 0x2f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x61, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x6c, 0x6c, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x67, 0x77, 0x61, 0x74, 0x5f,
+	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0xa9, 0x01, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79,
+	0x63, 0x6c, 0x65, 0x12, 0x2a, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65,
+	0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
+	0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2b, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76,
+	0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x66, 0x65, 0x63,
+	0x79, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f,
+	0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x61, 0x74, 0x65, 0x72, 0x66, 0x61, 0x6c, 0x6c,
+	0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x74, 0x78, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x7d, 0x12, 0x96, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x70, 0x69,
+	0x6e, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x12, 0x26, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70,
+	0x69, 0x6e, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65,
+	0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x69, 0x6e, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x63,
+	0x79, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f,
+	0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x61, 0x74, 0x65, 0x72, 0x66, 0x61, 0x6c, 0x6c,
+	0x2f, 0x73, 0x70, 0x69, 0x6e, 0x65, 0x73, 0x2f, 0x7b, 0x68, 0x61, 0x73, 0x68, 0x7d, 0x12, 0x94,
+	0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x73, 0x12, 0x24, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72,
+	0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x33, 0x12, 0x31, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x65, 0x74,
+	0x68, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x61, 0x74, 0x65, 0x72, 0x66, 0x61, 0x6c, 0x6c, 0x2f, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x2f, 0x7b, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x7d, 0x12, 0x97, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x24, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x12, 0x34, 0x2f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x61, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x6c, 0x6c, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x2f, 0x7b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x7d, 0x12,
+	0x8e, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x50, 0x72, 0x65, 0x76,
+	0x6f, 0x74, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x50,
+	0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x65, 0x74, 0x68,
+	0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65,
+	0x76, 0x6f, 0x74, 0x65, 0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x73,
+	0x12, 0x82, 0x01, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x65, 0x76, 0x6f,
+	0x74, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65,
+	0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x65, 0x76,
+	0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x3a, 0x01, 0x2a, 0x22, 0x25,
+	0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31,
+	0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x72, 0x65,
+	0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x96, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65,
+	0x76, 0x6f, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x23, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72,
+	0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x6f,
+	0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x31, 0x2f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x61,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x6c, 0x6c, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x2f, 0x70, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x32, 0xc1,
+	0x01, 0x0a, 0x0e, 0x57, 0x61, 0x74, 0x65, 0x72, 0x66, 0x61, 0x6c, 0x6c, 0x44, 0x65, 0x62, 0x75,
+	0x67, 0x12, 0xae, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65,
+	0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72,
+	0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x6f,
+	0x74, 0x65, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x28, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x63, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x3f, 0x12, 0x3d, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x65,
+	0x74, 0x68, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x61, 0x74, 0x65, 0x72, 0x66, 0x61, 0x6c, 0x6c, 0x2f,
+	0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x70, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x64, 0x65,
+	0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69,
+	0x64, 0x7d, 0x42, 0xac, 0x01, 0x0a, 0x18, 0x6f, 0x72, 0x67, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72,
+	0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42,
+	0x15, 0x57, 0x61, 0x74, 0x65, 0x72, 0x66, 0x61, 0x6c, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x49, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62,
+	0x2e, 0x77, 0x61, 0x74, 0x65, 0x72, 0x66, 0x61, 0x6c, 0x6c, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x2f, 0x77, 0x61, 0x74, 0x65, 0x72, 0x66, 0x61, 0x6c, 0x6c, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f,
+	0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0xaa, 0x02, 0x14, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x45,
+	0x74, 0x68, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0xca, 0x02, 0x14, 0x45, 0x74, 0x68,
+	0x65, 0x72, 0x65, 0x75, 0x6d, 0x5c, 0x45, 0x74, 0x68, 0x5c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var file_proto_eth_service_waterfall_service_proto_goTypes = []interface{}{
+	(*emptypb.Empty)(nil),                    // 0: google.protobuf.Empty
+	(*v1.StateRequest)(nil),                  // 1: ethereum.eth.v1.StateRequest
+	(*v1.OperationLifecycleRequest)(nil),     // 2: ethereum.eth.v1.OperationLifecycleRequest
+	(*v1.SpineLifecycleRequest)(nil),         // 3: ethereum.eth.v1.SpineLifecycleRequest
+	(*v1.EpochRewardsRequest)(nil),           // 4: ethereum.eth.v1.EpochRewardsRequest
+	(*v1.BlockRewardsRequest)(nil),           // 5: ethereum.eth.v1.BlockRewardsRequest
+	(*v1.PrevotesPoolRequest)(nil),           // 6: ethereum.eth.v1.PrevotesPoolRequest
+	(*v1.SubmitPrevotesRequest)(nil),         // 7: ethereum.eth.v1.SubmitPrevotesRequest
+	(*v1.PrevoteDataRequest)(nil),            // 8: ethereum.eth.v1.PrevoteDataRequest
+	(*v1.PrevoteDecisionRequest)(nil),        // 9: ethereum.eth.v1.PrevoteDecisionRequest
+	(*v1.GwatFinalizationResponse)(nil),      // 10: ethereum.eth.v1.GwatFinalizationResponse
+	(*v1.WaterfallForkScheduleResponse)(nil), // 11: ethereum.eth.v1.WaterfallForkScheduleResponse
+	(*v1.StateCoordinationResponse)(nil),     // 12: ethereum.eth.v1.StateCoordinationResponse
+	(*v1.GwatEndpointsResponse)(nil),         // 13: ethereum.eth.v1.GwatEndpointsResponse
+	(*v1.OperationLifecycleResponse)(nil),    // 14: ethereum.eth.v1.OperationLifecycleResponse
+	(*v1.SpineLifecycleResponse)(nil),        // 15: ethereum.eth.v1.SpineLifecycleResponse
+	(*v1.RewardsResponse)(nil),               // 16: ethereum.eth.v1.RewardsResponse
+	(*v1.PrevotesPoolResponse)(nil),          // 17: ethereum.eth.v1.PrevotesPoolResponse
+	(*v1.PrevoteDataResponse)(nil),           // 18: ethereum.eth.v1.PrevoteDataResponse
+	(*v1.PrevoteDecisionResponse)(nil),       // 19: ethereum.eth.v1.PrevoteDecisionResponse
+}
+var file_proto_eth_service_waterfall_service_proto_depIdxs = []int32{
+	0,  // 0: ethereum.eth.service.Waterfall.GetGwatFinalization:input_type -> google.protobuf.Empty
+	0,  // 1: ethereum.eth.service.Waterfall.GetForkSchedule:input_type -> google.protobuf.Empty
+	1,  // 2: ethereum.eth.service.Waterfall.GetStateCoordination:input_type -> ethereum.eth.v1.StateRequest
+	0,  // 3: ethereum.eth.service.Waterfall.GetGwatEndpoints:input_type -> google.protobuf.Empty
+	2,  // 4: ethereum.eth.service.Waterfall.GetOperationLifecycle:input_type -> ethereum.eth.v1.OperationLifecycleRequest
+	3,  // 5: ethereum.eth.service.Waterfall.GetSpineLifecycle:input_type -> ethereum.eth.v1.SpineLifecycleRequest
+	4,  // 6: ethereum.eth.service.Waterfall.GetEpochRewards:input_type -> ethereum.eth.v1.EpochRewardsRequest
+	5,  // 7: ethereum.eth.service.Waterfall.GetBlockRewards:input_type -> ethereum.eth.v1.BlockRewardsRequest
+	6,  // 8: ethereum.eth.service.Waterfall.ListPoolPrevotes:input_type -> ethereum.eth.v1.PrevotesPoolRequest
+	7,  // 9: ethereum.eth.service.Waterfall.SubmitPrevotes:input_type -> ethereum.eth.v1.SubmitPrevotesRequest
+	8,  // 10: ethereum.eth.service.Waterfall.GetPrevoteData:input_type -> ethereum.eth.v1.PrevoteDataRequest
+	9,  // 11: ethereum.eth.service.WaterfallDebug.GetPrevoteDecision:input_type -> ethereum.eth.v1.PrevoteDecisionRequest
+	10, // 12: ethereum.eth.service.Waterfall.GetGwatFinalization:output_type -> ethereum.eth.v1.GwatFinalizationResponse
+	11, // 13: ethereum.eth.service.Waterfall.GetForkSchedule:output_type -> ethereum.eth.v1.WaterfallForkScheduleResponse
+	12, // 14: ethereum.eth.service.Waterfall.GetStateCoordination:output_type -> ethereum.eth.v1.StateCoordinationResponse
+	13, // 15: ethereum.eth.service.Waterfall.GetGwatEndpoints:output_type -> ethereum.eth.v1.GwatEndpointsResponse
+	14, // 16: ethereum.eth.service.Waterfall.GetOperationLifecycle:output_type -> ethereum.eth.v1.OperationLifecycleResponse
+	15, // 17: ethereum.eth.service.Waterfall.GetSpineLifecycle:output_type -> ethereum.eth.v1.SpineLifecycleResponse
+	16, // 18: ethereum.eth.service.Waterfall.GetEpochRewards:output_type -> ethereum.eth.v1.RewardsResponse
+	16, // 19: ethereum.eth.service.Waterfall.GetBlockRewards:output_type -> ethereum.eth.v1.RewardsResponse
+	17, // 20: ethereum.eth.service.Waterfall.ListPoolPrevotes:output_type -> ethereum.eth.v1.PrevotesPoolResponse
+	0,  // 21: ethereum.eth.service.Waterfall.SubmitPrevotes:output_type -> google.protobuf.Empty
+	18, // 22: ethereum.eth.service.Waterfall.GetPrevoteData:output_type -> ethereum.eth.v1.PrevoteDataResponse
+	19, // 23: ethereum.eth.service.WaterfallDebug.GetPrevoteDecision:output_type -> ethereum.eth.v1.PrevoteDecisionResponse
+	12, // [12:24] is the sub-list for method output_type
+	0,  // [0:12] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_proto_eth_service_waterfall_service_proto_init() }
+func file_proto_eth_service_waterfall_service_proto_init() {
+	if File_proto_eth_service_waterfall_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_eth_service_waterfall_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_proto_eth_service_waterfall_service_proto_goTypes,
+		DependencyIndexes: file_proto_eth_service_waterfall_service_proto_depIdxs,
+	}.Build()
+	File_proto_eth_service_waterfall_service_proto = out.File
+	file_proto_eth_service_waterfall_service_proto_rawDesc = nil
+	file_proto_eth_service_waterfall_service_proto_goTypes = nil
+	file_proto_eth_service_waterfall_service_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// WaterfallClient is the client API for Waterfall service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WaterfallClient interface {
+	GetGwatFinalization(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v1.GwatFinalizationResponse, error)
+	GetForkSchedule(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v1.WaterfallForkScheduleResponse, error)
+	GetStateCoordination(ctx context.Context, in *v1.StateRequest, opts ...grpc.CallOption) (*v1.StateCoordinationResponse, error)
+	GetGwatEndpoints(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v1.GwatEndpointsResponse, error)
+	GetOperationLifecycle(ctx context.Context, in *v1.OperationLifecycleRequest, opts ...grpc.CallOption) (*v1.OperationLifecycleResponse, error)
+	GetSpineLifecycle(ctx context.Context, in *v1.SpineLifecycleRequest, opts ...grpc.CallOption) (*v1.SpineLifecycleResponse, error)
+	GetEpochRewards(ctx context.Context, in *v1.EpochRewardsRequest, opts ...grpc.CallOption) (*v1.RewardsResponse, error)
+	GetBlockRewards(ctx context.Context, in *v1.BlockRewardsRequest, opts ...grpc.CallOption) (*v1.RewardsResponse, error)
+	ListPoolPrevotes(ctx context.Context, in *v1.PrevotesPoolRequest, opts ...grpc.CallOption) (*v1.PrevotesPoolResponse, error)
+	SubmitPrevotes(ctx context.Context, in *v1.SubmitPrevotesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetPrevoteData(ctx context.Context, in *v1.PrevoteDataRequest, opts ...grpc.CallOption) (*v1.PrevoteDataResponse, error)
+}
+
+type waterfallClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWaterfallClient(cc grpc.ClientConnInterface) WaterfallClient {
+	return &waterfallClient{cc}
+}
+
+func (c *waterfallClient) GetGwatFinalization(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v1.GwatFinalizationResponse, error) {
+	out := new(v1.GwatFinalizationResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.service.Waterfall/GetGwatFinalization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *waterfallClient) GetForkSchedule(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v1.WaterfallForkScheduleResponse, error) {
+	out := new(v1.WaterfallForkScheduleResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.service.Waterfall/GetForkSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *waterfallClient) GetStateCoordination(ctx context.Context, in *v1.StateRequest, opts ...grpc.CallOption) (*v1.StateCoordinationResponse, error) {
+	out := new(v1.StateCoordinationResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.service.Waterfall/GetStateCoordination", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *waterfallClient) GetGwatEndpoints(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v1.GwatEndpointsResponse, error) {
+	out := new(v1.GwatEndpointsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.service.Waterfall/GetGwatEndpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *waterfallClient) GetOperationLifecycle(ctx context.Context, in *v1.OperationLifecycleRequest, opts ...grpc.CallOption) (*v1.OperationLifecycleResponse, error) {
+	out := new(v1.OperationLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.service.Waterfall/GetOperationLifecycle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *waterfallClient) GetSpineLifecycle(ctx context.Context, in *v1.SpineLifecycleRequest, opts ...grpc.CallOption) (*v1.SpineLifecycleResponse, error) {
+	out := new(v1.SpineLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.service.Waterfall/GetSpineLifecycle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *waterfallClient) GetEpochRewards(ctx context.Context, in *v1.EpochRewardsRequest, opts ...grpc.CallOption) (*v1.RewardsResponse, error) {
+	out := new(v1.RewardsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.service.Waterfall/GetEpochRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *waterfallClient) GetBlockRewards(ctx context.Context, in *v1.BlockRewardsRequest, opts ...grpc.CallOption) (*v1.RewardsResponse, error) {
+	out := new(v1.RewardsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.service.Waterfall/GetBlockRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *waterfallClient) ListPoolPrevotes(ctx context.Context, in *v1.PrevotesPoolRequest, opts ...grpc.CallOption) (*v1.PrevotesPoolResponse, error) {
+	out := new(v1.PrevotesPoolResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.service.Waterfall/ListPoolPrevotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *waterfallClient) SubmitPrevotes(ctx context.Context, in *v1.SubmitPrevotesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.service.Waterfall/SubmitPrevotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *waterfallClient) GetPrevoteData(ctx context.Context, in *v1.PrevoteDataRequest, opts ...grpc.CallOption) (*v1.PrevoteDataResponse, error) {
+	out := new(v1.PrevoteDataResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.service.Waterfall/GetPrevoteData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WaterfallServer is the server API for Waterfall service.
+type WaterfallServer interface {
+	GetGwatFinalization(context.Context, *emptypb.Empty) (*v1.GwatFinalizationResponse, error)
+	GetForkSchedule(context.Context, *emptypb.Empty) (*v1.WaterfallForkScheduleResponse, error)
+	GetStateCoordination(context.Context, *v1.StateRequest) (*v1.StateCoordinationResponse, error)
+	GetGwatEndpoints(context.Context, *emptypb.Empty) (*v1.GwatEndpointsResponse, error)
+	GetOperationLifecycle(context.Context, *v1.OperationLifecycleRequest) (*v1.OperationLifecycleResponse, error)
+	GetSpineLifecycle(context.Context, *v1.SpineLifecycleRequest) (*v1.SpineLifecycleResponse, error)
+	GetEpochRewards(context.Context, *v1.EpochRewardsRequest) (*v1.RewardsResponse, error)
+	GetBlockRewards(context.Context, *v1.BlockRewardsRequest) (*v1.RewardsResponse, error)
+	ListPoolPrevotes(context.Context, *v1.PrevotesPoolRequest) (*v1.PrevotesPoolResponse, error)
+	SubmitPrevotes(context.Context, *v1.SubmitPrevotesRequest) (*emptypb.Empty, error)
+	GetPrevoteData(context.Context, *v1.PrevoteDataRequest) (*v1.PrevoteDataResponse, error)
+}
+
+// UnimplementedWaterfallServer can be embedded to have forward compatible implementations.
+type UnimplementedWaterfallServer struct {
+}
+
+func (*UnimplementedWaterfallServer) GetGwatFinalization(context.Context, *emptypb.Empty) (*v1.GwatFinalizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGwatFinalization not implemented")
+}
+func (*UnimplementedWaterfallServer) GetForkSchedule(context.Context, *emptypb.Empty) (*v1.WaterfallForkScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForkSchedule not implemented")
+}
+func (*UnimplementedWaterfallServer) GetStateCoordination(context.Context, *v1.StateRequest) (*v1.StateCoordinationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStateCoordination not implemented")
+}
+func (*UnimplementedWaterfallServer) GetGwatEndpoints(context.Context, *emptypb.Empty) (*v1.GwatEndpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGwatEndpoints not implemented")
+}
+func (*UnimplementedWaterfallServer) GetOperationLifecycle(context.Context, *v1.OperationLifecycleRequest) (*v1.OperationLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperationLifecycle not implemented")
+}
+func (*UnimplementedWaterfallServer) GetSpineLifecycle(context.Context, *v1.SpineLifecycleRequest) (*v1.SpineLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpineLifecycle not implemented")
+}
+func (*UnimplementedWaterfallServer) GetEpochRewards(context.Context, *v1.EpochRewardsRequest) (*v1.RewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEpochRewards not implemented")
+}
+func (*UnimplementedWaterfallServer) GetBlockRewards(context.Context, *v1.BlockRewardsRequest) (*v1.RewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockRewards not implemented")
+}
+func (*UnimplementedWaterfallServer) ListPoolPrevotes(context.Context, *v1.PrevotesPoolRequest) (*v1.PrevotesPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPoolPrevotes not implemented")
+}
+func (*UnimplementedWaterfallServer) SubmitPrevotes(context.Context, *v1.SubmitPrevotesRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitPrevotes not implemented")
+}
+func (*UnimplementedWaterfallServer) GetPrevoteData(context.Context, *v1.PrevoteDataRequest) (*v1.PrevoteDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrevoteData not implemented")
+}
+
+func RegisterWaterfallServer(s *grpc.Server, srv WaterfallServer) {
+	s.RegisterService(&_Waterfall_serviceDesc, srv)
+}
+
+func _Waterfall_GetGwatFinalization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WaterfallServer).GetGwatFinalization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.service.Waterfall/GetGwatFinalization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WaterfallServer).GetGwatFinalization(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Waterfall_GetForkSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WaterfallServer).GetForkSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.service.Waterfall/GetForkSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WaterfallServer).GetForkSchedule(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Waterfall_GetStateCoordination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.StateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WaterfallServer).GetStateCoordination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.service.Waterfall/GetStateCoordination",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WaterfallServer).GetStateCoordination(ctx, req.(*v1.StateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Waterfall_GetGwatEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WaterfallServer).GetGwatEndpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.service.Waterfall/GetGwatEndpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WaterfallServer).GetGwatEndpoints(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Waterfall_GetOperationLifecycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.OperationLifecycleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WaterfallServer).GetOperationLifecycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.service.Waterfall/GetOperationLifecycle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WaterfallServer).GetOperationLifecycle(ctx, req.(*v1.OperationLifecycleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Waterfall_GetSpineLifecycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.SpineLifecycleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WaterfallServer).GetSpineLifecycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.service.Waterfall/GetSpineLifecycle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WaterfallServer).GetSpineLifecycle(ctx, req.(*v1.SpineLifecycleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Waterfall_GetEpochRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.EpochRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WaterfallServer).GetEpochRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.service.Waterfall/GetEpochRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WaterfallServer).GetEpochRewards(ctx, req.(*v1.EpochRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Waterfall_GetBlockRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.BlockRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WaterfallServer).GetBlockRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.service.Waterfall/GetBlockRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WaterfallServer).GetBlockRewards(ctx, req.(*v1.BlockRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Waterfall_ListPoolPrevotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.PrevotesPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WaterfallServer).ListPoolPrevotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.service.Waterfall/ListPoolPrevotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WaterfallServer).ListPoolPrevotes(ctx, req.(*v1.PrevotesPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Waterfall_SubmitPrevotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.SubmitPrevotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WaterfallServer).SubmitPrevotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.service.Waterfall/SubmitPrevotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WaterfallServer).SubmitPrevotes(ctx, req.(*v1.SubmitPrevotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Waterfall_GetPrevoteData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.PrevoteDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WaterfallServer).GetPrevoteData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.service.Waterfall/GetPrevoteData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WaterfallServer).GetPrevoteData(ctx, req.(*v1.PrevoteDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Waterfall_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.eth.service.Waterfall",
+	HandlerType: (*WaterfallServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetGwatFinalization",
+			Handler:    _Waterfall_GetGwatFinalization_Handler,
+		},
+		{
+			MethodName: "GetForkSchedule",
+			Handler:    _Waterfall_GetForkSchedule_Handler,
+		},
+		{
+			MethodName: "GetStateCoordination",
+			Handler:    _Waterfall_GetStateCoordination_Handler,
+		},
+		{
+			MethodName: "GetGwatEndpoints",
+			Handler:    _Waterfall_GetGwatEndpoints_Handler,
+		},
+		{
+			MethodName: "GetOperationLifecycle",
+			Handler:    _Waterfall_GetOperationLifecycle_Handler,
+		},
+		{
+			MethodName: "GetSpineLifecycle",
+			Handler:    _Waterfall_GetSpineLifecycle_Handler,
+		},
+		{
+			MethodName: "GetEpochRewards",
+			Handler:    _Waterfall_GetEpochRewards_Handler,
+		},
+		{
+			MethodName: "GetBlockRewards",
+			Handler:    _Waterfall_GetBlockRewards_Handler,
+		},
+		{
+			MethodName: "ListPoolPrevotes",
+			Handler:    _Waterfall_ListPoolPrevotes_Handler,
+		},
+		{
+			MethodName: "SubmitPrevotes",
+			Handler:    _Waterfall_SubmitPrevotes_Handler,
+		},
+		{
+			MethodName: "GetPrevoteData",
+			Handler:    _Waterfall_GetPrevoteData_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/eth/service/waterfall_service.proto",
+}
+
+// WaterfallDebugClient is the client API for WaterfallDebug service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WaterfallDebugClient interface {
+	GetPrevoteDecision(ctx context.Context, in *v1.PrevoteDecisionRequest, opts ...grpc.CallOption) (*v1.PrevoteDecisionResponse, error)
+}
+
+type waterfallDebugClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWaterfallDebugClient(cc grpc.ClientConnInterface) WaterfallDebugClient {
+	return &waterfallDebugClient{cc}
+}
+
+func (c *waterfallDebugClient) GetPrevoteDecision(ctx context.Context, in *v1.PrevoteDecisionRequest, opts ...grpc.CallOption) (*v1.PrevoteDecisionResponse, error) {
+	out := new(v1.PrevoteDecisionResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.service.WaterfallDebug/GetPrevoteDecision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WaterfallDebugServer is the server API for WaterfallDebug service.
+type WaterfallDebugServer interface {
+	GetPrevoteDecision(context.Context, *v1.PrevoteDecisionRequest) (*v1.PrevoteDecisionResponse, error)
+}
+
+// UnimplementedWaterfallDebugServer can be embedded to have forward compatible implementations.
+type UnimplementedWaterfallDebugServer struct {
+}
+
+func (*UnimplementedWaterfallDebugServer) GetPrevoteDecision(context.Context, *v1.PrevoteDecisionRequest) (*v1.PrevoteDecisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrevoteDecision not implemented")
+}
+
+func RegisterWaterfallDebugServer(s *grpc.Server, srv WaterfallDebugServer) {
+	s.RegisterService(&_WaterfallDebug_serviceDesc, srv)
+}
+
+func _WaterfallDebug_GetPrevoteDecision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.PrevoteDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WaterfallDebugServer).GetPrevoteDecision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.service.WaterfallDebug/GetPrevoteDecision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WaterfallDebugServer).GetPrevoteDecision(ctx, req.(*v1.PrevoteDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _WaterfallDebug_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.eth.service.WaterfallDebug",
+	HandlerType: (*WaterfallDebugServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPrevoteDecision",
+			Handler:    _WaterfallDebug_GetPrevoteDecision_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/eth/service/waterfall_service.proto",
+}
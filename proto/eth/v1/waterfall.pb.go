@@ -0,0 +1,3287 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.15.8
+// source: proto/eth/v1/waterfall.proto
+
+package v1
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	github_com_prysmaticlabs_eth2_types "github.com/prysmaticlabs/eth2-types"
+	github_com_prysmaticlabs_go_bitfield "github.com/prysmaticlabs/go-bitfield"
+	_ "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/ext"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GwatFinalizationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data *GwatFinalizationStatus `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GwatFinalizationResponse) Reset() {
+	*x = GwatFinalizationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GwatFinalizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GwatFinalizationResponse) ProtoMessage() {}
+
+func (x *GwatFinalizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GwatFinalizationResponse.ProtoReflect.Descriptor instead.
+func (*GwatFinalizationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GwatFinalizationResponse) GetData() *GwatFinalizationStatus {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The status of the coordinator to gwat finalization link.
+type GwatFinalizationStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The params and the result of the last gwat finalization call.
+	LastFinalization *GwatFinalization `protobuf:"bytes,1,opt,name=last_finalization,json=lastFinalization,proto3" json:"last_finalization,omitempty"`
+	// The cached gwat coordinated checkpoint.
+	CoordinatedState *GwatCheckpoint `protobuf:"bytes,2,opt,name=coordinated_state,json=coordinatedState,proto3" json:"coordinated_state,omitempty"`
+	// True while gwat synchronization is running.
+	IsSyncing bool `protobuf:"varint,3,opt,name=is_syncing,json=isSyncing,proto3" json:"is_syncing,omitempty"`
+	// True while gwat finalization repairing is running.
+	IsRepairing bool `protobuf:"varint,4,opt,name=is_repairing,json=isRepairing,proto3" json:"is_repairing,omitempty"`
+	// The current stage of gwat finalization recovery.
+	RecoveryStage string `protobuf:"bytes,5,opt,name=recovery_stage,json=recoveryStage,proto3" json:"recovery_stage,omitempty"`
+	// The number of consecutive recovery attempts.
+	RecoveryAttempts uint64 `protobuf:"varint,6,opt,name=recovery_attempts,json=recoveryAttempts,proto3" json:"recovery_attempts,omitempty"`
+}
+
+func (x *GwatFinalizationStatus) Reset() {
+	*x = GwatFinalizationStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GwatFinalizationStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GwatFinalizationStatus) ProtoMessage() {}
+
+func (x *GwatFinalizationStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GwatFinalizationStatus.ProtoReflect.Descriptor instead.
+func (*GwatFinalizationStatus) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GwatFinalizationStatus) GetLastFinalization() *GwatFinalization {
+	if x != nil {
+		return x.LastFinalization
+	}
+	return nil
+}
+
+func (x *GwatFinalizationStatus) GetCoordinatedState() *GwatCheckpoint {
+	if x != nil {
+		return x.CoordinatedState
+	}
+	return nil
+}
+
+func (x *GwatFinalizationStatus) GetIsSyncing() bool {
+	if x != nil {
+		return x.IsSyncing
+	}
+	return false
+}
+
+func (x *GwatFinalizationStatus) GetIsRepairing() bool {
+	if x != nil {
+		return x.IsRepairing
+	}
+	return false
+}
+
+func (x *GwatFinalizationStatus) GetRecoveryStage() string {
+	if x != nil {
+		return x.RecoveryStage
+	}
+	return ""
+}
+
+func (x *GwatFinalizationStatus) GetRecoveryAttempts() uint64 {
+	if x != nil {
+		return x.RecoveryAttempts
+	}
+	return 0
+}
+
+// The params and the result of a gwat finalization call.
+type GwatFinalization struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot     github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.Slot"`
+	SyncMode string                                   `protobuf:"bytes,2,opt,name=sync_mode,json=syncMode,proto3" json:"sync_mode,omitempty"`
+	// 32 byte hashes of the spines sent to finalization.
+	Spines     [][]byte        `protobuf:"bytes,3,rep,name=spines,proto3" json:"spines,omitempty"`
+	BaseSpine  []byte          `protobuf:"bytes,4,opt,name=base_spine,json=baseSpine,proto3" json:"base_spine,omitempty"`
+	Checkpoint *GwatCheckpoint `protobuf:"bytes,5,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	// 32 byte hash of the last finalized spine returned by gwat.
+	LfSpine []byte `protobuf:"bytes,6,opt,name=lf_spine,json=lfSpine,proto3" json:"lf_spine,omitempty"`
+	Success bool   `protobuf:"varint,7,opt,name=success,proto3" json:"success,omitempty"`
+	Error   string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	// Unix time of the call in seconds.
+	Time uint64 `protobuf:"varint,9,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *GwatFinalization) Reset() {
+	*x = GwatFinalization{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GwatFinalization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GwatFinalization) ProtoMessage() {}
+
+func (x *GwatFinalization) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GwatFinalization.ProtoReflect.Descriptor instead.
+func (*GwatFinalization) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GwatFinalization) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if x != nil {
+		return x.Slot
+	}
+	return github_com_prysmaticlabs_eth2_types.Slot(0)
+}
+
+func (x *GwatFinalization) GetSyncMode() string {
+	if x != nil {
+		return x.SyncMode
+	}
+	return ""
+}
+
+func (x *GwatFinalization) GetSpines() [][]byte {
+	if x != nil {
+		return x.Spines
+	}
+	return nil
+}
+
+func (x *GwatFinalization) GetBaseSpine() []byte {
+	if x != nil {
+		return x.BaseSpine
+	}
+	return nil
+}
+
+func (x *GwatFinalization) GetCheckpoint() *GwatCheckpoint {
+	if x != nil {
+		return x.Checkpoint
+	}
+	return nil
+}
+
+func (x *GwatFinalization) GetLfSpine() []byte {
+	if x != nil {
+		return x.LfSpine
+	}
+	return nil
+}
+
+func (x *GwatFinalization) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GwatFinalization) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GwatFinalization) GetTime() uint64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+type GwatCheckpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch    github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.Epoch"`
+	FinEpoch github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,2,opt,name=fin_epoch,json=finEpoch,proto3" json:"fin_epoch,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.Epoch"`
+	Root     []byte                                    `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty" ssz-size:"32"`
+	Spine    []byte                                    `protobuf:"bytes,4,opt,name=spine,proto3" json:"spine,omitempty" ssz-size:"32"`
+}
+
+func (x *GwatCheckpoint) Reset() {
+	*x = GwatCheckpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GwatCheckpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GwatCheckpoint) ProtoMessage() {}
+
+func (x *GwatCheckpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GwatCheckpoint.ProtoReflect.Descriptor instead.
+func (*GwatCheckpoint) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GwatCheckpoint) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if x != nil {
+		return x.Epoch
+	}
+	return github_com_prysmaticlabs_eth2_types.Epoch(0)
+}
+
+func (x *GwatCheckpoint) GetFinEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if x != nil {
+		return x.FinEpoch
+	}
+	return github_com_prysmaticlabs_eth2_types.Epoch(0)
+}
+
+func (x *GwatCheckpoint) GetRoot() []byte {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *GwatCheckpoint) GetSpine() []byte {
+	if x != nil {
+		return x.Spine
+	}
+	return nil
+}
+
+type WaterfallForkScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data *WaterfallForkSchedule `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *WaterfallForkScheduleResponse) Reset() {
+	*x = WaterfallForkScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaterfallForkScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaterfallForkScheduleResponse) ProtoMessage() {}
+
+func (x *WaterfallForkScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaterfallForkScheduleResponse.ProtoReflect.Descriptor instead.
+func (*WaterfallForkScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WaterfallForkScheduleResponse) GetData() *WaterfallForkSchedule {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The registry of the Waterfall forks activated by slot.
+type WaterfallForkSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Forks []*WaterfallFork `protobuf:"bytes,1,rep,name=forks,proto3" json:"forks,omitempty"`
+	// 4 byte digest of the forks exchanged by peers after the status handshake.
+	Digest []byte `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty" ssz-size:"4"`
+}
+
+func (x *WaterfallForkSchedule) Reset() {
+	*x = WaterfallForkSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaterfallForkSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaterfallForkSchedule) ProtoMessage() {}
+
+func (x *WaterfallForkSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaterfallForkSchedule.ProtoReflect.Descriptor instead.
+func (*WaterfallForkSchedule) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WaterfallForkSchedule) GetForks() []*WaterfallFork {
+	if x != nil {
+		return x.Forks
+	}
+	return nil
+}
+
+func (x *WaterfallForkSchedule) GetDigest() []byte {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+type WaterfallFork struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string                                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Slot  github_com_prysmaticlabs_eth2_types.Slot  `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.Slot"`
+	Epoch github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.Epoch"`
+}
+
+func (x *WaterfallFork) Reset() {
+	*x = WaterfallFork{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaterfallFork) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaterfallFork) ProtoMessage() {}
+
+func (x *WaterfallFork) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaterfallFork.ProtoReflect.Descriptor instead.
+func (*WaterfallFork) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WaterfallFork) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WaterfallFork) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if x != nil {
+		return x.Slot
+	}
+	return github_com_prysmaticlabs_eth2_types.Slot(0)
+}
+
+func (x *WaterfallFork) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if x != nil {
+		return x.Epoch
+	}
+	return github_com_prysmaticlabs_eth2_types.Epoch(0)
+}
+
+type StateCoordinationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data *StateCoordination `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *StateCoordinationResponse) Reset() {
+	*x = StateCoordinationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateCoordinationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateCoordinationResponse) ProtoMessage() {}
+
+func (x *StateCoordinationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateCoordinationResponse.ProtoReflect.Descriptor instead.
+func (*StateCoordinationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StateCoordinationResponse) GetData() *StateCoordination {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The data coordinating a state with gwat, which is required to start a node from the state by checkpoint sync.
+type StateCoordination struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.Slot"`
+	// 32 byte root of the block the state integrates.
+	BlockRoot      []byte          `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty" ssz-size:"32"`
+	SpineData      *SpineDataKeys  `protobuf:"bytes,3,opt,name=spine_data,json=spineData,proto3" json:"spine_data,omitempty"`
+	GwatCheckpoint *GwatCheckpoint `protobuf:"bytes,4,opt,name=gwat_checkpoint,json=gwatCheckpoint,proto3" json:"gwat_checkpoint,omitempty"`
+	// The unique non-empty spines lists the state refers to.
+	Spines []*SpinesList `protobuf:"bytes,5,rep,name=spines,proto3" json:"spines,omitempty"`
+}
+
+func (x *StateCoordination) Reset() {
+	*x = StateCoordination{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateCoordination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateCoordination) ProtoMessage() {}
+
+func (x *StateCoordination) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateCoordination.ProtoReflect.Descriptor instead.
+func (*StateCoordination) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StateCoordination) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if x != nil {
+		return x.Slot
+	}
+	return github_com_prysmaticlabs_eth2_types.Slot(0)
+}
+
+func (x *StateCoordination) GetBlockRoot() []byte {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return nil
+}
+
+func (x *StateCoordination) GetSpineData() *SpineDataKeys {
+	if x != nil {
+		return x.SpineData
+	}
+	return nil
+}
+
+func (x *StateCoordination) GetGwatCheckpoint() *GwatCheckpoint {
+	if x != nil {
+		return x.GwatCheckpoint
+	}
+	return nil
+}
+
+func (x *StateCoordination) GetSpines() []*SpinesList {
+	if x != nil {
+		return x.Spines
+	}
+	return nil
+}
+
+// The SpineData of a state by the keys of its spines lists.
+type SpineDataKeys struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Spines       []byte `protobuf:"bytes,1,opt,name=spines,proto3" json:"spines,omitempty" ssz-size:"32"`
+	Prefix       []byte `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty" ssz-size:"32"`
+	Finalization []byte `protobuf:"bytes,3,opt,name=finalization,proto3" json:"finalization,omitempty" ssz-size:"32"`
+	CpFinalized  []byte `protobuf:"bytes,4,opt,name=cp_finalized,json=cpFinalized,proto3" json:"cp_finalized,omitempty" ssz-size:"32"`
+}
+
+func (x *SpineDataKeys) Reset() {
+	*x = SpineDataKeys{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpineDataKeys) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpineDataKeys) ProtoMessage() {}
+
+func (x *SpineDataKeys) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpineDataKeys.ProtoReflect.Descriptor instead.
+func (*SpineDataKeys) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SpineDataKeys) GetSpines() []byte {
+	if x != nil {
+		return x.Spines
+	}
+	return nil
+}
+
+func (x *SpineDataKeys) GetPrefix() []byte {
+	if x != nil {
+		return x.Prefix
+	}
+	return nil
+}
+
+func (x *SpineDataKeys) GetFinalization() []byte {
+	if x != nil {
+		return x.Finalization
+	}
+	return nil
+}
+
+func (x *SpineDataKeys) GetCpFinalized() []byte {
+	if x != nil {
+		return x.CpFinalized
+	}
+	return nil
+}
+
+type SpinesList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key  []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty" ssz-size:"32"`
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *SpinesList) Reset() {
+	*x = SpinesList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpinesList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpinesList) ProtoMessage() {}
+
+func (x *SpinesList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpinesList.ProtoReflect.Descriptor instead.
+func (*SpinesList) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SpinesList) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *SpinesList) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GwatEndpointsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*GwatEndpoint `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GwatEndpointsResponse) Reset() {
+	*x = GwatEndpointsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GwatEndpointsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GwatEndpointsResponse) ProtoMessage() {}
+
+func (x *GwatEndpointsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GwatEndpointsResponse.ProtoReflect.Descriptor instead.
+func (*GwatEndpointsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GwatEndpointsResponse) GetData() []*GwatEndpoint {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The health and the consistency of a configured gwat endpoint.
+type GwatEndpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The endpoint url with masked credentials.
+	Url              string                                    `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Current          bool                                      `protobuf:"varint,2,opt,name=current,proto3" json:"current,omitempty"`
+	Checked          bool                                      `protobuf:"varint,3,opt,name=checked,proto3" json:"checked,omitempty"`
+	Healthy          bool                                      `protobuf:"varint,4,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	Consistent       bool                                      `protobuf:"varint,5,opt,name=consistent,proto3" json:"consistent,omitempty"`
+	HealthScore      int64                                     `protobuf:"varint,6,opt,name=health_score,json=healthScore,proto3" json:"health_score,omitempty"`
+	ConsistencyScore int64                                     `protobuf:"varint,7,opt,name=consistency_score,json=consistencyScore,proto3" json:"consistency_score,omitempty"`
+	LfSpine          []byte                                    `protobuf:"bytes,8,opt,name=lf_spine,json=lfSpine,proto3" json:"lf_spine,omitempty"`
+	CpEpoch          github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,9,opt,name=cp_epoch,json=cpEpoch,proto3" json:"cp_epoch,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.Epoch"`
+	CpRoot           []byte                                    `protobuf:"bytes,10,opt,name=cp_root,json=cpRoot,proto3" json:"cp_root,omitempty"`
+	Error            string                                    `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *GwatEndpoint) Reset() {
+	*x = GwatEndpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GwatEndpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GwatEndpoint) ProtoMessage() {}
+
+func (x *GwatEndpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GwatEndpoint.ProtoReflect.Descriptor instead.
+func (*GwatEndpoint) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GwatEndpoint) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GwatEndpoint) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+func (x *GwatEndpoint) GetChecked() bool {
+	if x != nil {
+		return x.Checked
+	}
+	return false
+}
+
+func (x *GwatEndpoint) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *GwatEndpoint) GetConsistent() bool {
+	if x != nil {
+		return x.Consistent
+	}
+	return false
+}
+
+func (x *GwatEndpoint) GetHealthScore() int64 {
+	if x != nil {
+		return x.HealthScore
+	}
+	return 0
+}
+
+func (x *GwatEndpoint) GetConsistencyScore() int64 {
+	if x != nil {
+		return x.ConsistencyScore
+	}
+	return 0
+}
+
+func (x *GwatEndpoint) GetLfSpine() []byte {
+	if x != nil {
+		return x.LfSpine
+	}
+	return nil
+}
+
+func (x *GwatEndpoint) GetCpEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if x != nil {
+		return x.CpEpoch
+	}
+	return github_com_prysmaticlabs_eth2_types.Epoch(0)
+}
+
+func (x *GwatEndpoint) GetCpRoot() []byte {
+	if x != nil {
+		return x.CpRoot
+	}
+	return nil
+}
+
+func (x *GwatEndpoint) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type OperationLifecycleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 32 byte hash of the gwat transaction initiating the operation.
+	TxHash []byte `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (x *OperationLifecycleRequest) Reset() {
+	*x = OperationLifecycleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationLifecycleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationLifecycleRequest) ProtoMessage() {}
+
+func (x *OperationLifecycleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationLifecycleRequest.ProtoReflect.Descriptor instead.
+func (*OperationLifecycleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *OperationLifecycleRequest) GetTxHash() []byte {
+	if x != nil {
+		return x.TxHash
+	}
+	return nil
+}
+
+type OperationLifecycleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data *OperationLifecycle `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *OperationLifecycleResponse) Reset() {
+	*x = OperationLifecycleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationLifecycleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationLifecycleResponse) ProtoMessage() {}
+
+func (x *OperationLifecycleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationLifecycleResponse.ProtoReflect.Descriptor instead.
+func (*OperationLifecycleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *OperationLifecycleResponse) GetData() *OperationLifecycle {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The lifecycle of a withdrawal or an exit initiated by a gwat transaction.
+type OperationLifecycle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InitTxHash     []byte                                             `protobuf:"bytes,1,opt,name=init_tx_hash,json=initTxHash,proto3" json:"init_tx_hash,omitempty" ssz-size:"32"`
+	Type           string                                             `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	ValidatorIndex github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,3,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.ValidatorIndex"`
+	Amount         uint64                                             `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status         string                                             `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Records        []*LifecycleRecord                                 `protobuf:"bytes,6,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (x *OperationLifecycle) Reset() {
+	*x = OperationLifecycle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationLifecycle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationLifecycle) ProtoMessage() {}
+
+func (x *OperationLifecycle) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationLifecycle.ProtoReflect.Descriptor instead.
+func (*OperationLifecycle) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *OperationLifecycle) GetInitTxHash() []byte {
+	if x != nil {
+		return x.InitTxHash
+	}
+	return nil
+}
+
+func (x *OperationLifecycle) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *OperationLifecycle) GetValidatorIndex() github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if x != nil {
+		return x.ValidatorIndex
+	}
+	return github_com_prysmaticlabs_eth2_types.ValidatorIndex(0)
+}
+
+func (x *OperationLifecycle) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *OperationLifecycle) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OperationLifecycle) GetRecords() []*LifecycleRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+// A lifecycle stage reached by an operation or a spine.
+type LifecycleRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stage string                                   `protobuf:"bytes,1,opt,name=stage,proto3" json:"stage,omitempty"`
+	Slot  github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.Slot"`
+	// 32 byte root of the block of the stage, empty for the stages not related to a block.
+	BlockRoot []byte `protobuf:"bytes,3,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+}
+
+func (x *LifecycleRecord) Reset() {
+	*x = LifecycleRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LifecycleRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LifecycleRecord) ProtoMessage() {}
+
+func (x *LifecycleRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LifecycleRecord.ProtoReflect.Descriptor instead.
+func (*LifecycleRecord) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *LifecycleRecord) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *LifecycleRecord) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if x != nil {
+		return x.Slot
+	}
+	return github_com_prysmaticlabs_eth2_types.Slot(0)
+}
+
+func (x *LifecycleRecord) GetBlockRoot() []byte {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return nil
+}
+
+type SpineLifecycleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 32 byte hash of the gwat spine.
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *SpineLifecycleRequest) Reset() {
+	*x = SpineLifecycleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpineLifecycleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpineLifecycleRequest) ProtoMessage() {}
+
+func (x *SpineLifecycleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpineLifecycleRequest.ProtoReflect.Descriptor instead.
+func (*SpineLifecycleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SpineLifecycleRequest) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+type SpineLifecycleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data *SpineLifecycle `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *SpineLifecycleResponse) Reset() {
+	*x = SpineLifecycleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpineLifecycleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpineLifecycleResponse) ProtoMessage() {}
+
+func (x *SpineLifecycleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpineLifecycleResponse.ProtoReflect.Descriptor instead.
+func (*SpineLifecycleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SpineLifecycleResponse) GetData() *SpineLifecycle {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The coordinator finality of a gwat spine.
+type SpineLifecycle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash    []byte             `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty" ssz-size:"32"`
+	Status  string             `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Records []*LifecycleRecord `protobuf:"bytes,3,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (x *SpineLifecycle) Reset() {
+	*x = SpineLifecycle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpineLifecycle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpineLifecycle) ProtoMessage() {}
+
+func (x *SpineLifecycle) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpineLifecycle.ProtoReflect.Descriptor instead.
+func (*SpineLifecycle) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SpineLifecycle) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *SpineLifecycle) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SpineLifecycle) GetRecords() []*LifecycleRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type EpochRewardsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.Epoch"`
+	// The validators to return the balance changes of, all validators if empty.
+	ValidatorIndex []github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,2,rep,packed,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.ValidatorIndex"`
+}
+
+func (x *EpochRewardsRequest) Reset() {
+	*x = EpochRewardsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochRewardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochRewardsRequest) ProtoMessage() {}
+
+func (x *EpochRewardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpochRewardsRequest.ProtoReflect.Descriptor instead.
+func (*EpochRewardsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *EpochRewardsRequest) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if x != nil {
+		return x.Epoch
+	}
+	return github_com_prysmaticlabs_eth2_types.Epoch(0)
+}
+
+func (x *EpochRewardsRequest) GetValidatorIndex() []github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if x != nil {
+		return x.ValidatorIndex
+	}
+	return []github_com_prysmaticlabs_eth2_types.ValidatorIndex(nil)
+}
+
+type BlockRewardsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The block id which can be a slot or a 32 byte block root.
+	BlockId []byte `protobuf:"bytes,1,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	// The validators to return the balance changes of, all validators if empty.
+	ValidatorIndex []github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,2,rep,packed,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.ValidatorIndex"`
+}
+
+func (x *BlockRewardsRequest) Reset() {
+	*x = BlockRewardsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockRewardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockRewardsRequest) ProtoMessage() {}
+
+func (x *BlockRewardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockRewardsRequest.ProtoReflect.Descriptor instead.
+func (*BlockRewardsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BlockRewardsRequest) GetBlockId() []byte {
+	if x != nil {
+		return x.BlockId
+	}
+	return nil
+}
+
+func (x *BlockRewardsRequest) GetValidatorIndex() []github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if x != nil {
+		return x.ValidatorIndex
+	}
+	return []github_com_prysmaticlabs_eth2_types.ValidatorIndex(nil)
+}
+
+type RewardsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*BalanceChange `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *RewardsResponse) Reset() {
+	*x = RewardsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardsResponse) ProtoMessage() {}
+
+func (x *RewardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardsResponse.ProtoReflect.Descriptor instead.
+func (*RewardsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RewardsResponse) GetData() []*BalanceChange {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// A reward or a penalty applied to the validator balance.
+type BalanceChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValidatorIndex github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.ValidatorIndex"`
+	BlockRoot      []byte                                             `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty" ssz-size:"32"`
+	Slot           github_com_prysmaticlabs_eth2_types.Slot           `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.Slot"`
+	Epoch          github_com_prysmaticlabs_eth2_types.Epoch          `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.Epoch"`
+	Operation      string                                             `protobuf:"bytes,5,opt,name=operation,proto3" json:"operation,omitempty"`
+	Role           string                                             `protobuf:"bytes,6,opt,name=role,proto3" json:"role,omitempty"`
+	Amount         uint64                                             `protobuf:"varint,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	VotesIncluded  []uint64                                           `protobuf:"varint,8,rep,packed,name=votes_included,json=votesIncluded,proto3" json:"votes_included,omitempty"`
+	BalanceBefore  uint64                                             `protobuf:"varint,9,opt,name=balance_before,json=balanceBefore,proto3" json:"balance_before,omitempty"`
+	BalanceAfter   uint64                                             `protobuf:"varint,10,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
+}
+
+func (x *BalanceChange) Reset() {
+	*x = BalanceChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BalanceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceChange) ProtoMessage() {}
+
+func (x *BalanceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceChange.ProtoReflect.Descriptor instead.
+func (*BalanceChange) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BalanceChange) GetValidatorIndex() github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if x != nil {
+		return x.ValidatorIndex
+	}
+	return github_com_prysmaticlabs_eth2_types.ValidatorIndex(0)
+}
+
+func (x *BalanceChange) GetBlockRoot() []byte {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return nil
+}
+
+func (x *BalanceChange) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if x != nil {
+		return x.Slot
+	}
+	return github_com_prysmaticlabs_eth2_types.Slot(0)
+}
+
+func (x *BalanceChange) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if x != nil {
+		return x.Epoch
+	}
+	return github_com_prysmaticlabs_eth2_types.Epoch(0)
+}
+
+func (x *BalanceChange) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *BalanceChange) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *BalanceChange) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *BalanceChange) GetVotesIncluded() []uint64 {
+	if x != nil {
+		return x.VotesIncluded
+	}
+	return nil
+}
+
+func (x *BalanceChange) GetBalanceBefore() uint64 {
+	if x != nil {
+		return x.BalanceBefore
+	}
+	return 0
+}
+
+func (x *BalanceChange) GetBalanceAfter() uint64 {
+	if x != nil {
+		return x.BalanceAfter
+	}
+	return 0
+}
+
+type PrevotesPoolRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot *github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,opt,name=slot,proto3,oneof" json:"slot,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.Slot"`
+}
+
+func (x *PrevotesPoolRequest) Reset() {
+	*x = PrevotesPoolRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrevotesPoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrevotesPoolRequest) ProtoMessage() {}
+
+func (x *PrevotesPoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrevotesPoolRequest.ProtoReflect.Descriptor instead.
+func (*PrevotesPoolRequest) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PrevotesPoolRequest) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if x != nil && x.Slot != nil {
+		return *x.Slot
+	}
+	return github_com_prysmaticlabs_eth2_types.Slot(0)
+}
+
+type PrevotesPoolResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*Prevote `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *PrevotesPoolResponse) Reset() {
+	*x = PrevotesPoolResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrevotesPoolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrevotesPoolResponse) ProtoMessage() {}
+
+func (x *PrevotesPoolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrevotesPoolResponse.ProtoReflect.Descriptor instead.
+func (*PrevotesPoolResponse) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PrevotesPoolResponse) GetData() []*Prevote {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SubmitPrevotesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*Prevote `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *SubmitPrevotesRequest) Reset() {
+	*x = SubmitPrevotesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitPrevotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitPrevotesRequest) ProtoMessage() {}
+
+func (x *SubmitPrevotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitPrevotesRequest.ProtoReflect.Descriptor instead.
+func (*SubmitPrevotesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SubmitPrevotesRequest) GetData() []*Prevote {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type Prevote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AggregationBits github_com_prysmaticlabs_go_bitfield.Bitlist `protobuf:"bytes,1,opt,name=aggregation_bits,json=aggregationBits,proto3" json:"aggregation_bits,omitempty" cast-type:"github.com/prysmaticlabs/go-bitfield.Bitlist" ssz-max:"2048"`
+	Data            *PrevoteData                                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// 96 byte BLS aggregate signature.
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty" ssz-size:"96"`
+}
+
+func (x *Prevote) Reset() {
+	*x = Prevote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Prevote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Prevote) ProtoMessage() {}
+
+func (x *Prevote) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Prevote.ProtoReflect.Descriptor instead.
+func (*Prevote) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Prevote) GetAggregationBits() github_com_prysmaticlabs_go_bitfield.Bitlist {
+	if x != nil {
+		return x.AggregationBits
+	}
+	return github_com_prysmaticlabs_go_bitfield.Bitlist(nil)
+}
+
+func (x *Prevote) GetData() *PrevoteData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Prevote) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type PrevoteData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.Slot"`
+	// The committee index that submitted this prevote.
+	Index github_com_prysmaticlabs_eth2_types.CommitteeIndex `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.CommitteeIndex"`
+	// The 32 byte array of hashes presented sequence of the GWAT spines candidates.
+	Candidates []byte `protobuf:"bytes,3,opt,name=candidates,proto3" json:"candidates,omitempty" ssz-max:"4096"`
+}
+
+func (x *PrevoteData) Reset() {
+	*x = PrevoteData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrevoteData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrevoteData) ProtoMessage() {}
+
+func (x *PrevoteData) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrevoteData.ProtoReflect.Descriptor instead.
+func (*PrevoteData) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PrevoteData) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if x != nil {
+		return x.Slot
+	}
+	return github_com_prysmaticlabs_eth2_types.Slot(0)
+}
+
+func (x *PrevoteData) GetIndex() github_com_prysmaticlabs_eth2_types.CommitteeIndex {
+	if x != nil {
+		return x.Index
+	}
+	return github_com_prysmaticlabs_eth2_types.CommitteeIndex(0)
+}
+
+func (x *PrevoteData) GetCandidates() []byte {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+type PrevoteDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot           github_com_prysmaticlabs_eth2_types.Slot           `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.Slot"`
+	CommitteeIndex github_com_prysmaticlabs_eth2_types.CommitteeIndex `protobuf:"varint,2,opt,name=committee_index,json=committeeIndex,proto3" json:"committee_index,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.CommitteeIndex"`
+}
+
+func (x *PrevoteDataRequest) Reset() {
+	*x = PrevoteDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrevoteDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrevoteDataRequest) ProtoMessage() {}
+
+func (x *PrevoteDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrevoteDataRequest.ProtoReflect.Descriptor instead.
+func (*PrevoteDataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PrevoteDataRequest) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if x != nil {
+		return x.Slot
+	}
+	return github_com_prysmaticlabs_eth2_types.Slot(0)
+}
+
+func (x *PrevoteDataRequest) GetCommitteeIndex() github_com_prysmaticlabs_eth2_types.CommitteeIndex {
+	if x != nil {
+		return x.CommitteeIndex
+	}
+	return github_com_prysmaticlabs_eth2_types.CommitteeIndex(0)
+}
+
+type PrevoteDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data *PrevoteData `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *PrevoteDataResponse) Reset() {
+	*x = PrevoteDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrevoteDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrevoteDataResponse) ProtoMessage() {}
+
+func (x *PrevoteDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrevoteDataResponse.ProtoReflect.Descriptor instead.
+func (*PrevoteDataResponse) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PrevoteDataResponse) GetData() *PrevoteData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type PrevoteDecisionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The block id which can be a slot or a 32 byte block root.
+	BlockId []byte `protobuf:"bytes,1,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+}
+
+func (x *PrevoteDecisionRequest) Reset() {
+	*x = PrevoteDecisionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrevoteDecisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrevoteDecisionRequest) ProtoMessage() {}
+
+func (x *PrevoteDecisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrevoteDecisionRequest.ProtoReflect.Descriptor instead.
+func (*PrevoteDecisionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PrevoteDecisionRequest) GetBlockId() []byte {
+	if x != nil {
+		return x.BlockId
+	}
+	return nil
+}
+
+type PrevoteDecisionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data *PrevoteDecision `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *PrevoteDecisionResponse) Reset() {
+	*x = PrevoteDecisionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrevoteDecisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrevoteDecisionResponse) ProtoMessage() {}
+
+func (x *PrevoteDecisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrevoteDecisionResponse.ProtoReflect.Descriptor instead.
+func (*PrevoteDecisionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PrevoteDecisionResponse) GetData() *PrevoteDecision {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The decision of the proposer on the gwat spines candidates of a produced block.
+type PrevoteDecision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty" cast-type:"github.com/prysmaticlabs/eth2-types.Slot"`
+	// 32 byte root of the produced block, empty until the block is signed.
+	BlockRoot  []byte `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	ParentRoot []byte `protobuf:"bytes,3,opt,name=parent_root,json=parentRoot,proto3" json:"parent_root,omitempty" ssz-size:"32"`
+	// The candidates calculated by the gwat optimistic spines.
+	OptimisticCandidates [][]byte             `protobuf:"bytes,4,rep,name=optimistic_candidates,json=optimisticCandidates,proto3" json:"optimistic_candidates,omitempty"`
+	Prevotes             []*ConsideredPrevote `protobuf:"bytes,5,rep,name=prevotes,proto3" json:"prevotes,omitempty"`
+	Chains               []*VotedChain        `protobuf:"bytes,6,rep,name=chains,proto3" json:"chains,omitempty"`
+	Chosen               [][]byte             `protobuf:"bytes,7,rep,name=chosen,proto3" json:"chosen,omitempty"`
+	Reason               string               `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The number of the candidates cut to fit the AllSpinesLimit.
+	SpinesLimitCut int64 `protobuf:"varint,9,opt,name=spines_limit_cut,json=spinesLimitCut,proto3" json:"spines_limit_cut,omitempty"`
+	// The candidates put into the block.
+	Candidates [][]byte `protobuf:"bytes,10,rep,name=candidates,proto3" json:"candidates,omitempty"`
+}
+
+func (x *PrevoteDecision) Reset() {
+	*x = PrevoteDecision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrevoteDecision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrevoteDecision) ProtoMessage() {}
+
+func (x *PrevoteDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrevoteDecision.ProtoReflect.Descriptor instead.
+func (*PrevoteDecision) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PrevoteDecision) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if x != nil {
+		return x.Slot
+	}
+	return github_com_prysmaticlabs_eth2_types.Slot(0)
+}
+
+func (x *PrevoteDecision) GetBlockRoot() []byte {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return nil
+}
+
+func (x *PrevoteDecision) GetParentRoot() []byte {
+	if x != nil {
+		return x.ParentRoot
+	}
+	return nil
+}
+
+func (x *PrevoteDecision) GetOptimisticCandidates() [][]byte {
+	if x != nil {
+		return x.OptimisticCandidates
+	}
+	return nil
+}
+
+func (x *PrevoteDecision) GetPrevotes() []*ConsideredPrevote {
+	if x != nil {
+		return x.Prevotes
+	}
+	return nil
+}
+
+func (x *PrevoteDecision) GetChains() []*VotedChain {
+	if x != nil {
+		return x.Chains
+	}
+	return nil
+}
+
+func (x *PrevoteDecision) GetChosen() [][]byte {
+	if x != nil {
+		return x.Chosen
+	}
+	return nil
+}
+
+func (x *PrevoteDecision) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PrevoteDecision) GetSpinesLimitCut() int64 {
+	if x != nil {
+		return x.SpinesLimitCut
+	}
+	return 0
+}
+
+func (x *PrevoteDecision) GetCandidates() [][]byte {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+// A prevote considered by the proposer.
+type ConsideredPrevote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Candidates [][]byte `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	Votes      uint64   `protobuf:"varint,2,opt,name=votes,proto3" json:"votes,omitempty"`
+	Aggregated bool     `protobuf:"varint,3,opt,name=aggregated,proto3" json:"aggregated,omitempty"`
+	// The length of the prefix of the candidates contained in the optimistic candidates.
+	Accepted int64 `protobuf:"varint,4,opt,name=accepted,proto3" json:"accepted,omitempty"`
+}
+
+func (x *ConsideredPrevote) Reset() {
+	*x = ConsideredPrevote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsideredPrevote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsideredPrevote) ProtoMessage() {}
+
+func (x *ConsideredPrevote) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsideredPrevote.ProtoReflect.Descriptor instead.
+func (*ConsideredPrevote) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ConsideredPrevote) GetCandidates() [][]byte {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+func (x *ConsideredPrevote) GetVotes() uint64 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+func (x *ConsideredPrevote) GetAggregated() bool {
+	if x != nil {
+		return x.Aggregated
+	}
+	return false
+}
+
+func (x *ConsideredPrevote) GetAccepted() int64 {
+	if x != nil {
+		return x.Accepted
+	}
+	return 0
+}
+
+// A subchain of the prevote candidates with its votes weight.
+type VotedChain struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chain [][]byte `protobuf:"bytes,1,rep,name=chain,proto3" json:"chain,omitempty"`
+	Votes uint64   `protobuf:"varint,2,opt,name=votes,proto3" json:"votes,omitempty"`
+}
+
+func (x *VotedChain) Reset() {
+	*x = VotedChain{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_waterfall_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VotedChain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotedChain) ProtoMessage() {}
+
+func (x *VotedChain) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_waterfall_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VotedChain.ProtoReflect.Descriptor instead.
+func (*VotedChain) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_waterfall_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *VotedChain) GetChain() [][]byte {
+	if x != nil {
+		return x.Chain
+	}
+	return nil
+}
+
+func (x *VotedChain) GetVotes() uint64 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+var File_proto_eth_v1_waterfall_proto protoreflect.FileDescriptor
+
+var file_proto_eth_v1_waterfall_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x2f, 0x77,
+	0x61, 0x74, 0x65, 0x72, 0x66, 0x61, 0x6c, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f,
+	0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x1a,
+	0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x65, 0x78, 0x74,
+	0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x57,
+	0x0a, 0x18, 0x47, 0x77, 0x61, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72,
+	0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x77, 0x61, 0x74, 0x46,
+	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xcc, 0x02, 0x0a, 0x16, 0x47, 0x77, 0x61, 0x74,
+	0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x4e, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x69, 0x6e, 0x61, 0x6c,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x77, 0x61, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x11, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x77, 0x61, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x10,
+	0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x53, 0x79, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x12,
+	0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x69,
+	0x6e, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x73,
+	0x74, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x41, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x22, 0xc8, 0x02, 0x0a, 0x10, 0x47, 0x77, 0x61, 0x74, 0x46,
+	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x04, 0x73,
+	0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0x82, 0xb5, 0x18, 0x28, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61,
+	0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x79, 0x6e, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x70,
+	0x69, 0x6e, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x70, 0x69, 0x6e,
+	0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x70, 0x69, 0x6e, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x53, 0x70, 0x69, 0x6e,
+	0x65, 0x12, 0x3f, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x77, 0x61, 0x74, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x66, 0x5f, 0x73, 0x70, 0x69, 0x6e, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6c, 0x66, 0x53, 0x70, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x22, 0xdb, 0x01, 0x0a, 0x0e, 0x47, 0x77, 0x61, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x2d, 0x82, 0xb5, 0x18, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62,
+	0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x4a, 0x0a, 0x09, 0x66, 0x69, 0x6e,
+	0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0x82, 0xb5,
+	0x18, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79,
+	0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x08, 0x66, 0x69, 0x6e,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x33, 0x32, 0x52, 0x04, 0x72, 0x6f, 0x6f,
+	0x74, 0x12, 0x1c, 0x0a, 0x05, 0x73, 0x70, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x33, 0x32, 0x52, 0x05, 0x73, 0x70, 0x69, 0x6e, 0x65, 0x22,
+	0x5b, 0x0a, 0x1d, 0x57, 0x61, 0x74, 0x65, 0x72, 0x66, 0x61, 0x6c, 0x6c, 0x46, 0x6f, 0x72, 0x6b,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3a, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x61, 0x74, 0x65, 0x72, 0x66, 0x61, 0x6c, 0x6c, 0x46, 0x6f, 0x72, 0x6b, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x6c, 0x0a, 0x15,
+	0x57, 0x61, 0x74, 0x65, 0x72, 0x66, 0x61, 0x6c, 0x6c, 0x46, 0x6f, 0x72, 0x6b, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x65, 0x72, 0x66, 0x61, 0x6c, 0x6c,
+	0x46, 0x6f, 0x72, 0x6b, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x6b, 0x73, 0x12, 0x1d, 0x0a, 0x06, 0x64,
+	0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x05, 0x8a, 0xb5, 0x18,
+	0x01, 0x34, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x22, 0xaa, 0x01, 0x0a, 0x0d, 0x57,
+	0x61, 0x74, 0x65, 0x72, 0x66, 0x61, 0x6c, 0x6c, 0x46, 0x6f, 0x72, 0x6b, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x40, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c,
+	0x82, 0xb5, 0x18, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70,
+	0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68,
+	0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x04, 0x73, 0x6c,
+	0x6f, 0x74, 0x12, 0x43, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x2d, 0x82, 0xb5, 0x18, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f,
+	0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x53, 0x0a, 0x19, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xba, 0x02, 0x0a,
+	0x11, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x2c, 0x82, 0xb5, 0x18, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65,
+	0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x04,
+	0x73, 0x6c, 0x6f, 0x74, 0x12, 0x25, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f,
+	0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x33, 0x32,
+	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x3d, 0x0a, 0x0a, 0x73,
+	0x70, 0x69, 0x6e, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x70, 0x69, 0x6e, 0x65, 0x44, 0x61, 0x74, 0x61, 0x4b, 0x65, 0x79, 0x73, 0x52,
+	0x09, 0x73, 0x70, 0x69, 0x6e, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x48, 0x0a, 0x0f, 0x67, 0x77,
+	0x61, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65,
+	0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x77, 0x61, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0e, 0x67, 0x77, 0x61, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x70, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x69, 0x6e, 0x65, 0x73, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x06, 0x73, 0x70, 0x69, 0x6e, 0x65, 0x73, 0x22, 0xa6, 0x01, 0x0a, 0x0d, 0x53, 0x70,
+	0x69, 0x6e, 0x65, 0x44, 0x61, 0x74, 0x61, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x1e, 0x0a, 0x06, 0x73,
+	0x70, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0x8a, 0xb5, 0x18,
+	0x02, 0x33, 0x32, 0x52, 0x06, 0x73, 0x70, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x06, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0x8a, 0xb5, 0x18,
+	0x02, 0x33, 0x32, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x2a, 0x0a, 0x0c, 0x66,
+	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x33, 0x32, 0x52, 0x0c, 0x66, 0x69, 0x6e, 0x61, 0x6c,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x0c, 0x63, 0x70, 0x5f, 0x66, 0x69,
+	0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0x8a,
+	0xb5, 0x18, 0x02, 0x33, 0x32, 0x52, 0x0b, 0x63, 0x70, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x64, 0x22, 0x3a, 0x0a, 0x0a, 0x53, 0x70, 0x69, 0x6e, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0x8a,
+	0xb5, 0x18, 0x02, 0x33, 0x32, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4a,
+	0x0a, 0x15, 0x47, 0x77, 0x61, 0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x77, 0x61, 0x74, 0x45, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xf2, 0x02, 0x0a, 0x0c, 0x47,
+	0x77, 0x61, 0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x2b,
+	0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c,
+	0x66, 0x5f, 0x73, 0x70, 0x69, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6c,
+	0x66, 0x53, 0x70, 0x69, 0x6e, 0x65, 0x12, 0x48, 0x0a, 0x08, 0x63, 0x70, 0x5f, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0x82, 0xb5, 0x18, 0x29, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74,
+	0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x07, 0x63, 0x70, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x12, 0x17, 0x0a, 0x07, 0x63, 0x70, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x06, 0x63, 0x70, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x34, 0x0a, 0x19, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x66, 0x65,
+	0x63, 0x79, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74,
+	0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x55, 0x0a, 0x1a, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x66,
+	0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x9f, 0x02, 0x0a,
+	0x12, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79,
+	0x63, 0x6c, 0x65, 0x12, 0x28, 0x0a, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x74, 0x78, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x33,
+	0x32, 0x52, 0x0a, 0x69, 0x6e, 0x69, 0x74, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x5f, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x36, 0x82, 0xb5, 0x18, 0x32,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d,
+	0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x3a, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65,
+	0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x88,
+	0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x12, 0x40, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0x82, 0xb5, 0x18, 0x28, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63,
+	0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x53, 0x6c, 0x6f, 0x74, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0x2b, 0x0a, 0x15, 0x53, 0x70, 0x69,
+	0x6e, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x4d, 0x0a, 0x16, 0x53, 0x70, 0x69, 0x6e, 0x65, 0x4c,
+	0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x70, 0x69, 0x6e, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x80, 0x01, 0x0a, 0x0e, 0x53, 0x70, 0x69, 0x6e, 0x65, 0x4c,
+	0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x33, 0x32, 0x52, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3a, 0x0a, 0x07,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52,
+	0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0xbb, 0x01, 0x0a, 0x13, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x43, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x2d, 0x82, 0xb5, 0x18, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74,
+	0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x05,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x5f, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x42, 0x36,
+	0x82, 0xb5, 0x18, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70,
+	0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68,
+	0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x91, 0x01, 0x0a, 0x13, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x5f, 0x0a, 0x0f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x04, 0x42, 0x36, 0x82, 0xb5, 0x18, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73,
+	0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x45, 0x0a, 0x0f, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0xdb, 0x03, 0x0a, 0x0d, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x5f, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x36, 0x82, 0xb5,
+	0x18, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79,
+	0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x25, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f,
+	0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x33, 0x32,
+	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x40, 0x0a, 0x04, 0x73,
+	0x6c, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0x82, 0xb5, 0x18, 0x28, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61,
+	0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x43, 0x0a,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0x82, 0xb5,
+	0x18, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79,
+	0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x76, 0x6f, 0x74, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x0d, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x49, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22,
+	0x65, 0x0a, 0x13, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0x82, 0xb5, 0x18, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61,
+	0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6c,
+	0x6f, 0x74, 0x48, 0x00, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x22, 0x44, 0x0a, 0x14, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74,
+	0x65, 0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x45, 0x0a, 0x15,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65,
+	0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0xc6, 0x01, 0x0a, 0x07, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x12,
+	0x63, 0x0a, 0x10, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62,
+	0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x38, 0x82, 0xb5, 0x18, 0x2c, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61,
+	0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x67, 0x6f, 0x2d, 0x62, 0x69, 0x74, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x2e, 0x42, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x92, 0xb5, 0x18, 0x04, 0x32,
+	0x30, 0x34, 0x38, 0x52, 0x0f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x69, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x39,
+	0x36, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xc7, 0x01, 0x0a,
+	0x0b, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x40, 0x0a, 0x04,
+	0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0x82, 0xb5, 0x18, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d,
+	0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x4c,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x36, 0x82,
+	0xb5, 0x18, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72,
+	0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32,
+	0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x28, 0x0a, 0x0a,
+	0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x08, 0x92, 0xb5, 0x18, 0x04, 0x34, 0x30, 0x39, 0x36, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0xb7, 0x01, 0x0a, 0x12, 0x50, 0x72, 0x65, 0x76, 0x6f,
+	0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a,
+	0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0x82, 0xb5, 0x18,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73,
+	0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12,
+	0x5f, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x36, 0x82, 0xb5, 0x18, 0x32, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74,
+	0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x22, 0x47, 0x0a, 0x13, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x33, 0x0a, 0x16, 0x50, 0x72, 0x65,
+	0x76, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x22, 0x4f,
+	0x0a, 0x17, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65,
+	0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74,
+	0x65, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0xbf, 0x03, 0x0a, 0x0f, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x63, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x2c, 0x82, 0xb5, 0x18, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f,
+	0x65, 0x74, 0x68, 0x32, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52,
+	0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72,
+	0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x6f, 0x6f, 0x74, 0x12, 0x27, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72,
+	0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x33,
+	0x32, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x33, 0x0a,
+	0x15, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x73, 0x74, 0x69, 0x63, 0x5f, 0x63, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x14, 0x6f, 0x70,
+	0x74, 0x69, 0x6d, 0x69, 0x73, 0x74, 0x69, 0x63, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x12, 0x3e, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x72, 0x65,
+	0x64, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x6f, 0x74,
+	0x65, 0x73, 0x12, 0x33, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52,
+	0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x6f, 0x73, 0x65,
+	0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x63, 0x68, 0x6f, 0x73, 0x65, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x70, 0x69, 0x6e, 0x65,
+	0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x63, 0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x73, 0x70, 0x69, 0x6e, 0x65, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x43, 0x75,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x73, 0x22, 0x85, 0x01, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x72, 0x65, 0x64,
+	0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x22, 0x38, 0x0a, 0x0a, 0x56, 0x6f, 0x74,
+	0x65, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x6f,
+	0x74, 0x65, 0x73, 0x42, 0x91, 0x01, 0x0a, 0x13, 0x6f, 0x72, 0x67, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x42, 0x0e, 0x57, 0x61, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x44, 0x67,
+	0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x77, 0x61, 0x74, 0x65, 0x72, 0x66, 0x61, 0x6c, 0x6c, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x77, 0x61, 0x74, 0x65, 0x72, 0x66, 0x61, 0x6c,
+	0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6f, 0x72, 0x64,
+	0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x74, 0x68,
+	0x2f, 0x76, 0x31, 0xaa, 0x02, 0x0f, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x45,
+	0x74, 0x68, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0f, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x5c, 0x45, 0x74, 0x68, 0x5c, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_eth_v1_waterfall_proto_rawDescOnce sync.Once
+	file_proto_eth_v1_waterfall_proto_rawDescData = file_proto_eth_v1_waterfall_proto_rawDesc
+)
+
+func file_proto_eth_v1_waterfall_proto_rawDescGZIP() []byte {
+	file_proto_eth_v1_waterfall_proto_rawDescOnce.Do(func() {
+		file_proto_eth_v1_waterfall_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_eth_v1_waterfall_proto_rawDescData)
+	})
+	return file_proto_eth_v1_waterfall_proto_rawDescData
+}
+
+var file_proto_eth_v1_waterfall_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_proto_eth_v1_waterfall_proto_goTypes = []interface{}{
+	(*GwatFinalizationResponse)(nil),      // 0: ethereum.eth.v1.GwatFinalizationResponse
+	(*GwatFinalizationStatus)(nil),        // 1: ethereum.eth.v1.GwatFinalizationStatus
+	(*GwatFinalization)(nil),              // 2: ethereum.eth.v1.GwatFinalization
+	(*GwatCheckpoint)(nil),                // 3: ethereum.eth.v1.GwatCheckpoint
+	(*WaterfallForkScheduleResponse)(nil), // 4: ethereum.eth.v1.WaterfallForkScheduleResponse
+	(*WaterfallForkSchedule)(nil),         // 5: ethereum.eth.v1.WaterfallForkSchedule
+	(*WaterfallFork)(nil),                 // 6: ethereum.eth.v1.WaterfallFork
+	(*StateCoordinationResponse)(nil),     // 7: ethereum.eth.v1.StateCoordinationResponse
+	(*StateCoordination)(nil),             // 8: ethereum.eth.v1.StateCoordination
+	(*SpineDataKeys)(nil),                 // 9: ethereum.eth.v1.SpineDataKeys
+	(*SpinesList)(nil),                    // 10: ethereum.eth.v1.SpinesList
+	(*GwatEndpointsResponse)(nil),         // 11: ethereum.eth.v1.GwatEndpointsResponse
+	(*GwatEndpoint)(nil),                  // 12: ethereum.eth.v1.GwatEndpoint
+	(*OperationLifecycleRequest)(nil),     // 13: ethereum.eth.v1.OperationLifecycleRequest
+	(*OperationLifecycleResponse)(nil),    // 14: ethereum.eth.v1.OperationLifecycleResponse
+	(*OperationLifecycle)(nil),            // 15: ethereum.eth.v1.OperationLifecycle
+	(*LifecycleRecord)(nil),               // 16: ethereum.eth.v1.LifecycleRecord
+	(*SpineLifecycleRequest)(nil),         // 17: ethereum.eth.v1.SpineLifecycleRequest
+	(*SpineLifecycleResponse)(nil),        // 18: ethereum.eth.v1.SpineLifecycleResponse
+	(*SpineLifecycle)(nil),                // 19: ethereum.eth.v1.SpineLifecycle
+	(*EpochRewardsRequest)(nil),           // 20: ethereum.eth.v1.EpochRewardsRequest
+	(*BlockRewardsRequest)(nil),           // 21: ethereum.eth.v1.BlockRewardsRequest
+	(*RewardsResponse)(nil),               // 22: ethereum.eth.v1.RewardsResponse
+	(*BalanceChange)(nil),                 // 23: ethereum.eth.v1.BalanceChange
+	(*PrevotesPoolRequest)(nil),           // 24: ethereum.eth.v1.PrevotesPoolRequest
+	(*PrevotesPoolResponse)(nil),          // 25: ethereum.eth.v1.PrevotesPoolResponse
+	(*SubmitPrevotesRequest)(nil),         // 26: ethereum.eth.v1.SubmitPrevotesRequest
+	(*Prevote)(nil),                       // 27: ethereum.eth.v1.Prevote
+	(*PrevoteData)(nil),                   // 28: ethereum.eth.v1.PrevoteData
+	(*PrevoteDataRequest)(nil),            // 29: ethereum.eth.v1.PrevoteDataRequest
+	(*PrevoteDataResponse)(nil),           // 30: ethereum.eth.v1.PrevoteDataResponse
+	(*PrevoteDecisionRequest)(nil),        // 31: ethereum.eth.v1.PrevoteDecisionRequest
+	(*PrevoteDecisionResponse)(nil),       // 32: ethereum.eth.v1.PrevoteDecisionResponse
+	(*PrevoteDecision)(nil),               // 33: ethereum.eth.v1.PrevoteDecision
+	(*ConsideredPrevote)(nil),             // 34: ethereum.eth.v1.ConsideredPrevote
+	(*VotedChain)(nil),                    // 35: ethereum.eth.v1.VotedChain
+}
+var file_proto_eth_v1_waterfall_proto_depIdxs = []int32{
+	1,  // 0: ethereum.eth.v1.GwatFinalizationResponse.data:type_name -> ethereum.eth.v1.GwatFinalizationStatus
+	2,  // 1: ethereum.eth.v1.GwatFinalizationStatus.last_finalization:type_name -> ethereum.eth.v1.GwatFinalization
+	3,  // 2: ethereum.eth.v1.GwatFinalizationStatus.coordinated_state:type_name -> ethereum.eth.v1.GwatCheckpoint
+	3,  // 3: ethereum.eth.v1.GwatFinalization.checkpoint:type_name -> ethereum.eth.v1.GwatCheckpoint
+	5,  // 4: ethereum.eth.v1.WaterfallForkScheduleResponse.data:type_name -> ethereum.eth.v1.WaterfallForkSchedule
+	6,  // 5: ethereum.eth.v1.WaterfallForkSchedule.forks:type_name -> ethereum.eth.v1.WaterfallFork
+	8,  // 6: ethereum.eth.v1.StateCoordinationResponse.data:type_name -> ethereum.eth.v1.StateCoordination
+	9,  // 7: ethereum.eth.v1.StateCoordination.spine_data:type_name -> ethereum.eth.v1.SpineDataKeys
+	3,  // 8: ethereum.eth.v1.StateCoordination.gwat_checkpoint:type_name -> ethereum.eth.v1.GwatCheckpoint
+	10, // 9: ethereum.eth.v1.StateCoordination.spines:type_name -> ethereum.eth.v1.SpinesList
+	12, // 10: ethereum.eth.v1.GwatEndpointsResponse.data:type_name -> ethereum.eth.v1.GwatEndpoint
+	15, // 11: ethereum.eth.v1.OperationLifecycleResponse.data:type_name -> ethereum.eth.v1.OperationLifecycle
+	16, // 12: ethereum.eth.v1.OperationLifecycle.records:type_name -> ethereum.eth.v1.LifecycleRecord
+	19, // 13: ethereum.eth.v1.SpineLifecycleResponse.data:type_name -> ethereum.eth.v1.SpineLifecycle
+	16, // 14: ethereum.eth.v1.SpineLifecycle.records:type_name -> ethereum.eth.v1.LifecycleRecord
+	23, // 15: ethereum.eth.v1.RewardsResponse.data:type_name -> ethereum.eth.v1.BalanceChange
+	27, // 16: ethereum.eth.v1.PrevotesPoolResponse.data:type_name -> ethereum.eth.v1.Prevote
+	27, // 17: ethereum.eth.v1.SubmitPrevotesRequest.data:type_name -> ethereum.eth.v1.Prevote
+	28, // 18: ethereum.eth.v1.Prevote.data:type_name -> ethereum.eth.v1.PrevoteData
+	28, // 19: ethereum.eth.v1.PrevoteDataResponse.data:type_name -> ethereum.eth.v1.PrevoteData
+	33, // 20: ethereum.eth.v1.PrevoteDecisionResponse.data:type_name -> ethereum.eth.v1.PrevoteDecision
+	34, // 21: ethereum.eth.v1.PrevoteDecision.prevotes:type_name -> ethereum.eth.v1.ConsideredPrevote
+	35, // 22: ethereum.eth.v1.PrevoteDecision.chains:type_name -> ethereum.eth.v1.VotedChain
+	23, // [23:23] is the sub-list for method output_type
+	23, // [23:23] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
+}
+
+func init() { file_proto_eth_v1_waterfall_proto_init() }
+func file_proto_eth_v1_waterfall_proto_init() {
+	if File_proto_eth_v1_waterfall_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_eth_v1_waterfall_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GwatFinalizationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GwatFinalizationStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GwatFinalization); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GwatCheckpoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaterfallForkScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaterfallForkSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaterfallFork); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateCoordinationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateCoordination); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpineDataKeys); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpinesList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GwatEndpointsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GwatEndpoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationLifecycleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationLifecycleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationLifecycle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LifecycleRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpineLifecycleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpineLifecycleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpineLifecycle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochRewardsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockRewardsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalanceChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrevotesPoolRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrevotesPoolResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitPrevotesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Prevote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrevoteData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrevoteDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrevoteDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrevoteDecisionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrevoteDecisionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrevoteDecision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsideredPrevote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_waterfall_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VotedChain); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_proto_eth_v1_waterfall_proto_msgTypes[24].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_eth_v1_waterfall_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   36,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_eth_v1_waterfall_proto_goTypes,
+		DependencyIndexes: file_proto_eth_v1_waterfall_proto_depIdxs,
+		MessageInfos:      file_proto_eth_v1_waterfall_proto_msgTypes,
+	}.Build()
+	File_proto_eth_v1_waterfall_proto = out.File
+	file_proto_eth_v1_waterfall_proto_rawDesc = nil
+	file_proto_eth_v1_waterfall_proto_goTypes = nil
+	file_proto_eth_v1_waterfall_proto_depIdxs = nil
+}
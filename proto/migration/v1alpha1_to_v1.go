@@ -230,6 +230,33 @@ func V1ExitToV1Alpha1(v1Exit *ethpbv1.VoluntaryExit) *ethpbalpha.VoluntaryExit {
 	}
 }
 
+// V1Alpha1PrevoteToV1 converts a v1alpha1 prevote to v1.
+func V1Alpha1PrevoteToV1(v1alpha1Prevote *ethpbalpha.PreVote) *ethpbv1.Prevote {
+	if v1alpha1Prevote == nil {
+		return &ethpbv1.Prevote{}
+	}
+	v1Prevote := &ethpbv1.Prevote{
+		AggregationBits: bytesutil.SafeCopyBytes(v1alpha1Prevote.AggregationBits),
+		Signature:       bytesutil.SafeCopyBytes(v1alpha1Prevote.Signature),
+	}
+	if v1alpha1Prevote.Data != nil {
+		v1Prevote.Data = V1Alpha1PrevoteDataToV1(v1alpha1Prevote.Data)
+	}
+	return v1Prevote
+}
+
+// V1Alpha1PrevoteDataToV1 converts a v1alpha1 prevote data to v1.
+func V1Alpha1PrevoteDataToV1(v1alpha1PrevoteData *ethpbalpha.PreVoteData) *ethpbv1.PrevoteData {
+	if v1alpha1PrevoteData == nil {
+		return &ethpbv1.PrevoteData{}
+	}
+	return &ethpbv1.PrevoteData{
+		Slot:       v1alpha1PrevoteData.Slot,
+		Index:      v1alpha1PrevoteData.Index,
+		Candidates: bytesutil.SafeCopyBytes(v1alpha1PrevoteData.Candidates),
+	}
+}
+
 // V1AttToV1Alpha1 converts a v1 attestation to v1alpha1.
 func V1AttToV1Alpha1(v1Att *ethpbv1.Attestation) *ethpbalpha.Attestation {
 	if v1Att == nil {
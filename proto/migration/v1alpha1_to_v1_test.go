@@ -252,6 +252,29 @@ func Test_V1ExitToV1Alpha1(t *testing.T) {
 	assert.DeepEqual(t, alphaRoot, v1Root)
 }
 
+func Test_V1Alpha1PrevoteToV1(t *testing.T) {
+	alphaPrevote := &ethpbalpha.PreVote{
+		AggregationBits: aggregationBits,
+		Data: &ethpbalpha.PreVoteData{
+			Slot:       slot,
+			Index:      committeeIndex,
+			Candidates: append(bytesutil.PadTo([]byte("candidate1"), 32), bytesutil.PadTo([]byte("candidate2"), 32)...),
+		},
+		Signature: signature,
+	}
+
+	v1Prevote := V1Alpha1PrevoteToV1(alphaPrevote)
+	assert.DeepEqual(t, []byte(alphaPrevote.AggregationBits), []byte(v1Prevote.AggregationBits))
+	assert.DeepEqual(t, alphaPrevote.Signature, v1Prevote.Signature)
+	require.NotNil(t, v1Prevote.Data)
+	assert.Equal(t, slot, v1Prevote.Data.Slot)
+	assert.Equal(t, committeeIndex, v1Prevote.Data.Index)
+	assert.DeepEqual(t, alphaPrevote.Data.Candidates, v1Prevote.Data.Candidates)
+
+	v1Prevote = V1Alpha1PrevoteToV1(&ethpbalpha.PreVote{Signature: signature})
+	assert.Equal(t, (*ethpbv1.PrevoteData)(nil), v1Prevote.Data)
+}
+
 func Test_V1AttSlashingToV1Alpha1(t *testing.T) {
 	v1Attestation := &ethpbv1.IndexedAttestation{
 		AttestingIndices: attestingIndices,
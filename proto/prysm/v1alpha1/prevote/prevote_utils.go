@@ -91,3 +91,59 @@ func VerifyIndexedPrevoteSig(ctx context.Context, indextedPrevote *ethpb.Indexed
 	}
 	return nil
 }
+
+// Aggregate merges the prevotes of the same data with not overlapping aggregation bits.
+// The prevotes are merged in the given order, so the result is not the optimal aggregation.
+func Aggregate(prevotes []*ethpb.PreVote) ([]*ethpb.PreVote, error) {
+	aggregated := make([]*ethpb.PreVote, 0, len(prevotes))
+	dataRoots := make([][32]byte, 0, len(prevotes))
+	for _, pv := range prevotes {
+		root, err := pv.Data.HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get prevote data root")
+		}
+		merged := false
+		for i, agg := range aggregated {
+			if dataRoots[i] != root {
+				continue
+			}
+			o, err := agg.AggregationBits.Overlaps(pv.AggregationBits)
+			if err != nil {
+				return nil, err
+			}
+			if o {
+				continue
+			}
+			if aggregated[i], err = aggregatePair(agg, pv); err != nil {
+				return nil, err
+			}
+			merged = true
+			break
+		}
+		if !merged {
+			aggregated = append(aggregated, ethpb.CopyPrevote(pv))
+			dataRoots = append(dataRoots, root)
+		}
+	}
+	return aggregated, nil
+}
+
+// aggregatePair aggregates the prevotes of the same data with not overlapping aggregation bits.
+func aggregatePair(p1, p2 *ethpb.PreVote) (*ethpb.PreVote, error) {
+	bits, err := p1.AggregationBits.Or(p2.AggregationBits)
+	if err != nil {
+		return nil, err
+	}
+	sig1, err := bls.SignatureFromBytes(p1.Signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert bytes to signature")
+	}
+	sig2, err := bls.SignatureFromBytes(p2.Signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert bytes to signature")
+	}
+	res := ethpb.CopyPrevote(p1)
+	res.AggregationBits = bits
+	res.Signature = bls.AggregateSignatures([]bls.Signature{sig1, sig2}).Marshal()
+	return res, nil
+}
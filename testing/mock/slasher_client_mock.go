@@ -95,3 +95,43 @@ func (mr *MockSlasherClientMockRecorder) IsSlashableBlock(arg0, arg1 interface{}
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSlashableBlock", reflect.TypeOf((*MockSlasherClient)(nil).IsSlashableBlock), varargs...)
 }
+
+// IsSlashablePrevote mocks base method.
+func (m *MockSlasherClient) IsSlashablePrevote(arg0 context.Context, arg1 *eth.IndexedPreVote, arg2 ...grpc.CallOption) (*eth.PrevoteSlashingResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "IsSlashablePrevote", varargs...)
+	ret0, _ := ret[0].(*eth.PrevoteSlashingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsSlashablePrevote indicates an expected call of IsSlashablePrevote.
+func (mr *MockSlasherClientMockRecorder) IsSlashablePrevote(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSlashablePrevote", reflect.TypeOf((*MockSlasherClient)(nil).IsSlashablePrevote), varargs...)
+}
+
+// PrevoteSlashings mocks base method.
+func (m *MockSlasherClient) PrevoteSlashings(arg0 context.Context, arg1 *eth.PrevoteSlashingRequest, arg2 ...grpc.CallOption) (*eth.PrevoteSlashingResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PrevoteSlashings", varargs...)
+	ret0, _ := ret[0].(*eth.PrevoteSlashingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrevoteSlashings indicates an expected call of PrevoteSlashings.
+func (mr *MockSlasherClientMockRecorder) PrevoteSlashings(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrevoteSlashings", reflect.TypeOf((*MockSlasherClient)(nil).PrevoteSlashings), varargs...)
+}
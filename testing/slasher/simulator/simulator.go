@@ -87,6 +87,7 @@ func New(ctx context.Context, srvConfig *ServiceConfig) (*Simulator, error) {
 	slasherSrv, err := slasher.New(ctx, &slasher.ServiceConfig{
 		IndexedAttestationsFeed: indexedAttsFeed,
 		BeaconBlockHeadersFeed:  beaconBlocksFeed,
+		IndexedPrevotesFeed:     new(event.Feed),
 		Database:                srvConfig.Database,
 		StateNotifier:           srvConfig.StateNotifier,
 		HeadStateFetcher:        srvConfig.HeadStateFetcher,
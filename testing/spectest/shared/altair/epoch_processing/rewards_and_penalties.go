@@ -34,7 +34,7 @@ func processRewardsAndPenaltiesPrecomputeWrapper(t *testing.T, st state.BeaconSt
 	vp, bp, err = altair.ProcessEpochParticipation(ctx, st, bp, vp)
 	require.NoError(t, err)
 
-	st, err = altair.ProcessRewardsAndPenaltiesPrecompute(st, bp, vp)
+	st, err = altair.ProcessRewardsAndPenaltiesPrecompute(context.Background(), st, bp, vp)
 	require.NoError(t, err, "Could not process reward")
 
 	return st, nil
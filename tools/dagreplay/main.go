@@ -0,0 +1,184 @@
+/**
+ * DAG consensus replay
+ *
+ * Given a beacon-chain DB, start slot and end slot. This tool opens the DB read-only
+ * and replays the DAG consensus calculation (prefix and parent spines, finalization,
+ * stale votes cleanup) block by block. For every block it prints the votes of each
+ * candidates sequence, the BlockVotingMinSupport thresholds reached by BlockVoting items
+ * and the reasons of BlockVoting items and votes removal.
+ */
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"sort"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	log "github.com/sirupsen/logrus"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/blocks"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/transition"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/db/filters"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/db/kv"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/state"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1/block"
+	gwatCommon "gitlab.waterfall.network/waterfall/protocol/gwat/common"
+)
+
+var (
+	datadir   = flag.String("datadir", "", "Path to data directory.")
+	startSlot = flag.Uint64("start-slot", 0, "Start slot of the replay.")
+	endSlot   = flag.Uint64("end-slot", 0, "End slot of the replay.")
+)
+
+func main() {
+	flag.Parse()
+
+	if *datadir == "" {
+		log.Fatal("Please specify --datadir <db path> to read the database")
+	}
+	if *endSlot < *startSlot {
+		log.Fatal("--end-slot must not be less than --start-slot")
+	}
+
+	ctx := context.Background()
+	db, err := kv.NewKVStore(ctx, *datadir, &kv.Config{ReadOnly: true})
+	if err != nil {
+		log.Fatalf("could not open db, %v", err)
+	}
+	defer func() {
+		if closeErr := db.Close(); closeErr != nil {
+			log.Fatalf("could not close db, %v", closeErr)
+		}
+	}()
+
+	filter := filters.NewFilter().SetStartSlot(types.Slot(*startSlot)).SetEndSlot(types.Slot(*endSlot))
+	blks, roots, err := db.Blocks(ctx, filter)
+	if err != nil {
+		log.Fatalf("could not retrieve blocks, %v", err)
+	}
+	ixs := make([]int, len(blks))
+	for i := range ixs {
+		ixs[i] = i
+	}
+	sort.SliceStable(ixs, func(i, j int) bool {
+		return blks[ixs[i]].Block().Slot() < blks[ixs[j]].Block().Slot()
+	})
+
+	// post states of the replayed blocks, used as pre states of their children.
+	postStates := make(map[[32]byte]state.BeaconState)
+	for _, ix := range ixs {
+		blk, root := blks[ix], roots[ix]
+		parentRoot := bytesutil.ToBytes32(blk.Block().ParentRoot())
+
+		preState, ok := postStates[parentRoot]
+		if ok {
+			preState = preState.Copy()
+		} else {
+			preState, err = db.State(ctx, parentRoot)
+			if err != nil {
+				log.Fatalf("could not retrieve state of root %#x, %v", parentRoot, err)
+			}
+			if preState == nil || preState.IsNil() {
+				log.Warnf("skip block %#x of slot %d: state of parent %#x not found", root, blk.Block().Slot(), parentRoot)
+				continue
+			}
+		}
+
+		tr, err := replayDagConsensus(ctx, preState.Copy(), blk)
+		if err != nil {
+			log.Fatalf("could not replay dag consensus of block %#x, %v", root, err)
+		}
+		printTrace(root, tr)
+
+		_, postState, err := transition.ExecuteStateTransitionNoVerifyAnySig(ctx, preState, blk)
+		if err != nil {
+			log.Fatalf("could not execute state transition of block %#x, %v", root, err)
+		}
+		postStates[root] = postState
+	}
+}
+
+// replayDagConsensus processes the state up to the point of the dag consensus
+// calculation of the block (see transition.ProcessBlockForStateRoot) and traces the calculation.
+func replayDagConsensus(ctx context.Context, st state.BeaconState, signed block.SignedBeaconBlock) (*blocks.DagConsensusTrace, error) {
+	blk := signed.Block()
+	st, err := transition.ProcessSlots(ctx, st, blk.Slot())
+	if err != nil {
+		return nil, fmt.Errorf("could not process slots: %w", err)
+	}
+	bodyRoot, err := blk.Body().HashTreeRoot()
+	if err != nil {
+		return nil, fmt.Errorf("could not hash tree root beacon block body: %w", err)
+	}
+	st, err = blocks.ProcessBlockHeaderNoVerify(ctx, st, blk.Slot(), blk.ProposerIndex(), blk.ParentRoot(), bodyRoot[:])
+	if err != nil {
+		return nil, fmt.Errorf("could not process block header: %w", err)
+	}
+	st, err = blocks.ProcessRandaoNoVerify(st, blk.Body().RandaoReveal())
+	if err != nil {
+		return nil, fmt.Errorf("could not process randao: %w", err)
+	}
+	st, err = blocks.ProcessEth1DataInBlock(ctx, st, blk.Body().Eth1Data())
+	if err != nil {
+		return nil, fmt.Errorf("could not process eth1 data: %w", err)
+	}
+	_, tr, err := blocks.TraceDagConsensus(ctx, st, signed)
+	if err != nil {
+		return nil, fmt.Errorf("could not process dag consensus: %w", err)
+	}
+	return tr, nil
+}
+
+func printTrace(root [32]byte, tr *blocks.DagConsensusTrace) {
+	fmt.Printf("block %#x slot %d\n", root, tr.Slot)
+	fmt.Printf("  prefix: %s\n", hashesToString(tr.Prefix))
+	for i, spines := range tr.ParentSpines {
+		fmt.Printf("  parent spines [%d]: %s\n", i, hashesToString(spines))
+	}
+
+	fmt.Printf("  block votings: %d\n", len(tr.Votings))
+	for _, bv := range tr.Votings {
+		fmt.Printf("    root %#x slot %d supported=%t candidates: %s\n", bv.Root, bv.Slot, bv.Supported, hashesToString(bv.Candidates))
+		for _, ss := range bv.SlotsSupport {
+			reached := ""
+			if ss.Votes >= uint64(ss.MinSupport) {
+				reached = " (threshold reached)"
+			}
+			fmt.Printf("      slot %d: votes %d, min support %d%s\n", ss.Slot, ss.Votes, ss.MinSupport, reached)
+		}
+	}
+
+	fmt.Printf("  candidates votes (required %d):\n", tr.RequiredVotes)
+	for _, cv := range tr.CandidatesVotes {
+		fmt.Printf("    votes %d: %s\n", cv.Votes, hashesToString(cv.Candidates))
+	}
+	fmt.Printf("  finalized: %s\n", hashesToString(tr.Finalized))
+
+	fmt.Printf("  dropped: %d\n", len(tr.Dropped))
+	for _, d := range tr.Dropped {
+		votesSlot := "all"
+		if d.VotesSlot != nil {
+			votesSlot = fmt.Sprintf("slot %d", *d.VotesSlot)
+		}
+		fmt.Printf("    root %#x votes %s: %s; candidates: %s\n", d.Root, votesSlot, d.Reason, hashesToString(d.Candidates))
+	}
+	fmt.Println()
+}
+
+func hashesToString(hashes gwatCommon.HashArray) string {
+	if len(hashes) == 0 {
+		return "[]"
+	}
+	res := "["
+	for i, h := range hashes {
+		if i > 0 {
+			res += ", "
+		}
+		res += h.Hex()
+	}
+	return res + "]"
+}
@@ -0,0 +1,188 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package accounts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli/v2"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/signing"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/cmd/validator/flags"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/io/file"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	validatorpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1/validator-client"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/validator/accounts/iface"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/validator/accounts/userprompt"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/validator/accounts/wallet"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/validator/keymanager"
+	"gitlab.waterfall.network/waterfall/protocol/gwat/common"
+	"gitlab.waterfall.network/waterfall/protocol/gwat/common/hexutil"
+)
+
+// DepositDataJSON is the signed deposit data of a validator account in the format
+// accepted by the gwat deposit contract and the genesis-state-gen tool.
+type DepositDataJSON struct {
+	PubKey            string `json:"pubkey"`
+	Amount            uint64 `json:"amount"`
+	CreatorAddress    string `json:"creator_address"`
+	WithdrawalAddress string `json:"withdrawal_address"`
+	DepositDataRoot   string `json:"deposit_data_root"`
+	Signature         string `json:"signature"`
+}
+
+// DepositDataCli generates the signed deposit data of the selected validator accounts
+// of a wallet and writes it to a JSON file.
+func DepositDataCli(cliCtx *cli.Context) error {
+	w, err := wallet.OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*wallet.Wallet, error) {
+		return nil, wallet.ErrNoWalletFound
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not open wallet")
+	}
+	if w.KeymanagerKind() == keymanager.Web3Signer {
+		return errors.New(
+			"web3signer wallets cannot generate deposit data through cli command. please sign the deposits on the remote signer node",
+		)
+	}
+	creatorAddress, err := addressFromFlag(cliCtx, flags.DepositCreatorAddressFlag)
+	if err != nil {
+		return err
+	}
+	withdrawalAddress, err := addressFromFlag(cliCtx, flags.DepositWithdrawalAddressFlag)
+	if err != nil {
+		return err
+	}
+	amount := cliCtx.Uint64(flags.DepositAmountFlag.Name)
+	if amount == 0 {
+		amount = params.BeaconConfig().MaxEffectiveBalance
+	}
+	if amount < params.BeaconConfig().MinDepositAmount {
+		return fmt.Errorf("deposit amount %d is less than the min deposit amount %d", amount, params.BeaconConfig().MinDepositAmount)
+	}
+
+	km, err := w.InitializeKeymanager(cliCtx.Context, iface.InitKeymanagerConfig{ListenForChanges: false})
+	if err != nil {
+		return errors.Wrap(err, ErrCouldNotInitializeKeymanager)
+	}
+	validatingPublicKeys, err := km.FetchValidatingPublicKeys(cliCtx.Context)
+	if err != nil {
+		return errors.Wrap(err, "could not fetch validating public keys")
+	}
+	if len(validatingPublicKeys) == 0 {
+		return errors.New("wallet is empty, no accounts to generate deposit data for")
+	}
+	filteredPubKeys, err := filterPublicKeysFromUserInput(
+		cliCtx,
+		flags.DepositPublicKeysFlag,
+		validatingPublicKeys,
+		userprompt.SelectAccountsDepositDataPromptText,
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not filter public keys for deposit data")
+	}
+	pubKeys := make([][]byte, len(filteredPubKeys))
+	for i, pk := range filteredPubKeys {
+		pubKeys[i] = pk.Marshal()
+	}
+
+	depositData, err := GenerateDepositData(cliCtx.Context, km, pubKeys, creatorAddress, withdrawalAddress, amount)
+	if err != nil {
+		return err
+	}
+	encoded, err := json.MarshalIndent(depositData, "", "\t")
+	if err != nil {
+		return errors.Wrap(err, "could not marshal deposit data")
+	}
+	outputFile := cliCtx.String(flags.DepositDataFileFlag.Name)
+	if err := file.WriteFile(outputFile, encoded); err != nil {
+		return errors.Wrapf(err, "could not write deposit data to %s", outputFile)
+	}
+	log.WithField("file", outputFile).Infof("Successfully generated deposit data of %d accounts", len(depositData))
+	return nil
+}
+
+// GenerateDepositData signs the deposit messages of the validator keys with the keymanager
+// and returns the resulting deposit data.
+func GenerateDepositData(
+	ctx context.Context,
+	km keymanager.IKeymanager,
+	pubKeys [][]byte,
+	creatorAddress, withdrawalAddress []byte,
+	amount uint64,
+) ([]*DepositDataJSON, error) {
+	// Deposits are valid across forks, so the domain is computed with the default fork version.
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainDeposit, nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute deposit domain")
+	}
+	depositData := make([]*DepositDataJSON, len(pubKeys))
+	for i, pk := range pubKeys {
+		// The amount is not a part of the signed message, the beacon chain verifies
+		// the deposit signatures the same way.
+		depositMessage := &ethpb.DepositMessage{
+			PublicKey:             pk,
+			CreatorAddress:        creatorAddress,
+			WithdrawalCredentials: withdrawalAddress,
+		}
+		signingRoot, err := signing.ComputeSigningRoot(depositMessage, domain)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not compute signing root of deposit message for %#x", pk)
+		}
+		sig, err := km.Sign(ctx, &validatorpb.SignRequest{
+			PublicKey:       pk,
+			SigningRoot:     signingRoot[:],
+			SignatureDomain: domain,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not sign deposit message for %#x", pk)
+		}
+		data := &ethpb.Deposit_Data{
+			PublicKey:             pk,
+			CreatorAddress:        creatorAddress,
+			WithdrawalCredentials: withdrawalAddress,
+			Amount:                amount,
+			Signature:             sig.Marshal(),
+			InitTxHash:            make([]byte, 32),
+		}
+		dataRoot, err := data.HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not compute deposit data root for %#x", pk)
+		}
+		depositData[i] = &DepositDataJSON{
+			PubKey:            hexutil.Encode(pk),
+			Amount:            amount,
+			CreatorAddress:    hexutil.Encode(creatorAddress),
+			WithdrawalAddress: hexutil.Encode(withdrawalAddress),
+			DepositDataRoot:   hexutil.Encode(dataRoot[:]),
+			Signature:         hexutil.Encode(data.Signature),
+		}
+	}
+	return depositData, nil
+}
+
+func addressFromFlag(cliCtx *cli.Context, addressFlag *cli.StringFlag) ([]byte, error) {
+	address := cliCtx.String(addressFlag.Name)
+	if address == "" {
+		return nil, fmt.Errorf("--%s is required", addressFlag.Name)
+	}
+	if !common.IsHexAddress(address) {
+		return nil, fmt.Errorf("--%s %s is not a valid address", addressFlag.Name, address)
+	}
+	return common.HexToAddress(address).Bytes(), nil
+}
@@ -0,0 +1,173 @@
+package accounts
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/signing"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/crypto/bls"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/assert"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/validator/accounts/iface"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/validator/accounts/wallet"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/validator/keymanager"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/validator/keymanager/derived"
+	constant "gitlab.waterfall.network/waterfall/protocol/coordinator/validator/testing"
+	"gitlab.waterfall.network/waterfall/protocol/gwat/common/hexutil"
+)
+
+const (
+	testCreatorAddress    = "0xa7062a2bd7270740f1d15ab70b3dee189a87b6de"
+	testWithdrawalAddress = "0x1dd8b5f2bc7d8e5fa49fe2a6d7ab9d1b2a7b0f33"
+)
+
+func TestDepositDataCli_Noninteractive_Derived(t *testing.T) {
+	walletDir, _, passwordFilePath := setupWalletAndPasswordsDir(t)
+	password := "Pa$sW0rD0__Fo0xPr"
+	require.NoError(t, ioutil.WriteFile(passwordFilePath, []byte(password), os.ModePerm))
+	depositDataFile := filepath.Join(t.TempDir(), "deposit_data.json")
+
+	cliCtx := setupWalletCtx(t, &testWalletConfig{
+		walletDir:          walletDir,
+		keymanagerKind:     keymanager.Derived,
+		walletPasswordFile: passwordFilePath,
+	})
+	w, err := CreateWalletWithKeymanager(cliCtx.Context, &CreateWalletConfig{
+		WalletCfg: &wallet.Config{
+			WalletDir:      walletDir,
+			KeymanagerKind: keymanager.Derived,
+			WalletPassword: password,
+		},
+	})
+	require.NoError(t, err)
+	km, err := w.InitializeKeymanager(cliCtx.Context, iface.InitKeymanagerConfig{ListenForChanges: false})
+	require.NoError(t, err)
+	derivedKM, ok := km.(*derived.Keymanager)
+	require.Equal(t, true, ok)
+	require.NoError(t, derivedKM.RecoverAccountsFromMnemonic(cliCtx.Context, constant.TestMnemonic, "", 3))
+	pubKeys, err := km.FetchValidatingPublicKeys(cliCtx.Context)
+	require.NoError(t, err)
+
+	// Generate the deposit data of the first two accounts only.
+	cliCtx = setupWalletCtx(t, &testWalletConfig{
+		walletDir:             walletDir,
+		keymanagerKind:        keymanager.Derived,
+		walletPasswordFile:    passwordFilePath,
+		depositPublicKeys:     hex.EncodeToString(pubKeys[0][:]) + "," + hex.EncodeToString(pubKeys[1][:]),
+		depositCreatorAddress: testCreatorAddress,
+		depositWithdrawalAddr: testWithdrawalAddress,
+		depositDataFile:       depositDataFile,
+	})
+	require.NoError(t, DepositDataCli(cliCtx))
+
+	encoded, err := ioutil.ReadFile(depositDataFile)
+	require.NoError(t, err)
+	var depositData []*DepositDataJSON
+	require.NoError(t, json.Unmarshal(encoded, &depositData))
+	require.Equal(t, 2, len(depositData))
+
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainDeposit, nil, nil)
+	require.NoError(t, err)
+	for i, item := range depositData {
+		assert.Equal(t, hexutil.Encode(pubKeys[i][:]), item.PubKey)
+		assert.Equal(t, params.BeaconConfig().MaxEffectiveBalance, item.Amount)
+		assert.Equal(t, testCreatorAddress, item.CreatorAddress)
+		assert.Equal(t, testWithdrawalAddress, item.WithdrawalAddress)
+
+		creatorAddress, err := hexutil.Decode(item.CreatorAddress)
+		require.NoError(t, err)
+		withdrawalAddress, err := hexutil.Decode(item.WithdrawalAddress)
+		require.NoError(t, err)
+		sigBytes, err := hexutil.Decode(item.Signature)
+		require.NoError(t, err)
+
+		// The signature is verified the same way as the beacon chain verifies the deposits.
+		signingRoot, err := signing.ComputeSigningRoot(&ethpb.DepositMessage{
+			PublicKey:             pubKeys[i][:],
+			CreatorAddress:        creatorAddress,
+			WithdrawalCredentials: withdrawalAddress,
+		}, domain)
+		require.NoError(t, err)
+		pubKey, err := bls.PublicKeyFromBytes(pubKeys[i][:])
+		require.NoError(t, err)
+		sig, err := bls.SignatureFromBytes(sigBytes)
+		require.NoError(t, err)
+		assert.Equal(t, true, sig.Verify(pubKey, signingRoot[:]))
+
+		dataRoot, err := (&ethpb.Deposit_Data{
+			PublicKey:             pubKeys[i][:],
+			CreatorAddress:        creatorAddress,
+			WithdrawalCredentials: withdrawalAddress,
+			Amount:                item.Amount,
+			Signature:             sigBytes,
+			InitTxHash:            make([]byte, 32),
+		}).HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, hexutil.Encode(dataRoot[:]), item.DepositDataRoot)
+	}
+}
+
+func TestDepositDataCli_InvalidInput(t *testing.T) {
+	walletDir, _, passwordFilePath := setupWalletAndPasswordsDir(t)
+	password := "Pa$sW0rD0__Fo0xPr"
+	require.NoError(t, ioutil.WriteFile(passwordFilePath, []byte(password), os.ModePerm))
+	cliCtx := setupWalletCtx(t, &testWalletConfig{
+		walletDir:          walletDir,
+		keymanagerKind:     keymanager.Local,
+		walletPasswordFile: passwordFilePath,
+	})
+	_, err := CreateWalletWithKeymanager(cliCtx.Context, &CreateWalletConfig{
+		WalletCfg: &wallet.Config{
+			WalletDir:      walletDir,
+			KeymanagerKind: keymanager.Local,
+			WalletPassword: password,
+		},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		cfg    *testWalletConfig
+		errMsg string
+	}{
+		{
+			name:   "missing creator address",
+			cfg:    &testWalletConfig{depositWithdrawalAddr: testWithdrawalAddress},
+			errMsg: "--deposit-creator-address is required",
+		},
+		{
+			name:   "invalid withdrawal address",
+			cfg:    &testWalletConfig{depositCreatorAddress: testCreatorAddress, depositWithdrawalAddr: "0x1234"},
+			errMsg: "--deposit-withdrawal-address 0x1234 is not a valid address",
+		},
+		{
+			name: "amount too low",
+			cfg: &testWalletConfig{
+				depositCreatorAddress: testCreatorAddress,
+				depositWithdrawalAddr: testWithdrawalAddress,
+				depositAmount:         params.BeaconConfig().MinDepositAmount - 1,
+			},
+			errMsg: "is less than the min deposit amount",
+		},
+		{
+			name:   "empty wallet",
+			cfg:    &testWalletConfig{depositCreatorAddress: testCreatorAddress, depositWithdrawalAddr: testWithdrawalAddress},
+			errMsg: "wallet is empty",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.cfg.walletDir = walletDir
+			tt.cfg.keymanagerKind = keymanager.Local
+			tt.cfg.walletPasswordFile = passwordFilePath
+			err := DepositDataCli(setupWalletCtx(t, tt.cfg))
+			require.ErrorContains(t, tt.errMsg, err)
+		})
+	}
+}
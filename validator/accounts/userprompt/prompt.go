@@ -28,6 +28,8 @@ const (
 	SelectAccountsDeletePromptText = "Select the account(s) you would like to delete"
 	// SelectAccountsBackupPromptText --
 	SelectAccountsBackupPromptText = "Select the account(s) you wish to backup"
+	// SelectAccountsDepositDataPromptText --
+	SelectAccountsDepositDataPromptText = "Select the account(s) you wish to generate the deposit data for"
 	// SelectAccountsVoluntaryExitPromptText --
 	SelectAccountsVoluntaryExitPromptText = "Select the account(s) on which you wish to perform a voluntary exit"
 )
@@ -49,6 +49,11 @@ type testWalletConfig struct {
 	backupPublicKeys        string
 	voluntaryExitPublicKeys string
 	deletePublicKeys        string
+	depositPublicKeys       string
+	depositCreatorAddress   string
+	depositWithdrawalAddr   string
+	depositAmount           uint64
+	depositDataFile         string
 	keysDir                 string
 	backupDir               string
 	passwordsDir            string
@@ -74,6 +79,14 @@ func setupWalletCtx(
 	set.Bool(flags.SkipDepositConfirmationFlag.Name, cfg.skipDepositConfirm, "")
 	set.Bool(flags.SkipMnemonic25thWordCheckFlag.Name, true, "")
 	set.String(flags.GrpcHeadersFlag.Name, cfg.grpcHeaders, "")
+	set.String(flags.DepositCreatorAddressFlag.Name, cfg.depositCreatorAddress, "")
+	set.String(flags.DepositWithdrawalAddressFlag.Name, cfg.depositWithdrawalAddr, "")
+	set.Uint64(flags.DepositAmountFlag.Name, cfg.depositAmount, "")
+	set.String(flags.DepositDataFileFlag.Name, cfg.depositDataFile, "")
+	if cfg.depositPublicKeys != "" {
+		set.String(flags.DepositPublicKeysFlag.Name, cfg.depositPublicKeys, "")
+		assert.NoError(tb, set.Set(flags.DepositPublicKeysFlag.Name, cfg.depositPublicKeys))
+	}
 
 	if cfg.privateKeyFile != "" {
 		set.String(flags.ImportPrivateKeyFileFlag.Name, cfg.privateKeyFile, "")
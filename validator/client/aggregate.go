@@ -150,12 +150,7 @@ func (v *validator) waitToSlotTwoThirds(ctx context.Context, slot types.Slot) {
 	ctx, span := trace.StartSpan(ctx, "validator.waitToSlotTwoThirds")
 	defer span.End()
 
-	oneThird := slots.DivideSlotBy(3 /* one third of slot duration */)
-	twoThird := oneThird + oneThird
-	delay := twoThird
-
-	startTime := slots.StartTime(v.genesisTime, slot)
-	finalTime := startTime.Add(delay)
+	finalTime := v.slotTwoThirdsTime(slot)
 	wait := prysmTime.Until(finalTime)
 	if wait <= 0 {
 		return
@@ -171,6 +166,13 @@ func (v *validator) waitToSlotTwoThirds(ctx context.Context, slot types.Slot) {
 	}
 }
 
+// slotTwoThirdsTime returns the time of two thirds through the slot period.
+func (v *validator) slotTwoThirdsTime(slot types.Slot) time.Time {
+	oneThird := slots.DivideSlotBy(3 /* one third of slot duration */)
+	twoThird := oneThird + oneThird
+	return slots.StartTime(v.genesisTime, slot).Add(twoThird)
+}
+
 // This returns the signature of validator signing over aggregate and
 // proof object.
 func (v *validator) aggregateAndProofSig(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, agg *ethpb.AggregateAttestationAndProof, slot types.Slot) ([]byte, error) {
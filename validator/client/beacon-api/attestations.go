@@ -0,0 +1,106 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package beacon_api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+	ethpbv1 "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/migration"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/gwat/common/hexutil"
+	"google.golang.org/grpc"
+)
+
+// GetAttestationData requests the beacon node to produce the attestation data of the slot and committee.
+func (c *beaconApiValidatorClient) GetAttestationData(ctx context.Context, req *ethpb.AttestationDataRequest, _ ...grpc.CallOption) (*ethpb.AttestationData, error) {
+	data := &ethpbv1.AttestationData{}
+	endpoint := fmt.Sprintf("/eth/v1/validator/attestation_data?slot=%d&committee_index=%d", req.Slot, req.CommitteeIndex)
+	if err := getData(ctx, c.jsonRestHandler, endpoint, data); err != nil {
+		return nil, errors.Wrap(err, "could not get attestation data")
+	}
+	return migration.V1AttDataToV1Alpha1(data), nil
+}
+
+// ProposeAttestation submits the attestation to the attestations pool of the beacon node.
+func (c *beaconApiValidatorClient) ProposeAttestation(ctx context.Context, att *ethpb.Attestation, _ ...grpc.CallOption) (*ethpb.AttestResponse, error) {
+	if att.Data == nil {
+		return nil, errors.New("attestation data can't be nil")
+	}
+	root, err := att.Data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute attestation data root")
+	}
+	body := []interface{}{protoToJson(migration.V1Alpha1AttestationToV1(att))}
+	if err := c.jsonRestHandler.post(ctx, "/eth/v1/beacon/pool/attestations", body, nil); err != nil {
+		return nil, errors.Wrap(err, "could not submit attestation")
+	}
+	return &ethpb.AttestResponse{AttestationDataRoot: root[:]}, nil
+}
+
+// SubmitAggregateSelectionProof retrieves the aggregated attestation of the committee from the beacon node
+// and wraps it into the aggregate and proof of the validator.
+func (c *beaconApiValidatorClient) SubmitAggregateSelectionProof(ctx context.Context, req *ethpb.AggregateSelectionRequest, _ ...grpc.CallOption) (*ethpb.AggregateSelectionResponse, error) {
+	idx, err := c.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: req.PublicKey})
+	if err != nil {
+		return nil, err
+	}
+	data, err := c.GetAttestationData(ctx, &ethpb.AttestationDataRequest{Slot: req.Slot, CommitteeIndex: req.CommitteeIndex})
+	if err != nil {
+		return nil, err
+	}
+	root, err := data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute attestation data root")
+	}
+
+	query := url.Values{}
+	query.Set("attestation_data_root", hexutil.Encode(root[:]))
+	query.Set("slot", fmt.Sprint(req.Slot))
+	aggregate := &ethpbv1.Attestation{}
+	if err := getData(ctx, c.jsonRestHandler, "/eth/v1/validator/aggregate_attestation?"+query.Encode(), aggregate); err != nil {
+		return nil, errors.Wrap(err, "could not get aggregate attestation")
+	}
+	return &ethpb.AggregateSelectionResponse{
+		AggregateAndProof: &ethpb.AggregateAttestationAndProof{
+			AggregatorIndex: idx.Index,
+			Aggregate:       migration.V1AttToV1Alpha1(aggregate),
+			SelectionProof:  req.SlotSignature,
+		},
+	}, nil
+}
+
+// SubmitSignedAggregateSelectionProof submits the signed aggregate and proof to the beacon node.
+func (c *beaconApiValidatorClient) SubmitSignedAggregateSelectionProof(ctx context.Context, req *ethpb.SignedAggregateSubmitRequest, _ ...grpc.CallOption) (*ethpb.SignedAggregateSubmitResponse, error) {
+	if req.SignedAggregateAndProof == nil || req.SignedAggregateAndProof.Message == nil ||
+		req.SignedAggregateAndProof.Message.Aggregate == nil {
+		return nil, errors.New("signed aggregate request can't be nil")
+	}
+	root, err := req.SignedAggregateAndProof.Message.Aggregate.Data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute attestation data root")
+	}
+	body := []interface{}{protoToJson(&ethpbv1.SignedAggregateAttestationAndProof{
+		Message:   migration.V1Alpha1AggregateAttAndProofToV1(req.SignedAggregateAndProof.Message),
+		Signature: req.SignedAggregateAndProof.Signature,
+	})}
+	if err := c.jsonRestHandler.post(ctx, "/eth/v1/validator/aggregate_and_proofs", body, nil); err != nil {
+		return nil, errors.Wrap(err, "could not submit aggregate and proof")
+	}
+	return &ethpb.SignedAggregateSubmitResponse{AttestationDataRoot: root[:]}, nil
+}
@@ -0,0 +1,67 @@
+package beacon_api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	ethpbv1 "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/migration"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/util"
+)
+
+func TestGetAttestationData(t *testing.T) {
+	want := util.HydrateAttestationData(&ethpb.AttestationData{
+		Slot:            4,
+		CommitteeIndex:  1,
+		BeaconBlockRoot: bytesutil.PadTo([]byte{0x01}, 32),
+		Source:          &ethpb.Checkpoint{Epoch: 0, Root: bytesutil.PadTo([]byte{0x02}, 32)},
+	})
+	client := newTestClient(t, map[string]http.HandlerFunc{
+		"/eth/v1/validator/attestation_data": func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "4", r.URL.Query().Get("slot"))
+			require.Equal(t, "1", r.URL.Query().Get("committee_index"))
+			writeData(t, w, protoToJson(migration.V1Alpha1AttDataToV1(want)))
+		},
+	})
+
+	data, err := client.GetAttestationData(context.Background(), &ethpb.AttestationDataRequest{Slot: 4, CommitteeIndex: 1})
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, want, data)
+}
+
+func TestProposeAttestation(t *testing.T) {
+	var submitted []*ethpbv1.Attestation
+	client := newTestClient(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/pool/attestations": func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, http.MethodPost, r.Method)
+			var items []json.RawMessage
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&items))
+			for _, item := range items {
+				att := &ethpbv1.Attestation{}
+				require.NoError(t, jsonToProto(item, att))
+				submitted = append(submitted, att)
+			}
+		},
+	})
+
+	att := util.HydrateAttestation(&ethpb.Attestation{
+		AggregationBits: bitfield.NewBitlist(8),
+		Data:            &ethpb.AttestationData{Slot: 4, CommitteeIndex: 1},
+	})
+	resp, err := client.ProposeAttestation(context.Background(), att)
+	require.NoError(t, err)
+	root, err := att.Data.HashTreeRoot()
+	require.NoError(t, err)
+	require.DeepEqual(t, root[:], resp.AttestationDataRoot)
+	require.Equal(t, 1, len(submitted))
+	require.DeepSSZEqual(t, migration.V1Alpha1AttestationToV1(att), submitted[0])
+
+	_, err = client.ProposeAttestation(context.Background(), &ethpb.Attestation{})
+	require.ErrorContains(t, "attestation data can't be nil", err)
+}
@@ -0,0 +1,122 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+// Package beacon_api implements the beacon node clients of the validator client
+// on top of the Ethereum beacon API served over HTTP, together with the Waterfall
+// specific endpoints such as the prevote ones. It lets the validator client work with
+// a beacon node which is reachable through the REST gateway only.
+package beacon_api
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// errNotSupported is returned by the methods which have no counterpart in the beacon API.
+var errNotSupported = errors.New("not supported by the beacon REST API")
+
+type beaconApiValidatorClient struct {
+	jsonRestHandler *jsonRestHandler
+	genesisProvider *genesisProvider
+
+	dutiesLock    sync.RWMutex
+	subscriptions map[committeeKey]*committeeSubscription
+}
+
+// NewBeaconApiValidatorClient creates a validator client which talks to the beacon node
+// through the REST API at the given host.
+func NewBeaconApiValidatorClient(host string, timeout time.Duration) ethpb.BeaconNodeValidatorClient {
+	handler := newJsonRestHandler(host, timeout)
+	return &beaconApiValidatorClient{
+		jsonRestHandler: handler,
+		genesisProvider: &genesisProvider{jsonRestHandler: handler},
+		subscriptions:   make(map[committeeKey]*committeeSubscription),
+	}
+}
+
+// StreamDuties is not supported by the beacon API, the duties are polled with GetDuties instead.
+func (c *beaconApiValidatorClient) StreamDuties(_ context.Context, _ *ethpb.DutiesRequest, _ ...grpc.CallOption) (ethpb.BeaconNodeValidator_StreamDutiesClient, error) {
+	return nil, errors.Wrap(errNotSupported, "StreamDuties")
+}
+
+// GetBlock is deprecated and not supported by the beacon API, GetBeaconBlock is used instead.
+func (c *beaconApiValidatorClient) GetBlock(_ context.Context, _ *ethpb.BlockRequest, _ ...grpc.CallOption) (*ethpb.BeaconBlock, error) {
+	return nil, errors.Wrap(errNotSupported, "GetBlock")
+}
+
+// ProposeBlock is deprecated and not supported by the beacon API, ProposeBeaconBlock is used instead.
+func (c *beaconApiValidatorClient) ProposeBlock(_ context.Context, _ *ethpb.SignedBeaconBlock, _ ...grpc.CallOption) (*ethpb.ProposeResponse, error) {
+	return nil, errors.Wrap(errNotSupported, "ProposeBlock")
+}
+
+// ProposeExit is not supported, since the gateway does not accept the voluntary exits.
+func (c *beaconApiValidatorClient) ProposeExit(_ context.Context, _ *ethpb.VoluntaryExit, _ ...grpc.CallOption) (*ethpb.ProposeExitResponse, error) {
+	return nil, errors.Wrap(errNotSupported, "ProposeExit")
+}
+
+// CheckDoppelGanger is not supported by the beacon API.
+func (c *beaconApiValidatorClient) CheckDoppelGanger(_ context.Context, _ *ethpb.DoppelGangerRequest, _ ...grpc.CallOption) (*ethpb.DoppelGangerResponse, error) {
+	return nil, errors.Wrap(errNotSupported, "CheckDoppelGanger")
+}
+
+// convertProto copies the source message into the destination one, which must have
+// the same wire format, e.g. a block of the beacon API and its v1alpha1 counterpart.
+func convertProto(src, dst proto.Message) error {
+	b, err := proto.Marshal(src)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal message")
+	}
+	if err := proto.Unmarshal(b, dst); err != nil {
+		return errors.Wrap(err, "could not unmarshal message")
+	}
+	return nil
+}
+
+// dataResponseJson is the envelope of the beacon API responses.
+type dataResponseJson struct {
+	Data json.RawMessage `json:"data"`
+}
+
+// getData requests the endpoint and decodes the data of its response into the proto message.
+func getData(ctx context.Context, handler *jsonRestHandler, endpoint string, msg proto.Message) error {
+	resp := &dataResponseJson{}
+	if err := handler.get(ctx, endpoint, resp); err != nil {
+		return err
+	}
+	if err := jsonToProto(resp.Data, msg); err != nil {
+		return errors.Wrapf(err, "could not decode data of endpoint %s", endpoint)
+	}
+	return nil
+}
+
+// decodeList decodes the JSON array of the response data, calling decode for every item.
+func decodeList(data json.RawMessage, decode func(item []byte) error) error {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return errors.Wrap(err, "could not decode json array")
+	}
+	for _, item := range items {
+		if err := decode(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package beacon_api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+)
+
+var _ = ethpb.BeaconNodeValidatorClient(&beaconApiValidatorClient{})
+
+// newTestClient starts the beacon API server with the handlers and returns the validator client connected to it.
+func newTestClient(t *testing.T, handlers map[string]http.HandlerFunc) *beaconApiValidatorClient {
+	mux := http.NewServeMux()
+	for pattern, handler := range handlers {
+		mux.HandleFunc(pattern, handler)
+	}
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	client, ok := NewBeaconApiValidatorClient(srv.URL, time.Second).(*beaconApiValidatorClient)
+	require.Equal(t, true, ok)
+	return client
+}
+
+func writeData(t *testing.T, w http.ResponseWriter, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{"data": data}))
+}
+
+func TestBeaconApiValidatorClient_ErrorResponse(t *testing.T) {
+	client := newTestClient(t, map[string]http.HandlerFunc{
+		"/eth/v1/validator/attestation_data": func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, err := w.Write([]byte(`{"code":503,"message":"Beacon node is currently syncing"}`))
+			require.NoError(t, err)
+		},
+	})
+
+	_, err := client.GetAttestationData(context.Background(), &ethpb.AttestationDataRequest{Slot: 1})
+	require.ErrorContains(t, "responded with status code 503: Beacon node is currently syncing", err)
+
+	_, err = client.GetPrevoteData(context.Background(), &ethpb.PreVoteRequest{Slot: 1})
+	require.Equal(t, true, errors.Is(err, errNotFound))
+}
+
+func TestBeaconApiValidatorClient_NotSupported(t *testing.T) {
+	client := newTestClient(t, nil)
+
+	_, err := client.ProposeExit(context.Background(), &ethpb.VoluntaryExit{})
+	require.Equal(t, true, errors.Is(err, errNotSupported))
+	_, err = client.CheckDoppelGanger(context.Background(), &ethpb.DoppelGangerRequest{})
+	require.Equal(t, true, errors.Is(err, errNotSupported))
+}
@@ -0,0 +1,91 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package beacon_api
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpbv1 "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v1"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/time/slots"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/validator/client/iface"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type beaconApiBeaconChainClient struct {
+	jsonRestHandler *jsonRestHandler
+}
+
+// NewBeaconApiBeaconChainClient creates a beacon chain client which talks to the beacon node
+// through the REST API at the given host.
+func NewBeaconApiBeaconChainClient(host string, timeout time.Duration) iface.BeaconChainClient {
+	return &beaconApiBeaconChainClient{
+		jsonRestHandler: newJsonRestHandler(host, timeout),
+	}
+}
+
+// GetChainHead retrieves the head block and the checkpoints of the head state.
+func (c *beaconApiBeaconChainClient) GetChainHead(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.ChainHead, error) {
+	header := &ethpbv1.BlockHeaderContainer{}
+	if err := getData(ctx, c.jsonRestHandler, "/eth/v1/beacon/headers/head", header); err != nil {
+		return nil, errors.Wrap(err, "could not get head block header")
+	}
+	if header.Header == nil || header.Header.Message == nil {
+		return nil, errors.New("head block header is nil")
+	}
+	checkpoints := &ethpbv1.StateFinalityCheckpointResponse_StateFinalityCheckpoint{}
+	if err := getData(ctx, c.jsonRestHandler, "/eth/v1/beacon/states/head/finality_checkpoints", checkpoints); err != nil {
+		return nil, errors.Wrap(err, "could not get finality checkpoints")
+	}
+	if checkpoints.Finalized == nil || checkpoints.CurrentJustified == nil || checkpoints.PreviousJustified == nil {
+		return nil, errors.New("finality checkpoints are nil")
+	}
+
+	finalizedSlot, err := slots.EpochStart(checkpoints.Finalized.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	justifiedSlot, err := slots.EpochStart(checkpoints.CurrentJustified.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	prevJustifiedSlot, err := slots.EpochStart(checkpoints.PreviousJustified.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	headSlot := header.Header.Message.Slot
+	return &ethpb.ChainHead{
+		HeadSlot:                   headSlot,
+		HeadEpoch:                  slots.ToEpoch(headSlot),
+		HeadBlockRoot:              header.Root,
+		FinalizedSlot:              finalizedSlot,
+		FinalizedEpoch:             checkpoints.Finalized.Epoch,
+		FinalizedBlockRoot:         checkpoints.Finalized.Root,
+		JustifiedSlot:              justifiedSlot,
+		JustifiedEpoch:             checkpoints.CurrentJustified.Epoch,
+		JustifiedBlockRoot:         checkpoints.CurrentJustified.Root,
+		PreviousJustifiedSlot:      prevJustifiedSlot,
+		PreviousJustifiedEpoch:     checkpoints.PreviousJustified.Epoch,
+		PreviousJustifiedBlockRoot: checkpoints.PreviousJustified.Root,
+	}, nil
+}
+
+// GetValidatorPerformance is not supported by the beacon API.
+func (c *beaconApiBeaconChainClient) GetValidatorPerformance(_ context.Context, _ *ethpb.ValidatorPerformanceRequest, _ ...grpc.CallOption) (*ethpb.ValidatorPerformanceResponse, error) {
+	return nil, errors.Wrap(errNotSupported, "GetValidatorPerformance")
+}
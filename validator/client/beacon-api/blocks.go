@@ -0,0 +1,257 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package beacon_api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	ethpbv1 "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v1"
+	ethpbv2 "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v2"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/migration"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/gwat/common/hexutil"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type versionedResponseJson struct {
+	Version string          `json:"version"`
+	Data    json.RawMessage `json:"data"`
+}
+
+type signedBlockJson struct {
+	Message   json.RawMessage `json:"message"`
+	Signature string          `json:"signature"`
+}
+
+type blockRootResponseJson struct {
+	Data *struct {
+		Root string `json:"root"`
+	} `json:"data"`
+}
+
+type feeRecipientJson struct {
+	ValidatorIndex uint64 `json:"validator_index"`
+	FeeRecipient   string `json:"fee_recipient"`
+}
+
+// GetBeaconBlock requests the beacon node to produce a block of the slot and converts it
+// from the version reported by the beacon API into the v1alpha1 block.
+func (c *beaconApiValidatorClient) GetBeaconBlock(ctx context.Context, req *ethpb.BlockRequest, _ ...grpc.CallOption) (*ethpb.GenericBeaconBlock, error) {
+	query := url.Values{}
+	query.Set("randao_reveal", hexutil.Encode(req.RandaoReveal))
+	if len(req.Graffiti) > 0 {
+		query.Set("graffiti", hexutil.Encode(req.Graffiti))
+	}
+	resp := &versionedResponseJson{}
+	endpoint := fmt.Sprintf("/eth/v2/validator/blocks/%d?%s", req.Slot, query.Encode())
+	if err := c.jsonRestHandler.get(ctx, endpoint, resp); err != nil {
+		return nil, errors.Wrap(err, "could not produce block")
+	}
+
+	switch strings.ToLower(resp.Version) {
+	case strings.ToLower(ethpbv2.Version_PHASE0.String()):
+		blk := &ethpbv1.BeaconBlock{}
+		alphaBlk := &ethpb.BeaconBlock{}
+		if err := decodeBlock(resp.Data, blk, alphaBlk); err != nil {
+			return nil, err
+		}
+		return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Phase0{Phase0: alphaBlk}}, nil
+	case strings.ToLower(ethpbv2.Version_ALTAIR.String()):
+		blk := &ethpbv2.BeaconBlockAltair{}
+		alphaBlk := &ethpb.BeaconBlockAltair{}
+		if err := decodeBlock(resp.Data, blk, alphaBlk); err != nil {
+			return nil, err
+		}
+		return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Altair{Altair: alphaBlk}}, nil
+	case strings.ToLower(ethpbv2.Version_BELLATRIX.String()):
+		blk := &ethpbv2.BeaconBlockBellatrix{}
+		alphaBlk := &ethpb.BeaconBlockBellatrix{}
+		if err := decodeBlock(resp.Data, blk, alphaBlk); err != nil {
+			return nil, err
+		}
+		return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Bellatrix{Bellatrix: alphaBlk}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported block version %s", resp.Version)
+	}
+}
+
+// ProposeBeaconBlock publishes the signed block. The beacon API chooses the block version by its slot.
+func (c *beaconApiValidatorClient) ProposeBeaconBlock(ctx context.Context, req *ethpb.GenericSignedBeaconBlock, _ ...grpc.CallOption) (*ethpb.ProposeResponse, error) {
+	var (
+		message   proto.Message
+		signature []byte
+		hashRoot  func() ([32]byte, error)
+		err       error
+	)
+	switch b := req.Block.(type) {
+	case *ethpb.GenericSignedBeaconBlock_Phase0:
+		message, err = migration.V1Alpha1ToV1Block(b.Phase0.Block)
+		signature, hashRoot = b.Phase0.Signature, b.Phase0.Block.HashTreeRoot
+	case *ethpb.GenericSignedBeaconBlock_Altair:
+		message, err = migration.V1Alpha1BeaconBlockAltairToV2(b.Altair.Block)
+		signature, hashRoot = b.Altair.Signature, b.Altair.Block.HashTreeRoot
+	case *ethpb.GenericSignedBeaconBlock_Bellatrix:
+		message, err = migration.V1Alpha1BeaconBlockBellatrixToV2(b.Bellatrix.Block)
+		signature, hashRoot = b.Bellatrix.Signature, b.Bellatrix.Block.HashTreeRoot
+	case *ethpb.GenericSignedBeaconBlock_BlindedBellatrix:
+		return nil, errors.Wrap(errNotSupported, "blinded blocks")
+	default:
+		return nil, fmt.Errorf("unsupported block type %T", req.Block)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert block")
+	}
+	root, err := hashRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute block root")
+	}
+
+	body := map[string]interface{}{
+		"message":   protoToJson(message),
+		"signature": hexutil.Encode(signature),
+	}
+	if err := c.jsonRestHandler.post(ctx, "/eth/v1/beacon/blocks", body, nil); err != nil {
+		return nil, errors.Wrap(err, "could not publish block")
+	}
+	return &ethpb.ProposeResponse{BlockRoot: root[:]}, nil
+}
+
+// PrepareBeaconProposer sends the fee recipients of the validators to the beacon node.
+func (c *beaconApiValidatorClient) PrepareBeaconProposer(ctx context.Context, req *ethpb.PrepareBeaconProposerRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	recipients := make([]*feeRecipientJson, len(req.Recipients))
+	for i, r := range req.Recipients {
+		recipients[i] = &feeRecipientJson{
+			ValidatorIndex: uint64(r.ValidatorIndex),
+			FeeRecipient:   hexutil.Encode(r.FeeRecipient),
+		}
+	}
+	if err := c.jsonRestHandler.post(ctx, "/eth/v1/validator/prepare_beacon_proposer", recipients, nil); err != nil {
+		return nil, errors.Wrap(err, "could not prepare beacon proposer")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// StreamBlocksAltair returns a stream of the head blocks of the beacon node.
+// The beacon API serves the blocks of the canonical chain only, so the verified only flag is ignored.
+func (c *beaconApiValidatorClient) StreamBlocksAltair(ctx context.Context, _ *ethpb.StreamBlocksRequest, _ ...grpc.CallOption) (ethpb.BeaconNodeValidator_StreamBlocksAltairClient, error) {
+	return &streamBlocksStream{
+		pollingStream: pollingStream{ctx: ctx},
+		client:        c,
+	}, nil
+}
+
+type streamBlocksStream struct {
+	pollingStream
+	client   *beaconApiValidatorClient
+	headRoot []byte
+}
+
+// Recv polls the head of the beacon node every half of a slot and returns its block once the head changes.
+func (s *streamBlocksStream) Recv() (*ethpb.StreamBlocksResponse, error) {
+	for {
+		root, err := s.client.headBlockRoot(s.ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(root, s.headRoot) {
+			res, err := s.client.signedBlock(s.ctx, root)
+			if err != nil {
+				return nil, err
+			}
+			s.headRoot = root
+			return res, nil
+		}
+		if err := s.wait(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second / 2); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (c *beaconApiValidatorClient) headBlockRoot(ctx context.Context) ([]byte, error) {
+	resp := &blockRootResponseJson{}
+	if err := c.jsonRestHandler.get(ctx, "/eth/v1/beacon/blocks/head/root", resp); err != nil {
+		return nil, errors.Wrap(err, "could not get head block root")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("head block root is nil")
+	}
+	root, err := hexutil.Decode(resp.Data.Root)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid head block root %s", resp.Data.Root)
+	}
+	return root, nil
+}
+
+func (c *beaconApiValidatorClient) signedBlock(ctx context.Context, root []byte) (*ethpb.StreamBlocksResponse, error) {
+	resp := &versionedResponseJson{}
+	if err := c.jsonRestHandler.get(ctx, "/eth/v2/beacon/blocks/"+hexutil.Encode(root), resp); err != nil {
+		return nil, errors.Wrapf(err, "could not get block %#x", root)
+	}
+	signed := &signedBlockJson{}
+	if err := json.Unmarshal(resp.Data, signed); err != nil {
+		return nil, errors.Wrap(err, "could not decode signed block")
+	}
+	signature, err := hexutil.Decode(signed.Signature)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid block signature %s", signed.Signature)
+	}
+
+	switch strings.ToLower(resp.Version) {
+	case strings.ToLower(ethpbv2.Version_PHASE0.String()):
+		blk := &ethpb.BeaconBlock{}
+		if err := decodeBlock(signed.Message, &ethpbv1.BeaconBlock{}, blk); err != nil {
+			return nil, err
+		}
+		return &ethpb.StreamBlocksResponse{Block: &ethpb.StreamBlocksResponse_Phase0Block{
+			Phase0Block: &ethpb.SignedBeaconBlock{Block: blk, Signature: signature},
+		}}, nil
+	case strings.ToLower(ethpbv2.Version_ALTAIR.String()):
+		blk := &ethpb.BeaconBlockAltair{}
+		if err := decodeBlock(signed.Message, &ethpbv2.BeaconBlockAltair{}, blk); err != nil {
+			return nil, err
+		}
+		return &ethpb.StreamBlocksResponse{Block: &ethpb.StreamBlocksResponse_AltairBlock{
+			AltairBlock: &ethpb.SignedBeaconBlockAltair{Block: blk, Signature: signature},
+		}}, nil
+	case strings.ToLower(ethpbv2.Version_BELLATRIX.String()):
+		blk := &ethpb.BeaconBlockBellatrix{}
+		if err := decodeBlock(signed.Message, &ethpbv2.BeaconBlockBellatrix{}, blk); err != nil {
+			return nil, err
+		}
+		return &ethpb.StreamBlocksResponse{Block: &ethpb.StreamBlocksResponse_BellatrixBlock{
+			BellatrixBlock: &ethpb.SignedBeaconBlockBellatrix{Block: blk, Signature: signature},
+		}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported block version %s", resp.Version)
+	}
+}
+
+// decodeBlock decodes the JSON block of the beacon API into apiBlk and converts it into the v1alpha1 alphaBlk.
+func decodeBlock(data []byte, apiBlk, alphaBlk proto.Message) error {
+	if err := jsonToProto(data, apiBlk); err != nil {
+		return errors.Wrap(err, "could not decode block")
+	}
+	return convertProto(apiBlk, alphaBlk)
+}
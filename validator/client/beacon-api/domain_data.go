@@ -0,0 +1,44 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package beacon_api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/signing"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/network/forks"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"google.golang.org/grpc"
+)
+
+// DomainData computes the signature domain of the requested epoch and domain type
+// from the fork schedule of the local config and the genesis validators root of the beacon node.
+func (c *beaconApiValidatorClient) DomainData(ctx context.Context, req *ethpb.DomainRequest, _ ...grpc.CallOption) (*ethpb.DomainResponse, error) {
+	genesis, err := c.genesisProvider.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fork, err := forks.Fork(req.Epoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get fork")
+	}
+	domain, err := signing.Domain(fork, req.Epoch, bytesutil.ToBytes4(req.Domain), genesis.genesisValidatorsRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute domain")
+	}
+	return &ethpb.DomainResponse{SignatureDomain: domain}, nil
+}
@@ -0,0 +1,294 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package beacon_api
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	fieldparams "gitlab.waterfall.network/waterfall/protocol/coordinator/config/fieldparams"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	ethpbv1 "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v1"
+	ethpbv2 "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v2"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type committeeKey struct {
+	slot           types.Slot
+	committeeIndex types.CommitteeIndex
+}
+
+// committeeSubscription keeps the data of an attester duty
+// which the beacon committee subscriptions endpoint requires.
+type committeeSubscription struct {
+	validatorIndex   types.ValidatorIndex
+	committeesAtSlot uint64
+}
+
+// GetDuties retrieves the attester, proposer and sync committee duties of the validators
+// for the requested and the next epochs. The proposer duties are known for the requested epoch only.
+func (c *beaconApiValidatorClient) GetDuties(ctx context.Context, req *ethpb.DutiesRequest, _ ...grpc.CallOption) (*ethpb.DutiesResponse, error) {
+	validators, err := c.validatorsByPubKey(ctx, req.PublicKeys)
+	if err != nil {
+		return nil, err
+	}
+	currentDuties, err := c.epochDuties(ctx, req.Epoch, req.PublicKeys, validators, true)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get duties of epoch %d", req.Epoch)
+	}
+	nextDuties, err := c.epochDuties(ctx, req.Epoch+1, req.PublicKeys, validators, false)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get duties of epoch %d", req.Epoch+1)
+	}
+	return &ethpb.DutiesResponse{
+		Duties:             currentDuties,
+		CurrentEpochDuties: currentDuties,
+		NextEpochDuties:    nextDuties,
+	}, nil
+}
+
+func (c *beaconApiValidatorClient) epochDuties(
+	ctx context.Context,
+	epoch types.Epoch,
+	pubKeys [][]byte,
+	validators map[[fieldparams.BLSPubkeyLength]byte]*ethpbv1.ValidatorContainer,
+	withProposals bool,
+) ([]*ethpb.DutiesResponse_Duty, error) {
+	duties := make([]*ethpb.DutiesResponse_Duty, len(pubKeys))
+	indices := make([]string, 0, len(validators))
+	for i, pk := range pubKeys {
+		duties[i] = &ethpb.DutiesResponse_Duty{
+			PublicKey: pk,
+			Status:    ethpb.ValidatorStatus_UNKNOWN_STATUS,
+		}
+		if v, ok := validators[bytesutil.ToBytes48(pk)]; ok {
+			duties[i].ValidatorIndex = v.Index
+			duties[i].Status = validatorStatus(v.Status)
+			indices = append(indices, strconv.FormatUint(uint64(v.Index), 10))
+		}
+	}
+	if len(indices) == 0 {
+		return duties, nil
+	}
+
+	attesterDuties, err := c.attesterDuties(ctx, epoch, indices)
+	if err != nil {
+		return nil, err
+	}
+	committees, err := c.committees(ctx, epoch)
+	if err != nil {
+		return nil, err
+	}
+	var proposerSlots map[types.ValidatorIndex][]types.Slot
+	if withProposals {
+		if proposerSlots, err = c.proposerSlots(ctx, epoch); err != nil {
+			return nil, err
+		}
+	}
+	var syncCommittee map[types.ValidatorIndex]bool
+	if epoch >= params.BeaconConfig().AltairForkEpoch {
+		if syncCommittee, err = c.syncCommitteeMembers(ctx, epoch, indices); err != nil {
+			return nil, err
+		}
+	}
+
+	c.dutiesLock.Lock()
+	defer c.dutiesLock.Unlock()
+	for _, duty := range duties {
+		if _, ok := validators[bytesutil.ToBytes48(duty.PublicKey)]; !ok {
+			continue
+		}
+		duty.ProposerSlots = proposerSlots[duty.ValidatorIndex]
+		duty.IsSyncCommittee = syncCommittee[duty.ValidatorIndex]
+		ad, ok := attesterDuties[duty.ValidatorIndex]
+		if !ok {
+			continue
+		}
+		key := committeeKey{slot: ad.Slot, committeeIndex: ad.CommitteeIndex}
+		duty.AttesterSlot = ad.Slot
+		duty.CommitteeIndex = ad.CommitteeIndex
+		duty.Committee = committees[key]
+		c.subscriptions[key] = &committeeSubscription{
+			validatorIndex:   ad.ValidatorIndex,
+			committeesAtSlot: ad.CommitteesAtSlot,
+		}
+	}
+	return duties, nil
+}
+
+func (c *beaconApiValidatorClient) attesterDuties(ctx context.Context, epoch types.Epoch, indices []string) (map[types.ValidatorIndex]*ethpbv1.AttesterDuty, error) {
+	resp := &dataResponseJson{}
+	endpoint := fmt.Sprintf("/eth/v1/validator/duties/attester/%d", epoch)
+	if err := c.jsonRestHandler.post(ctx, endpoint, indices, resp); err != nil {
+		return nil, errors.Wrap(err, "could not get attester duties")
+	}
+	duties := make(map[types.ValidatorIndex]*ethpbv1.AttesterDuty, len(indices))
+	err := decodeList(resp.Data, func(item []byte) error {
+		d := &ethpbv1.AttesterDuty{}
+		if err := jsonToProto(item, d); err != nil {
+			return err
+		}
+		duties[d.ValidatorIndex] = d
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode attester duties")
+	}
+	return duties, nil
+}
+
+func (c *beaconApiValidatorClient) committees(ctx context.Context, epoch types.Epoch) (map[committeeKey][]types.ValidatorIndex, error) {
+	resp := &dataResponseJson{}
+	endpoint := fmt.Sprintf("/eth/v1/beacon/states/head/committees?epoch=%d", epoch)
+	if err := c.jsonRestHandler.get(ctx, endpoint, resp); err != nil {
+		return nil, errors.Wrap(err, "could not get committees")
+	}
+	committees := make(map[committeeKey][]types.ValidatorIndex)
+	err := decodeList(resp.Data, func(item []byte) error {
+		committee := &ethpbv1.Committee{}
+		if err := jsonToProto(item, committee); err != nil {
+			return err
+		}
+		committees[committeeKey{slot: committee.Slot, committeeIndex: committee.Index}] = committee.Validators
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode committees")
+	}
+	return committees, nil
+}
+
+func (c *beaconApiValidatorClient) proposerSlots(ctx context.Context, epoch types.Epoch) (map[types.ValidatorIndex][]types.Slot, error) {
+	resp := &dataResponseJson{}
+	endpoint := fmt.Sprintf("/eth/v1/validator/duties/proposer/%d", epoch)
+	if err := c.jsonRestHandler.get(ctx, endpoint, resp); err != nil {
+		return nil, errors.Wrap(err, "could not get proposer duties")
+	}
+	proposerSlots := make(map[types.ValidatorIndex][]types.Slot)
+	err := decodeList(resp.Data, func(item []byte) error {
+		d := &ethpbv1.ProposerDuty{}
+		if err := jsonToProto(item, d); err != nil {
+			return err
+		}
+		proposerSlots[d.ValidatorIndex] = append(proposerSlots[d.ValidatorIndex], d.Slot)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode proposer duties")
+	}
+	return proposerSlots, nil
+}
+
+func (c *beaconApiValidatorClient) syncCommitteeDuties(ctx context.Context, epoch types.Epoch, indices []string) ([]*ethpbv2.SyncCommitteeDuty, error) {
+	resp := &dataResponseJson{}
+	endpoint := fmt.Sprintf("/eth/v1/validator/duties/sync/%d", epoch)
+	if err := c.jsonRestHandler.post(ctx, endpoint, indices, resp); err != nil {
+		return nil, errors.Wrap(err, "could not get sync committee duties")
+	}
+	var duties []*ethpbv2.SyncCommitteeDuty
+	err := decodeList(resp.Data, func(item []byte) error {
+		d := &ethpbv2.SyncCommitteeDuty{}
+		if err := jsonToProto(item, d); err != nil {
+			return err
+		}
+		duties = append(duties, d)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode sync committee duties")
+	}
+	return duties, nil
+}
+
+func (c *beaconApiValidatorClient) syncCommitteeMembers(ctx context.Context, epoch types.Epoch, indices []string) (map[types.ValidatorIndex]bool, error) {
+	duties, err := c.syncCommitteeDuties(ctx, epoch, indices)
+	if err != nil {
+		return nil, err
+	}
+	members := make(map[types.ValidatorIndex]bool, len(duties))
+	for _, d := range duties {
+		members[d.ValidatorIndex] = true
+	}
+	return members, nil
+}
+
+// SubscribeCommitteeSubnets subscribes the beacon node to the attestation and prevote subnets
+// of the committees. The subscriptions require the duties of the committees to be fetched with GetDuties first.
+func (c *beaconApiValidatorClient) SubscribeCommitteeSubnets(ctx context.Context, req *ethpb.CommitteeSubnetsSubscribeRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	if len(req.Slots) != len(req.CommitteeIds) || len(req.CommitteeIds) != len(req.IsAggregator) {
+		return nil, errors.New("request fields are not the same length")
+	}
+
+	c.dutiesLock.RLock()
+	subscriptions := make([]*beaconCommitteeSubscriptionJson, 0, len(req.Slots))
+	for i := range req.Slots {
+		sub, ok := c.subscriptions[committeeKey{slot: req.Slots[i], committeeIndex: req.CommitteeIds[i]}]
+		if !ok {
+			log.WithField("slot", req.Slots[i]).WithField("committeeIndex", req.CommitteeIds[i]).Warn("Unknown committee duty, skipping subscription")
+			continue
+		}
+		subscriptions = append(subscriptions, &beaconCommitteeSubscriptionJson{
+			ValidatorIndex:   strconv.FormatUint(uint64(sub.validatorIndex), 10),
+			CommitteeIndex:   strconv.FormatUint(uint64(req.CommitteeIds[i]), 10),
+			CommitteesAtSlot: strconv.FormatUint(sub.committeesAtSlot, 10),
+			Slot:             strconv.FormatUint(uint64(req.Slots[i]), 10),
+			IsAggregator:     req.IsAggregator[i],
+		})
+	}
+	c.dutiesLock.RUnlock()
+
+	if len(subscriptions) == 0 {
+		return &emptypb.Empty{}, nil
+	}
+	if err := c.jsonRestHandler.post(ctx, "/eth/v1/validator/beacon_committee_subscriptions", subscriptions, nil); err != nil {
+		return nil, errors.Wrap(err, "could not subscribe to committee subnets")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+type beaconCommitteeSubscriptionJson struct {
+	ValidatorIndex   string `json:"validator_index"`
+	CommitteeIndex   string `json:"committee_index"`
+	CommitteesAtSlot string `json:"committees_at_slot"`
+	Slot             string `json:"slot"`
+	IsAggregator     bool   `json:"is_aggregator"`
+}
+
+// validatorStatus maps the status of the beacon API onto the validator status of the v1alpha1 API.
+func validatorStatus(status ethpbv1.ValidatorStatus) ethpb.ValidatorStatus {
+	switch status {
+	case ethpbv1.ValidatorStatus_PENDING_INITIALIZED:
+		return ethpb.ValidatorStatus_DEPOSITED
+	case ethpbv1.ValidatorStatus_PENDING_QUEUED:
+		return ethpb.ValidatorStatus_PENDING
+	case ethpbv1.ValidatorStatus_ACTIVE_ONGOING:
+		return ethpb.ValidatorStatus_ACTIVE
+	case ethpbv1.ValidatorStatus_ACTIVE_EXITING:
+		return ethpb.ValidatorStatus_EXITING
+	case ethpbv1.ValidatorStatus_ACTIVE_SLASHED:
+		return ethpb.ValidatorStatus_SLASHING
+	case ethpbv1.ValidatorStatus_EXITED_UNSLASHED, ethpbv1.ValidatorStatus_EXITED_SLASHED,
+		ethpbv1.ValidatorStatus_WITHDRAWAL_POSSIBLE, ethpbv1.ValidatorStatus_WITHDRAWAL_DONE:
+		return ethpb.ValidatorStatus_EXITED
+	default:
+		return ethpb.ValidatorStatus_UNKNOWN_STATUS
+	}
+}
@@ -0,0 +1,132 @@
+package beacon_api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	"gitlab.waterfall.network/waterfall/protocol/gwat/common/hexutil"
+)
+
+func validatorJson(index string, pubKey []byte, status string) map[string]interface{} {
+	return map[string]interface{}{
+		"index":   index,
+		"balance": "32000000000",
+		"status":  status,
+		"validator": map[string]interface{}{
+			"pubkey":           hexutil.Encode(pubKey),
+			"activation_epoch": "0",
+			"exit_epoch":       "18446744073709551615",
+		},
+	}
+}
+
+func TestGetDuties(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 1
+	params.OverrideBeaconConfig(cfg)
+
+	pubKey := bytesutil.PadTo([]byte{0x01}, 48)
+	unknownPubKey := bytesutil.PadTo([]byte{0x02}, 48)
+
+	var subscriptions []map[string]interface{}
+	client := newTestClient(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/states/head/validators": func(w http.ResponseWriter, r *http.Request) {
+			require.DeepEqual(t, []string{hexutil.Encode(pubKey), hexutil.Encode(unknownPubKey)}, r.URL.Query()["id"])
+			writeData(t, w, []interface{}{validatorJson("3", pubKey, "active_ongoing")})
+		},
+		"/eth/v1/validator/duties/attester/": func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, http.MethodPost, r.Method)
+			var indices []string
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&indices))
+			require.DeepEqual(t, []string{"3"}, indices)
+			slot := "5"
+			if strings.HasSuffix(r.URL.Path, "/1") {
+				slot = "37"
+			}
+			writeData(t, w, []interface{}{map[string]string{
+				"pubkey":             hexutil.Encode(pubKey),
+				"validator_index":    "3",
+				"committee_index":    "1",
+				"committee_length":   "2",
+				"committees_at_slot": "4",
+				"slot":               slot,
+			}})
+		},
+		"/eth/v1/beacon/states/head/committees": func(w http.ResponseWriter, r *http.Request) {
+			slot := "5"
+			if r.URL.Query().Get("epoch") == "1" {
+				slot = "37"
+			}
+			writeData(t, w, []interface{}{map[string]interface{}{
+				"index":      "1",
+				"slot":       slot,
+				"validators": []string{"3", "8"},
+			}})
+		},
+		"/eth/v1/validator/duties/proposer/0": func(w http.ResponseWriter, _ *http.Request) {
+			writeData(t, w, []interface{}{
+				map[string]string{"pubkey": hexutil.Encode(pubKey), "validator_index": "3", "slot": "2"},
+				map[string]string{"pubkey": hexutil.Encode(bytesutil.PadTo([]byte{0x08}, 48)), "validator_index": "8", "slot": "4"},
+			})
+		},
+		"/eth/v1/validator/duties/sync/1": func(w http.ResponseWriter, _ *http.Request) {
+			writeData(t, w, []interface{}{map[string]interface{}{
+				"pubkey":                           hexutil.Encode(pubKey),
+				"validator_index":                  "3",
+				"validator_sync_committee_indices": []string{"0"},
+			}})
+		},
+		"/eth/v1/validator/beacon_committee_subscriptions": func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&subscriptions))
+		},
+	})
+
+	resp, err := client.GetDuties(context.Background(), &ethpb.DutiesRequest{
+		Epoch:      0,
+		PublicKeys: [][]byte{pubKey, unknownPubKey},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.CurrentEpochDuties))
+	require.Equal(t, 2, len(resp.NextEpochDuties))
+
+	duty := resp.CurrentEpochDuties[0]
+	require.Equal(t, types.ValidatorIndex(3), duty.ValidatorIndex)
+	require.Equal(t, ethpb.ValidatorStatus_ACTIVE, duty.Status)
+	require.Equal(t, types.Slot(5), duty.AttesterSlot)
+	require.Equal(t, types.CommitteeIndex(1), duty.CommitteeIndex)
+	require.DeepEqual(t, []types.ValidatorIndex{3, 8}, duty.Committee)
+	require.DeepEqual(t, []types.Slot{2}, duty.ProposerSlots)
+	require.Equal(t, false, duty.IsSyncCommittee)
+	require.Equal(t, ethpb.ValidatorStatus_UNKNOWN_STATUS, resp.CurrentEpochDuties[1].Status)
+
+	next := resp.NextEpochDuties[0]
+	require.Equal(t, types.Slot(37), next.AttesterSlot)
+	require.Equal(t, 0, len(next.ProposerSlots))
+	require.Equal(t, true, next.IsSyncCommittee)
+
+	_, err = client.SubscribeCommitteeSubnets(context.Background(), &ethpb.CommitteeSubnetsSubscribeRequest{
+		Slots:        []types.Slot{5, 37, 40},
+		CommitteeIds: []types.CommitteeIndex{1, 1, 2},
+		IsAggregator: []bool{true, false, false},
+	})
+	require.NoError(t, err)
+	require.DeepEqual(t, []map[string]interface{}{
+		{"validator_index": "3", "committee_index": "1", "committees_at_slot": "4", "slot": "5", "is_aggregator": true},
+		{"validator_index": "3", "committee_index": "1", "committees_at_slot": "4", "slot": "37", "is_aggregator": false},
+	}, subscriptions)
+
+	_, err = client.SubscribeCommitteeSubnets(context.Background(), &ethpb.CommitteeSubnetsSubscribeRequest{
+		Slots:        []types.Slot{5},
+		CommitteeIds: []types.CommitteeIndex{1, 2},
+	})
+	require.ErrorContains(t, "request fields are not the same length", err)
+}
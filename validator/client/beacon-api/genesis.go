@@ -0,0 +1,114 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package beacon_api
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/gwat/common/hexutil"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type genesisInfo struct {
+	genesisTime           uint64
+	genesisValidatorsRoot []byte
+}
+
+type genesisResponseJson struct {
+	Data *genesisJson `json:"data"`
+}
+
+type genesisJson struct {
+	GenesisTime           string `json:"genesis_time"`
+	GenesisValidatorsRoot string `json:"genesis_validators_root"`
+}
+
+// genesisProvider retrieves the genesis of the chain and caches it once the chain has started.
+type genesisProvider struct {
+	jsonRestHandler *jsonRestHandler
+	lock            sync.Mutex
+	genesis         *genesisInfo
+}
+
+// get returns the genesis of the chain. The error wraps errNotFound if the chain has not started yet.
+func (p *genesisProvider) get(ctx context.Context) (*genesisInfo, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.genesis != nil {
+		return p.genesis, nil
+	}
+
+	resp := &genesisResponseJson{}
+	if err := p.jsonRestHandler.get(ctx, "/eth/v1/beacon/genesis", resp); err != nil {
+		return nil, errors.Wrap(err, "could not get genesis")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("genesis data is nil")
+	}
+	genesisTime, err := strconv.ParseUint(resp.Data.GenesisTime, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid genesis time %s", resp.Data.GenesisTime)
+	}
+	root, err := hexutil.Decode(resp.Data.GenesisValidatorsRoot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid genesis validators root %s", resp.Data.GenesisValidatorsRoot)
+	}
+	p.genesis = &genesisInfo{
+		genesisTime:           genesisTime,
+		genesisValidatorsRoot: root,
+	}
+	return p.genesis, nil
+}
+
+// WaitForChainStart returns a stream which waits until the beacon node knows the genesis of the chain.
+func (c *beaconApiValidatorClient) WaitForChainStart(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (ethpb.BeaconNodeValidator_WaitForChainStartClient, error) {
+	return &waitForChainStartStream{
+		pollingStream: pollingStream{ctx: ctx},
+		client:        c,
+	}, nil
+}
+
+type waitForChainStartStream struct {
+	pollingStream
+	client *beaconApiValidatorClient
+}
+
+// Recv polls the genesis endpoint until the chain has started.
+func (s *waitForChainStartStream) Recv() (*ethpb.ChainStartResponse, error) {
+	for {
+		genesis, err := s.client.genesisProvider.get(s.ctx)
+		if err == nil {
+			return &ethpb.ChainStartResponse{
+				Started:               true,
+				GenesisTime:           genesis.genesisTime,
+				GenesisValidatorsRoot: genesis.genesisValidatorsRoot,
+			}, nil
+		}
+		if !errors.Is(err, errNotFound) {
+			return nil, err
+		}
+		log.Debug("Chain has not started yet")
+		if err := s.wait(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second); err != nil {
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package beacon_api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/beacon-chain/core/signing"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/network/forks"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	"gitlab.waterfall.network/waterfall/protocol/gwat/common/hexutil"
+)
+
+func TestWaitForChainStart(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.SecondsPerSlot = 1
+	params.OverrideBeaconConfig(cfg)
+
+	root := bytesutil.PadTo([]byte{0x0a}, 32)
+	requests := 0
+	client := newTestClient(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/genesis": func(w http.ResponseWriter, _ *http.Request) {
+			requests++
+			if requests == 1 {
+				http.Error(w, `{"code":404,"message":"Chain genesis info is not yet known"}`, http.StatusNotFound)
+				return
+			}
+			writeData(t, w, map[string]string{
+				"genesis_time":            "1600000000",
+				"genesis_validators_root": hexutil.Encode(root),
+				"genesis_fork_version":    "0x00000000",
+			})
+		},
+	})
+
+	stream, err := client.WaitForChainStart(context.Background(), nil)
+	require.NoError(t, err)
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, true, resp.Started)
+	require.Equal(t, uint64(1600000000), resp.GenesisTime)
+	require.DeepEqual(t, root, resp.GenesisValidatorsRoot)
+	require.Equal(t, 2, requests)
+
+	// The genesis is cached after it has been received.
+	_, err = client.DomainData(context.Background(), &ethpb.DomainRequest{Epoch: 0, Domain: params.BeaconConfig().DomainBeaconAttester[:]})
+	require.NoError(t, err)
+	require.Equal(t, 2, requests)
+}
+
+func TestWaitForChainStart_ContextCanceled(t *testing.T) {
+	client := newTestClient(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/genesis": func(w http.ResponseWriter, _ *http.Request) {
+			http.Error(w, "not found", http.StatusNotFound)
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := client.WaitForChainStart(ctx, nil)
+	require.NoError(t, err)
+	cancel()
+	_, err = stream.Recv()
+	require.ErrorContains(t, "context canceled", err)
+}
+
+func TestDomainData(t *testing.T) {
+	root := bytesutil.PadTo([]byte{0x0b}, 32)
+	client := newTestClient(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/genesis": func(w http.ResponseWriter, _ *http.Request) {
+			writeData(t, w, map[string]string{
+				"genesis_time":            "1600000000",
+				"genesis_validators_root": hexutil.Encode(root),
+			})
+		},
+	})
+
+	epoch := params.BeaconConfig().AltairForkEpoch
+	domainType := params.BeaconConfig().DomainSyncCommittee
+	resp, err := client.DomainData(context.Background(), &ethpb.DomainRequest{Epoch: epoch, Domain: domainType[:]})
+	require.NoError(t, err)
+
+	fork, err := forks.Fork(epoch)
+	require.NoError(t, err)
+	want, err := signing.Domain(fork, epoch, domainType, root)
+	require.NoError(t, err)
+	require.DeepEqual(t, want, resp.SignatureDomain)
+}
@@ -0,0 +1,207 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package beacon_api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gitlab.waterfall.network/waterfall/protocol/gwat/common/hexutil"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// protoToJson converts the proto message into the JSON representation used by the beacon API:
+// the fields are named after the proto fields, bytes are hex encoded,
+// integers are decimal strings and enums are lowercase names.
+func protoToJson(msg proto.Message) map[string]interface{} {
+	return messageToJson(msg.ProtoReflect())
+}
+
+func messageToJson(m protoreflect.Message) map[string]interface{} {
+	res := make(map[string]interface{})
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.ContainingOneof() != nil && !m.Has(fd) {
+			continue
+		}
+		v := m.Get(fd)
+		if fd.IsList() {
+			list := v.List()
+			items := make([]interface{}, list.Len())
+			for j := 0; j < list.Len(); j++ {
+				items[j] = valueToJson(fd, list.Get(j))
+			}
+			res[string(fd.Name())] = items
+			continue
+		}
+		res[string(fd.Name())] = valueToJson(fd, v)
+	}
+	return res
+}
+
+func valueToJson(fd protoreflect.FieldDescriptor, v protoreflect.Value) interface{} {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return messageToJson(v.Message())
+	case protoreflect.BytesKind:
+		return hexutil.Encode(v.Bytes())
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return strconv.FormatUint(v.Uint(), 10)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return strconv.FormatInt(v.Int(), 10)
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return strings.ToLower(string(ev.Name()))
+		}
+		return strconv.FormatInt(int64(v.Enum()), 10)
+	default:
+		return v.Interface()
+	}
+}
+
+// jsonToProto decodes the beacon API JSON representation of an object into the proto message.
+// The fields which are not defined in the proto message are ignored.
+func jsonToProto(data []byte, msg proto.Message) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return errors.Wrap(err, "could not decode json")
+	}
+	return jsonToMessage(v, msg.ProtoReflect())
+}
+
+func jsonToMessage(data interface{}, m protoreflect.Message) error {
+	obj, ok := data.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("expected json object for %s", m.Descriptor().FullName())
+	}
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		raw, ok := obj[string(fd.Name())]
+		if !ok || raw == nil {
+			continue
+		}
+		if fd.IsList() {
+			items, ok := raw.([]interface{})
+			if !ok {
+				return fmt.Errorf("expected json array for field %s", fd.FullName())
+			}
+			list := m.Mutable(fd).List()
+			for _, item := range items {
+				if fd.Kind() == protoreflect.MessageKind {
+					elem := list.NewElement()
+					if err := jsonToMessage(item, elem.Message()); err != nil {
+						return err
+					}
+					list.Append(elem)
+					continue
+				}
+				v, err := jsonToValue(fd, item)
+				if err != nil {
+					return err
+				}
+				list.Append(v)
+			}
+			continue
+		}
+		if fd.Kind() == protoreflect.MessageKind {
+			if err := jsonToMessage(raw, m.Mutable(fd).Message()); err != nil {
+				return err
+			}
+			continue
+		}
+		v, err := jsonToValue(fd, raw)
+		if err != nil {
+			return err
+		}
+		m.Set(fd, v)
+	}
+	return nil
+}
+
+func jsonToValue(fd protoreflect.FieldDescriptor, raw interface{}) (protoreflect.Value, error) {
+	if fd.Kind() == protoreflect.BoolKind {
+		b, ok := raw.(bool)
+		if !ok {
+			return protoreflect.Value{}, fmt.Errorf("expected json boolean for field %s", fd.FullName())
+		}
+		return protoreflect.ValueOfBool(b), nil
+	}
+
+	var s string
+	switch v := raw.(type) {
+	case string:
+		s = v
+	case json.Number:
+		s = v.String()
+	default:
+		return protoreflect.Value{}, fmt.Errorf("expected json string for field %s", fd.FullName())
+	}
+
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(s), nil
+	case protoreflect.BytesKind:
+		b, err := hexutil.Decode(s)
+		if err != nil {
+			return protoreflect.Value{}, errors.Wrapf(err, "invalid hex value of field %s", fd.FullName())
+		}
+		return protoreflect.ValueOfBytes(b), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		n, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return protoreflect.Value{}, errors.Wrapf(err, "invalid value of field %s", fd.FullName())
+		}
+		return protoreflect.ValueOfUint32(uint32(n)), nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		n, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return protoreflect.Value{}, errors.Wrapf(err, "invalid value of field %s", fd.FullName())
+		}
+		return protoreflect.ValueOfUint64(n), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		n, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return protoreflect.Value{}, errors.Wrapf(err, "invalid value of field %s", fd.FullName())
+		}
+		return protoreflect.ValueOfInt32(int32(n)), nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return protoreflect.Value{}, errors.Wrapf(err, "invalid value of field %s", fd.FullName())
+		}
+		return protoreflect.ValueOfInt64(n), nil
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByName(protoreflect.Name(strings.ToUpper(s))); ev != nil {
+			return protoreflect.ValueOfEnum(ev.Number()), nil
+		}
+		n, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("unknown enum value %s of field %s", s, fd.FullName())
+		}
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(n)), nil
+	default:
+		return protoreflect.Value{}, fmt.Errorf("unsupported kind %s of field %s", fd.Kind(), fd.FullName())
+	}
+}
@@ -0,0 +1,65 @@
+package beacon_api
+
+import (
+	"encoding/json"
+	"testing"
+
+	ethpbv1 "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/util"
+)
+
+func TestProtoToJson(t *testing.T) {
+	data := &ethpbv1.AttestationData{
+		Slot:            3,
+		Index:           2,
+		BeaconBlockRoot: []byte{0x01, 0x02},
+		Source:          &ethpbv1.Checkpoint{Epoch: 1, Root: []byte{0xaa}},
+		Target:          &ethpbv1.Checkpoint{Epoch: 2, Root: []byte{0xbb}},
+	}
+
+	res := protoToJson(data)
+	require.Equal(t, "3", res["slot"])
+	require.Equal(t, "2", res["index"])
+	require.Equal(t, "0x0102", res["beacon_block_root"])
+	require.DeepEqual(t, map[string]interface{}{"epoch": "1", "root": "0xaa"}, res["source"])
+
+	v := &ethpbv1.ValidatorContainer{Index: 5, Status: ethpbv1.ValidatorStatus_ACTIVE_ONGOING}
+	require.Equal(t, "active_ongoing", protoToJson(v)["status"])
+}
+
+func TestJsonToProto(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		att := util.HydrateV1Attestation(&ethpbv1.Attestation{
+			AggregationBits: []byte{0x03},
+			Data: &ethpbv1.AttestationData{
+				Slot:  7,
+				Index: 1,
+			},
+		})
+		b, err := json.Marshal(protoToJson(att))
+		require.NoError(t, err)
+
+		res := &ethpbv1.Attestation{}
+		require.NoError(t, jsonToProto(b, res))
+		require.DeepSSZEqual(t, att, res)
+	})
+	t.Run("lists, enums and unknown fields", func(t *testing.T) {
+		b := []byte(`{"index":"4","slot":"9","validators":["1","2","3"],"unknown":"0x00"}`)
+		committee := &ethpbv1.Committee{}
+		require.NoError(t, jsonToProto(b, committee))
+		require.Equal(t, uint64(4), uint64(committee.Index))
+		require.Equal(t, uint64(9), uint64(committee.Slot))
+		require.Equal(t, 3, len(committee.Validators))
+
+		v := &ethpbv1.ValidatorContainer{}
+		require.NoError(t, jsonToProto([]byte(`{"index":"5","status":"exited_slashed"}`), v))
+		require.Equal(t, ethpbv1.ValidatorStatus_EXITED_SLASHED, v.Status)
+	})
+	t.Run("invalid values", func(t *testing.T) {
+		require.ErrorContains(t, "invalid hex value", jsonToProto([]byte(`{"root":"xyz"}`), &ethpbv1.Checkpoint{}))
+		require.ErrorContains(t, "invalid value", jsonToProto([]byte(`{"epoch":"-1"}`), &ethpbv1.Checkpoint{}))
+		require.ErrorContains(t, "unknown enum value", jsonToProto([]byte(`{"status":"sleeping"}`), &ethpbv1.ValidatorContainer{}))
+		require.ErrorContains(t, "expected json object", jsonToProto([]byte(`[]`), &ethpbv1.Checkpoint{}))
+	})
+}
@@ -0,0 +1,111 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package beacon_api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/api/gateway/apimiddleware"
+)
+
+// errNotFound is returned when the beacon node responds with the 404 status code.
+var errNotFound = errors.New("not found")
+
+// jsonRestHandler sends the requests to the beacon node REST API and decodes its JSON responses.
+type jsonRestHandler struct {
+	httpClient http.Client
+	host       string
+}
+
+func newJsonRestHandler(host string, timeout time.Duration) *jsonRestHandler {
+	if !strings.Contains(host, "://") {
+		host = "http://" + host
+	}
+	return &jsonRestHandler{
+		httpClient: http.Client{Timeout: timeout},
+		host:       strings.TrimSuffix(host, "/"),
+	}
+}
+
+// get sends a GET request to the endpoint and decodes the JSON response into responseJson.
+func (h *jsonRestHandler) get(ctx context.Context, endpoint string, responseJson interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, h.host+endpoint, nil)
+	if err != nil {
+		return errors.Wrapf(err, "could not create request for endpoint %s", endpoint)
+	}
+	return h.do(req, endpoint, responseJson)
+}
+
+// post sends a POST request with the JSON encoded data to the endpoint
+// and decodes the JSON response into responseJson, if the latter is not nil.
+func (h *jsonRestHandler) post(ctx context.Context, endpoint string, data interface{}, responseJson interface{}) error {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return errors.Wrapf(err, "could not marshal request for endpoint %s", endpoint)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.host+endpoint, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrapf(err, "could not create request for endpoint %s", endpoint)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return h.do(req, endpoint, responseJson)
+}
+
+func (h *jsonRestHandler) do(req *http.Request, endpoint string, responseJson interface{}) error {
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "could not send request to endpoint %s", endpoint)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Error("Could not close response body")
+		}
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "could not read response body of endpoint %s", endpoint)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return responseError(endpoint, resp.StatusCode, body)
+	}
+	if responseJson == nil {
+		return nil
+	}
+	if err := json.Unmarshal(body, responseJson); err != nil {
+		return errors.Wrapf(err, "could not decode response of endpoint %s", endpoint)
+	}
+	return nil
+}
+
+func responseError(endpoint string, code int, body []byte) error {
+	errJson := &apimiddleware.DefaultErrorJSON{}
+	msg := string(body)
+	if err := json.Unmarshal(body, errJson); err == nil && errJson.Message != "" {
+		msg = errJson.Message
+	}
+	if code == http.StatusNotFound {
+		return errors.Wrapf(errNotFound, "endpoint %s: %s", endpoint, msg)
+	}
+	return fmt.Errorf("endpoint %s responded with status code %d: %s", endpoint, code, msg)
+}
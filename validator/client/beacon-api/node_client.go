@@ -0,0 +1,107 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package beacon_api
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpbv1 "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v1"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/gwat/common/hexutil"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type beaconApiNodeClient struct {
+	jsonRestHandler *jsonRestHandler
+	genesisProvider *genesisProvider
+}
+
+// NewBeaconApiNodeClient creates a node client which talks to the beacon node
+// through the REST API at the given host.
+func NewBeaconApiNodeClient(host string, timeout time.Duration) ethpb.NodeClient {
+	handler := newJsonRestHandler(host, timeout)
+	return &beaconApiNodeClient{
+		jsonRestHandler: handler,
+		genesisProvider: &genesisProvider{jsonRestHandler: handler},
+	}
+}
+
+// GetSyncStatus retrieves whether the beacon node is syncing.
+func (c *beaconApiNodeClient) GetSyncStatus(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.SyncStatus, error) {
+	info := &ethpbv1.SyncInfo{}
+	if err := getData(ctx, c.jsonRestHandler, "/eth/v1/node/syncing", info); err != nil {
+		return nil, errors.Wrap(err, "could not get sync status")
+	}
+	return &ethpb.SyncStatus{Syncing: info.IsSyncing}, nil
+}
+
+// GetGenesis retrieves the genesis of the chain and the address of the deposit contract.
+func (c *beaconApiNodeClient) GetGenesis(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.Genesis, error) {
+	genesis, err := c.genesisProvider.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	contract := &ethpbv1.DepositContract{}
+	if err := getData(ctx, c.jsonRestHandler, "/eth/v1/config/deposit_contract", contract); err != nil {
+		return nil, errors.Wrap(err, "could not get deposit contract")
+	}
+	address, err := hexutil.Decode(contract.Address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid deposit contract address %s", contract.Address)
+	}
+	return &ethpb.Genesis{
+		GenesisTime:            timestamppb.New(time.Unix(int64(genesis.genesisTime), 0)),
+		DepositContractAddress: address,
+		GenesisValidatorsRoot:  genesis.genesisValidatorsRoot,
+	}, nil
+}
+
+// GetVersion retrieves the version of the beacon node.
+func (c *beaconApiNodeClient) GetVersion(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.Version, error) {
+	version := &ethpbv1.Version{}
+	if err := getData(ctx, c.jsonRestHandler, "/eth/v1/node/version", version); err != nil {
+		return nil, errors.Wrap(err, "could not get version")
+	}
+	return &ethpb.Version{Version: version.Version}, nil
+}
+
+// ListImplementedServices is not supported by the beacon API.
+func (c *beaconApiNodeClient) ListImplementedServices(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.ImplementedServices, error) {
+	return nil, errors.Wrap(errNotSupported, "ListImplementedServices")
+}
+
+// GetHost is not supported by the beacon API.
+func (c *beaconApiNodeClient) GetHost(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.HostData, error) {
+	return nil, errors.Wrap(errNotSupported, "GetHost")
+}
+
+// GetPeer is not supported by the beacon API.
+func (c *beaconApiNodeClient) GetPeer(_ context.Context, _ *ethpb.PeerRequest, _ ...grpc.CallOption) (*ethpb.Peer, error) {
+	return nil, errors.Wrap(errNotSupported, "GetPeer")
+}
+
+// ListPeers is not supported by the beacon API.
+func (c *beaconApiNodeClient) ListPeers(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.Peers, error) {
+	return nil, errors.Wrap(errNotSupported, "ListPeers")
+}
+
+// GetETH1ConnectionStatus is not supported by the beacon API.
+func (c *beaconApiNodeClient) GetETH1ConnectionStatus(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.ETH1ConnectionStatus, error) {
+	return nil, errors.Wrap(errNotSupported, "GetETH1ConnectionStatus")
+}
@@ -0,0 +1,50 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package beacon_api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"google.golang.org/grpc"
+)
+
+// GetPrevoteData requests the beacon node to produce the prevote data of the slot and committee.
+func (c *beaconApiValidatorClient) GetPrevoteData(ctx context.Context, req *ethpb.PreVoteRequest, _ ...grpc.CallOption) (*ethpb.PreVoteData, error) {
+	data := &ethpb.PreVoteData{}
+	endpoint := fmt.Sprintf("/eth/v1/waterfall/validator/prevote_data?slot=%d&committee_index=%d", req.Slot, req.CommitteeIndex)
+	if err := getData(ctx, c.jsonRestHandler, endpoint, data); err != nil {
+		return nil, errors.Wrap(err, "could not get prevote data")
+	}
+	return data, nil
+}
+
+// ProposePrevote submits the prevote to the prevotes pool of the beacon node.
+func (c *beaconApiValidatorClient) ProposePrevote(ctx context.Context, pv *ethpb.PreVote, _ ...grpc.CallOption) (*ethpb.PrevoteResponse, error) {
+	if pv.Data == nil {
+		return nil, errors.New("prevote data can't be nil")
+	}
+	root, err := pv.Data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute prevote data root")
+	}
+	body := []interface{}{protoToJson(pv)}
+	if err := c.jsonRestHandler.post(ctx, "/eth/v1/beacon/pool/prevotes", body, nil); err != nil {
+		return nil, errors.Wrap(err, "could not submit prevote")
+	}
+	return &ethpb.PrevoteResponse{PrevoteDataRoot: root[:]}, nil
+}
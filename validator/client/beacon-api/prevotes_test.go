@@ -0,0 +1,62 @@
+package beacon_api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+)
+
+func TestGetPrevoteData(t *testing.T) {
+	client := newTestClient(t, map[string]http.HandlerFunc{
+		"/eth/v1/waterfall/validator/prevote_data": func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "9", r.URL.Query().Get("slot"))
+			require.Equal(t, "2", r.URL.Query().Get("committee_index"))
+			writeData(t, w, map[string]string{
+				"slot":       "9",
+				"index":      "2",
+				"candidates": "0x0a0b",
+			})
+		},
+	})
+
+	data, err := client.GetPrevoteData(context.Background(), &ethpb.PreVoteRequest{Slot: 9, CommitteeIndex: 2})
+	require.NoError(t, err)
+	require.DeepEqual(t, &ethpb.PreVoteData{Slot: 9, Index: 2, Candidates: []byte{0x0a, 0x0b}}, data)
+}
+
+func TestProposePrevote(t *testing.T) {
+	var submitted []*ethpb.PreVote
+	client := newTestClient(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/pool/prevotes": func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, http.MethodPost, r.Method)
+			var items []json.RawMessage
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&items))
+			for _, item := range items {
+				pv := &ethpb.PreVote{}
+				require.NoError(t, jsonToProto(item, pv))
+				submitted = append(submitted, pv)
+			}
+		},
+	})
+
+	pv := &ethpb.PreVote{
+		AggregationBits: bitfield.NewBitlist(4),
+		Data:            &ethpb.PreVoteData{Slot: 9, Index: 2, Candidates: []byte{0x0a}},
+		Signature:       make([]byte, 96),
+	}
+	resp, err := client.ProposePrevote(context.Background(), pv)
+	require.NoError(t, err)
+	root, err := pv.Data.HashTreeRoot()
+	require.NoError(t, err)
+	require.DeepEqual(t, root[:], resp.PrevoteDataRoot)
+	require.Equal(t, 1, len(submitted))
+	require.DeepSSZEqual(t, pv, submitted[0])
+
+	_, err = client.ProposePrevote(context.Background(), &ethpb.PreVote{})
+	require.ErrorContains(t, "prevote data can't be nil", err)
+}
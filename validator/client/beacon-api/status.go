@@ -0,0 +1,225 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package beacon_api
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	fieldparams "gitlab.waterfall.network/waterfall/protocol/coordinator/config/fieldparams"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	ethpbv1 "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v1"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/gwat/common/hexutil"
+	"google.golang.org/grpc"
+)
+
+// validatorsBatchSize is the maximal number of validator ids requested from the validators endpoint at once,
+// which keeps the length of the request URL reasonable.
+const validatorsBatchSize = 64
+
+// nonExistentIndex is the index of the validators which are not found in the head state.
+var nonExistentIndex = types.ValidatorIndex(^uint64(0))
+
+// ValidatorIndex retrieves the index of the validator from the head state.
+func (c *beaconApiValidatorClient) ValidatorIndex(ctx context.Context, req *ethpb.ValidatorIndexRequest, _ ...grpc.CallOption) (*ethpb.ValidatorIndexResponse, error) {
+	v := &ethpbv1.ValidatorContainer{}
+	if err := getData(ctx, c.jsonRestHandler, "/eth/v1/beacon/states/head/validators/"+hexutil.Encode(req.PublicKey), v); err != nil {
+		return nil, errors.Wrapf(err, "could not get validator %#x", req.PublicKey)
+	}
+	return &ethpb.ValidatorIndexResponse{Index: v.Index}, nil
+}
+
+// ValidatorStatus retrieves the status of the validator from the head state.
+func (c *beaconApiValidatorClient) ValidatorStatus(ctx context.Context, req *ethpb.ValidatorStatusRequest, _ ...grpc.CallOption) (*ethpb.ValidatorStatusResponse, error) {
+	resp, err := c.MultipleValidatorStatus(ctx, &ethpb.MultipleValidatorStatusRequest{PublicKeys: [][]byte{req.PublicKey}})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Statuses) == 0 {
+		return unknownValidatorStatus(), nil
+	}
+	return resp.Statuses[0], nil
+}
+
+// MultipleValidatorStatus retrieves the statuses of the validators requested by public keys and indices.
+// The validators which are not found in the head state are reported with the unknown status.
+func (c *beaconApiValidatorClient) MultipleValidatorStatus(ctx context.Context, req *ethpb.MultipleValidatorStatusRequest, _ ...grpc.CallOption) (*ethpb.MultipleValidatorStatusResponse, error) {
+	pubKeys := make([][]byte, 0, len(req.PublicKeys)+len(req.Indices))
+	filtered := make(map[[fieldparams.BLSPubkeyLength]byte]bool)
+	filtered[[fieldparams.BLSPubkeyLength]byte{}] = true // Filter out keys with all zeros.
+	for _, pk := range req.PublicKeys {
+		if !filtered[bytesutil.ToBytes48(pk)] {
+			pubKeys = append(pubKeys, pk)
+			filtered[bytesutil.ToBytes48(pk)] = true
+		}
+	}
+	validators, err := c.validatorsByPubKey(ctx, pubKeys)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Indices) > 0 {
+		ids := make([]string, len(req.Indices))
+		for i, idx := range req.Indices {
+			ids[i] = strconv.FormatInt(idx, 10)
+		}
+		byIndex, err := c.getValidators(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range byIndex {
+			pk := bytesutil.ToBytes48(v.Validator.Pubkey)
+			if !filtered[pk] {
+				pubKeys = append(pubKeys, v.Validator.Pubkey)
+				filtered[pk] = true
+				validators[pk] = v
+			}
+		}
+	}
+
+	resp := &ethpb.MultipleValidatorStatusResponse{
+		PublicKeys: pubKeys,
+		Statuses:   make([]*ethpb.ValidatorStatusResponse, len(pubKeys)),
+		Indices:    make([]types.ValidatorIndex, len(pubKeys)),
+	}
+	for i, pk := range pubKeys {
+		v, ok := validators[bytesutil.ToBytes48(pk)]
+		if !ok {
+			resp.Statuses[i] = unknownValidatorStatus()
+			resp.Indices[i] = nonExistentIndex
+			continue
+		}
+		resp.Statuses[i] = &ethpb.ValidatorStatusResponse{
+			Status:          validatorStatus(v.Status),
+			ActivationEpoch: v.Validator.ActivationEpoch,
+		}
+		resp.Indices[i] = v.Index
+	}
+	return resp, nil
+}
+
+// WaitForActivation returns a stream which reports the statuses of the validators every slot
+// until one of them is active.
+func (c *beaconApiValidatorClient) WaitForActivation(ctx context.Context, req *ethpb.ValidatorActivationRequest, _ ...grpc.CallOption) (ethpb.BeaconNodeValidator_WaitForActivationClient, error) {
+	return &waitForActivationStream{
+		pollingStream: pollingStream{ctx: ctx},
+		client:        c,
+		pubKeys:       req.PublicKeys,
+	}, nil
+}
+
+type waitForActivationStream struct {
+	pollingStream
+	client  *beaconApiValidatorClient
+	pubKeys [][]byte
+	polled  bool
+	done    bool
+}
+
+// Recv returns the statuses of the validators, the first time immediately and then once per slot.
+// It returns io.EOF after the statuses with an active validator have been received.
+func (s *waitForActivationStream) Recv() (*ethpb.ValidatorActivationResponse, error) {
+	if s.done {
+		return nil, io.EOF
+	}
+	if s.polled {
+		if err := s.wait(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second); err != nil {
+			return nil, err
+		}
+	}
+	s.polled = true
+
+	resp, err := s.client.MultipleValidatorStatus(s.ctx, &ethpb.MultipleValidatorStatusRequest{PublicKeys: s.pubKeys})
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]*ethpb.ValidatorActivationResponse_Status, len(resp.Statuses))
+	for i := range resp.Statuses {
+		statuses[i] = &ethpb.ValidatorActivationResponse_Status{
+			PublicKey: resp.PublicKeys[i],
+			Status:    resp.Statuses[i],
+			Index:     resp.Indices[i],
+		}
+		if resp.Statuses[i].Status == ethpb.ValidatorStatus_ACTIVE {
+			s.done = true
+		}
+	}
+	return &ethpb.ValidatorActivationResponse{Statuses: statuses}, nil
+}
+
+// validatorsByPubKey returns the validators of the head state known by the beacon node, keyed by their public keys.
+func (c *beaconApiValidatorClient) validatorsByPubKey(ctx context.Context, pubKeys [][]byte) (map[[fieldparams.BLSPubkeyLength]byte]*ethpbv1.ValidatorContainer, error) {
+	ids := make([]string, len(pubKeys))
+	for i, pk := range pubKeys {
+		ids[i] = hexutil.Encode(pk)
+	}
+	validators, err := c.getValidators(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[[fieldparams.BLSPubkeyLength]byte]*ethpbv1.ValidatorContainer, len(validators))
+	for _, v := range validators {
+		res[bytesutil.ToBytes48(v.Validator.Pubkey)] = v
+	}
+	return res, nil
+}
+
+// getValidators returns the validators of the head state with the given ids, which are either
+// hex encoded public keys or indices. The unknown validators are omitted.
+func (c *beaconApiValidatorClient) getValidators(ctx context.Context, ids []string) ([]*ethpbv1.ValidatorContainer, error) {
+	validators := make([]*ethpbv1.ValidatorContainer, 0, len(ids))
+	for start := 0; start < len(ids); start += validatorsBatchSize {
+		end := start + validatorsBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		query := url.Values{}
+		for _, id := range ids[start:end] {
+			query.Add("id", id)
+		}
+		resp := &dataResponseJson{}
+		if err := c.jsonRestHandler.get(ctx, "/eth/v1/beacon/states/head/validators?"+query.Encode(), resp); err != nil {
+			return nil, errors.Wrap(err, "could not get validators")
+		}
+		err := decodeList(resp.Data, func(item []byte) error {
+			v := &ethpbv1.ValidatorContainer{}
+			if err := jsonToProto(item, v); err != nil {
+				return err
+			}
+			if v.Validator == nil {
+				return errors.New("validator is nil")
+			}
+			validators = append(validators, v)
+			return nil
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "could not decode validators")
+		}
+	}
+	return validators, nil
+}
+
+func unknownValidatorStatus() *ethpb.ValidatorStatusResponse {
+	return &ethpb.ValidatorStatusResponse{
+		Status:          ethpb.ValidatorStatus_UNKNOWN_STATUS,
+		ActivationEpoch: params.BeaconConfig().FarFutureEpoch,
+	}
+}
@@ -0,0 +1,69 @@
+package beacon_api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+	"gitlab.waterfall.network/waterfall/protocol/gwat/common/hexutil"
+)
+
+func TestMultipleValidatorStatus(t *testing.T) {
+	pubKey1 := bytesutil.PadTo([]byte{0x01}, 48)
+	pubKey2 := bytesutil.PadTo([]byte{0x02}, 48)
+	pubKey3 := bytesutil.PadTo([]byte{0x03}, 48)
+	validators := map[string]map[string]interface{}{
+		hexutil.Encode(pubKey1): validatorJson("1", pubKey1, "active_ongoing"),
+		hexutil.Encode(pubKey2): validatorJson("2", pubKey2, "pending_queued"),
+		"2":                     validatorJson("2", pubKey2, "pending_queued"),
+	}
+	client := newTestClient(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/states/head/validators": func(w http.ResponseWriter, r *http.Request) {
+			data := make([]interface{}, 0)
+			for _, id := range r.URL.Query()["id"] {
+				if v, ok := validators[id]; ok {
+					data = append(data, v)
+				}
+			}
+			writeData(t, w, data)
+		},
+	})
+
+	resp, err := client.MultipleValidatorStatus(context.Background(), &ethpb.MultipleValidatorStatusRequest{
+		PublicKeys: [][]byte{pubKey1, make([]byte, 48), pubKey1, pubKey3},
+		Indices:    []int64{2},
+	})
+	require.NoError(t, err)
+	require.DeepEqual(t, [][]byte{pubKey1, pubKey3, pubKey2}, resp.PublicKeys)
+	require.DeepEqual(t, []types.ValidatorIndex{1, nonExistentIndex, 2}, resp.Indices)
+	require.Equal(t, ethpb.ValidatorStatus_ACTIVE, resp.Statuses[0].Status)
+	require.Equal(t, ethpb.ValidatorStatus_UNKNOWN_STATUS, resp.Statuses[1].Status)
+	require.Equal(t, params.BeaconConfig().FarFutureEpoch, resp.Statuses[1].ActivationEpoch)
+	require.Equal(t, ethpb.ValidatorStatus_PENDING, resp.Statuses[2].Status)
+}
+
+func TestWaitForActivation(t *testing.T) {
+	pubKey := bytesutil.PadTo([]byte{0x01}, 48)
+	client := newTestClient(t, map[string]http.HandlerFunc{
+		"/eth/v1/beacon/states/head/validators": func(w http.ResponseWriter, _ *http.Request) {
+			writeData(t, w, []interface{}{validatorJson("1", pubKey, "active_ongoing")})
+		},
+	})
+
+	stream, err := client.WaitForActivation(context.Background(), &ethpb.ValidatorActivationRequest{PublicKeys: [][]byte{pubKey}})
+	require.NoError(t, err)
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Statuses))
+	require.Equal(t, types.ValidatorIndex(1), resp.Statuses[0].Index)
+	require.Equal(t, ethpb.ValidatorStatus_ACTIVE, resp.Statuses[0].Status.Status)
+
+	_, err = stream.Recv()
+	require.Equal(t, io.EOF, err)
+}
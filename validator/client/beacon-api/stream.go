@@ -0,0 +1,64 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package beacon_api
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// pollingStream implements the gRPC client stream part of the streaming methods,
+// which the REST client emulates by polling the beacon node.
+type pollingStream struct {
+	ctx context.Context
+}
+
+func (s *pollingStream) Header() (metadata.MD, error) {
+	return nil, nil
+}
+
+func (s *pollingStream) Trailer() metadata.MD {
+	return nil
+}
+
+func (s *pollingStream) CloseSend() error {
+	return nil
+}
+
+func (s *pollingStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *pollingStream) SendMsg(_ interface{}) error {
+	return errNotSupported
+}
+
+func (s *pollingStream) RecvMsg(_ interface{}) error {
+	return errNotSupported
+}
+
+// wait blocks for the given duration or until the stream context is done.
+func (s *pollingStream) wait(d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
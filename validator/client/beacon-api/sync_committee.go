@@ -0,0 +1,109 @@
+//Copyright 2024   Blue Wave Inc.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package beacon_api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpbv2 "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/eth/v2"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/migration"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/time/slots"
+	"gitlab.waterfall.network/waterfall/protocol/gwat/common/hexutil"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// GetSyncMessageBlockRoot retrieves the root of the head block, which the sync committee members sign.
+func (c *beaconApiValidatorClient) GetSyncMessageBlockRoot(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.SyncMessageBlockRootResponse, error) {
+	root, err := c.headBlockRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ethpb.SyncMessageBlockRootResponse{Root: root}, nil
+}
+
+// SubmitSyncMessage submits the sync committee message to the sync committees pool of the beacon node.
+func (c *beaconApiValidatorClient) SubmitSyncMessage(ctx context.Context, msg *ethpb.SyncCommitteeMessage, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	apiMsg := &ethpbv2.SyncCommitteeMessage{}
+	if err := convertProto(msg, apiMsg); err != nil {
+		return nil, err
+	}
+	body := []interface{}{protoToJson(apiMsg)}
+	if err := c.jsonRestHandler.post(ctx, "/eth/v1/beacon/pool/sync_committees", body, nil); err != nil {
+		return nil, errors.Wrap(err, "could not submit sync committee message")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetSyncSubcommitteeIndex retrieves the indices of the validator in the sync committee of the slot.
+func (c *beaconApiValidatorClient) GetSyncSubcommitteeIndex(ctx context.Context, req *ethpb.SyncSubcommitteeIndexRequest, _ ...grpc.CallOption) (*ethpb.SyncSubcommitteeIndexResponse, error) {
+	idx, err := c.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: req.PublicKey})
+	if err != nil {
+		return nil, err
+	}
+	// The sync committee of the next slot is the one which signs the block of the current slot.
+	epoch := slots.ToEpoch(req.Slot + 1)
+	duties, err := c.syncCommitteeDuties(ctx, epoch, []string{strconv.FormatUint(uint64(idx.Index), 10)})
+	if err != nil {
+		return nil, err
+	}
+	var indices []types.CommitteeIndex
+	for _, d := range duties {
+		if d.ValidatorIndex != idx.Index {
+			continue
+		}
+		for _, i := range d.ValidatorSyncCommitteeIndices {
+			indices = append(indices, types.CommitteeIndex(i))
+		}
+	}
+	return &ethpb.SyncSubcommitteeIndexResponse{Indices: indices}, nil
+}
+
+// GetSyncCommitteeContribution requests the beacon node to produce the contribution of the sync subcommittee
+// for the head block root.
+func (c *beaconApiValidatorClient) GetSyncCommitteeContribution(ctx context.Context, req *ethpb.SyncCommitteeContributionRequest, _ ...grpc.CallOption) (*ethpb.SyncCommitteeContribution, error) {
+	root, err := c.headBlockRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := url.Values{}
+	query.Set("slot", fmt.Sprint(req.Slot))
+	query.Set("subcommittee_index", fmt.Sprint(req.SubnetId))
+	query.Set("beacon_block_root", hexutil.Encode(root))
+	contribution := &ethpbv2.SyncCommitteeContribution{}
+	if err := getData(ctx, c.jsonRestHandler, "/eth/v1/validator/sync_committee_contribution?"+query.Encode(), contribution); err != nil {
+		return nil, errors.Wrap(err, "could not get sync committee contribution")
+	}
+	alphaContribution := &ethpb.SyncCommitteeContribution{}
+	if err := convertProto(contribution, alphaContribution); err != nil {
+		return nil, err
+	}
+	return alphaContribution, nil
+}
+
+// SubmitSignedContributionAndProof submits the signed contribution and proof to the beacon node.
+func (c *beaconApiValidatorClient) SubmitSignedContributionAndProof(ctx context.Context, req *ethpb.SignedContributionAndProof, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	body := []interface{}{protoToJson(migration.V1Alpha1SignedContributionAndProofToV2(req))}
+	if err := c.jsonRestHandler.post(ctx, "/eth/v1/validator/contribution_and_proofs", body, nil); err != nil {
+		return nil, errors.Wrap(err, "could not submit contribution and proof")
+	}
+	return &emptypb.Empty{}, nil
+}
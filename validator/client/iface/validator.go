@@ -7,7 +7,10 @@ import (
 
 	types "github.com/prysmaticlabs/eth2-types"
 	fieldparams "gitlab.waterfall.network/waterfall/protocol/coordinator/config/fieldparams"
+	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/validator/keymanager"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 // ErrConnectionIssue represents a connection problem.
@@ -61,3 +64,9 @@ type Validator interface {
 	UpdateFeeRecipient(ctx context.Context, km keymanager.IKeymanager) error
 	RolesAtNextEpoch(ctx context.Context, slot types.Slot) (map[[fieldparams.BLSPubkeyLength]byte][]ValidatorRole, error)
 }
+
+// BeaconChainClient defines the methods of the beacon chain service used by the validator client.
+type BeaconChainClient interface {
+	GetChainHead(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ethpb.ChainHead, error)
+	GetValidatorPerformance(ctx context.Context, in *ethpb.ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ethpb.ValidatorPerformanceResponse, error)
+}
@@ -179,6 +179,81 @@ var (
 			"pubkey",
 		},
 	)
+	// ValidatorPrevoteSuccessVec used to count successful prevotes.
+	ValidatorPrevoteSuccessVec = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "successful_prevotes",
+		},
+		[]string{
+			"pubkey",
+		},
+	)
+	// ValidatorPrevoteFailVec used to count failed prevotes.
+	ValidatorPrevoteFailVec = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "failed_prevotes",
+		},
+		[]string{
+			"pubkey",
+		},
+	)
+	// ValidatorPrevoteFailVecSlasher used to count failed prevotes by slashing protection.
+	ValidatorPrevoteFailVecSlasher = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "validator_prevotes_rejected_total",
+			Help: "Count the prevotes rejected by slashing protection.",
+		},
+		[]string{
+			"pubkey",
+		},
+	)
+	// ValidatorPrevotedSlotsGaugeVec used to track validator prevoted slots by public key.
+	ValidatorPrevotedSlotsGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "last_prevoted_slot",
+			Help:      "Last prevoted slot.",
+		},
+		[]string{
+			"pubkey",
+		},
+	)
+	// ValidatorPrevoteDelaySeconds used to track the delay of the prevotes submission
+	// from two thirds of the slot.
+	ValidatorPrevoteDelaySeconds = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "validator",
+			Name:      "prevote_submission_delay_seconds",
+			Help:      "Delay between two thirds of the slot and the prevote submission to the beacon node.",
+			Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2, 4, 8},
+		},
+	)
+	// ValidatorPrevotesSeenGaugeVec used to track the number of the validator prevotes
+	// seen by the beacon node at previous epoch.
+	ValidatorPrevotesSeenGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "prevotes_seen",
+			Help:      "Number of the prevotes seen by the beacon node at previous epoch.",
+		},
+		[]string{
+			"pubkey",
+		},
+	)
+	// ValidatorPrevotesIncludedGaugeVec used to track the number of the validator prevotes
+	// whose candidates were included into the block at previous epoch.
+	ValidatorPrevotesIncludedGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "prevotes_included",
+			Help:      "Number of the prevotes whose candidates were included into the block at previous epoch.",
+		},
+		[]string{
+			"pubkey",
+		},
+	)
 	// ValidatorNextAttestationSlotGaugeVec used to track validator statuses by public key.
 	ValidatorNextAttestationSlotGaugeVec = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -344,6 +419,11 @@ func (v *validator) LogValidatorGainsAndLosses(ctx context.Context, slot types.S
 					log.WithField("pubKey", truncatedKey).Warn("Missing inactivity score")
 				}
 			}
+			// The prevotes inclusion is not reported if the beacon node does not track it.
+			if i < len(resp.PrevotesSeen) && i < len(resp.PrevotesIncluded) {
+				previousEpochSummaryFields["prevotesSeen"] = resp.PrevotesSeen[i]
+				previousEpochSummaryFields["prevotesIncluded"] = resp.PrevotesIncluded[i]
+			}
 
 			log.WithFields(previousEpochSummaryFields).Info("Previous epoch voting summary")
 			if v.emitAccountMetrics {
@@ -376,6 +456,10 @@ func (v *validator) LogValidatorGainsAndLosses(ctx context.Context, slot types.S
 						ValidatorInactivityScoreGaugeVec.WithLabelValues(fmtKey).Set(float64(resp.InactivityScores[i]))
 					}
 				}
+				if i < len(resp.PrevotesSeen) && i < len(resp.PrevotesIncluded) {
+					ValidatorPrevotesSeenGaugeVec.WithLabelValues(fmtKey).Set(float64(resp.PrevotesSeen[i]))
+					ValidatorPrevotesIncludedGaugeVec.WithLabelValues(fmtKey).Set(float64(resp.PrevotesIncluded[i]))
+				}
 			}
 		}
 		v.prevBalance[pubKeyBytes] = balBeforeEpoch
@@ -391,6 +475,7 @@ func (v *validator) UpdateLogAggregateStats(resp *ethpb.ValidatorPerformanceResp
 	summary := &v.voteStats
 	currentEpoch := types.Epoch(slot / params.BeaconConfig().SlotsPerEpoch)
 	var attested, correctSource, correctTarget, correctHead, inactivityScore int
+	var prevotesSeen, prevotesIncluded uint64
 
 	for i := range resp.PublicKeys {
 		if slots.ToEpoch(slot) < params.BeaconConfig().AltairForkEpoch && i < len(resp.InclusionDistances) {
@@ -423,7 +508,13 @@ func (v *validator) UpdateLogAggregateStats(resp *ethpb.ValidatorPerformanceResp
 		if slots.ToEpoch(slot) > params.BeaconConfig().AltairForkEpoch && i < len(resp.InactivityScores) {
 			inactivityScore += int(resp.InactivityScores[i])
 		}
+		if i < len(resp.PrevotesSeen) && i < len(resp.PrevotesIncluded) {
+			prevotesSeen += resp.PrevotesSeen[i]
+			prevotesIncluded += resp.PrevotesIncluded[i]
+		}
 	}
+	summary.totalPrevotesSeen += prevotesSeen
+	summary.totalPrevotesIncluded += prevotesIncluded
 
 	// Return early if no attestation got included from previous epoch.
 	// This happens when validators joined halfway through epoch and already passed its assigned slot.
@@ -443,6 +534,9 @@ func (v *validator) UpdateLogAggregateStats(resp *ethpb.ValidatorPerformanceResp
 	if slots.ToEpoch(slot) > params.BeaconConfig().AltairForkEpoch && attested > 0 {
 		epochSummaryFields["averageInactivityScore"] = fmt.Sprintf("%.0f", float64(inactivityScore)/float64(len(resp.PublicKeys)))
 	}
+	if prevotesSeen > 0 {
+		epochSummaryFields["prevoteInclusionPct"] = fmt.Sprintf("%.0f%%", (float64(prevotesIncluded)/float64(prevotesSeen))*100)
+	}
 
 	log.WithFields(epochSummaryFields).Info("Previous epoch aggregated voting summary")
 
@@ -474,6 +568,9 @@ func (v *validator) UpdateLogAggregateStats(resp *ethpb.ValidatorPerformanceResp
 	if slots.ToEpoch(slot) < params.BeaconConfig().AltairForkEpoch {
 		launchSummaryFields["averageInclusionDistance"] = fmt.Sprintf("%.2f slots", float64(summary.totalDistance)/float64(summary.totalAttestedCount))
 	}
+	if summary.totalPrevotesSeen > 0 {
+		launchSummaryFields["prevotesInclusionPct"] = fmt.Sprintf("%.0f%%", (float64(summary.totalPrevotesIncluded)/float64(summary.totalPrevotesSeen))*100)
+	}
 
 	log.WithFields(launchSummaryFields).Info("Vote summary since launch")
 }
@@ -92,6 +92,62 @@ func TestUpdateLogAggregateStats(t *testing.T) {
 		"correctlyVotedTargetPct=\"86%\" numberOfEpochs=3 pctChangeCombinedBalance=\"0.20555%\" prefix=validator\n")
 }
 
+func TestUpdateLogPrevotesAggregateStats(t *testing.T) {
+	v := &validator{
+		logValidatorBalances: true,
+		startBalances:        make(map[[fieldparams.BLSPubkeyLength]byte]uint64),
+		prevBalance:          make(map[[fieldparams.BLSPubkeyLength]byte]uint64),
+		voteStats: voteStats{
+			startEpoch: 0,
+		},
+	}
+
+	pubKeyBytes := [][fieldparams.BLSPubkeyLength]byte{
+		bytesutil.ToBytes48([]byte("000000000000000000000000000000000000000012345678")),
+		bytesutil.ToBytes48([]byte("000000000000000000000000000000000000000099999999")),
+	}
+	v.startBalances[pubKeyBytes[0]] = uint64(32000000000)
+	v.startBalances[pubKeyBytes[1]] = uint64(32000000000)
+	v.prevBalance[pubKeyBytes[0]] = uint64(32000000000)
+	v.prevBalance[pubKeyBytes[1]] = uint64(32000000000)
+
+	responses := []*ethpb.ValidatorPerformanceResponse{
+		{
+			PublicKeys: [][]byte{
+				bytesutil.FromBytes48(pubKeyBytes[0]),
+				bytesutil.FromBytes48(pubKeyBytes[1]),
+			},
+			CorrectlyVotedSource: []bool{true, true},
+			CorrectlyVotedTarget: []bool{true, true},
+			CorrectlyVotedHead:   []bool{true, true},
+			PrevotesSeen:         []uint64{2, 2},
+			PrevotesIncluded:     []uint64{2, 2},
+		},
+		{
+			PublicKeys: [][]byte{
+				bytesutil.FromBytes48(pubKeyBytes[0]),
+				bytesutil.FromBytes48(pubKeyBytes[1]),
+			},
+			CorrectlyVotedSource: []bool{true, true},
+			CorrectlyVotedTarget: []bool{true, true},
+			CorrectlyVotedHead:   []bool{true, true},
+			PrevotesSeen:         []uint64{2, 2},
+			PrevotesIncluded:     []uint64{1, 1},
+		},
+	}
+
+	var hook *logTest.Hook
+	for i, val := range responses {
+		if i == len(responses)-1 { // Handle last log.
+			hook = logTest.NewGlobal()
+		}
+		v.UpdateLogAggregateStats(val, types.Slot(params.BeaconConfig().SlotsPerEpoch*types.Slot(i+1)))
+	}
+
+	require.LogsContain(t, hook, "prevoteInclusionPct=\"50%\"")
+	require.LogsContain(t, hook, "prevotesInclusionPct=\"75%\"")
+}
+
 func TestUpdateLogAltairAggregateStats(t *testing.T) {
 	v := &validator{
 		logValidatorBalances: true,
@@ -0,0 +1,48 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	fieldparams "gitlab.waterfall.network/waterfall/protocol/coordinator/config/fieldparams"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+)
+
+var failedPrevoteSignLocalErr = "attempted to sign a double prevote, prevote rejected by local protection"
+
+// slashablePrevoteCheck checks if a prevote with the given signing root conflicts
+// with a prevote signed by the validator for the same slot. If it does not,
+// the prevote is recorded in the validator's prevote history.
+func (v *validator) slashablePrevoteCheck(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot types.Slot, signingRoot [32]byte,
+) error {
+	fmtKey := fmt.Sprintf("%#x", pubKey[:])
+
+	prevSigningRoot, prevoteAtSlotExists, err := v.db.PrevoteHistoryForSlot(ctx, pubKey, slot)
+	if err != nil {
+		if v.emitAccountMetrics {
+			ValidatorPrevoteFailVec.WithLabelValues(fmtKey).Inc()
+		}
+		return errors.Wrap(err, "failed to get prevote history")
+	}
+
+	// If a prevote exists in our history for the slot, we consider it slashable if
+	// its signing root is empty (zero hash) or differs from the incoming signing root.
+	signingRootIsDifferent := prevSigningRoot == params.BeaconConfig().ZeroHash || prevSigningRoot != signingRoot
+	if prevoteAtSlotExists && signingRootIsDifferent {
+		if v.emitAccountMetrics {
+			ValidatorPrevoteFailVecSlasher.WithLabelValues(fmtKey).Inc()
+		}
+		return errors.New(failedPrevoteSignLocalErr)
+	}
+
+	if err := v.db.SavePrevoteHistoryForSlot(ctx, pubKey, slot, signingRoot[:]); err != nil {
+		if v.emitAccountMetrics {
+			ValidatorPrevoteFailVec.WithLabelValues(fmtKey).Inc()
+		}
+		return errors.Wrap(err, "failed to save updated prevote history")
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	fieldparams "gitlab.waterfall.network/waterfall/protocol/coordinator/config/fieldparams"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+)
+
+func Test_slashablePrevoteCheck_OK(t *testing.T) {
+	ctx := context.Background()
+	validator, _, validatorKey, finish := setup(t)
+	defer finish()
+	pubKeyBytes := [fieldparams.BLSPubkeyLength]byte{}
+	copy(pubKeyBytes[:], validatorKey.PublicKey().Marshal())
+	slot := types.Slot(10)
+
+	// The first prevote at the slot is accepted and recorded.
+	require.NoError(t, validator.slashablePrevoteCheck(ctx, pubKeyBytes, slot, [32]byte{1}))
+	signingRoot, exists, err := validator.db.PrevoteHistoryForSlot(ctx, pubKeyBytes, slot)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	require.Equal(t, [32]byte{1}, signingRoot)
+
+	// Signing the same prevote again is not slashable.
+	require.NoError(t, validator.slashablePrevoteCheck(ctx, pubKeyBytes, slot, [32]byte{1}))
+
+	// A prevote at another slot is not slashable.
+	require.NoError(t, validator.slashablePrevoteCheck(ctx, pubKeyBytes, slot+1, [32]byte{2}))
+}
+
+func Test_slashablePrevoteCheck_PreventsDoublePrevote(t *testing.T) {
+	ctx := context.Background()
+	validator, _, validatorKey, finish := setup(t)
+	defer finish()
+	pubKeyBytes := [fieldparams.BLSPubkeyLength]byte{}
+	copy(pubKeyBytes[:], validatorKey.PublicKey().Marshal())
+	slot := types.Slot(10)
+
+	require.NoError(t, validator.db.SavePrevoteHistoryForSlot(ctx, pubKeyBytes, slot, []byte{1}))
+	err := validator.slashablePrevoteCheck(ctx, pubKeyBytes, slot, [32]byte{2})
+	require.ErrorContains(t, failedPrevoteSignLocalErr, err)
+
+	// A prevote history with an empty signing root is considered slashable.
+	require.NoError(t, validator.db.SavePrevoteHistoryForSlot(ctx, pubKeyBytes, slot+1, make([]byte, 32)))
+	err = validator.slashablePrevoteCheck(ctx, pubKeyBytes, slot+1, [32]byte{})
+	require.ErrorContains(t, failedPrevoteSignLocalErr, err)
+}
@@ -30,6 +30,7 @@ import (
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/monitoring/tracing"
 	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
 	validatorpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1/validator-client"
+	prysmTime "gitlab.waterfall.network/waterfall/protocol/coordinator/time"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/time/slots"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/validator/client/iface"
 	"go.opencensus.io/trace"
@@ -66,7 +67,7 @@ func (v *validator) SubmitPrevote(ctx context.Context, slot types.Slot, pubKey [
 	if err != nil {
 		log.WithError(err).Error("Could not fetch validator assignment while prevote")
 		if v.emitAccountMetrics {
-			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
+			ValidatorPrevoteFailVec.WithLabelValues(fmtKey).Inc()
 		}
 		tracing.AnnotateError(span, err)
 		return
@@ -78,6 +79,7 @@ func (v *validator) SubmitPrevote(ctx context.Context, slot types.Slot, pubKey [
 
 	// Pass slot-1 because this parameter enters SubmitPrevote method as current slot+1
 	v.waitToSlotTwoThirds(ctx, slot-1)
+	twoThirdsTime := v.slotTwoThirdsTime(slot - 1)
 
 	req := &ethpb.PreVoteRequest{
 		Slot:           slot,
@@ -87,7 +89,7 @@ func (v *validator) SubmitPrevote(ctx context.Context, slot types.Slot, pubKey [
 	if err != nil {
 		log.WithError(err).Errorf("Could not request prevote data to sign at slot %v", req.Slot)
 		if v.emitAccountMetrics {
-			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
+			ValidatorPrevoteFailVec.WithLabelValues(fmtKey).Inc()
 		}
 		tracing.AnnotateError(span, err)
 		return
@@ -110,7 +112,7 @@ func (v *validator) SubmitPrevote(ctx context.Context, slot types.Slot, pubKey [
 	if err != nil {
 		log.WithError(err).Errorf("Could not get domain and signing root from prevote at slot %v", req.Slot)
 		if v.emitAccountMetrics {
-			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
+			ValidatorPrevoteFailVec.WithLabelValues(fmtKey).Inc()
 		}
 		tracing.AnnotateError(span, err)
 		return
@@ -120,7 +122,7 @@ func (v *validator) SubmitPrevote(ctx context.Context, slot types.Slot, pubKey [
 	if err != nil {
 		log.WithError(err).Errorf("Could not sign prevote at slot %v", req.Slot)
 		if v.emitAccountMetrics {
-			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
+			ValidatorPrevoteFailVec.WithLabelValues(fmtKey).Inc()
 		}
 		tracing.AnnotateError(span, err)
 		return
@@ -138,7 +140,7 @@ func (v *validator) SubmitPrevote(ctx context.Context, slot types.Slot, pubKey [
 	if !found {
 		log.Errorf("Validator ID %d not found in committee of %v", duty.ValidatorIndex, duty.Committee)
 		if v.emitAccountMetrics {
-			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
+			ValidatorPrevoteFailVec.WithLabelValues(fmtKey).Inc()
 		}
 		return
 	}
@@ -153,12 +155,22 @@ func (v *validator) SubmitPrevote(ctx context.Context, slot types.Slot, pubKey [
 
 	// Set the signature of the attestation and send it out to the beacon node.
 	indexedPrevote.Signature = sig
+	if err := v.slashablePrevoteCheck(ctx, pubKey, data.Slot, signingRoot); err != nil {
+		log.WithError(err).Error("Failed prevote slashing protection check")
+		log.WithFields(logrus.Fields{
+			"data.Slot":       data.Slot,
+			"data.Index":      data.Index,
+			"data.Candidates": fmt.Sprintf("%#x", data.Candidates),
+		}).Debug("Attempted slashable prevote details")
+		tracing.AnnotateError(span, err)
+		return
+	}
 
 	pvResp, err := v.validatorClient.ProposePrevote(ctx, prevote)
 	if err != nil {
 		log.WithError(err).Errorf("Could not submit prevote to beacon node at slot %v", req.Slot)
 		if v.emitAccountMetrics {
-			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
+			ValidatorPrevoteFailVec.WithLabelValues(fmtKey).Inc()
 		}
 		tracing.AnnotateError(span, err)
 		return
@@ -171,9 +183,13 @@ func (v *validator) SubmitPrevote(ctx context.Context, slot types.Slot, pubKey [
 		trace.StringAttribute("bitfield", fmt.Sprintf("%#x", aggregationBitfield)),
 	)
 
+	delay := prysmTime.Since(twoThirdsTime)
+	ValidatorPrevoteDelaySeconds.Observe(delay.Seconds())
+	log.WithField("delay", delay).Debug("Submitted prevote")
+
 	if v.emitAccountMetrics {
-		ValidatorAttestSuccessVec.WithLabelValues(fmtKey).Inc()
-		ValidatorAttestedSlotsGaugeVec.WithLabelValues(fmtKey).Set(float64(slot))
+		ValidatorPrevoteSuccessVec.WithLabelValues(fmtKey).Inc()
+		ValidatorPrevotedSlotsGaugeVec.WithLabelValues(fmtKey).Set(float64(slot))
 	}
 }
 
@@ -21,6 +21,7 @@ import (
 	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1/block"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/validator/accounts/wallet"
+	beacon_api "gitlab.waterfall.network/waterfall/protocol/coordinator/validator/client/beacon-api"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/validator/client/iface"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/validator/db"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/validator/graffiti"
@@ -71,6 +72,9 @@ type ValidatorService struct {
 	graffiti              []byte
 	Web3SignerConfig      *remote_web3signer.SetupConfig
 	feeRecipientConfig    *validator_service_config.FeeRecipientConfig
+	beaconApiEndpoint     string
+	beaconApiTimeout      time.Duration
+	nodeClient            ethpb.NodeClient
 }
 
 // Config for the validator service.
@@ -95,6 +99,8 @@ type Config struct {
 	Endpoint                   string
 	Web3SignerConfig           *remote_web3signer.SetupConfig
 	FeeRecipientConfig         *validator_service_config.FeeRecipientConfig
+	BeaconApiEndpoint          string
+	BeaconApiTimeout           time.Duration
 }
 
 // NewValidatorService creates a new validator service for the service
@@ -124,34 +130,53 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		logDutyCountDown:      cfg.LogDutyCountDown,
 		Web3SignerConfig:      cfg.Web3SignerConfig,
 		feeRecipientConfig:    cfg.FeeRecipientConfig,
+		beaconApiEndpoint:     cfg.BeaconApiEndpoint,
+		beaconApiTimeout:      cfg.BeaconApiTimeout,
 	}, nil
 }
 
 // Start the validator service. Launches the main go routine for the validator
 // client.
 func (v *ValidatorService) Start() {
-	dialOpts := ConstructDialOptions(
-		v.maxCallRecvMsgSize,
-		v.withCert,
-		v.grpcRetries,
-		v.grpcRetryDelay,
+	var (
+		validatorClient ethpb.BeaconNodeValidatorClient
+		beaconClient    iface.BeaconChainClient
+		slasherClient   ethpb.SlasherClient
 	)
-	if dialOpts == nil {
-		return
-	}
+	if v.beaconApiEndpoint != "" {
+		validatorClient = beacon_api.NewBeaconApiValidatorClient(v.beaconApiEndpoint, v.beaconApiTimeout)
+		beaconClient = beacon_api.NewBeaconApiBeaconChainClient(v.beaconApiEndpoint, v.beaconApiTimeout)
+		v.nodeClient = beacon_api.NewBeaconApiNodeClient(v.beaconApiEndpoint, v.beaconApiTimeout)
+		log.WithField("endpoint", v.beaconApiEndpoint).Info("Using the beacon REST API")
+	} else {
+		dialOpts := ConstructDialOptions(
+			v.maxCallRecvMsgSize,
+			v.withCert,
+			v.grpcRetries,
+			v.grpcRetryDelay,
+		)
+		if dialOpts == nil {
+			return
+		}
 
-	v.ctx = grpcutil.AppendHeaders(v.ctx, v.grpcHeaders)
+		v.ctx = grpcutil.AppendHeaders(v.ctx, v.grpcHeaders)
 
-	conn, err := grpc.DialContext(v.ctx, v.endpoint, dialOpts...)
-	if err != nil {
-		log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
-		return
-	}
-	if v.withCert != "" {
-		log.Info("Established secure gRPC connection")
+		conn, err := grpc.DialContext(v.ctx, v.endpoint, dialOpts...)
+		if err != nil {
+			log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
+			return
+		}
+		if v.withCert != "" {
+			log.Info("Established secure gRPC connection")
+		}
+
+		v.conn = conn
+		validatorClient = ethpb.NewBeaconNodeValidatorClient(v.conn)
+		beaconClient = ethpb.NewBeaconChainClient(v.conn)
+		slasherClient = ethpb.NewSlasherClient(v.conn)
+		v.nodeClient = ethpb.NewNodeClient(v.conn)
 	}
 
-	v.conn = conn
 	cache, err := ristretto.NewCache(&ristretto.Config{
 		NumCounters: 1920, // number of keys to track.
 		MaxCost:     192,  // maximum cost of cache, 1 item = 1 cost.
@@ -181,10 +206,10 @@ func (v *ValidatorService) Start() {
 
 	valStruct := &validator{
 		db:                             v.db,
-		validatorClient:                ethpb.NewBeaconNodeValidatorClient(v.conn),
-		beaconClient:                   ethpb.NewBeaconChainClient(v.conn),
-		slashingProtectionClient:       ethpb.NewSlasherClient(v.conn),
-		node:                           ethpb.NewNodeClient(v.conn),
+		validatorClient:                validatorClient,
+		beaconClient:                   beaconClient,
+		slashingProtectionClient:       slasherClient,
+		node:                           v.nodeClient,
 		graffiti:                       v.graffiti,
 		logValidatorBalances:           v.logValidatorBalances,
 		emitAccountMetrics:             v.emitAccountMetrics,
@@ -234,7 +259,7 @@ func (v *ValidatorService) Stop() error {
 
 // Status of the validator service.
 func (v *ValidatorService) Status() error {
-	if v.conn == nil {
+	if v.nodeClient == nil {
 		return errors.New("no connection to beacon RPC")
 	}
 	return nil
@@ -305,8 +330,7 @@ func ConstructDialOptions(
 
 // Syncing returns whether or not the beacon node is currently synchronizing the chain.
 func (v *ValidatorService) Syncing(ctx context.Context) (bool, error) {
-	nc := ethpb.NewNodeClient(v.conn)
-	resp, err := nc.GetSyncStatus(ctx, &emptypb.Empty{})
+	resp, err := v.nodeClient.GetSyncStatus(ctx, &emptypb.Empty{})
 	if err != nil {
 		return false, err
 	}
@@ -316,6 +340,5 @@ func (v *ValidatorService) Syncing(ctx context.Context) (bool, error) {
 // GenesisInfo queries the beacon node for the chain genesis info containing
 // the genesis time along with the validator deposit contract address.
 func (v *ValidatorService) GenesisInfo(ctx context.Context) (*ethpb.Genesis, error) {
-	nc := ethpb.NewNodeClient(v.conn)
-	return nc.GetGenesis(ctx, &emptypb.Empty{})
+	return v.nodeClient.GetGenesis(ctx, &emptypb.Empty{})
 }
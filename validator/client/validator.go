@@ -88,7 +88,7 @@ type validator struct {
 	node                               ethpb.NodeClient
 	slashingProtectionClient           ethpb.SlasherClient
 	db                                 vdb.Database
-	beaconClient                       ethpb.BeaconChainClient
+	beaconClient                       iface.BeaconChainClient
 	keyManager                         keymanager.IKeymanager
 	ticker                             slots.Ticker
 	validatorClient                    ethpb.BeaconNodeValidatorClient
@@ -1115,11 +1115,13 @@ func validatorSubscribeKey(slot types.Slot, committeeID types.CommitteeIndex) [6
 
 // This tracks all validators' voting status.
 type voteStats struct {
-	startEpoch          types.Epoch
-	totalAttestedCount  uint64
-	totalRequestedCount uint64
-	totalDistance       types.Slot
-	totalCorrectSource  uint64
-	totalCorrectTarget  uint64
-	totalCorrectHead    uint64
+	startEpoch            types.Epoch
+	totalAttestedCount    uint64
+	totalRequestedCount   uint64
+	totalDistance         types.Slot
+	totalCorrectSource    uint64
+	totalCorrectTarget    uint64
+	totalCorrectHead      uint64
+	totalPrevotesSeen     uint64
+	totalPrevotesIncluded uint64
 }
@@ -37,6 +37,12 @@ type ValidatorDB interface {
 	SaveProposalHistoryForSlot(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot types.Slot, signingRoot []byte) error
 	ProposedPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error)
 
+	// Prevote protection related methods.
+	PrevoteHistoryForPubKey(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) ([]*kv.Prevote, error)
+	PrevoteHistoryForSlot(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte, slot types.Slot) ([32]byte, bool, error)
+	SavePrevoteHistoryForSlot(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot types.Slot, signingRoot []byte) error
+	PrevotedPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error)
+
 	// Attester protection related methods.
 	// Methods to store and read blacklisted public keys from EIP-3076
 	// slashing protection imports.
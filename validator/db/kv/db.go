@@ -143,6 +143,7 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			genesisInfoBucket,
 			deprecatedAttestationHistoryBucket,
 			historicProposalsBucket,
+			prevoteHistoryBucket,
 			lowestSignedSourceBucket,
 			lowestSignedTargetBucket,
 			lowestSignedProposalsBucket,
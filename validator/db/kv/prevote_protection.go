@@ -0,0 +1,131 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	fieldparams "gitlab.waterfall.network/waterfall/protocol/coordinator/config/fieldparams"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/time/slots"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// Prevote representation for a validator public key.
+type Prevote struct {
+	Slot        types.Slot `json:"slot"`
+	SigningRoot []byte     `json:"signing_root"`
+}
+
+// PrevotedPublicKeys retrieves all public keys in our prevotes history bucket.
+func (s *Store) PrevotedPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	_, span := trace.StartSpan(ctx, "Validator.PrevotedPublicKeys")
+	defer span.End()
+	var err error
+	prevotedPublicKeys := make([][fieldparams.BLSPubkeyLength]byte, 0)
+	err = s.view(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(prevoteHistoryBucket)
+		return bucket.ForEach(func(key []byte, _ []byte) error {
+			pubKeyBytes := [fieldparams.BLSPubkeyLength]byte{}
+			copy(pubKeyBytes[:], key)
+			prevotedPublicKeys = append(prevotedPublicKeys, pubKeyBytes)
+			return nil
+		})
+	})
+	return prevotedPublicKeys, err
+}
+
+// PrevoteHistoryForSlot accepts a validator public key and returns the corresponding signing root as well
+// as a boolean that tells us if we have a prevote history stored at the slot. It is possible we have
+// prevoted at a slot but stored a nil signing root, so the boolean helps give full information.
+func (s *Store) PrevoteHistoryForSlot(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte, slot types.Slot) ([32]byte, bool, error) {
+	_, span := trace.StartSpan(ctx, "Validator.PrevoteHistoryForSlot")
+	defer span.End()
+
+	var err error
+	var prevoteExists bool
+	signingRoot := [32]byte{}
+	err = s.view(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(prevoteHistoryBucket)
+		valBucket := bucket.Bucket(publicKey[:])
+		if valBucket == nil {
+			return nil
+		}
+		signingRootBytes := valBucket.Get(bytesutil.SlotToBytesBigEndian(slot))
+		if signingRootBytes == nil {
+			return nil
+		}
+		prevoteExists = true
+		copy(signingRoot[:], signingRootBytes)
+		return nil
+	})
+	return signingRoot, prevoteExists, err
+}
+
+// PrevoteHistoryForPubKey returns the entire prevote history for a given public key.
+func (s *Store) PrevoteHistoryForPubKey(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) ([]*Prevote, error) {
+	_, span := trace.StartSpan(ctx, "Validator.PrevoteHistoryForPubKey")
+	defer span.End()
+
+	prevotes := make([]*Prevote, 0)
+	err := s.view(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(prevoteHistoryBucket)
+		valBucket := bucket.Bucket(publicKey[:])
+		if valBucket == nil {
+			return nil
+		}
+		return valBucket.ForEach(func(slotKey, signingRootBytes []byte) error {
+			slot := bytesutil.BytesToSlotBigEndian(slotKey)
+			sr := make([]byte, fieldparams.RootLength)
+			copy(sr, signingRootBytes)
+			prevotes = append(prevotes, &Prevote{
+				Slot:        slot,
+				SigningRoot: sr,
+			})
+			return nil
+		})
+	})
+	return prevotes, err
+}
+
+// SavePrevoteHistoryForSlot saves the prevote history for the requested validator public key.
+// Prevotes older than the weak subjectivity period are pruned on each save.
+func (s *Store) SavePrevoteHistoryForSlot(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot types.Slot, signingRoot []byte) error {
+	_, span := trace.StartSpan(ctx, "Validator.SavePrevoteHistoryForSlot")
+	defer span.End()
+
+	err := s.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(prevoteHistoryBucket)
+		valBucket, err := bucket.CreateBucketIfNotExists(pubKey[:])
+		if err != nil {
+			return fmt.Errorf("could not create bucket for public key %#x", pubKey)
+		}
+		if err := valBucket.Put(bytesutil.SlotToBytesBigEndian(slot), signingRoot); err != nil {
+			return err
+		}
+		return prunePrevoteHistoryBySlot(valBucket, slot)
+	})
+	return err
+}
+
+func prunePrevoteHistoryBySlot(valBucket *bolt.Bucket, newestSlot types.Slot) error {
+	c := valBucket.Cursor()
+	for k, _ := c.First(); k != nil; k, _ = c.First() {
+		slot := bytesutil.BytesToSlotBigEndian(k)
+		epoch := slots.ToEpoch(slot)
+		newestEpoch := slots.ToEpoch(newestSlot)
+		// Only delete epochs that are older than the weak subjectivity period.
+		if epoch+params.BeaconConfig().WeakSubjectivityPeriod <= newestEpoch {
+			if err := c.Delete(); err != nil {
+				return errors.Wrapf(err, "could not prune epoch %d in prevote history", epoch)
+			}
+		} else {
+			// If starting from the oldest, we dont find anything prunable, stop pruning.
+			break
+		}
+	}
+	return nil
+}
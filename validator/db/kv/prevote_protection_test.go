@@ -0,0 +1,69 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	fieldparams "gitlab.waterfall.network/waterfall/protocol/coordinator/config/fieldparams"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/assert"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/testing/require"
+)
+
+func TestPrevoteHistoryForSlot_ReturnsNilIfNoHistory(t *testing.T) {
+	valPubkey := [fieldparams.BLSPubkeyLength]byte{1, 2, 3}
+	db := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{})
+
+	_, prevoteExists, err := db.PrevoteHistoryForSlot(context.Background(), valPubkey, 0)
+	require.NoError(t, err)
+	assert.Equal(t, false, prevoteExists)
+
+	prevoteHistory, err := db.PrevoteHistoryForPubKey(context.Background(), valPubkey)
+	require.NoError(t, err)
+	assert.DeepEqual(t, make([]*Prevote, 0), prevoteHistory)
+}
+
+func TestSavePrevoteHistoryForSlot_OK(t *testing.T) {
+	pubkey := [fieldparams.BLSPubkeyLength]byte{3}
+	db := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubkey})
+	ctx := context.Background()
+
+	require.NoError(t, db.SavePrevoteHistoryForSlot(ctx, pubkey, 2, []byte{1}))
+	require.NoError(t, db.SavePrevoteHistoryForSlot(ctx, pubkey, 3, []byte{2}))
+
+	signingRoot, prevoteExists, err := db.PrevoteHistoryForSlot(ctx, pubkey, 2)
+	require.NoError(t, err)
+	require.Equal(t, true, prevoteExists)
+	require.DeepEqual(t, bytesutil.PadTo([]byte{1}, 32), signingRoot[:])
+
+	prevoteHistory, err := db.PrevoteHistoryForPubKey(ctx, pubkey)
+	require.NoError(t, err)
+	require.DeepEqual(t, []*Prevote{
+		{Slot: 2, SigningRoot: bytesutil.PadTo([]byte{1}, 32)},
+		{Slot: 3, SigningRoot: bytesutil.PadTo([]byte{2}, 32)},
+	}, prevoteHistory)
+
+	prevotedKeys, err := db.PrevotedPublicKeys(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, [][fieldparams.BLSPubkeyLength]byte{pubkey}, prevotedKeys)
+}
+
+func TestPrunePrevoteHistoryBySlot_OK(t *testing.T) {
+	pubkey := [fieldparams.BLSPubkeyLength]byte{3}
+	db := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubkey})
+	ctx := context.Background()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+
+	require.NoError(t, db.SavePrevoteHistoryForSlot(ctx, pubkey, 1, []byte{1}))
+	newestSlot := slotsPerEpoch.Mul(uint64(wsPeriod + 1))
+	require.NoError(t, db.SavePrevoteHistoryForSlot(ctx, pubkey, newestSlot, []byte{2}))
+
+	_, prevoteExists, err := db.PrevoteHistoryForSlot(ctx, pubkey, 1)
+	require.NoError(t, err)
+	assert.Equal(t, false, prevoteExists, "Expected old prevote to be pruned")
+	_, prevoteExists, err = db.PrevoteHistoryForSlot(ctx, pubkey, newestSlot)
+	require.NoError(t, err)
+	assert.Equal(t, true, prevoteExists)
+}
@@ -8,6 +8,9 @@ var (
 	historicProposalsBucket            = []byte("proposal-history-bucket-interchange")
 	deprecatedAttestationHistoryBucket = []byte("attestation-history-bucket-interchange")
 
+	// Validator slashing protection from double prevotes.
+	prevoteHistoryBucket = []byte("prevote-history-bucket")
+
 	// Buckets for lowest signed source and target epoch for individual validator.
 	lowestSignedSourceBucket = []byte("lowest-signed-source-bucket")
 	lowestSignedTargetBucket = []byte("lowest-signed-target-bucket")
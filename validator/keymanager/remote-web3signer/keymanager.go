@@ -166,12 +166,26 @@ func getSignRequestJSON(ctx context.Context, validator *validator.Validate, requ
 		}
 		blockV2SignRequestsTotal.Inc()
 		return json.Marshal(blocv2AltairSignRequest)
-	// TODO(#10053): Need to add support for merge blocks.
-
-	/*
-		case *validatorpb.SignRequest_BlockV3:
-		return "BLOCK_V3", nil
-	*/
+	case *validatorpb.SignRequest_BlockV3:
+		blockV2BellatrixSignRequest, err := v1.GetBlockV2BellatrixSignRequest(request, genesisValidatorsRoot)
+		if err != nil {
+			return nil, err
+		}
+		if err = validator.StructCtx(ctx, blockV2BellatrixSignRequest); err != nil {
+			return nil, err
+		}
+		blockV3SignRequestsTotal.Inc()
+		return json.Marshal(blockV2BellatrixSignRequest)
+	case *validatorpb.SignRequest_BlindedBlockV3:
+		blindedBlockV2SignRequest, err := v1.GetBlockV2BlindedSignRequest(request, genesisValidatorsRoot)
+		if err != nil {
+			return nil, err
+		}
+		if err = validator.StructCtx(ctx, blindedBlockV2SignRequest); err != nil {
+			return nil, err
+		}
+		blindedBlockV3SignRequestsTotal.Inc()
+		return json.Marshal(blindedBlockV2SignRequest)
 
 	// We do not support "DEPOSIT" type.
 	/*
@@ -229,6 +243,16 @@ func getSignRequestJSON(ctx context.Context, validator *validator.Validate, requ
 		}
 		syncCommitteeContributionAndProofSignRequestsTotal.Inc()
 		return json.Marshal(contributionAndProofRequest)
+	case *validatorpb.SignRequest_PrevoteData:
+		prevoteSignRequest, err := v1.GetPrevoteSignRequest(request, genesisValidatorsRoot)
+		if err != nil {
+			return nil, err
+		}
+		if err = validator.StructCtx(ctx, prevoteSignRequest); err != nil {
+			return nil, err
+		}
+		prevoteSignRequestsTotal.Inc()
+		return json.Marshal(prevoteSignRequest)
 	default:
 		return nil, fmt.Errorf("web3signer sign request type %T not supported", request.Object)
 	}
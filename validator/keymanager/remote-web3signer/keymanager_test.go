@@ -123,6 +123,30 @@ func TestKeymanager_Sign(t *testing.T) {
 			want:    desiredSig,
 			wantErr: false,
 		},
+		{
+			name: "BLOCK_V3",
+			args: args{
+				request: mock.GetMockSignRequest("BLOCK_V3"),
+			},
+			want:    desiredSig,
+			wantErr: false,
+		},
+		{
+			name: "BLINDED_BLOCK_V3",
+			args: args{
+				request: mock.GetMockSignRequest("BLINDED_BLOCK_V3"),
+			},
+			want:    desiredSig,
+			wantErr: false,
+		},
+		{
+			name: "PREVOTE",
+			args: args{
+				request: mock.GetMockSignRequest("PREVOTE"),
+			},
+			want:    desiredSig,
+			wantErr: false,
+		},
 		{
 			name: "RANDAO_REVEAL",
 			args: args{
@@ -6,6 +6,7 @@ import (
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/network/forks"
+	enginev1 "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/engine/v1"
 	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/time/slots"
 	"gitlab.waterfall.network/waterfall/protocol/gwat/common/hexutil"
@@ -118,6 +119,7 @@ func MapBeaconBlockBody(body *ethpb.BeaconBlockBody) (*BeaconBlockBody, error) {
 		Attestations:      make([]*Attestation, len(body.Attestations)),
 		Deposits:          make([]*Deposit, len(body.Deposits)),
 		VoluntaryExits:    make([]*VoluntaryExit, len(body.VoluntaryExits)),
+		Withdrawals:       make([]*Withdrawal, len(body.Withdrawals)),
 	}
 	for i, slashing := range body.ProposerSlashings {
 		slashing, err := MapProposerSlashing(slashing)
@@ -154,6 +156,13 @@ func MapBeaconBlockBody(body *ethpb.BeaconBlockBody) (*BeaconBlockBody, error) {
 		}
 		block.VoluntaryExits[i] = voluntaryExit
 	}
+	for i, withdrawal := range body.Withdrawals {
+		withdrawal, err := MapWithdrawal(withdrawal)
+		if err != nil {
+			return nil, fmt.Errorf("could not map withdrawal at index %v: %v", i, err)
+		}
+		block.Withdrawals[i] = withdrawal
+	}
 	return block, nil
 }
 
@@ -283,6 +292,20 @@ func MapVoluntaryExit(voluntaryExit *ethpb.VoluntaryExit) (*VoluntaryExit, error
 	}, nil
 }
 
+// MapWithdrawal maps the eth2.Withdrawal proto to the Web3Signer spec.
+func MapWithdrawal(withdrawal *ethpb.Withdrawal) (*Withdrawal, error) {
+	if withdrawal == nil {
+		return nil, fmt.Errorf("withdrawal is nil")
+	}
+	return &Withdrawal{
+		PublicKey:      hexutil.Encode(withdrawal.PublicKey),
+		Epoch:          fmt.Sprint(withdrawal.Epoch),
+		ValidatorIndex: fmt.Sprint(withdrawal.ValidatorIndex),
+		Amount:         fmt.Sprint(withdrawal.Amount),
+		InitTxHash:     hexutil.Encode(withdrawal.InitTxHash),
+	}, nil
+}
+
 // MapBeaconBlockAltair maps the eth2.BeaconBlockAltair proto to the Web3Signer spec.
 func MapBeaconBlockAltair(block *ethpb.BeaconBlockAltair) (*BeaconBlockAltair, error) {
 	if block == nil {
@@ -335,6 +358,110 @@ func MapBeaconBlockBodyAltair(body *ethpb.BeaconBlockBodyAltair) (*BeaconBlockBo
 			SyncCommitteeBits:      hexutil.Encode(body.SyncAggregate.SyncCommitteeBits),
 			SyncCommitteeSignature: hexutil.Encode(body.SyncAggregate.SyncCommitteeSignature),
 		},
+		Withdrawals: make([]*Withdrawal, len(body.Withdrawals)),
+	}
+	for i, slashing := range body.ProposerSlashings {
+		proposer, err := MapProposerSlashing(slashing)
+		if err != nil {
+			return nil, fmt.Errorf("could not map proposer slashing at index %v: %v", i, err)
+		}
+		block.ProposerSlashings[i] = proposer
+	}
+	for i, slashing := range body.AttesterSlashings {
+		attesterSlashing, err := MapAttesterSlashing(slashing)
+		if err != nil {
+			return nil, fmt.Errorf("could not map attester slashing at index %v: %v", i, err)
+		}
+		block.AttesterSlashings[i] = attesterSlashing
+	}
+	for i, attestation := range body.Attestations {
+		attestation, err := MapAttestation(attestation)
+		if err != nil {
+			return nil, fmt.Errorf("could not map attestation at index %v: %v", i, err)
+		}
+		block.Attestations[i] = attestation
+	}
+	for i, deposit := range body.Deposits {
+		deposit, err := MapDeposit(deposit)
+		if err != nil {
+			return nil, fmt.Errorf("could not map deposit at index %v: %v", i, err)
+		}
+		block.Deposits[i] = deposit
+	}
+	for i, exit := range body.VoluntaryExits {
+
+		exit, err := MapVoluntaryExit(exit)
+		if err != nil {
+			return nil, fmt.Errorf("could not map signed voluntary exit at index %v: %v", i, err)
+		}
+		block.VoluntaryExits[i] = exit
+	}
+	for i, withdrawal := range body.Withdrawals {
+		withdrawal, err := MapWithdrawal(withdrawal)
+		if err != nil {
+			return nil, fmt.Errorf("could not map withdrawal at index %v: %v", i, err)
+		}
+		block.Withdrawals[i] = withdrawal
+	}
+	return block, nil
+}
+
+// MapBeaconBlockBellatrix maps the eth2.BeaconBlockBellatrix proto to the Web3Signer spec.
+func MapBeaconBlockBellatrix(block *ethpb.BeaconBlockBellatrix) (*BeaconBlockBellatrix, error) {
+	if block == nil {
+		return nil, fmt.Errorf("beacon block bellatrix is nil")
+	}
+	body, err := MapBeaconBlockBodyBellatrix(block.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not map beacon block body for bellatrix")
+	}
+	return &BeaconBlockBellatrix{
+		Slot:          fmt.Sprint(block.Slot),
+		ProposerIndex: fmt.Sprint(block.ProposerIndex),
+		ParentRoot:    hexutil.Encode(block.ParentRoot),
+		StateRoot:     hexutil.Encode(block.StateRoot),
+		Body:          body,
+	}, nil
+}
+
+// MapBeaconBlockBodyBellatrix maps the eth2.BeaconBlockBodyBellatrix proto to the Web3Signer spec.
+func MapBeaconBlockBodyBellatrix(body *ethpb.BeaconBlockBodyBellatrix) (*BeaconBlockBodyBellatrix, error) {
+	if body == nil {
+		return nil, fmt.Errorf("beacon block body bellatrix is nil")
+	}
+	if body.Eth1Data == nil {
+		return nil, fmt.Errorf("eth1 data in beacon block body bellatrix is nil")
+	}
+	if body.SyncAggregate == nil {
+		return nil, fmt.Errorf("sync aggregate in beacon block body bellatrix is nil")
+	}
+	if body.SyncAggregate.SyncCommitteeBits == nil {
+		return nil, fmt.Errorf("sync committee bits in sync aggregate in beacon block body bellatrix is nil")
+	}
+	payload, err := MapExecutionPayload(body.ExecutionPayload)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not map execution payload")
+	}
+	block := &BeaconBlockBodyBellatrix{
+		RandaoReveal: hexutil.Encode(body.RandaoReveal),
+		Eth1Data: &Eth1Data{
+			DepositRoot:  hexutil.Encode(body.Eth1Data.DepositRoot),
+			DepositCount: fmt.Sprint(body.Eth1Data.DepositCount),
+			BlockHash:    hexutil.Encode(body.Eth1Data.BlockHash),
+			Candidates:   hexutil.Encode(body.Eth1Data.Candidates),
+		},
+		Graffiti:          hexutil.Encode(body.Graffiti),
+		ProposerSlashings: make([]*ProposerSlashing, len(body.ProposerSlashings)),
+		AttesterSlashings: make([]*AttesterSlashing, len(body.AttesterSlashings)),
+		Attestations:      make([]*Attestation, len(body.Attestations)),
+		Deposits:          make([]*Deposit, len(body.Deposits)),
+		VoluntaryExits:    make([]*VoluntaryExit, len(body.VoluntaryExits)),
+		SyncAggregate: &SyncAggregate{
+			SyncCommitteeBits:      hexutil.Encode(body.SyncAggregate.SyncCommitteeBits),
+			SyncCommitteeSignature: hexutil.Encode(body.SyncAggregate.SyncCommitteeSignature),
+		},
+		ExecutionPayload: payload,
+		Withdrawals:      make([]*Withdrawal, len(body.Withdrawals)),
 	}
 	for i, slashing := range body.ProposerSlashings {
 		proposer, err := MapProposerSlashing(slashing)
@@ -365,16 +492,186 @@ func MapBeaconBlockBodyAltair(body *ethpb.BeaconBlockBodyAltair) (*BeaconBlockBo
 		block.Deposits[i] = deposit
 	}
 	for i, exit := range body.VoluntaryExits {
+		exit, err := MapVoluntaryExit(exit)
+		if err != nil {
+			return nil, fmt.Errorf("could not map signed voluntary exit at index %v: %v", i, err)
+		}
+		block.VoluntaryExits[i] = exit
+	}
+	for i, withdrawal := range body.Withdrawals {
+		withdrawal, err := MapWithdrawal(withdrawal)
+		if err != nil {
+			return nil, fmt.Errorf("could not map withdrawal at index %v: %v", i, err)
+		}
+		block.Withdrawals[i] = withdrawal
+	}
+	return block, nil
+}
+
+// MapExecutionPayload maps the engine.ExecutionPayload proto to the Web3Signer spec.
+func MapExecutionPayload(payload *enginev1.ExecutionPayload) (*ExecutionPayload, error) {
+	if payload == nil {
+		return nil, fmt.Errorf("execution payload is nil")
+	}
+	transactions := make([]string, len(payload.Transactions))
+	for i, tx := range payload.Transactions {
+		transactions[i] = hexutil.Encode(tx)
+	}
+	return &ExecutionPayload{
+		ParentHash:    hexutil.Encode(payload.ParentHash),
+		FeeRecipient:  hexutil.Encode(payload.FeeRecipient),
+		StateRoot:     hexutil.Encode(payload.StateRoot),
+		ReceiptsRoot:  hexutil.Encode(payload.ReceiptsRoot),
+		LogsBloom:     hexutil.Encode(payload.LogsBloom),
+		PrevRandao:    hexutil.Encode(payload.PrevRandao),
+		BlockNumber:   fmt.Sprint(payload.BlockNumber),
+		GasLimit:      fmt.Sprint(payload.GasLimit),
+		GasUsed:       fmt.Sprint(payload.GasUsed),
+		Timestamp:     fmt.Sprint(payload.Timestamp),
+		ExtraData:     hexutil.Encode(payload.ExtraData),
+		BaseFeePerGas: hexutil.Encode(payload.BaseFeePerGas),
+		BlockHash:     hexutil.Encode(payload.BlockHash),
+		Transactions:  transactions,
+	}, nil
+}
 
+// MapBlindedBeaconBlockBellatrix maps the eth2.BlindedBeaconBlockBellatrix proto to the Web3Signer spec.
+func MapBlindedBeaconBlockBellatrix(block *ethpb.BlindedBeaconBlockBellatrix) (*BlindedBeaconBlockBellatrix, error) {
+	if block == nil {
+		return nil, fmt.Errorf("blinded beacon block bellatrix is nil")
+	}
+	body, err := MapBlindedBeaconBlockBodyBellatrix(block.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not map blinded beacon block body for bellatrix")
+	}
+	return &BlindedBeaconBlockBellatrix{
+		Slot:          fmt.Sprint(block.Slot),
+		ProposerIndex: fmt.Sprint(block.ProposerIndex),
+		ParentRoot:    hexutil.Encode(block.ParentRoot),
+		StateRoot:     hexutil.Encode(block.StateRoot),
+		Body:          body,
+	}, nil
+}
+
+// MapBlindedBeaconBlockBodyBellatrix maps the eth2.BlindedBeaconBlockBodyBellatrix proto to the Web3Signer spec.
+func MapBlindedBeaconBlockBodyBellatrix(body *ethpb.BlindedBeaconBlockBodyBellatrix) (*BlindedBeaconBlockBodyBellatrix, error) {
+	if body == nil {
+		return nil, fmt.Errorf("blinded beacon block body bellatrix is nil")
+	}
+	if body.Eth1Data == nil {
+		return nil, fmt.Errorf("eth1 data in blinded beacon block body bellatrix is nil")
+	}
+	if body.SyncAggregate == nil {
+		return nil, fmt.Errorf("sync aggregate in blinded beacon block body bellatrix is nil")
+	}
+	if body.SyncAggregate.SyncCommitteeBits == nil {
+		return nil, fmt.Errorf("sync committee bits in sync aggregate in blinded beacon block body bellatrix is nil")
+	}
+	header, err := MapExecutionPayloadHeader(body.ExecutionPayloadHeader)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not map execution payload header")
+	}
+	block := &BlindedBeaconBlockBodyBellatrix{
+		RandaoReveal: hexutil.Encode(body.RandaoReveal),
+		Eth1Data: &Eth1Data{
+			DepositRoot:  hexutil.Encode(body.Eth1Data.DepositRoot),
+			DepositCount: fmt.Sprint(body.Eth1Data.DepositCount),
+			BlockHash:    hexutil.Encode(body.Eth1Data.BlockHash),
+			Candidates:   hexutil.Encode(body.Eth1Data.Candidates),
+		},
+		Graffiti:          hexutil.Encode(body.Graffiti),
+		ProposerSlashings: make([]*ProposerSlashing, len(body.ProposerSlashings)),
+		AttesterSlashings: make([]*AttesterSlashing, len(body.AttesterSlashings)),
+		Attestations:      make([]*Attestation, len(body.Attestations)),
+		Deposits:          make([]*Deposit, len(body.Deposits)),
+		VoluntaryExits:    make([]*VoluntaryExit, len(body.VoluntaryExits)),
+		SyncAggregate: &SyncAggregate{
+			SyncCommitteeBits:      hexutil.Encode(body.SyncAggregate.SyncCommitteeBits),
+			SyncCommitteeSignature: hexutil.Encode(body.SyncAggregate.SyncCommitteeSignature),
+		},
+		ExecutionPayloadHeader: header,
+		Withdrawals:            make([]*Withdrawal, len(body.Withdrawals)),
+	}
+	for i, slashing := range body.ProposerSlashings {
+		proposer, err := MapProposerSlashing(slashing)
+		if err != nil {
+			return nil, fmt.Errorf("could not map proposer slashing at index %v: %v", i, err)
+		}
+		block.ProposerSlashings[i] = proposer
+	}
+	for i, slashing := range body.AttesterSlashings {
+		attesterSlashing, err := MapAttesterSlashing(slashing)
+		if err != nil {
+			return nil, fmt.Errorf("could not map attester slashing at index %v: %v", i, err)
+		}
+		block.AttesterSlashings[i] = attesterSlashing
+	}
+	for i, attestation := range body.Attestations {
+		attestation, err := MapAttestation(attestation)
+		if err != nil {
+			return nil, fmt.Errorf("could not map attestation at index %v: %v", i, err)
+		}
+		block.Attestations[i] = attestation
+	}
+	for i, deposit := range body.Deposits {
+		deposit, err := MapDeposit(deposit)
+		if err != nil {
+			return nil, fmt.Errorf("could not map deposit at index %v: %v", i, err)
+		}
+		block.Deposits[i] = deposit
+	}
+	for i, exit := range body.VoluntaryExits {
 		exit, err := MapVoluntaryExit(exit)
 		if err != nil {
 			return nil, fmt.Errorf("could not map signed voluntary exit at index %v: %v", i, err)
 		}
 		block.VoluntaryExits[i] = exit
 	}
+	for i, withdrawal := range body.Withdrawals {
+		withdrawal, err := MapWithdrawal(withdrawal)
+		if err != nil {
+			return nil, fmt.Errorf("could not map withdrawal at index %v: %v", i, err)
+		}
+		block.Withdrawals[i] = withdrawal
+	}
 	return block, nil
 }
 
+// MapExecutionPayloadHeader maps the eth2.ExecutionPayloadHeader proto to the Web3Signer spec.
+func MapExecutionPayloadHeader(header *ethpb.ExecutionPayloadHeader) (*ExecutionPayloadHeader, error) {
+	if header == nil {
+		return nil, fmt.Errorf("execution payload header is nil")
+	}
+	return &ExecutionPayloadHeader{
+		ParentHash:       hexutil.Encode(header.ParentHash),
+		FeeRecipient:     hexutil.Encode(header.FeeRecipient),
+		StateRoot:        hexutil.Encode(header.StateRoot),
+		ReceiptsRoot:     hexutil.Encode(header.ReceiptRoot),
+		LogsBloom:        hexutil.Encode(header.LogsBloom),
+		PrevRandao:       hexutil.Encode(header.PrevRandao),
+		BlockNumber:      fmt.Sprint(header.BlockNumber),
+		GasLimit:         fmt.Sprint(header.GasLimit),
+		GasUsed:          fmt.Sprint(header.GasUsed),
+		Timestamp:        fmt.Sprint(header.Timestamp),
+		ExtraData:        hexutil.Encode(header.ExtraData),
+		BaseFeePerGas:    hexutil.Encode(header.BaseFeePerGas),
+		BlockHash:        hexutil.Encode(header.BlockHash),
+		TransactionsRoot: hexutil.Encode(header.TransactionsRoot),
+	}, nil
+}
+
+// MapPrevoteData maps the eth2.PreVoteData proto to the Web3Signer spec.
+func MapPrevoteData(data *ethpb.PreVoteData) (*PrevoteData, error) {
+	if data == nil {
+		return nil, fmt.Errorf("prevote data is nil")
+	}
+	return &PrevoteData{
+		Slot:       fmt.Sprint(data.Slot),
+		Index:      fmt.Sprint(data.Index),
+		Candidates: hexutil.Encode(data.Candidates),
+	}, nil
+}
+
 // MapSyncAggregatorSelectionData maps the eth2.SyncAggregatorSelectionData proto to the Web3Signer spec.
 func MapSyncAggregatorSelectionData(data *ethpb.SyncAggregatorSelectionData) (*SyncAggregatorSelectionData, error) {
 	if data == nil {
@@ -8,6 +8,7 @@ import (
 	"github.com/prysmaticlabs/go-bitfield"
 	fieldparams "gitlab.waterfall.network/waterfall/protocol/coordinator/config/fieldparams"
 	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
+	validatorpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1/validator-client"
 	v1 "gitlab.waterfall.network/waterfall/protocol/coordinator/validator/keymanager/remote-web3signer/v1"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/validator/keymanager/remote-web3signer/v1/mock"
 	"gitlab.waterfall.network/waterfall/protocol/gwat/common/hexutil"
@@ -329,6 +330,15 @@ func TestMapBeaconBlockAltair(t *testing.T) {
 								ValidatorIndex: 0,
 							},
 						},
+						Withdrawals: []*ethpb.Withdrawal{
+							{
+								PublicKey:      make([]byte, fieldparams.BLSPubkeyLength),
+								ValidatorIndex: 0,
+								Amount:         0,
+								InitTxHash:     make([]byte, fieldparams.RootLength),
+								Epoch:          0,
+							},
+						},
 						SyncAggregate: &ethpb.SyncAggregate{
 							SyncCommitteeSignature: make([]byte, fieldparams.BLSSignatureLength),
 							SyncCommitteeBits:      mock.MockSyncComitteeBits(),
@@ -463,6 +473,15 @@ func TestMapBeaconBlockBody(t *testing.T) {
 							ValidatorIndex: 0,
 						},
 					},
+					Withdrawals: []*ethpb.Withdrawal{
+						{
+							PublicKey:      make([]byte, fieldparams.BLSPubkeyLength),
+							ValidatorIndex: 0,
+							Amount:         0,
+							InitTxHash:     make([]byte, fieldparams.RootLength),
+							Epoch:          0,
+						},
+					},
 				},
 			},
 			want:    mock.MockBeaconBlockBody(),
@@ -483,6 +502,86 @@ func TestMapBeaconBlockBody(t *testing.T) {
 	}
 }
 
+func TestMapBeaconBlockBellatrix(t *testing.T) {
+	request := mock.GetMockSignRequest("BLOCK_V3")
+	block := request.Object.(*validatorpb.SignRequest_BlockV3).BlockV3
+	tests := []struct {
+		name    string
+		block   *ethpb.BeaconBlockBellatrix
+		want    *v1.BeaconBlockBellatrix
+		wantErr bool
+	}{
+		{
+			name:    "Happy Path Test",
+			block:   block,
+			want:    mock.MockBeaconBlockBellatrix(),
+			wantErr: false,
+		},
+		{
+			name: "Nil execution payload",
+			block: &ethpb.BeaconBlockBellatrix{
+				Body: &ethpb.BeaconBlockBodyBellatrix{
+					Eth1Data:      block.Body.Eth1Data,
+					SyncAggregate: block.Body.SyncAggregate,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := v1.MapBeaconBlockBellatrix(tt.block)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MapBeaconBlockBellatrix() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MapBeaconBlockBellatrix() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMapBlindedBeaconBlockBellatrix(t *testing.T) {
+	request := mock.GetMockSignRequest("BLINDED_BLOCK_V3")
+	block := request.Object.(*validatorpb.SignRequest_BlindedBlockV3).BlindedBlockV3
+	tests := []struct {
+		name    string
+		block   *ethpb.BlindedBeaconBlockBellatrix
+		want    *v1.BlindedBeaconBlockBellatrix
+		wantErr bool
+	}{
+		{
+			name:    "Happy Path Test",
+			block:   block,
+			want:    mock.MockBlindedBeaconBlockBellatrix(),
+			wantErr: false,
+		},
+		{
+			name: "Nil execution payload header",
+			block: &ethpb.BlindedBeaconBlockBellatrix{
+				Body: &ethpb.BlindedBeaconBlockBodyBellatrix{
+					Eth1Data:      block.Body.Eth1Data,
+					SyncAggregate: block.Body.SyncAggregate,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := v1.MapBlindedBeaconBlockBellatrix(tt.block)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MapBlindedBeaconBlockBellatrix() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MapBlindedBeaconBlockBellatrix() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestMapContributionAndProof(t *testing.T) {
 	type args struct {
 		contribution *ethpb.ContributionAndProof
@@ -561,6 +660,56 @@ func TestMapForkInfo(t *testing.T) {
 	}
 }
 
+func TestMapPrevoteData(t *testing.T) {
+	candidates := append(bytes32(0x01), bytes32(0x02)...)
+	tests := []struct {
+		name    string
+		data    *ethpb.PreVoteData
+		want    *v1.PrevoteData
+		wantErr bool
+	}{
+		{
+			name: "Happy Path Test",
+			data: &ethpb.PreVoteData{
+				Slot:       5,
+				Index:      2,
+				Candidates: candidates,
+			},
+			want: &v1.PrevoteData{
+				Slot:       "5",
+				Index:      "2",
+				Candidates: hexutil.Encode(candidates),
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Nil prevote data",
+			data:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := v1.MapPrevoteData(tt.data)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MapPrevoteData() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MapPrevoteData() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func bytes32(b byte) []byte {
+	res := make([]byte, fieldparams.RootLength)
+	for i := range res {
+		res[i] = b
+	}
+	return res
+}
+
 func TestMapSyncAggregatorSelectionData(t *testing.T) {
 	type args struct {
 		data *ethpb.SyncAggregatorSelectionData
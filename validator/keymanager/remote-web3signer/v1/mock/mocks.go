@@ -5,6 +5,7 @@ import (
 
 	"github.com/prysmaticlabs/go-bitfield"
 	fieldparams "gitlab.waterfall.network/waterfall/protocol/coordinator/config/fieldparams"
+	enginev1 "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/engine/v1"
 	eth "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
 	validatorpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1/validator-client"
 	v1 "gitlab.waterfall.network/waterfall/protocol/coordinator/validator/keymanager/remote-web3signer/v1"
@@ -203,6 +204,15 @@ func GetMockSignRequest(t string) *validatorpb.SignRequest {
 								ValidatorIndex: 0,
 							},
 						},
+						Withdrawals: []*eth.Withdrawal{
+							{
+								PublicKey:      make([]byte, fieldparams.BLSPubkeyLength),
+								ValidatorIndex: 0,
+								Amount:         0,
+								InitTxHash:     make([]byte, fieldparams.RootLength),
+								Epoch:          0,
+							},
+						},
 					},
 				},
 			},
@@ -315,15 +325,178 @@ func GetMockSignRequest(t string) *validatorpb.SignRequest {
 								ValidatorIndex: 0,
 							},
 						},
+						Withdrawals: []*eth.Withdrawal{
+							{
+								PublicKey:      make([]byte, fieldparams.BLSPubkeyLength),
+								ValidatorIndex: 0,
+								Amount:         0,
+								InitTxHash:     make([]byte, fieldparams.RootLength),
+								Epoch:          0,
+							},
+						},
+						SyncAggregate: &eth.SyncAggregate{
+							SyncCommitteeSignature: make([]byte, fieldparams.BLSSignatureLength),
+							SyncCommitteeBits:      MockSyncComitteeBits(),
+						},
+					},
+				},
+			},
+			SigningSlot: 0,
+		}
+	case "BLOCK_V3":
+		return &validatorpb.SignRequest{
+			PublicKey:       make([]byte, fieldparams.BLSPubkeyLength),
+			SigningRoot:     make([]byte, fieldparams.RootLength),
+			SignatureDomain: make([]byte, 4),
+			Object: &validatorpb.SignRequest_BlockV3{
+				BlockV3: &eth.BeaconBlockBellatrix{
+					Slot:          0,
+					ProposerIndex: 0,
+					ParentRoot:    make([]byte, fieldparams.RootLength),
+					StateRoot:     make([]byte, fieldparams.RootLength),
+					Body: &eth.BeaconBlockBodyBellatrix{
+						RandaoReveal: make([]byte, 32),
+						Eth1Data: &eth.Eth1Data{
+							DepositRoot:  make([]byte, fieldparams.RootLength),
+							DepositCount: 0,
+							BlockHash:    make([]byte, 32),
+							Candidates:   make([]byte, 2*fieldparams.RootLength),
+						},
+						Graffiti: make([]byte, 32),
+						Attestations: []*eth.Attestation{
+							{
+								AggregationBits: bitfield.Bitlist{0b1101},
+								Data: &eth.AttestationData{
+									BeaconBlockRoot: make([]byte, fieldparams.RootLength),
+									Source: &eth.Checkpoint{
+										Root: make([]byte, fieldparams.RootLength),
+									},
+									Target: &eth.Checkpoint{
+										Root: make([]byte, fieldparams.RootLength),
+									},
+								},
+								Signature: make([]byte, 96),
+							},
+						},
+						VoluntaryExits: []*eth.VoluntaryExit{
+							{
+								Epoch:          0,
+								ValidatorIndex: 0,
+							},
+						},
 						SyncAggregate: &eth.SyncAggregate{
 							SyncCommitteeSignature: make([]byte, fieldparams.BLSSignatureLength),
 							SyncCommitteeBits:      MockSyncComitteeBits(),
 						},
+						ExecutionPayload: &enginev1.ExecutionPayload{
+							ParentHash:    make([]byte, fieldparams.RootLength),
+							FeeRecipient:  make([]byte, 20),
+							StateRoot:     make([]byte, fieldparams.RootLength),
+							ReceiptsRoot:  make([]byte, fieldparams.RootLength),
+							LogsBloom:     make([]byte, 256),
+							PrevRandao:    make([]byte, fieldparams.RootLength),
+							BaseFeePerGas: make([]byte, fieldparams.RootLength),
+							BlockHash:     make([]byte, fieldparams.RootLength),
+							Transactions:  [][]byte{[]byte("A")},
+						},
+						Withdrawals: []*eth.Withdrawal{
+							{
+								PublicKey:      make([]byte, fieldparams.BLSPubkeyLength),
+								ValidatorIndex: 0,
+								Amount:         0,
+								InitTxHash:     make([]byte, fieldparams.RootLength),
+								Epoch:          0,
+							},
+						},
 					},
 				},
 			},
 			SigningSlot: 0,
 		}
+	case "BLINDED_BLOCK_V3":
+		return &validatorpb.SignRequest{
+			PublicKey:       make([]byte, fieldparams.BLSPubkeyLength),
+			SigningRoot:     make([]byte, fieldparams.RootLength),
+			SignatureDomain: make([]byte, 4),
+			Object: &validatorpb.SignRequest_BlindedBlockV3{
+				BlindedBlockV3: &eth.BlindedBeaconBlockBellatrix{
+					Slot:          0,
+					ProposerIndex: 0,
+					ParentRoot:    make([]byte, fieldparams.RootLength),
+					StateRoot:     make([]byte, fieldparams.RootLength),
+					Body: &eth.BlindedBeaconBlockBodyBellatrix{
+						RandaoReveal: make([]byte, 32),
+						Eth1Data: &eth.Eth1Data{
+							DepositRoot:  make([]byte, fieldparams.RootLength),
+							DepositCount: 0,
+							BlockHash:    make([]byte, 32),
+							Candidates:   make([]byte, 2*fieldparams.RootLength),
+						},
+						Graffiti: make([]byte, 32),
+						Attestations: []*eth.Attestation{
+							{
+								AggregationBits: bitfield.Bitlist{0b1101},
+								Data: &eth.AttestationData{
+									BeaconBlockRoot: make([]byte, fieldparams.RootLength),
+									Source: &eth.Checkpoint{
+										Root: make([]byte, fieldparams.RootLength),
+									},
+									Target: &eth.Checkpoint{
+										Root: make([]byte, fieldparams.RootLength),
+									},
+								},
+								Signature: make([]byte, 96),
+							},
+						},
+						VoluntaryExits: []*eth.VoluntaryExit{
+							{
+								Epoch:          0,
+								ValidatorIndex: 0,
+							},
+						},
+						SyncAggregate: &eth.SyncAggregate{
+							SyncCommitteeSignature: make([]byte, fieldparams.BLSSignatureLength),
+							SyncCommitteeBits:      MockSyncComitteeBits(),
+						},
+						ExecutionPayloadHeader: &eth.ExecutionPayloadHeader{
+							ParentHash:       make([]byte, fieldparams.RootLength),
+							FeeRecipient:     make([]byte, 20),
+							StateRoot:        make([]byte, fieldparams.RootLength),
+							ReceiptRoot:      make([]byte, fieldparams.RootLength),
+							LogsBloom:        make([]byte, 256),
+							PrevRandao:       make([]byte, fieldparams.RootLength),
+							BaseFeePerGas:    make([]byte, fieldparams.RootLength),
+							BlockHash:        make([]byte, fieldparams.RootLength),
+							TransactionsRoot: make([]byte, fieldparams.RootLength),
+						},
+						Withdrawals: []*eth.Withdrawal{
+							{
+								PublicKey:      make([]byte, fieldparams.BLSPubkeyLength),
+								ValidatorIndex: 0,
+								Amount:         0,
+								InitTxHash:     make([]byte, fieldparams.RootLength),
+								Epoch:          0,
+							},
+						},
+					},
+				},
+			},
+			SigningSlot: 0,
+		}
+	case "PREVOTE":
+		return &validatorpb.SignRequest{
+			PublicKey:       make([]byte, fieldparams.BLSPubkeyLength),
+			SigningRoot:     make([]byte, fieldparams.RootLength),
+			SignatureDomain: make([]byte, 4),
+			Object: &validatorpb.SignRequest_PrevoteData{
+				PrevoteData: &eth.PreVoteData{
+					Slot:       0,
+					Index:      0,
+					Candidates: make([]byte, 2*fieldparams.RootLength),
+				},
+			},
+			SigningSlot: 0,
+		}
 	case "RANDAO_REVEAL":
 		return &validatorpb.SignRequest{
 			PublicKey:       make([]byte, fieldparams.BLSPubkeyLength),
@@ -459,6 +632,46 @@ func MockBlockV2AltairSignRequest() *v1.BlockV2AltairSignRequest {
 	}
 }
 
+// MockBlockV2BellatrixSignRequest is a mock implementation of the BlockV2BellatrixSignRequest.
+func MockBlockV2BellatrixSignRequest() *v1.BlockV2BellatrixSignRequest {
+	return &v1.BlockV2BellatrixSignRequest{
+		Type:        "BLOCK_V2",
+		ForkInfo:    MockForkInfo(),
+		SigningRoot: hexutil.Encode(make([]byte, fieldparams.RootLength)),
+		BeaconBlock: &v1.BeaconBlockBellatrixBlockV2{
+			Version: "BELLATRIX",
+			Block:   MockBeaconBlockBellatrix(),
+		},
+	}
+}
+
+// MockBlockV2BlindedSignRequest is a mock implementation of the BlockV2BlindedSignRequest.
+func MockBlockV2BlindedSignRequest() *v1.BlockV2BlindedSignRequest {
+	return &v1.BlockV2BlindedSignRequest{
+		Type:        "BLOCK_V2",
+		ForkInfo:    MockForkInfo(),
+		SigningRoot: hexutil.Encode(make([]byte, fieldparams.RootLength)),
+		BeaconBlock: &v1.BlindedBeaconBlockBlockV2{
+			Version: "BELLATRIX",
+			Block:   MockBlindedBeaconBlockBellatrix(),
+		},
+	}
+}
+
+// MockPrevoteSignRequest is a mock implementation of the PrevoteSignRequest.
+func MockPrevoteSignRequest() *v1.PrevoteSignRequest {
+	return &v1.PrevoteSignRequest{
+		Type:        "PREVOTE",
+		ForkInfo:    MockForkInfo(),
+		SigningRoot: hexutil.Encode(make([]byte, fieldparams.RootLength)),
+		Prevote: &v1.PrevoteData{
+			Slot:       "0",
+			Index:      "0",
+			Candidates: hexutil.Encode(make([]byte, 2*fieldparams.RootLength)),
+		},
+	}
+}
+
 // MockRandaoRevealSignRequest is a mock implementation of the RandaoRevealSignRequest.
 func MockRandaoRevealSignRequest() *v1.RandaoRevealSignRequest {
 	return &v1.RandaoRevealSignRequest{
@@ -648,10 +861,131 @@ func MockBeaconBlockAltair() *v1.BeaconBlockAltair {
 				SyncCommitteeSignature: hexutil.Encode(make([]byte, fieldparams.BLSSignatureLength)),
 				SyncCommitteeBits:      hexutil.Encode(MockSyncComitteeBits()),
 			},
+			Withdrawals: []*v1.Withdrawal{
+				MockWithdrawal(),
+			},
 		},
 	}
 }
 
+func MockBeaconBlockBellatrix() *v1.BeaconBlockBellatrix {
+	return &v1.BeaconBlockBellatrix{
+		Slot:          "0",
+		ProposerIndex: "0",
+		ParentRoot:    hexutil.Encode(make([]byte, fieldparams.RootLength)),
+		StateRoot:     hexutil.Encode(make([]byte, fieldparams.RootLength)),
+		Body: &v1.BeaconBlockBodyBellatrix{
+			RandaoReveal: hexutil.Encode(make([]byte, 32)),
+			Eth1Data: &v1.Eth1Data{
+				DepositRoot:  hexutil.Encode(make([]byte, fieldparams.RootLength)),
+				DepositCount: "0",
+				BlockHash:    hexutil.Encode(make([]byte, 32)),
+				Candidates:   hexutil.Encode(make([]byte, 2*fieldparams.RootLength)),
+			},
+			Graffiti:          hexutil.Encode(make([]byte, 32)),
+			ProposerSlashings: []*v1.ProposerSlashing{},
+			AttesterSlashings: []*v1.AttesterSlashing{},
+			Attestations: []*v1.Attestation{
+				MockAttestation(),
+			},
+			Deposits: []*v1.Deposit{},
+			VoluntaryExits: []*v1.VoluntaryExit{
+				{
+					Epoch:          "0",
+					ValidatorIndex: "0",
+				},
+			},
+			SyncAggregate: &v1.SyncAggregate{
+				SyncCommitteeSignature: hexutil.Encode(make([]byte, fieldparams.BLSSignatureLength)),
+				SyncCommitteeBits:      hexutil.Encode(MockSyncComitteeBits()),
+			},
+			ExecutionPayload: &v1.ExecutionPayload{
+				ParentHash:    hexutil.Encode(make([]byte, fieldparams.RootLength)),
+				FeeRecipient:  hexutil.Encode(make([]byte, 20)),
+				StateRoot:     hexutil.Encode(make([]byte, fieldparams.RootLength)),
+				ReceiptsRoot:  hexutil.Encode(make([]byte, fieldparams.RootLength)),
+				LogsBloom:     hexutil.Encode(make([]byte, 256)),
+				PrevRandao:    hexutil.Encode(make([]byte, fieldparams.RootLength)),
+				BlockNumber:   "0",
+				GasLimit:      "0",
+				GasUsed:       "0",
+				Timestamp:     "0",
+				ExtraData:     hexutil.Encode(nil),
+				BaseFeePerGas: hexutil.Encode(make([]byte, fieldparams.RootLength)),
+				BlockHash:     hexutil.Encode(make([]byte, fieldparams.RootLength)),
+				Transactions:  []string{"0x41"},
+			},
+			Withdrawals: []*v1.Withdrawal{
+				MockWithdrawal(),
+			},
+		},
+	}
+}
+
+func MockBlindedBeaconBlockBellatrix() *v1.BlindedBeaconBlockBellatrix {
+	return &v1.BlindedBeaconBlockBellatrix{
+		Slot:          "0",
+		ProposerIndex: "0",
+		ParentRoot:    hexutil.Encode(make([]byte, fieldparams.RootLength)),
+		StateRoot:     hexutil.Encode(make([]byte, fieldparams.RootLength)),
+		Body: &v1.BlindedBeaconBlockBodyBellatrix{
+			RandaoReveal: hexutil.Encode(make([]byte, 32)),
+			Eth1Data: &v1.Eth1Data{
+				DepositRoot:  hexutil.Encode(make([]byte, fieldparams.RootLength)),
+				DepositCount: "0",
+				BlockHash:    hexutil.Encode(make([]byte, 32)),
+				Candidates:   hexutil.Encode(make([]byte, 2*fieldparams.RootLength)),
+			},
+			Graffiti:          hexutil.Encode(make([]byte, 32)),
+			ProposerSlashings: []*v1.ProposerSlashing{},
+			AttesterSlashings: []*v1.AttesterSlashing{},
+			Attestations: []*v1.Attestation{
+				MockAttestation(),
+			},
+			Deposits: []*v1.Deposit{},
+			VoluntaryExits: []*v1.VoluntaryExit{
+				{
+					Epoch:          "0",
+					ValidatorIndex: "0",
+				},
+			},
+			SyncAggregate: &v1.SyncAggregate{
+				SyncCommitteeSignature: hexutil.Encode(make([]byte, fieldparams.BLSSignatureLength)),
+				SyncCommitteeBits:      hexutil.Encode(MockSyncComitteeBits()),
+			},
+			ExecutionPayloadHeader: &v1.ExecutionPayloadHeader{
+				ParentHash:       hexutil.Encode(make([]byte, fieldparams.RootLength)),
+				FeeRecipient:     hexutil.Encode(make([]byte, 20)),
+				StateRoot:        hexutil.Encode(make([]byte, fieldparams.RootLength)),
+				ReceiptsRoot:     hexutil.Encode(make([]byte, fieldparams.RootLength)),
+				LogsBloom:        hexutil.Encode(make([]byte, 256)),
+				PrevRandao:       hexutil.Encode(make([]byte, fieldparams.RootLength)),
+				BlockNumber:      "0",
+				GasLimit:         "0",
+				GasUsed:          "0",
+				Timestamp:        "0",
+				ExtraData:        hexutil.Encode(nil),
+				BaseFeePerGas:    hexutil.Encode(make([]byte, fieldparams.RootLength)),
+				BlockHash:        hexutil.Encode(make([]byte, fieldparams.RootLength)),
+				TransactionsRoot: hexutil.Encode(make([]byte, fieldparams.RootLength)),
+			},
+			Withdrawals: []*v1.Withdrawal{
+				MockWithdrawal(),
+			},
+		},
+	}
+}
+
+func MockWithdrawal() *v1.Withdrawal {
+	return &v1.Withdrawal{
+		PublicKey:      hexutil.Encode(make([]byte, fieldparams.BLSPubkeyLength)),
+		Epoch:          "0",
+		ValidatorIndex: "0",
+		Amount:         "0",
+		InitTxHash:     hexutil.Encode(make([]byte, fieldparams.RootLength)),
+	}
+}
+
 func MockBeaconBlockBody() *v1.BeaconBlockBody {
 	return &v1.BeaconBlockBody{
 		RandaoReveal: hexutil.Encode(make([]byte, 32)),
@@ -713,6 +1047,9 @@ func MockBeaconBlockBody() *v1.BeaconBlockBody {
 				ValidatorIndex: "0",
 			},
 		},
+		Withdrawals: []*v1.Withdrawal{
+			MockWithdrawal(),
+		},
 	}
 }
 
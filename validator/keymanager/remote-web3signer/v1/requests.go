@@ -140,6 +140,62 @@ func GetBlockV2AltairSignRequest(request *validatorpb.SignRequest, genesisValida
 	}, nil
 }
 
+// GetBlockV2BellatrixSignRequest maps the request for signing type BLOCK_V2 of bellatrix block.
+func GetBlockV2BellatrixSignRequest(request *validatorpb.SignRequest, genesisValidatorsRoot []byte) (*BlockV2BellatrixSignRequest, error) {
+	beaconBlockV3, ok := request.Object.(*validatorpb.SignRequest_BlockV3)
+	if !ok {
+		return nil, errors.New("failed to cast request object to block v3")
+	}
+	if beaconBlockV3 == nil {
+		return nil, errors.New("invalid sign request: BeaconBlock is nil")
+	}
+	fork, err := MapForkInfo(request.SigningSlot, genesisValidatorsRoot)
+	if err != nil {
+		return nil, err
+	}
+	beaconBlockBellatrix, err := MapBeaconBlockBellatrix(beaconBlockV3.BlockV3)
+	if err != nil {
+		return nil, err
+	}
+	return &BlockV2BellatrixSignRequest{
+		Type:        "BLOCK_V2",
+		ForkInfo:    fork,
+		SigningRoot: hexutil.Encode(request.SigningRoot),
+		BeaconBlock: &BeaconBlockBellatrixBlockV2{
+			Version: "BELLATRIX",
+			Block:   beaconBlockBellatrix,
+		},
+	}, nil
+}
+
+// GetBlockV2BlindedSignRequest maps the request for signing type BLOCK_V2 of blinded bellatrix block.
+func GetBlockV2BlindedSignRequest(request *validatorpb.SignRequest, genesisValidatorsRoot []byte) (*BlockV2BlindedSignRequest, error) {
+	blindedBlockV3, ok := request.Object.(*validatorpb.SignRequest_BlindedBlockV3)
+	if !ok {
+		return nil, errors.New("failed to cast request object to blinded block v3")
+	}
+	if blindedBlockV3 == nil {
+		return nil, errors.New("invalid sign request: BlindedBeaconBlock is nil")
+	}
+	fork, err := MapForkInfo(request.SigningSlot, genesisValidatorsRoot)
+	if err != nil {
+		return nil, err
+	}
+	blindedBlockBellatrix, err := MapBlindedBeaconBlockBellatrix(blindedBlockV3.BlindedBlockV3)
+	if err != nil {
+		return nil, err
+	}
+	return &BlockV2BlindedSignRequest{
+		Type:        "BLOCK_V2",
+		ForkInfo:    fork,
+		SigningRoot: hexutil.Encode(request.SigningRoot),
+		BeaconBlock: &BlindedBeaconBlockBlockV2{
+			Version: "BELLATRIX",
+			Block:   blindedBlockBellatrix,
+		},
+	}, nil
+}
+
 // GetRandaoRevealSignRequest maps the request for signing type RANDAO_REVEAL.
 func GetRandaoRevealSignRequest(request *validatorpb.SignRequest, genesisValidatorsRoot []byte) (*RandaoRevealSignRequest, error) {
 	randaoReveal, ok := request.Object.(*validatorpb.SignRequest_Epoch)
@@ -263,3 +319,28 @@ func GetSyncCommitteeContributionAndProofSignRequest(request *validatorpb.SignRe
 		ContributionAndProof: contribution,
 	}, nil
 }
+
+// GetPrevoteSignRequest maps the request for signing type PREVOTE.
+func GetPrevoteSignRequest(request *validatorpb.SignRequest, genesisValidatorsRoot []byte) (*PrevoteSignRequest, error) {
+	prevote, ok := request.Object.(*validatorpb.SignRequest_PrevoteData)
+	if !ok {
+		return nil, errors.New("failed to cast request object to prevote")
+	}
+	if prevote == nil {
+		return nil, errors.New("invalid sign request: Prevote is nil")
+	}
+	fork, err := MapForkInfo(request.SigningSlot, genesisValidatorsRoot)
+	if err != nil {
+		return nil, err
+	}
+	prevoteData, err := MapPrevoteData(prevote.PrevoteData)
+	if err != nil {
+		return nil, err
+	}
+	return &PrevoteSignRequest{
+		Type:        "PREVOTE",
+		ForkInfo:    fork,
+		SigningRoot: hexutil.Encode(request.SigningRoot),
+		Prevote:     prevoteData,
+	}, nil
+}
@@ -184,6 +184,111 @@ func TestGetBlockV2AltairSignRequest(t *testing.T) {
 	}
 }
 
+func TestGetBlockV2BellatrixSignRequest(t *testing.T) {
+	type args struct {
+		request               *validatorpb.SignRequest
+		genesisValidatorsRoot []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *v1.BlockV2BellatrixSignRequest
+		wantErr bool
+	}{
+		{
+			name: "Happy Path Test",
+			args: args{
+				request:               mock.GetMockSignRequest("BLOCK_V3"),
+				genesisValidatorsRoot: make([]byte, fieldparams.RootLength),
+			},
+			want:    mock.MockBlockV2BellatrixSignRequest(),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := v1.GetBlockV2BellatrixSignRequest(tt.args.request, tt.args.genesisValidatorsRoot)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetBlockV2BellatrixSignRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetBlockV2BellatrixSignRequest() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetBlockV2BlindedSignRequest(t *testing.T) {
+	type args struct {
+		request               *validatorpb.SignRequest
+		genesisValidatorsRoot []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *v1.BlockV2BlindedSignRequest
+		wantErr bool
+	}{
+		{
+			name: "Happy Path Test",
+			args: args{
+				request:               mock.GetMockSignRequest("BLINDED_BLOCK_V3"),
+				genesisValidatorsRoot: make([]byte, fieldparams.RootLength),
+			},
+			want:    mock.MockBlockV2BlindedSignRequest(),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := v1.GetBlockV2BlindedSignRequest(tt.args.request, tt.args.genesisValidatorsRoot)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetBlockV2BlindedSignRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetBlockV2BlindedSignRequest() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetPrevoteSignRequest(t *testing.T) {
+	type args struct {
+		request               *validatorpb.SignRequest
+		genesisValidatorsRoot []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *v1.PrevoteSignRequest
+		wantErr bool
+	}{
+		{
+			name: "Happy Path Test",
+			args: args{
+				request:               mock.GetMockSignRequest("PREVOTE"),
+				genesisValidatorsRoot: make([]byte, fieldparams.RootLength),
+			},
+			want:    mock.MockPrevoteSignRequest(),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := v1.GetPrevoteSignRequest(tt.args.request, tt.args.genesisValidatorsRoot)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetPrevoteSignRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetPrevoteSignRequest() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestGetRandaoRevealSignRequest(t *testing.T) {
 	type args struct {
 		request               *validatorpb.SignRequest
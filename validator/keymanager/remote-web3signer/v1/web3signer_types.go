@@ -50,6 +50,22 @@ type BlockV2SignRequest struct {
 	BeaconBlock *BeaconBlockBlockV2 `json:"beacon_block" validate:"required"`
 }
 
+// BlockV2BellatrixSignRequest is a request object for web3signer sign api.
+type BlockV2BellatrixSignRequest struct {
+	Type        string                       `json:"type" validate:"required"`
+	ForkInfo    *ForkInfo                    `json:"fork_info" validate:"required"`
+	SigningRoot string                       `json:"signingRoot"`
+	BeaconBlock *BeaconBlockBellatrixBlockV2 `json:"beacon_block" validate:"required"`
+}
+
+// BlockV2BlindedSignRequest is a request object for web3signer sign api.
+type BlockV2BlindedSignRequest struct {
+	Type        string                     `json:"type" validate:"required"`
+	ForkInfo    *ForkInfo                  `json:"fork_info" validate:"required"`
+	SigningRoot string                     `json:"signingRoot"`
+	BeaconBlock *BlindedBeaconBlockBlockV2 `json:"beacon_block" validate:"required"`
+}
+
 // DepositSignRequest Not currently supported by Prysm.
 // DepositSignRequest is a request object for web3signer sign api.
 
@@ -93,6 +109,14 @@ type SyncCommitteeContributionAndProofSignRequest struct {
 	ContributionAndProof *ContributionAndProof `json:"contribution_and_proof" validate:"required"`
 }
 
+// PrevoteSignRequest is a request object for web3signer sign api.
+type PrevoteSignRequest struct {
+	Type        string       `json:"type" validate:"required"`
+	ForkInfo    *ForkInfo    `json:"fork_info" validate:"required"`
+	SigningRoot string       `json:"signingRoot"`
+	Prevote     *PrevoteData `json:"prevote" validate:"required"`
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // sub properties of Sign Requests /////////////////////////////////////////////
 ////////////////////////////////////////////////////////////////////////////////
@@ -232,11 +256,13 @@ type VoluntaryExit struct {
 	ValidatorIndex string `json:"validator_index"` /* uint64 */
 }
 
-// Withdrawal a sub property of Withdrawal.
+// Withdrawal a sub property of BeaconBlockBody.
 type Withdrawal struct {
+	PublicKey      string `json:"public_key"`
 	Epoch          string `json:"epoch"`           /* uint64 */
 	ValidatorIndex string `json:"validator_index"` /* uint64 */
 	Amount         string `json:"amount"`          /* uint64 */
+	InitTxHash     string `json:"init_tx_hash"`    /* Hash32 */
 }
 
 // BeaconBlockAltairBlockV2 a sub property of BlockV2AltairSignRequest.
@@ -280,6 +306,109 @@ type BeaconBlockBlockV2 struct {
 	Block   *BeaconBlock `json:"beacon_block"`
 }
 
+// BeaconBlockBellatrixBlockV2 a sub property of BlockV2BellatrixSignRequest.
+type BeaconBlockBellatrixBlockV2 struct {
+	Version string                `json:"version"`
+	Block   *BeaconBlockBellatrix `json:"block"`
+}
+
+// BeaconBlockBellatrix a sub property of BeaconBlockBellatrixBlockV2.
+type BeaconBlockBellatrix struct {
+	Slot          string                    `json:"slot"`           /* uint64 */
+	ProposerIndex string                    `json:"proposer_index"` /* uint64 */
+	ParentRoot    string                    `json:"parent_root"`
+	StateRoot     string                    `json:"state_root"`
+	Body          *BeaconBlockBodyBellatrix `json:"body"`
+}
+
+// BeaconBlockBodyBellatrix a sub property of BeaconBlockBellatrix.
+type BeaconBlockBodyBellatrix struct {
+	RandaoReveal      string              `json:"randao_reveal"`
+	Eth1Data          *Eth1Data           `json:"eth1_data"`
+	Graffiti          string              `json:"graffiti"` /* Hash32 */
+	ProposerSlashings []*ProposerSlashing `json:"proposer_slashings"`
+	AttesterSlashings []*AttesterSlashing `json:"attester_slashings"`
+	Attestations      []*Attestation      `json:"attestations"`
+	Deposits          []*Deposit          `json:"deposits"`
+	VoluntaryExits    []*VoluntaryExit    `json:"voluntary_exits"`
+	SyncAggregate     *SyncAggregate      `json:"sync_aggregate"`
+	ExecutionPayload  *ExecutionPayload   `json:"execution_payload"`
+	Withdrawals       []*Withdrawal       `json:"withdrawals"`
+}
+
+// ExecutionPayload a sub property of BeaconBlockBodyBellatrix.
+type ExecutionPayload struct {
+	ParentHash    string   `json:"parent_hash"`   /* Hash32 */
+	FeeRecipient  string   `json:"fee_recipient"` /* 20 bytes */
+	StateRoot     string   `json:"state_root"`    /* Hash32 */
+	ReceiptsRoot  string   `json:"receipts_root"` /* Hash32 */
+	LogsBloom     string   `json:"logs_bloom"`    /* 256 bytes */
+	PrevRandao    string   `json:"prev_randao"`   /* Hash32 */
+	BlockNumber   string   `json:"block_number"`  /* uint64 */
+	GasLimit      string   `json:"gas_limit"`     /* uint64 */
+	GasUsed       string   `json:"gas_used"`      /* uint64 */
+	Timestamp     string   `json:"timestamp"`     /* uint64 */
+	ExtraData     string   `json:"extra_data"`
+	BaseFeePerGas string   `json:"base_fee_per_gas"` /* Hash32 */
+	BlockHash     string   `json:"block_hash"`       /* Hash32 */
+	Transactions  []string `json:"transactions"`
+}
+
+// BlindedBeaconBlockBlockV2 a sub property of BlockV2BlindedSignRequest.
+type BlindedBeaconBlockBlockV2 struct {
+	Version string                       `json:"version"`
+	Block   *BlindedBeaconBlockBellatrix `json:"block"`
+}
+
+// BlindedBeaconBlockBellatrix a sub property of BlindedBeaconBlockBlockV2.
+type BlindedBeaconBlockBellatrix struct {
+	Slot          string                           `json:"slot"`           /* uint64 */
+	ProposerIndex string                           `json:"proposer_index"` /* uint64 */
+	ParentRoot    string                           `json:"parent_root"`
+	StateRoot     string                           `json:"state_root"`
+	Body          *BlindedBeaconBlockBodyBellatrix `json:"body"`
+}
+
+// BlindedBeaconBlockBodyBellatrix a sub property of BlindedBeaconBlockBellatrix.
+type BlindedBeaconBlockBodyBellatrix struct {
+	RandaoReveal           string                  `json:"randao_reveal"`
+	Eth1Data               *Eth1Data               `json:"eth1_data"`
+	Graffiti               string                  `json:"graffiti"` /* Hash32 */
+	ProposerSlashings      []*ProposerSlashing     `json:"proposer_slashings"`
+	AttesterSlashings      []*AttesterSlashing     `json:"attester_slashings"`
+	Attestations           []*Attestation          `json:"attestations"`
+	Deposits               []*Deposit              `json:"deposits"`
+	VoluntaryExits         []*VoluntaryExit        `json:"voluntary_exits"`
+	SyncAggregate          *SyncAggregate          `json:"sync_aggregate"`
+	ExecutionPayloadHeader *ExecutionPayloadHeader `json:"execution_payload_header"`
+	Withdrawals            []*Withdrawal           `json:"withdrawals"`
+}
+
+// ExecutionPayloadHeader a sub property of BlindedBeaconBlockBodyBellatrix.
+type ExecutionPayloadHeader struct {
+	ParentHash       string `json:"parent_hash"`   /* Hash32 */
+	FeeRecipient     string `json:"fee_recipient"` /* 20 bytes */
+	StateRoot        string `json:"state_root"`    /* Hash32 */
+	ReceiptsRoot     string `json:"receipts_root"` /* Hash32 */
+	LogsBloom        string `json:"logs_bloom"`    /* 256 bytes */
+	PrevRandao       string `json:"prev_randao"`   /* Hash32 */
+	BlockNumber      string `json:"block_number"`  /* uint64 */
+	GasLimit         string `json:"gas_limit"`     /* uint64 */
+	GasUsed          string `json:"gas_used"`      /* uint64 */
+	Timestamp        string `json:"timestamp"`     /* uint64 */
+	ExtraData        string `json:"extra_data"`
+	BaseFeePerGas    string `json:"base_fee_per_gas"`  /* Hash32 */
+	BlockHash        string `json:"block_hash"`        /* Hash32 */
+	TransactionsRoot string `json:"transactions_root"` /* Hash32 */
+}
+
+// PrevoteData a sub property of PrevoteSignRequest.
+type PrevoteData struct {
+	Slot       string `json:"slot"`       /* uint64 */
+	Index      string `json:"index"`      /* uint64 */
+	Candidates string `json:"candidates"` /* concatenated Hash32 of spines */
+}
+
 // RandaoReveal a sub property of RandaoRevealSignRequest.
 type RandaoReveal struct {
 	Epoch string `json:"epoch"` /* uint64 */
@@ -16,6 +16,7 @@ import (
 	"strings"
 	"sync"
 	"syscall"
+	"time"
 
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/pkg/errors"
@@ -374,6 +375,14 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 	endpoint := c.cliCtx.String(flags.BeaconRPCProviderFlag.Name)
 	dataDir := c.cliCtx.String(cmd.DataDirFlag.Name)
 	logValidatorBalances := !c.cliCtx.Bool(flags.DisablePenaltyRewardLogFlag.Name)
+	beaconApiEndpoint := c.cliCtx.String(flags.BeaconRESTApiProviderFlag.Name)
+	if beaconApiEndpoint != "" {
+		if features.Get().RemoteSlasherProtection || features.Get().EnableDoppelGanger {
+			return errors.New("remote slasher protection and doppelganger check are not supported over the beacon REST API")
+		}
+		log.Warn("Validator rewards and penalties logging is not supported over the beacon REST API and is disabled")
+		logValidatorBalances = false
+	}
 	emitAccountMetrics := !c.cliCtx.Bool(flags.DisableAccountMetricsFlag.Name)
 	cert := c.cliCtx.String(flags.CertFlag.Name)
 	graffiti := c.cliCtx.String(flags.GraffitiFlag.Name)
@@ -418,6 +427,8 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 		GrpcMaxCallRecvMsgSizeFlag: maxCallRecvMsgSize,
 		GrpcRetriesFlag:            grpcRetries,
 		GrpcRetryDelay:             grpcRetryDelay,
+		BeaconApiEndpoint:          beaconApiEndpoint,
+		BeaconApiTimeout:           time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second,
 		GrpcHeadersFlag:            c.cliCtx.String(flags.GrpcHeadersFlag.Name),
 		ValDB:                      c.db,
 		UseWeb:                     c.cliCtx.Bool(flags.EnableWebFlag.Name),
@@ -55,6 +55,10 @@ func ExportStandardProtectionJSON(
 	if err != nil {
 		return nil, errors.Wrap(err, "could not retrieve attested public keys from DB")
 	}
+	prevotedPublicKeys, err := validatorDB.PrevotedPublicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve prevoted public keys from DB")
+	}
 	dataByPubKey := make(map[[fieldparams.BLSPubkeyLength]byte]*format.ProtectionData)
 
 	// Extract the signed proposals by public key.
@@ -113,6 +117,35 @@ func ExportStandardProtectionJSON(
 		}
 	}
 
+	// Extract the signed prevotes by public key.
+	bar = progress.InitializeProgressBar(
+		len(prevotedPublicKeys), "Extracting signed prevotes by validator public key",
+	)
+	for _, pubKey := range prevotedPublicKeys {
+		if _, ok := filteredKeysMap[string(pubKey[:])]; len(filteredKeys) > 0 && !ok {
+			continue
+		}
+		pubKeyHex, err := pubKeyToHexString(pubKey[:])
+		if err != nil {
+			return nil, errors.Wrap(err, "could not convert public key to hex string")
+		}
+		signedPrevotes, err := signedPrevotesByPubKey(ctx, validatorDB, pubKey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not retrieve signed prevotes for public key %s", pubKeyHex)
+		}
+		if _, ok := dataByPubKey[pubKey]; ok {
+			dataByPubKey[pubKey].SignedPrevotes = signedPrevotes
+		} else {
+			dataByPubKey[pubKey] = &format.ProtectionData{
+				Pubkey:         pubKeyHex,
+				SignedPrevotes: signedPrevotes,
+			}
+		}
+		if err := bar.Add(1); err != nil {
+			return nil, err
+		}
+	}
+
 	// Next we turn our map into a slice as expected by the EIP-3076 JSON standard.
 	dataList := make([]*format.ProtectionData, 0)
 	for _, item := range dataByPubKey {
@@ -198,3 +231,25 @@ func signedBlocksByPubKey(ctx context.Context, validatorDB db.Database, pubKey [
 	}
 	return signedBlocks, nil
 }
+
+func signedPrevotesByPubKey(ctx context.Context, validatorDB db.Database, pubKey [fieldparams.BLSPubkeyLength]byte) ([]*format.SignedPrevote, error) {
+	prevoteHistory, err := validatorDB.PrevoteHistoryForPubKey(ctx, pubKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get prevote history for public key: %#x", pubKey)
+	}
+	signedPrevotes := make([]*format.SignedPrevote, 0, len(prevoteHistory))
+	for _, prevote := range prevoteHistory {
+		if ctx.Err() != nil {
+			return nil, errors.Wrap(ctx.Err(), "context canceled (prevotes by pubKey)")
+		}
+		signingRootHex, err := rootToHexString(prevote.SigningRoot)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not convert signing root to hex string")
+		}
+		signedPrevotes = append(signedPrevotes, &format.SignedPrevote{
+			Slot:        fmt.Sprintf("%d", prevote.Slot),
+			SigningRoot: signingRootHex,
+		})
+	}
+	return signedPrevotes, nil
+}
@@ -211,3 +211,35 @@ func Test_getSignedBlocksByPubKey(t *testing.T) {
 		assert.DeepEqual(t, blk, signedBlocks[i])
 	}
 }
+
+func Test_getSignedPrevotesByPubKey(t *testing.T) {
+	pubKeys := [][fieldparams.BLSPubkeyLength]byte{
+		{1},
+	}
+	ctx := context.Background()
+	validatorDB := dbtest.SetupDB(t, pubKeys)
+
+	// No prevote history will return empty.
+	signedPrevotes, err := signedPrevotesByPubKey(ctx, validatorDB, pubKeys[0])
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(signedPrevotes))
+
+	dummyRoot1 := [32]byte{1}
+	require.NoError(t, validatorDB.SavePrevoteHistoryForSlot(ctx, pubKeys[0], 2, dummyRoot1[:]))
+	dummyRoot2 := [32]byte{2}
+	require.NoError(t, validatorDB.SavePrevoteHistoryForSlot(ctx, pubKeys[0], 4, dummyRoot2[:]))
+
+	signedPrevotes, err = signedPrevotesByPubKey(ctx, validatorDB, pubKeys[0])
+	require.NoError(t, err)
+	wanted := []*format.SignedPrevote{
+		{
+			Slot:        "2",
+			SigningRoot: fmt.Sprintf("%#x", dummyRoot1),
+		},
+		{
+			Slot:        "4",
+			SigningRoot: fmt.Sprintf("%#x", dummyRoot2),
+		},
+	}
+	assert.DeepEqual(t, wanted, signedPrevotes)
+}
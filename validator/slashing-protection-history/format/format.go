@@ -19,6 +19,7 @@ type ProtectionData struct {
 	Pubkey             string               `json:"pubkey"`
 	SignedBlocks       []*SignedBlock       `json:"signed_blocks"`
 	SignedAttestations []*SignedAttestation `json:"signed_attestations"`
+	SignedPrevotes     []*SignedPrevote     `json:"signed_prevotes,omitempty"`
 }
 
 // SignedAttestation in the standard slashing protection format file, including
@@ -35,3 +36,11 @@ type SignedBlock struct {
 	Slot        string `json:"slot"`
 	SigningRoot string `json:"signing_root,omitempty"`
 }
+
+// SignedPrevote in the slashing protection format, including a slot
+// and an optional signing root. Prevotes are a Waterfall extension
+// of the standard format.
+type SignedPrevote struct {
+	Slot        string `json:"slot"`
+	SigningRoot string `json:"signing_root,omitempty"`
+}
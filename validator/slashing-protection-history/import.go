@@ -11,6 +11,7 @@ import (
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	fieldparams "gitlab.waterfall.network/waterfall/protocol/coordinator/config/fieldparams"
+	"gitlab.waterfall.network/waterfall/protocol/coordinator/config/params"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/encoding/bytesutil"
 	ethpb "gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1"
 	"gitlab.waterfall.network/waterfall/protocol/coordinator/proto/prysm/v1alpha1/slashings"
@@ -52,9 +53,14 @@ func ImportStandardProtectionJSON(ctx context.Context, validatorDB db.Database,
 	if err != nil {
 		return errors.Wrap(err, "could not parse unique entries for attestations by public key")
 	}
+	signedPrevotesByPubKey, err := parsePrevotesForUniquePublicKeys(interchangeJSON.Data)
+	if err != nil {
+		return errors.Wrap(err, "could not parse unique entries for prevotes by public key")
+	}
 
 	attestingHistoryByPubKey := make(map[[fieldparams.BLSPubkeyLength]byte][]*kv.AttestationRecord)
 	proposalHistoryByPubKey := make(map[[fieldparams.BLSPubkeyLength]byte]kv.ProposalHistoryForPubkey)
+	prevoteHistoryByPubKey := make(map[[fieldparams.BLSPubkeyLength]byte][]*kv.Prevote)
 	for pubKey, signedBlocks := range signedBlocksByPubKey {
 		// Transform the processed signed blocks data from the JSON
 		// file into the internal Prysm representation of proposal history.
@@ -75,6 +81,16 @@ func ImportStandardProtectionJSON(ctx context.Context, validatorDB db.Database,
 		attestingHistoryByPubKey[pubKey] = historicalAtt
 	}
 
+	for pubKey, signedPrevotes := range signedPrevotesByPubKey {
+		// Transform the processed signed prevote data from the JSON
+		// file into the internal representation of prevote history.
+		prevoteHistory, err := transformSignedPrevotes(signedPrevotes)
+		if err != nil {
+			return errors.Wrapf(err, "could not parse signed prevotes in JSON file for key %#x", pubKey)
+		}
+		prevoteHistoryByPubKey[pubKey] = prevoteHistory
+	}
+
 	// We validate and filter out public keys parsed from JSON to ensure we are
 	// not importing those which are slashable with respect to other data within the same JSON.
 	slashableProposerKeys := filterSlashablePubKeysFromBlocks(ctx, proposalHistoryByPubKey)
@@ -84,8 +100,16 @@ func ImportStandardProtectionJSON(ctx context.Context, validatorDB db.Database,
 	if err != nil {
 		return errors.Wrap(err, "could not filter slashable attester public keys from JSON data")
 	}
+	slashablePrevoterKeys, err := filterSlashablePubKeysFromPrevotes(ctx, validatorDB, prevoteHistoryByPubKey)
+	if err != nil {
+		return errors.Wrap(err, "could not filter slashable prevoter public keys from JSON data")
+	}
 
-	slashablePublicKeys := make([][fieldparams.BLSPubkeyLength]byte, 0, len(slashableAttesterKeys)+len(slashableProposerKeys))
+	slashablePublicKeys := make(
+		[][fieldparams.BLSPubkeyLength]byte,
+		0,
+		len(slashableAttesterKeys)+len(slashableProposerKeys)+len(slashablePrevoterKeys),
+	)
 	for _, pubKey := range slashableProposerKeys {
 		delete(proposalHistoryByPubKey, pubKey)
 		slashablePublicKeys = append(slashablePublicKeys, pubKey)
@@ -94,6 +118,10 @@ func ImportStandardProtectionJSON(ctx context.Context, validatorDB db.Database,
 		delete(attestingHistoryByPubKey, pubKey)
 		slashablePublicKeys = append(slashablePublicKeys, pubKey)
 	}
+	for _, pubKey := range slashablePrevoterKeys {
+		delete(prevoteHistoryByPubKey, pubKey)
+		slashablePublicKeys = append(slashablePublicKeys, pubKey)
+	}
 
 	if err := validatorDB.SaveEIPImportBlacklistedPublicKeys(ctx, slashablePublicKeys); err != nil {
 		return errors.Wrap(err, "could not save slashable public keys to database")
@@ -116,6 +144,20 @@ func ImportStandardProtectionJSON(ctx context.Context, validatorDB db.Database,
 			}
 		}
 	}
+	for pubKey, prevotes := range prevoteHistoryByPubKey {
+		bar := initializeProgressBar(
+			len(prevotes),
+			fmt.Sprintf("Importing prevotes for validator public key %#x", bytesutil.Trunc(pubKey[:])),
+		)
+		for _, prevote := range prevotes {
+			if err := bar.Add(1); err != nil {
+				log.WithError(err).Debug("Could not increase progress bar")
+			}
+			if err = validatorDB.SavePrevoteHistoryForSlot(ctx, pubKey, prevote.Slot, prevote.SigningRoot); err != nil {
+				return errors.Wrap(err, "could not save prevote history from imported JSON to database")
+			}
+		}
+	}
 	bar := initializeProgressBar(
 		len(attestingHistoryByPubKey),
 		"Importing attesting history for validator public keys",
@@ -238,6 +280,25 @@ func parseAttestationsForUniquePublicKeys(data []*format.ProtectionData) (map[[f
 	return signedAttestationsByPubKey, nil
 }
 
+// We create a map of pubKey -> []*SignedPrevote, appending the signed prevotes
+// of every entry for a public key the same way it is done for signed blocks.
+func parsePrevotesForUniquePublicKeys(data []*format.ProtectionData) (map[[fieldparams.BLSPubkeyLength]byte][]*format.SignedPrevote, error) {
+	signedPrevotesByPubKey := make(map[[fieldparams.BLSPubkeyLength]byte][]*format.SignedPrevote)
+	for _, validatorData := range data {
+		pubKey, err := PubKeyFromHex(validatorData.Pubkey)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid public key: %w", validatorData.Pubkey, err)
+		}
+		for _, sPrevote := range validatorData.SignedPrevotes {
+			if sPrevote == nil {
+				continue
+			}
+			signedPrevotesByPubKey[pubKey] = append(signedPrevotesByPubKey[pubKey], sPrevote)
+		}
+	}
+	return signedPrevotesByPubKey, nil
+}
+
 func filterSlashablePubKeysFromBlocks(_ context.Context, historyByPubKey map[[fieldparams.BLSPubkeyLength]byte]kv.ProposalHistoryForPubkey) [][fieldparams.BLSPubkeyLength]byte {
 	// Given signing roots are optional in the EIP standard, we behave as follows:
 	// For a given block:
@@ -314,6 +375,40 @@ func filterSlashablePubKeysFromAttestations(
 	return slashablePubKeys, nil
 }
 
+func filterSlashablePubKeysFromPrevotes(
+	ctx context.Context,
+	validatorDB db.Database,
+	historyByPubKey map[[fieldparams.BLSPubkeyLength]byte][]*kv.Prevote,
+) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	// As signing roots are optional, two prevotes for the same slot are considered
+	// slashable if either of the signing roots is missing or if they are different.
+	// This applies both within the JSON file and with respect to our database.
+	zeroHash := params.BeaconConfig().ZeroHash
+	isConflicting := func(a, b []byte) bool {
+		return bytes.Equal(a, zeroHash[:]) || bytes.Equal(b, zeroHash[:]) || !bytes.Equal(a, b)
+	}
+	slashablePubKeys := make([][fieldparams.BLSPubkeyLength]byte, 0)
+	for pubKey, prevotes := range historyByPubKey {
+		seenSigningRootsBySlot := make(map[types.Slot][]byte)
+		for _, prevote := range prevotes {
+			if signingRoot, ok := seenSigningRootsBySlot[prevote.Slot]; ok && isConflicting(signingRoot, prevote.SigningRoot) {
+				slashablePubKeys = append(slashablePubKeys, pubKey)
+				break
+			}
+			existingRoot, exists, err := validatorDB.PrevoteHistoryForSlot(ctx, pubKey, prevote.Slot)
+			if err != nil {
+				return nil, err
+			}
+			if exists && isConflicting(existingRoot[:], prevote.SigningRoot) {
+				slashablePubKeys = append(slashablePubKeys, pubKey)
+				break
+			}
+			seenSigningRootsBySlot[prevote.Slot] = prevote.SigningRoot
+		}
+	}
+	return slashablePubKeys, nil
+}
+
 func transformSignedBlocks(_ context.Context, signedBlocks []*format.SignedBlock) (*kv.ProposalHistoryForPubkey, error) {
 	proposals := make([]kv.Proposal, len(signedBlocks))
 	for i, proposal := range signedBlocks {
@@ -339,6 +434,29 @@ func transformSignedBlocks(_ context.Context, signedBlocks []*format.SignedBlock
 	}, nil
 }
 
+func transformSignedPrevotes(signedPrevotes []*format.SignedPrevote) ([]*kv.Prevote, error) {
+	prevotes := make([]*kv.Prevote, len(signedPrevotes))
+	for i, prevote := range signedPrevotes {
+		slot, err := SlotFromString(prevote.Slot)
+		if err != nil {
+			return nil, fmt.Errorf("%d is not a valid slot: %w", slot, err)
+		}
+		var signingRoot [32]byte
+		// Signing roots are optional in the JSON file.
+		if prevote.SigningRoot != "" {
+			signingRoot, err = RootFromHex(prevote.SigningRoot)
+			if err != nil {
+				return nil, fmt.Errorf("%#x is not a valid root: %w", signingRoot, err)
+			}
+		}
+		prevotes[i] = &kv.Prevote{
+			Slot:        slot,
+			SigningRoot: signingRoot[:],
+		}
+	}
+	return prevotes, nil
+}
+
 func transformSignedAttestations(pubKey [fieldparams.BLSPubkeyLength]byte, atts []*format.SignedAttestation) ([]*kv.AttestationRecord, error) {
 	historicalAtts := make([]*kv.AttestationRecord, 0)
 	for _, attestation := range atts {
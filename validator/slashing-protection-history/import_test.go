@@ -1068,3 +1068,71 @@ func Test_filterSlashablePubKeysFromAttestations(t *testing.T) {
 		})
 	}
 }
+
+func Test_filterSlashablePubKeysFromPrevotes(t *testing.T) {
+	ctx := context.Background()
+	pubKeys := [][fieldparams.BLSPubkeyLength]byte{{1}, {2}, {3}, {4}}
+	validatorDB := dbtest.SetupDB(t, pubKeys)
+	root := func(b byte) []byte {
+		r := [32]byte{b}
+		return r[:]
+	}
+	// Key {4} already signed a prevote at slot 1 with a different root.
+	require.NoError(t, validatorDB.SavePrevoteHistoryForSlot(ctx, pubKeys[3], 1, root(9)))
+
+	given := map[[fieldparams.BLSPubkeyLength]byte][]*kv.Prevote{
+		// Same slot and same signing root is not slashable.
+		{1}: {
+			{Slot: 1, SigningRoot: root(1)},
+			{Slot: 1, SigningRoot: root(1)},
+			{Slot: 2, SigningRoot: root(2)},
+		},
+		// Same slot with different signing roots is slashable.
+		{2}: {
+			{Slot: 1, SigningRoot: root(1)},
+			{Slot: 1, SigningRoot: root(2)},
+		},
+		// Same slot with a missing signing root is slashable.
+		{3}: {
+			{Slot: 1, SigningRoot: make([]byte, 32)},
+			{Slot: 1, SigningRoot: make([]byte, 32)},
+		},
+		// Conflicts with the prevote history in the database.
+		{4}: {
+			{Slot: 1, SigningRoot: root(1)},
+		},
+	}
+	slashableKeys, err := filterSlashablePubKeysFromPrevotes(ctx, validatorDB, given)
+	require.NoError(t, err)
+	wanted := map[[fieldparams.BLSPubkeyLength]byte]bool{
+		{2}: true,
+		{3}: true,
+		{4}: true,
+	}
+	require.Equal(t, len(wanted), len(slashableKeys))
+	for _, pk := range slashableKeys {
+		ok := wanted[pk]
+		require.Equal(t, true, ok)
+	}
+}
+
+func Test_parseUniqueSignedPrevotesByPubKey(t *testing.T) {
+	pubKeys, err := valtest.CreateRandomPubKeys(1)
+	require.NoError(t, err)
+	pubKeyHex := fmt.Sprintf("%#x", pubKeys[0])
+	data := []*format.ProtectionData{
+		{
+			Pubkey:         pubKeyHex,
+			SignedPrevotes: []*format.SignedPrevote{{Slot: "1"}, nil},
+		},
+		{
+			Pubkey:         pubKeyHex,
+			SignedPrevotes: []*format.SignedPrevote{{Slot: "2"}},
+		},
+	}
+	got, err := parsePrevotesForUniquePublicKeys(data)
+	require.NoError(t, err)
+	require.DeepEqual(t, map[[fieldparams.BLSPubkeyLength]byte][]*format.SignedPrevote{
+		pubKeys[0]: {{Slot: "1"}, {Slot: "2"}},
+	}, got)
+}
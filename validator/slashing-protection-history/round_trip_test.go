@@ -373,3 +373,46 @@ func TestStore_ImportInterchangeData_BadFormat_PreventsDBWrites(t *testing.T) {
 		)
 	}
 }
+
+func TestImportExport_RoundTrip_Prevotes(t *testing.T) {
+	ctx := context.Background()
+	publicKeys, err := slashtest.CreateRandomPubKeys(2)
+	require.NoError(t, err)
+	validatorDB := dbtest.SetupDB(t, publicKeys)
+
+	attestingHistory, proposalHistory := slashtest.MockAttestingAndProposalHistories(publicKeys)
+	wanted, err := slashtest.MockSlashingProtectionJSON(publicKeys, attestingHistory, proposalHistory)
+	require.NoError(t, err)
+	for i, item := range wanted.Data {
+		item.SignedPrevotes = []*format.SignedPrevote{
+			{
+				Slot:        fmt.Sprintf("%d", i+1),
+				SigningRoot: fmt.Sprintf("%#x", [32]byte{byte(i + 1)}),
+			},
+		}
+	}
+
+	blob, err := json.Marshal(wanted)
+	require.NoError(t, err)
+	require.NoError(t, history.ImportStandardProtectionJSON(ctx, validatorDB, bytes.NewBuffer(blob)))
+
+	for i, pubKey := range publicKeys {
+		signingRoot, exists, err := validatorDB.PrevoteHistoryForSlot(ctx, pubKey, types.Slot(i+1))
+		require.NoError(t, err)
+		require.Equal(t, true, exists)
+		require.Equal(t, [32]byte{byte(i + 1)}, signingRoot)
+	}
+
+	eipStandard, err := history.ExportStandardProtectionJSON(ctx, validatorDB)
+	require.NoError(t, err)
+	dataByPubKey := make(map[string]*format.ProtectionData)
+	for _, item := range wanted.Data {
+		dataByPubKey[item.Pubkey] = item
+	}
+	require.Equal(t, len(wanted.Data), len(eipStandard.Data))
+	for _, item := range eipStandard.Data {
+		want, ok := dataByPubKey[item.Pubkey]
+		require.Equal(t, true, ok)
+		require.DeepEqual(t, want.SignedPrevotes, item.SignedPrevotes)
+	}
+}